@@ -0,0 +1,119 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+var (
+	// TibiaDataCharactersBatchMaxSize is the maximum number of names of a characters batch request
+	TibiaDataCharactersBatchMaxSize = 200
+
+	// TibiaDataFanOutConcurrency is the number of concurrent requests to tibia.com of one request to the API
+	TibiaDataFanOutConcurrency = 5
+)
+
+// CharactersRequest is the body of a characters batch request
+type CharactersRequest struct {
+	Names []string `json:"names"` // The character names.
+}
+
+// Child of CharactersResponse
+type CharactersCharacter struct {
+	Name      string     `json:"name"`                // The requested character name.
+	Character *Character `json:"character,omitempty"` // The character (if it could be fetched).
+	Error     *Status    `json:"error,omitempty"`     // The error of the character (if it could not be fetched).
+}
+
+// The base includes two levels: Characters and Information
+type CharactersResponse struct {
+	Characters  []CharactersCharacter `json:"characters"`
+	Information Information           `json:"information"`
+}
+
+// TibiaCharactersBatchImpl func - fetches all characters of the names concurrently
+// Names are validated one by one, so that an invalid name only fails its own entry.
+// Names that only differ in case are fetched once.
+func TibiaCharactersBatchImpl(names []string, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (CharactersResponse, error) {
+	switch {
+	case len(names) == 0:
+		return CharactersResponse{}, validation.ErrorBatchEmpty
+	case len(names) > TibiaDataCharactersBatchMaxSize:
+		return CharactersResponse{}, validation.ErrorBatchTooBig
+	}
+
+	index := map[string]int{}
+	var unique []string
+	for _, name := range names {
+		key := strings.ToLower(name)
+		if _, exists := index[key]; !exists {
+			index[key] = len(unique)
+			unique = append(unique, name)
+		}
+	}
+
+	results := make([]CharactersCharacter, len(unique))
+	urls := make([][]string, len(unique))
+	TibiaDataParallel(len(unique), TibiaDataFanOutConcurrency, func(i int) {
+		results[i], urls[i] = tibiaCharactersBatchCharacter(unique[i], htmlDataCollector)
+	})
+
+	characters := make([]CharactersCharacter, 0, len(names))
+	tibiaURLs := []string{}
+	for _, name := range names {
+		result := results[index[strings.ToLower(name)]]
+		result.Name = name
+		characters = append(characters, result)
+	}
+	for _, url := range urls {
+		tibiaURLs = append(tibiaURLs, url...)
+	}
+
+	//
+	// Build the data-blob
+	return CharactersResponse{
+		characters,
+		Information{
+			APIDetails: TibiaDataAPIDetails,
+			Timestamp:  TibiaDataDatetime(""),
+			TibiaURLs:  tibiaURLs,
+			Status: Status{
+				HTTPCode: http.StatusOK,
+			},
+		},
+	}, nil
+}
+
+// tibiaCharactersBatchCharacter fetches one character of a batch and returns it together with its tibia urls
+// The error status is the same as the one of /v4/character/:name.
+func tibiaCharactersBatchCharacter(name string, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (CharactersCharacter, []string) {
+	result := CharactersCharacter{Name: name}
+
+	endpoint, err := tibiaCharactersCharacterEndpoint(name)
+	if err != nil {
+		status := TibiaDataErrorInformation(err, 0).Status
+		result.Error = &status
+		return result, nil
+	}
+
+	BoxContentHTML, err := htmlDataCollector(endpoint.Request)
+	if err != nil {
+		status := TibiaDataErrorInformation(err, http.StatusBadGateway).Status
+		result.Error = &status
+		return result, nil
+	}
+
+	data, err := endpoint.Parse(BoxContentHTML)
+	if err != nil {
+		status := TibiaDataErrorInformation(err, 0).Status
+		result.Error = &status
+		return result, nil
+	}
+
+	response := data.(CharacterResponse)
+	result.Character = &response.Character
+
+	return result, response.Information.TibiaURLs
+}
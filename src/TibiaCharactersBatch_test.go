@@ -0,0 +1,104 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+func TestCharactersBatch(t *testing.T) {
+	assert := assert.New(t)
+
+	character := testFileCollector(t, "testdata/characters/Darkside Rafa.html", nil)
+
+	var (
+		mu       sync.Mutex
+		requests []string
+	)
+	collector := func(request TibiaDataRequestStruct) (string, error) {
+		mu.Lock()
+		requests = append(requests, request.URL)
+		mu.Unlock()
+
+		if strings.HasSuffix(request.URL, "name=Rate+Limited") {
+			return "", validation.ErrStatusForbidden
+		}
+		return character(request)
+	}
+
+	batchJson, err := TibiaCharactersBatchImpl([]string{"Darkside Rafa", "a", "Rate Limited", "darkside rafa"}, collector)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	characters := batchJson.Characters
+	assert.Len(characters, 4)
+
+	// names differing in case are only fetched once
+	assert.Len(requests, 2)
+	assert.Equal([]string{"https://www.tibia.com/community/?subtopic=characters&name=Darkside+Rafa"}, batchJson.Information.TibiaURLs)
+
+	assert.Equal("Darkside Rafa", characters[0].Name)
+	assert.Nil(characters[0].Error)
+	if assert.NotNil(characters[0].Character) {
+		assert.Equal("Gladera", characters[0].Character.CharacterInfo.World)
+	}
+
+	assert.Equal("a", characters[1].Name)
+	assert.Nil(characters[1].Character)
+	if assert.NotNil(characters[1].Error) {
+		assert.Equal(10002, characters[1].Error.Error)
+		assert.Equal(http.StatusBadRequest, characters[1].Error.HTTPCode)
+	}
+
+	assert.Equal("Rate Limited", characters[2].Name)
+	if assert.NotNil(characters[2].Error) {
+		assert.Equal(20006, characters[2].Error.Error)
+		assert.Equal(http.StatusBadGateway, characters[2].Error.HTTPCode)
+	}
+
+	assert.Equal("darkside rafa", characters[3].Name)
+	assert.Equal(characters[0].Character, characters[3].Character)
+}
+
+func TestCharactersBatchSize(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := TibiaCharactersBatchImpl(nil, nil)
+	assert.Equal(validation.ErrorBatchEmpty, err)
+
+	defer func(size int) { TibiaDataCharactersBatchMaxSize = size }(TibiaDataCharactersBatchMaxSize)
+	TibiaDataCharactersBatchMaxSize = 2
+
+	_, err = TibiaCharactersBatchImpl([]string{"a", "b", "c"}, nil)
+	assert.Equal(validation.ErrorBatchTooBig, err)
+}
+
+func TestCharactersBatchHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	assert := assert.New(t)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/v4/characters", strings.NewReader(`{"names": "Darkside Rafa"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	tibiaCharactersBatch(c)
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Contains(w.Body.String(), `"error":9007`)
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/v4/characters", strings.NewReader(`{"names": []}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	tibiaCharactersBatch(c)
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Contains(w.Body.String(), `"error":9008`)
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+var (
+	// TibiaDataCharacterRanksMaxPages is the maximum number of highscore pages searched per category for character ranks
+	TibiaDataCharacterRanksMaxPages = 5
+
+	// tibiaHighscoresPageCache caches the highscore pages searched for character ranks by their tibia url
+	tibiaHighscoresPageCache = newTibiaDataCache[HighscoresResponse](5 * time.Minute)
+)
+
+// Child of CharacterRanks
+type CharacterRank struct {
+	Category string  `json:"category"`        // The highscore category.
+	Ranked   *bool   `json:"ranked"`          // Whether the character is on the highscores of the category, null if unknown (page limit reached or error).
+	Rank     int     `json:"rank,omitempty"`  // The character's rank. (if ranked)
+	Value    int     `json:"value,omitempty"` // The character's value for the highscores. (if ranked)
+	Page     int     `json:"page,omitempty"`  // The highscore page the character is on. (if ranked)
+	Error    *Status `json:"error,omitempty"` // The error of the category (if its pages could not be fetched).
+}
+
+// Child of JSONData
+type CharacterRanks struct {
+	Name     string          `json:"name"`     // The name of the character.
+	World    string          `json:"world"`    // The world the highscores belong to.
+	Vocation string          `json:"vocation"` // The vocation the highscores are filtered on.
+	Ranks    []CharacterRank `json:"ranks"`    // The character's rank in every highscore category.
+}
+
+// The base includes two levels: CharacterRanks and Information
+type CharacterRanksResponse struct {
+	CharacterRanks CharacterRanks `json:"character_ranks"`
+	Information    Information    `json:"information"`
+}
+
+// TibiaCharactersRanksImpl func - locates a character in all highscore categories of its world and vocation
+// The categories are searched concurrently, the pages of a category one after another until the
+// character is found, the last page is reached or the character's level (experience) or achievement
+// points (achievements) are below the lowest entry of a page. At most TibiaDataCharacterRanksMaxPages
+// pages are searched per category, the other values of the character are not on its character page.
+// Pages are cached for tibiaHighscoresPageCache.
+func TibiaCharactersRanksImpl(name string, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (CharacterRanksResponse, error) {
+	endpoint, err := tibiaCharactersCharacterEndpoint(name)
+	if err != nil {
+		return CharacterRanksResponse{}, err
+	}
+
+	BoxContentHTML, err := htmlDataCollector(endpoint.Request)
+	if err != nil {
+		return CharacterRanksResponse{}, err
+	}
+
+	data, err := endpoint.Parse(BoxContentHTML)
+	if err != nil {
+		return CharacterRanksResponse{}, err
+	}
+	characterResponse := data.(CharacterResponse)
+	character := characterResponse.Character.CharacterInfo
+
+	// the highscores only have the base vocations (e.g. Elite Knight is in knights)
+	vocationName := tibiaCharacterHighscoreVocation(character.Vocation)
+	if TibiaDataRestrictionMode {
+		vocationName = "all"
+	}
+
+	categories := make([]validation.HighscoreCategory, 0, validation.HighScoreBosspoints)
+	for category := validation.HighScoreAchievements; category <= validation.HighScoreBosspoints; category++ {
+		categories = append(categories, category)
+	}
+
+	ranks := make([]CharacterRank, len(categories))
+	urls := make([][]string, len(categories))
+	TibiaDataParallel(len(categories), TibiaDataFanOutConcurrency, func(i int) {
+		ranks[i], urls[i] = tibiaCharacterRank(character, vocationName, categories[i], htmlDataCollector)
+	})
+
+	tibiaURLs := characterResponse.Information.TibiaURLs
+	for _, url := range urls {
+		tibiaURLs = append(tibiaURLs, url...)
+	}
+
+	//
+	// Build the data-blob
+	return CharacterRanksResponse{
+		CharacterRanks{
+			Name:     character.Name,
+			World:    character.World,
+			Vocation: vocationName,
+			Ranks:    ranks,
+		},
+		Information{
+			APIDetails: TibiaDataAPIDetails,
+			Timestamp:  TibiaDataDatetime(""),
+			TibiaURLs:  tibiaURLs,
+			Status: Status{
+				HTTPCode: http.StatusOK,
+			},
+		},
+	}, nil
+}
+
+// tibiaCharacterHighscoreVocation returns the highscore vocation of a character's vocation (e.g. Master Sorcerer to sorcerers)
+func tibiaCharacterHighscoreVocation(vocation string) string {
+	words := strings.Fields(vocation)
+	if len(words) == 0 {
+		return "all"
+	}
+
+	vocationName, _ := TibiaDataVocationValidator(words[len(words)-1])
+	return vocationName
+}
+
+// tibiaCharacterRank searches the pages of one category for the character and returns its rank together with the tibia urls
+func tibiaCharacterRank(character CharacterInfo, vocationName string, category validation.HighscoreCategory, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (CharacterRank, []string) {
+	categoryName, _ := category.String()
+	rank := CharacterRank{Category: categoryName}
+
+	// the value of the character is only known for some categories
+	var (
+		knownValue   int
+		valueOfEntry func(Highscore) int
+	)
+	switch category {
+	case validation.HighScoreExperience:
+		knownValue, valueOfEntry = character.Level, func(entry Highscore) int { return entry.Level }
+	case validation.HighScoreAchievements:
+		knownValue, valueOfEntry = character.AchievementPoints, func(entry Highscore) int { return entry.Value }
+	}
+
+	var (
+		tibiaURLs        []string
+		ranked, unranked = true, false
+	)
+	for page := 1; ; page++ {
+		endpoint := tibiaHighscoresPageEndpoint(character.World, category, vocationName, page, nil, validation.HighscoreBattlEyeAny)
+		response, err := tibiaHighscoresCachedPage(endpoint, htmlDataCollector)
+		if err != nil {
+			status := TibiaDataErrorInformation(err, http.StatusBadGateway).Status
+			rank.Error = &status
+			return rank, tibiaURLs
+		}
+		tibiaURLs = append(tibiaURLs, endpoint.Request.URL)
+
+		highscores := response.Highscores
+		for _, entry := range highscores.HighscoreList {
+			if strings.EqualFold(entry.Name, character.Name) {
+				rank.Ranked, rank.Rank, rank.Value, rank.Page = &ranked, entry.Rank, entry.Value, page
+				return rank, tibiaURLs
+			}
+		}
+
+		if len(highscores.HighscoreList) == 0 || page >= highscores.HighscorePage.TotalPages {
+			rank.Ranked = &unranked
+			return rank, tibiaURLs
+		}
+
+		// the character can not be on the next pages if it is below the lowest entry of this page
+		if valueOfEntry != nil && knownValue < valueOfEntry(highscores.HighscoreList[len(highscores.HighscoreList)-1]) {
+			rank.Ranked = &unranked
+			return rank, tibiaURLs
+		}
+
+		// the character may be on the next pages, but they are not searched
+		if page >= TibiaDataCharacterRanksMaxPages {
+			return rank, tibiaURLs
+		}
+	}
+}
+
+// tibiaHighscoresCachedPage returns the highscore page of the endpoint from tibiaHighscoresPageCache or fetches it
+func tibiaHighscoresCachedPage(endpoint tibiaDataEndpoint, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (HighscoresResponse, error) {
+	if response, ok := tibiaHighscoresPageCache.Get(endpoint.Request.URL); ok {
+		return response, nil
+	}
+
+	BoxContentHTML, err := htmlDataCollector(endpoint.Request)
+	if err != nil {
+		return HighscoresResponse{}, err
+	}
+
+	data, err := endpoint.Parse(BoxContentHTML)
+	if err != nil {
+		return HighscoresResponse{}, err
+	}
+
+	response := data.(HighscoresResponse)
+	tibiaHighscoresPageCache.Set(endpoint.Request.URL, response)
+
+	return response, nil
+}
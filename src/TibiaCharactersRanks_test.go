@@ -0,0 +1,107 @@
+package main
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+func TestCharactersRanks(t *testing.T) {
+	assert := assert.New(t)
+
+	defer func(cache *tibiaDataCache[HighscoresResponse]) { tibiaHighscoresPageCache = cache }(tibiaHighscoresPageCache)
+	tibiaHighscoresPageCache = newTibiaDataCache[HighscoresResponse](time.Minute)
+
+	character := testFileCollector(t, "testdata/characters/Darkside Rafa.html", nil)
+	highscores := testFileCollector(t, "testdata/highscores/all.html", nil)
+
+	// the highscores test file has 20 pages, Darkside Rafa is on page 3 of magic level
+	var (
+		mu       sync.Mutex
+		requests []string
+	)
+	collector := func(request TibiaDataRequestStruct) (string, error) {
+		mu.Lock()
+		requests = append(requests, request.URL)
+		mu.Unlock()
+
+		switch {
+		case strings.Contains(request.URL, "subtopic=characters"):
+			return character(request)
+		case strings.Contains(request.URL, "&category=9&"):
+			return "", validation.ErrStatusForbidden
+		case strings.Contains(request.URL, "&category=11&") && strings.HasSuffix(request.URL, "&currentpage=3"):
+			html, err := highscores(request)
+			return strings.ReplaceAll(html, "Wujo Daro", "Darkside Rafa"), err
+		}
+		return highscores(request)
+	}
+
+	ranksJson, err := TibiaCharactersRanksImpl("Darkside Rafa", collector)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	characterRanks := ranksJson.CharacterRanks
+	assert.Equal("Darkside Rafa", characterRanks.Name)
+	assert.Equal("Gladera", characterRanks.World)
+	assert.Equal("knights", characterRanks.Vocation)
+	assert.Len(characterRanks.Ranks, 15)
+
+	ranks := map[string]CharacterRank{}
+	for _, rank := range characterRanks.Ranks {
+		ranks[rank.Category] = rank
+	}
+
+	ranked, unranked := true, false
+	assert.Equal(CharacterRank{Category: "magiclevel", Ranked: &ranked, Rank: 50, Value: 81816135617, Page: 3}, ranks["magiclevel"])
+	assert.Equal(CharacterRank{Category: "experience", Ranked: &unranked}, ranks["experience"])
+	if assert.NotNil(ranks["goshnarstaint"].Error) {
+		assert.Nil(ranks["goshnarstaint"].Ranked)
+		assert.Equal(20006, ranks["goshnarstaint"].Error.Error)
+	}
+
+	// the character is below the lowest experience and achievements entries of the first page and
+	// the test file has no loyalty entries, the other categories are unknown after the page limit
+	assert.Equal(CharacterRank{Category: "loyaltypoints", Ranked: &unranked}, ranks["loyaltypoints"])
+	assert.Equal(CharacterRank{Category: "fishing"}, ranks["fishing"])
+	assert.Len(requests, 1+1+1+1+3+1+10*TibiaDataCharacterRanksMaxPages)
+	assert.Len(ranksJson.Information.TibiaURLs, 1+1+1+1+3+10*TibiaDataCharacterRanksMaxPages)
+	assert.Contains(requests, "https://www.tibia.com/community/?subtopic=highscores&world=Gladera&category=6&profession=2&currentpage=1")
+	assert.NotContains(requests, "https://www.tibia.com/community/?subtopic=highscores&world=Gladera&category=6&profession=2&currentpage=2")
+
+	// the pages are cached, only the character and the failed pages are fetched again
+	requests = nil
+	_, err = TibiaCharactersRanksImpl("Darkside Rafa", collector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(requests, 2)
+}
+
+func TestCharactersRanksErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := TibiaCharactersRanksImpl("a", nil)
+	assert.Equal(validation.ErrorCharacterNameTooSmall, err)
+
+	_, err = TibiaCharactersRanksImpl("Darkside Rafa", func(TibiaDataRequestStruct) (string, error) {
+		return "", validation.ErrorMaintenanceMode
+	})
+	assert.Equal(validation.ErrorMaintenanceMode, err)
+}
+
+func TestCharacterHighscoreVocation(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("knights", tibiaCharacterHighscoreVocation("Elite Knight"))
+	assert.Equal("sorcerers", tibiaCharacterHighscoreVocation("Master Sorcerer"))
+	assert.Equal("paladins", tibiaCharacterHighscoreVocation("Paladin"))
+	assert.Equal("monks", tibiaCharacterHighscoreVocation("Exalted Monk"))
+	assert.Equal("none", tibiaCharacterHighscoreVocation("None"))
+	assert.Equal("all", tibiaCharacterHighscoreVocation(""))
+}
@@ -9,6 +9,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 	"unicode/utf8"
 
@@ -246,6 +247,37 @@ func getEnvAsBool(name string, defaultVal bool) bool {
 	return defaultVal
 }
 
+// getEnvAsInt func - read an environment variable into an int or return default value
+func getEnvAsInt(name string, defaultVal int) int {
+	valStr := getEnv(name, "")
+	if val, err := strconv.Atoi(valStr); err == nil {
+		return val
+	}
+
+	return defaultVal
+}
+
+// TibiaDataParallel func - calls fn for every index from 0 to n-1 with at most parallelism calls at the same time
+// It returns when all calls have finished.
+func TibiaDataParallel(n, parallelism int, fn func(i int)) {
+	semaphore := make(chan struct{}, max(parallelism, 1))
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		semaphore <- struct{}{}
+		wg.Add(1)
+
+		go func(i int) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
 // TibiaDataConvertValuesWithK func - convert price strings that contain k, kk or more to 3x0
 func TibiaDataConvertValuesWithK(data string) int {
 	return TibiaDataStringToInteger(strings.ReplaceAll(data, "k", "") + strings.Repeat("000", strings.Count(data, "k")))
@@ -2,6 +2,7 @@ package main
 
 import (
 	"os"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -66,6 +67,48 @@ func TestGetEnvAsBool(t *testing.T) {
 	os.Unsetenv("TIBIADATA_ENV")
 }
 
+func TestGetEnvAsInt(t *testing.T) {
+	assert := assert.New(t)
+
+	// Test when environment variable is not set
+	assert.Equal(10, getEnvAsInt("TIBIADATA_ENV", 10))
+
+	// Test when environment variable is set to a number
+	os.Setenv("TIBIADATA_ENV", "25")
+	assert.Equal(25, getEnvAsInt("TIBIADATA_ENV", 10))
+
+	// Test when environment variable is not a number
+	os.Setenv("TIBIADATA_ENV", "many")
+	assert.Equal(10, getEnvAsInt("TIBIADATA_ENV", 10))
+
+	os.Unsetenv("TIBIADATA_ENV")
+}
+
+func TestTibiaDataParallel(t *testing.T) {
+	assert := assert.New(t)
+
+	var running, maxRunning atomic.Int32
+	results := make([]int, 20)
+
+	TibiaDataParallel(len(results), 3, func(i int) {
+		current := running.Add(1)
+		for {
+			observed := maxRunning.Load()
+			if current <= observed || maxRunning.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+
+		results[i] = i * i
+		running.Add(-1)
+	})
+
+	for i, result := range results {
+		assert.Equal(i*i, result)
+	}
+	assert.LessOrEqual(maxRunning.Load(), int32(3))
+}
+
 func TestTibiaDataVocationValidator(t *testing.T) {
 	assert := assert.New(t)
 
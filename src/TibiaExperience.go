@@ -0,0 +1,111 @@
+package main
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+// Child of Highscore and Experience
+type LevelProgress struct {
+	ExperienceCurrentLevel int     `json:"experience_current_level"` // The experience needed for the current level.
+	ExperienceNextLevel    int     `json:"experience_next_level"`    // The experience needed for the next level.
+	ExperienceRemaining    int     `json:"experience_remaining"`     // The experience remaining to the next level.
+	Progress               float64 `json:"progress"`                 // The progress towards the next level in percent.
+}
+
+// Child of JSONData
+type Experience struct {
+	Level         int           `json:"level"`          // The level.
+	Experience    int           `json:"experience"`     // The experience points.
+	LevelProgress LevelProgress `json:"level_progress"` // The progress of the experience towards the next level.
+}
+
+// The base includes two levels: Experience and Information
+type ExperienceResponse struct {
+	Experience  Experience  `json:"experience"`
+	Information Information `json:"information"`
+}
+
+// TibiaDataExperienceForLevel func - returns the experience needed for a level
+// The formula of tibia.com is (50 * (level-1)^3 - 150 * (level-1)^2 + 400 * (level-1)) / 3.
+func TibiaDataExperienceForLevel(level int) int {
+	if level <= 1 {
+		return 0
+	}
+
+	x := level - 1
+	return (50*x*x*x - 150*x*x + 400*x) / 3
+}
+
+// TibiaDataLevelForExperience func - returns the highest level reached with an amount of experience
+func TibiaDataLevelForExperience(experience int) int {
+	if experience <= 0 {
+		return 1
+	}
+
+	// the cube root is close enough to only correct rounding errors
+	level := int(math.Cbrt(float64(experience)*3/50)) + 1
+	for level > 1 && TibiaDataExperienceForLevel(level) > experience {
+		level--
+	}
+	for TibiaDataExperienceForLevel(level+1) <= experience {
+		level++
+	}
+
+	return level
+}
+
+// TibiaDataLevelProgress func - returns the progress of experience on a level towards the next level
+func TibiaDataLevelProgress(level, experience int) LevelProgress {
+	current := TibiaDataExperienceForLevel(level)
+	next := TibiaDataExperienceForLevel(level + 1)
+
+	// experience lost by a death can be below the experience of the level
+	gained := min(max(experience-current, 0), next-current)
+
+	return LevelProgress{
+		ExperienceCurrentLevel: current,
+		ExperienceNextLevel:    next,
+		ExperienceRemaining:    next - current - gained,
+		Progress:               math.Floor(float64(gained)/float64(next-current)*10000) / 100,
+	}
+}
+
+// TibiaExperienceImpl func - calculates the level progress of either a level or an amount of experience
+func TibiaExperienceImpl(level, experience string) (ExperienceResponse, error) {
+	var result Experience
+
+	switch {
+	case (level == "") == (experience == ""):
+		return ExperienceResponse{}, validation.ErrorLevelOrExperienceRequired
+	case level != "":
+		value, err := strconv.Atoi(level)
+		if err != nil {
+			return ExperienceResponse{}, validation.ErrorLevelInvalid
+		}
+		if err := validation.IsLevelValid(value); err != nil {
+			return ExperienceResponse{}, err
+		}
+		result.Level = value
+		result.Experience = TibiaDataExperienceForLevel(value)
+	default:
+		value, err := strconv.Atoi(experience)
+		if err != nil {
+			return ExperienceResponse{}, validation.ErrorExperienceInvalid
+		}
+		if err := validation.IsExperienceValid(value); err != nil {
+			return ExperienceResponse{}, err
+		}
+		result.Level = TibiaDataLevelForExperience(value)
+		result.Experience = value
+	}
+
+	result.LevelProgress = TibiaDataLevelProgress(result.Level, result.Experience)
+
+	return ExperienceResponse{
+		Experience:  result,
+		Information: tibiaDataLocalInformation(),
+	}, nil
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+func TestExperienceForLevel(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(0, TibiaDataExperienceForLevel(1))
+	assert.Equal(100, TibiaDataExperienceForLevel(2))
+	assert.Equal(4200, TibiaDataExperienceForLevel(8))
+	assert.Equal(15694800, TibiaDataExperienceForLevel(100))
+	assert.Equal(validation.MaxExperience, TibiaDataExperienceForLevel(validation.MaxLevel))
+
+	assert.Equal(1, TibiaDataLevelForExperience(0))
+	assert.Equal(1, TibiaDataLevelForExperience(99))
+	assert.Equal(2, TibiaDataLevelForExperience(100))
+	assert.Equal(99, TibiaDataLevelForExperience(15694799))
+	assert.Equal(100, TibiaDataLevelForExperience(15694800))
+	assert.Equal(2197, TibiaDataLevelForExperience(176271164607))
+	assert.Equal(validation.MaxLevel, TibiaDataLevelForExperience(validation.MaxExperience))
+
+	for level := 1; level < 3000; level++ {
+		assert.Equal(level, TibiaDataLevelForExperience(TibiaDataExperienceForLevel(level)))
+		assert.Equal(level, TibiaDataLevelForExperience(TibiaDataExperienceForLevel(level+1)-1))
+	}
+}
+
+func TestLevelProgress(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(LevelProgress{
+		ExperienceCurrentLevel: 176259597600,
+		ExperienceNextLevel:    176500608700,
+		ExperienceRemaining:    229444093,
+		Progress:               4.79,
+	}, TibiaDataLevelProgress(2197, 176271164607))
+
+	// the experience of a character that died can be below its level
+	assert.Equal(LevelProgress{ExperienceCurrentLevel: 100, ExperienceNextLevel: 200, ExperienceRemaining: 100}, TibiaDataLevelProgress(2, 50))
+}
+
+func TestExperience(t *testing.T) {
+	assert := assert.New(t)
+
+	experienceJson, err := TibiaExperienceImpl("100", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(Experience{
+		Level:      100,
+		Experience: 15694800,
+		LevelProgress: LevelProgress{
+			ExperienceCurrentLevel: 15694800,
+			ExperienceNextLevel:    16180000,
+			ExperienceRemaining:    485200,
+		},
+	}, experienceJson.Experience)
+
+	experienceJson, err = TibiaExperienceImpl("", "16000000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(100, experienceJson.Experience.Level)
+	assert.Equal(16000000, experienceJson.Experience.Experience)
+	assert.Equal(180000, experienceJson.Experience.LevelProgress.ExperienceRemaining)
+	assert.Equal(62.9, experienceJson.Experience.LevelProgress.Progress)
+
+	for _, params := range []struct {
+		level, experience string
+		err               error
+	}{
+		{"", "", validation.ErrorLevelOrExperienceRequired},
+		{"100", "16000000", validation.ErrorLevelOrExperienceRequired},
+		{"0", "", validation.ErrorLevelInvalid},
+		{"abc", "", validation.ErrorLevelInvalid},
+		{"", "-1", validation.ErrorExperienceInvalid},
+		{"", "99999999999999999999", validation.ErrorExperienceInvalid},
+	} {
+		_, err = TibiaExperienceImpl(params.level, params.experience)
+		assert.Equal(params.err, err, params)
+	}
+}
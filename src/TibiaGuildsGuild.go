@@ -12,15 +12,14 @@ import (
 
 // Child of Guild
 type Guildhall struct {
-	Name  string `json:"name"`  // The name of the house.
-	World string `json:"world"` // The world the guildhall belongs to.
-	/*
-		Town      string `json:"town"`       // We can collect that from cached info?
-		Status    string `json:"status"`     // rented (but maybe also auctioned)
-		Owner     string `json:"owner"`      // We can collect that from cached info?
-		HouseID   int    `json:"houseid"`    // We can collect that from cached info?
-	*/
-	PaidUntil string `json:"paid_until"` // The date the last paid rent is due.
+	Name      string `json:"name"`              // The name of the house.
+	World     string `json:"world"`             // The world the guildhall belongs to.
+	Town      string `json:"town,omitempty"`    // The town where the guildhall is located (only with guildhall details).
+	Status    string `json:"status"`            // The status of the guildhall: rented, or with guildhall details also moving, transferring or auctioned.
+	Owner     string `json:"owner,omitempty"`   // The character who rented the guildhall (only with guildhall details).
+	HouseID   int    `json:"houseid,omitempty"` // The internal ID of the guildhall (only with guildhall details).
+	Rent      int    `json:"rent,omitempty"`    // The monthly cost in gold coins for the guildhall (only with guildhall details).
+	PaidUntil string `json:"paid_until"`        // The date the last paid rent is due.
 }
 
 // Child of Guild
@@ -177,6 +176,7 @@ func TibiaGuildsGuildImpl(guild string, BoxContentHTML string, url string) (Guil
 				GuildGuildhallData = append(GuildGuildhallData, Guildhall{
 					Name:      TibiaDataSanitizeEscapedString(subma1b[0][1]),
 					World:     GuildWorld,
+					Status:    "rented",
 					PaidUntil: TibiaDataDate(subma1b[0][2]),
 				})
 			}
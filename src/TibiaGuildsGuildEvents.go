@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+// The types of the guild events
+const (
+	GuildEventJoin              = "join"
+	GuildEventLeave             = "leave"
+	GuildEventKick              = "kick"
+	GuildEventInvite            = "invite"
+	GuildEventInvitationRevoked = "invitation_revoked"
+	GuildEventRankChange        = "rank_change"
+	GuildEventTitleChange       = "title_change"
+	GuildEventOther             = "other"
+)
+
+// Child of GuildEvents
+type GuildEvent struct {
+	Date        string `json:"date"`        // The time of the event.
+	Type        string `json:"type"`        // The type of the event (join, leave, kick, invite, invitation_revoked, rank_change, title_change or other).
+	Character   string `json:"character"`   // The character the event is about.
+	By          string `json:"by"`          // The character who caused the event (kick, invite, invitation_revoked, rank_change and title_change).
+	Rank        string `json:"rank"`        // The rank the character was set to (rank_change).
+	Title       string `json:"title"`       // The title the character was set to, empty if it was removed (title_change).
+	Description string `json:"description"` // The text of the event.
+}
+
+// Child of JSONData
+type GuildEvents struct {
+	Name   string       `json:"name"`   // The name of the guild.
+	Events []GuildEvent `json:"events"` // List of the events of the guild, the latest first.
+}
+
+// The base includes two levels: GuildEvents and Information
+type GuildEventsResponse struct {
+	GuildEvents GuildEvents `json:"guild_events"`
+	Information Information `json:"information"`
+}
+
+// ListEntries returns the events
+func (r GuildEventsResponse) ListEntries() interface{} {
+	return r.GuildEvents.Events
+}
+
+// The wording of the events is not checked against a capture of tibia.com yet, the fixtures in testdata/guilds/events are hand-written.
+var (
+	GuildEventJoinRegex              = regexp.MustCompile(`^(.+) has joined the guild\.$`)
+	GuildEventLeaveRegex             = regexp.MustCompile(`^(.+) has left the guild\.$`)
+	GuildEventKickRegex              = regexp.MustCompile(`^(.+) has been kicked by (.+)\.$`)
+	GuildEventInviteRegex            = regexp.MustCompile(`^(.+) has invited (.+)\.$`)
+	GuildEventInvitationRevokedRegex = regexp.MustCompile(`^(.+) has revoked the invitation of (.+)\.$`)
+	GuildEventRankRegex              = regexp.MustCompile(`^(.+) has set the rank of (.+) to (.+)\.$`)
+	GuildEventTitleRegex             = regexp.MustCompile(`^(.+) has set the title of (.+) to (.+)\.$`)
+	GuildEventTitleRemovedRegex      = regexp.MustCompile(`^(.+) has removed the title of (.+)\.$`)
+)
+
+func TibiaGuildsGuildEventsImpl(BoxContentHTML string, url string) (GuildEventsResponse, error) {
+	// Loading HTML data into ReaderHTML for goquery with NewReader
+	ReaderHTML, err := goquery.NewDocumentFromReader(strings.NewReader(BoxContentHTML))
+	if err != nil {
+		return GuildEventsResponse{}, fmt.Errorf("[error] TibiaGuildsGuildEventsImpl failed at goquery.NewDocumentFromReader, err: %s", err)
+	}
+
+	guildName := TibiaDataSanitizeStrings(ReaderHTML.Find("h1").First().Text())
+	if guildName == "" {
+		return GuildEventsResponse{}, validation.ErrorGuildNotFound
+	}
+
+	EventsData := []GuildEvent{}
+
+	// Running query over each row of the event history
+	ReaderHTML.Find(".TableContentContainer .TableContent tr").Each(func(index int, s *goquery.Selection) {
+		columns := s.Find("td")
+		if s.HasClass("LabelH") || columns.Length() != 2 {
+			return
+		}
+
+		EventsData = append(EventsData, tibiaGuildsGuildEvent(
+			TibiaDataDatetime(TibiaDataSanitizeStrings(columns.First().Text())),
+			TibiaDataSanitizeStrings(columns.Last().Text()),
+		))
+	})
+
+	//
+	// Build the data-blob
+	return GuildEventsResponse{
+		GuildEvents{
+			Name:   guildName,
+			Events: EventsData,
+		},
+		Information{
+			APIDetails: TibiaDataAPIDetails,
+			Timestamp:  TibiaDataDatetime(""),
+			TibiaURLs:  []string{url},
+			Status: Status{
+				HTTPCode: http.StatusOK,
+			},
+		},
+	}, nil
+}
+
+// tibiaGuildsGuildEvent classifies the text of a guild event
+func tibiaGuildsGuildEvent(date, description string) GuildEvent {
+	event := GuildEvent{Date: date, Type: GuildEventOther, Description: description}
+
+	if subma := GuildEventTitleRemovedRegex.FindStringSubmatch(description); subma != nil {
+		event.Type, event.By, event.Character = GuildEventTitleChange, subma[1], subma[2]
+	} else if subma := GuildEventTitleRegex.FindStringSubmatch(description); subma != nil {
+		event.Type, event.By, event.Character, event.Title = GuildEventTitleChange, subma[1], subma[2], subma[3]
+	} else if subma := GuildEventRankRegex.FindStringSubmatch(description); subma != nil {
+		event.Type, event.By, event.Character, event.Rank = GuildEventRankChange, subma[1], subma[2], subma[3]
+	} else if subma := GuildEventInvitationRevokedRegex.FindStringSubmatch(description); subma != nil {
+		event.Type, event.By, event.Character = GuildEventInvitationRevoked, subma[1], subma[2]
+	} else if subma := GuildEventKickRegex.FindStringSubmatch(description); subma != nil {
+		event.Type, event.Character, event.By = GuildEventKick, subma[1], subma[2]
+	} else if subma := GuildEventJoinRegex.FindStringSubmatch(description); subma != nil {
+		event.Type, event.Character = GuildEventJoin, subma[1]
+	} else if subma := GuildEventLeaveRegex.FindStringSubmatch(description); subma != nil {
+		event.Type, event.Character = GuildEventLeave, subma[1]
+	} else if subma := GuildEventInviteRegex.FindStringSubmatch(description); subma != nil {
+		event.Type, event.By, event.Character = GuildEventInvite, subma[1], subma[2]
+	}
+
+	return event
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/static"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+// The test file of the events is hand-written, the wording of the events is not verified against tibia.com yet.
+func TestNightsWatchEvents(t *testing.T) {
+	file, err := static.TestFiles.Open("testdata/guilds/events/Nights Watch.html")
+	if err != nil {
+		t.Fatalf("file opening error: %s", err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		t.Fatalf("File reading error: %s", err)
+	}
+
+	eventsJson, err := TibiaGuildsGuildEventsImpl(string(data), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert := assert.New(t)
+	events := eventsJson.GuildEvents
+
+	assert.Equal("Nights Watch", events.Name)
+	assert.Equal(9, len(events.Events))
+
+	assert.Equal(GuildEvent{
+		Date:        "2023-10-18T18:11:05Z",
+		Type:        GuildEventRankChange,
+		Character:   "Aira San",
+		By:          "Darksu",
+		Rank:        "Vice Leader",
+		Description: "Darksu has set the rank of Aira San to Vice Leader.",
+	}, events.Events[0])
+	assert.Equal(GuildEvent{Date: "2023-10-17T16:40:51Z", Type: GuildEventLeave, Character: "Kim Writter", Description: "Kim Writter has left the guild."}, events.Events[1])
+	assert.Equal(GuildEvent{Date: "2023-10-16T20:02:13Z", Type: GuildEventKick, Character: "Naia Irod", By: "Darksu", Description: "Naia Irod has been kicked by Darksu."}, events.Events[2])
+
+	titleChange := events.Events[3]
+	assert.Equal(GuildEventTitleChange, titleChange.Type)
+	assert.Equal("Nonehz", titleChange.Character)
+	assert.Equal("Drackky", titleChange.By)
+	assert.Equal("Sentinel", titleChange.Title)
+
+	assert.Equal(GuildEventJoin, events.Events[4].Type)
+	assert.Equal("Mah Oee", events.Events[4].Character)
+
+	invite := events.Events[5]
+	assert.Equal(GuildEventInvite, invite.Type)
+	assert.Equal("Mah Oee", invite.Character)
+	assert.Equal("Drackky", invite.By)
+
+	assert.Equal(GuildEventInvitationRevoked, events.Events[6].Type)
+	assert.Equal("Ferumbras toxico", events.Events[6].Character)
+
+	titleRemoved := events.Events[7]
+	assert.Equal(GuildEventTitleChange, titleRemoved.Type)
+	assert.Equal("Noben Cawin", titleRemoved.Character)
+	assert.Empty(titleRemoved.Title)
+
+	other := events.Events[8]
+	assert.Equal(GuildEventOther, other.Type)
+	assert.Empty(other.Character)
+	assert.Equal("The guildhall Shark Manor has been acquired.", other.Description)
+}
+
+func TestGuildEventsNotFound(t *testing.T) {
+	_, err := TibiaGuildsGuildEventsImpl("<html><body></body></html>", "")
+	assert.Equal(t, validation.ErrorGuildNotFound, err)
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+var (
+	// GuildMemberFields are the character fields that can be included in the members of an expanded guild
+	GuildMemberFields = []string{"account_status", "achievement_points", "deaths", "former_names", "houses", "last_login", "residence"}
+
+	// GuildMemberDefaultFields are the character fields included if none are requested
+	GuildMemberDefaultFields = []string{"deaths", "houses", "last_login", "residence"}
+
+	// TibiaDataGuildExpandedMaxMembers is the maximum number of members of a guild that is expanded
+	TibiaDataGuildExpandedMaxMembers = 200
+)
+
+// Child of GuildExpandedMember
+// Fields that are not included in the fields of GuildExpanded have their zero value.
+type GuildMemberCharacter struct {
+	AccountStatus     string   `json:"account_status"`     // Whether account is Free or Premium.
+	AchievementPoints int      `json:"achievement_points"` // The total of achievement points the character has.
+	Deaths            []Deaths `json:"deaths"`             // The character's deaths.
+	FormerNames       []string `json:"former_names"`       // List of former names of the character.
+	Houses            []Houses `json:"houses"`             // List of houses the character owns currently.
+	LastLogin         string   `json:"last_login"`         // The character's last logged in time.
+	Residence         string   `json:"residence"`          // The character's current residence.
+}
+
+// Child of GuildExpanded
+type GuildExpandedMember struct {
+	Name      string                `json:"name"`                // The name of the guild's member.
+	Title     string                `json:"title"`               // The member's title.
+	Rank      string                `json:"rank"`                // The rank the member does belong to.
+	Vocation  string                `json:"vocation"`            // The member's vocation.
+	Level     int                   `json:"level"`               // The member's level.
+	Joined    string                `json:"joined"`              // The day when the member joined.
+	Status    string                `json:"status"`              // Whether the member is online or offline.
+	Character *GuildMemberCharacter `json:"character,omitempty"` // The included fields of the member's character (if it could be fetched).
+	Error     *Status               `json:"error,omitempty"`     // The error of the member's character (if it could not be fetched).
+}
+
+// Child of JSONData
+type GuildExpanded struct {
+	Name          string                `json:"name"`           // The name of the guild.
+	World         string                `json:"world"`          // The world the guild belongs to.
+	Fields        []string              `json:"fields"`         // The character fields included in the members.
+	MembersTotal  int                   `json:"members_total"`  // The number of total members in the guild.
+	Members       []GuildExpandedMember `json:"members"`        // List of all members in the guild with their character fields.
+	FailedMembers []string              `json:"failed_members"` // The names of the members whose characters could not be fetched.
+}
+
+// The base includes two levels: GuildExpanded and Information
+type GuildExpandedResponse struct {
+	GuildExpanded GuildExpanded `json:"guild_expanded"`
+	Information   Information   `json:"information"`
+}
+
+// TibiaGuildsGuildExpandedImpl func - fetches a guild and the characters of all of its members concurrently
+// Members whose characters could not be fetched have an error and are listed in failed_members.
+// Guilds with more than TibiaDataGuildExpandedMaxMembers members are not expanded.
+func TibiaGuildsGuildExpandedImpl(name string, fields []string, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (GuildExpandedResponse, error) {
+	fields, err := tibiaGuildsMemberFields(fields)
+	if err != nil {
+		return GuildExpandedResponse{}, err
+	}
+
+	endpoint, err := tibiaGuildsGuildEndpoint(name)
+	if err != nil {
+		return GuildExpandedResponse{}, err
+	}
+
+	BoxContentHTML, err := htmlDataCollector(endpoint.Request)
+	if err != nil {
+		return GuildExpandedResponse{}, err
+	}
+
+	data, err := endpoint.Parse(BoxContentHTML)
+	if err != nil {
+		return GuildExpandedResponse{}, err
+	}
+	guildResponse := data.(GuildResponse)
+	guild := guildResponse.Guild
+	if len(guild.Members) > TibiaDataGuildExpandedMaxMembers {
+		return GuildExpandedResponse{}, validation.ErrorGuildTooManyMembers
+	}
+
+	characters := make([]CharactersCharacter, len(guild.Members))
+	urls := make([][]string, len(guild.Members))
+	TibiaDataParallel(len(guild.Members), TibiaDataFanOutConcurrency, func(i int) {
+		characters[i], urls[i] = tibiaCharactersBatchCharacter(guild.Members[i].Name, htmlDataCollector)
+	})
+
+	members := make([]GuildExpandedMember, 0, len(guild.Members))
+	failed := []string{}
+	for i, member := range guild.Members {
+		expanded := GuildExpandedMember{
+			Name:     member.Name,
+			Title:    member.Title,
+			Rank:     member.Rank,
+			Vocation: member.Vocation,
+			Level:    member.Level,
+			Joined:   member.Joined,
+			Status:   member.Status,
+			Error:    characters[i].Error,
+		}
+		if characters[i].Character != nil {
+			expanded.Character = tibiaGuildsMemberCharacter(*characters[i].Character, fields)
+		} else {
+			failed = append(failed, member.Name)
+		}
+		members = append(members, expanded)
+	}
+
+	tibiaURLs := guildResponse.Information.TibiaURLs
+	for _, url := range urls {
+		tibiaURLs = append(tibiaURLs, url...)
+	}
+
+	//
+	// Build the data-blob
+	return GuildExpandedResponse{
+		GuildExpanded{
+			Name:          guild.Name,
+			World:         guild.World,
+			Fields:        fields,
+			MembersTotal:  guild.MembersTotal,
+			Members:       members,
+			FailedMembers: failed,
+		},
+		Information{
+			APIDetails: TibiaDataAPIDetails,
+			Timestamp:  TibiaDataDatetime(""),
+			TibiaURLs:  tibiaURLs,
+			Status: Status{
+				HTTPCode: http.StatusOK,
+			},
+		},
+	}, nil
+}
+
+// tibiaGuildsMemberFields validates the requested character fields and returns them sorted without duplicates
+// The fields can be comma separated, no fields result in GuildMemberDefaultFields.
+func tibiaGuildsMemberFields(requested []string) ([]string, error) {
+	fields := []string{}
+	for _, values := range requested {
+		for _, field := range strings.Split(values, ",") {
+			field = strings.ToLower(strings.TrimSpace(field))
+			switch {
+			case field == "":
+				continue
+			case !slices.Contains(GuildMemberFields, field):
+				return nil, validation.ErrorGuildMemberFieldInvalid
+			case !slices.Contains(fields, field):
+				fields = append(fields, field)
+			}
+		}
+	}
+
+	if len(fields) == 0 {
+		return slices.Clone(GuildMemberDefaultFields), nil
+	}
+
+	slices.Sort(fields)
+	return fields, nil
+}
+
+// tibiaGuildsMemberCharacter returns the fields of a character
+// Included lists are never nil, so that an empty list is not mistaken for a field that is not included.
+func tibiaGuildsMemberCharacter(character Character, fields []string) *GuildMemberCharacter {
+	var memberCharacter GuildMemberCharacter
+	for _, field := range fields {
+		switch field {
+		case "account_status":
+			memberCharacter.AccountStatus = character.CharacterInfo.AccountStatus
+		case "achievement_points":
+			memberCharacter.AchievementPoints = character.CharacterInfo.AchievementPoints
+		case "deaths":
+			memberCharacter.Deaths = character.Deaths
+			if memberCharacter.Deaths == nil {
+				memberCharacter.Deaths = []Deaths{}
+			}
+		case "former_names":
+			memberCharacter.FormerNames = character.CharacterInfo.FormerNames
+			if memberCharacter.FormerNames == nil {
+				memberCharacter.FormerNames = []string{}
+			}
+		case "houses":
+			memberCharacter.Houses = character.CharacterInfo.Houses
+			if memberCharacter.Houses == nil {
+				memberCharacter.Houses = []Houses{}
+			}
+		case "last_login":
+			memberCharacter.LastLogin = character.CharacterInfo.LastLogin
+		case "residence":
+			memberCharacter.Residence = character.CharacterInfo.Residence
+		}
+	}
+
+	return &memberCharacter
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+func TestGuildExpanded(t *testing.T) {
+	assert := assert.New(t)
+
+	guild := testFileCollector(t, "testdata/guilds/guild/Order of Glory.html", nil)
+	character := testFileCollector(t, "testdata/characters/Darkside Rafa.html", nil)
+
+	var (
+		mu       sync.Mutex
+		requests int
+	)
+	collector := func(request TibiaDataRequestStruct) (string, error) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+
+		switch {
+		case strings.Contains(request.URL, "subtopic=guilds"):
+			return guild(request)
+		case strings.HasSuffix(request.URL, "name=Zyb+the+Warrior"):
+			return "", validation.ErrStatusForbidden
+		}
+		return character(request)
+	}
+
+	expandedJson, err := TibiaGuildsGuildExpandedImpl("Order of Glory", []string{"residence,deaths", "Residence"}, collector)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expanded := expandedJson.GuildExpanded
+	assert.Equal("Order of Glory", expanded.Name)
+	assert.Equal("Premia", expanded.World)
+	assert.Equal([]string{"deaths", "residence"}, expanded.Fields)
+	assert.Equal(33, expanded.MembersTotal)
+	assert.Len(expanded.Members, 33)
+	assert.Equal(34, requests)
+	assert.Len(expandedJson.Information.TibiaURLs, 33)
+
+	leader := expanded.Members[0]
+	assert.Equal("Zyb the Warrior", leader.Name)
+	assert.Equal("Leader", leader.Rank)
+	assert.Equal(385, leader.Level)
+	assert.Nil(leader.Character)
+	if assert.NotNil(leader.Error) {
+		assert.Equal(20006, leader.Error.Error)
+		assert.Equal(http.StatusBadGateway, leader.Error.HTTPCode)
+	}
+	assert.Equal([]string{"Zyb the Warrior"}, expanded.FailedMembers)
+
+	member := expanded.Members[1]
+	assert.Nil(member.Error)
+	if assert.NotNil(member.Character) {
+		assert.Equal("Thais", member.Character.Residence)
+		assert.NotEmpty(member.Character.Deaths)
+		assert.Empty(member.Character.LastLogin)
+		assert.Empty(member.Character.AccountStatus)
+	}
+
+	// guilds with more members than the maximum are not expanded
+	defer func(members int) { TibiaDataGuildExpandedMaxMembers = members }(TibiaDataGuildExpandedMaxMembers)
+	TibiaDataGuildExpandedMaxMembers = 32
+	requests = 0
+	_, err = TibiaGuildsGuildExpandedImpl("Order of Glory", nil, collector)
+	assert.Equal(validation.ErrorGuildTooManyMembers, err)
+	assert.Equal(1, requests)
+}
+
+func TestGuildExpandedMemberCharacter(t *testing.T) {
+	// included lists are empty instead of null, the other fields have their zero value
+	data, err := json.Marshal(tibiaGuildsMemberCharacter(Character{}, []string{"deaths", "houses", "residence"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"account_status":"","achievement_points":0,"deaths":[],"former_names":null,"houses":[],"last_login":"","residence":""}`, string(data))
+}
+
+func TestGuildExpandedFields(t *testing.T) {
+	assert := assert.New(t)
+
+	fields, err := tibiaGuildsMemberFields(nil)
+	assert.Nil(err)
+	assert.Equal(GuildMemberDefaultFields, fields)
+
+	fields, err = tibiaGuildsMemberFields([]string{"last_login, account_status", "houses"})
+	assert.Nil(err)
+	assert.Equal([]string{"account_status", "houses", "last_login"}, fields)
+
+	_, err = TibiaGuildsGuildExpandedImpl("Order of Glory", []string{"deaths,skills"}, nil)
+	assert.Equal(validation.ErrorGuildMemberFieldInvalid, err)
+
+	_, err = TibiaGuildsGuildExpandedImpl("a", nil, nil)
+	assert.Equal(validation.ErrorGuildNameTooSmall, err)
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+// The statuses of the guild wars
+const (
+	GuildWarActive   = "active"
+	GuildWarEnded    = "ended"
+	GuildWarRejected = "rejected"
+)
+
+// Child of GuildWars
+type GuildWar struct {
+	Status        string `json:"status"`         // The status of the war (active, ended or rejected).
+	Opponent      string `json:"opponent"`       // The name of the opposing guild.
+	DeclaredBy    string `json:"declared_by"`    // The name of the guild that declared the war (only past wars).
+	Declared      string `json:"declared"`       // The day the war was declared (only past wars).
+	Started       string `json:"started"`        // The day the war started.
+	EndDate       string `json:"end_date"`       // The day the war ended, or will end at the latest for active wars.
+	Kills         int    `json:"kills"`          // The kills of the guild.
+	OpponentKills int    `json:"opponent_kills"` // The kills of the opposing guild.
+	ScoreLimit    int    `json:"score_limit"`    // The score a guild has to reach to win the war.
+	Duration      int    `json:"duration"`       // The duration of the war in days (only past wars).
+	Fee           int    `json:"fee"`            // The fee in gold coins the guild pays if it loses.
+	OpponentFee   int    `json:"opponent_fee"`   // The fee in gold coins the opposing guild pays if it loses.
+	Winner        string `json:"winner"`         // The name of the winning guild, empty for active wars and draws.
+}
+
+// Child of JSONData
+type GuildWars struct {
+	Name    string     `json:"name"`    // The name of the guild.
+	Current []GuildWar `json:"current"` // List of the active wars of the guild.
+	History []GuildWar `json:"history"` // List of the past wars and rejected declarations of the guild, the latest first.
+}
+
+// The base includes two levels: GuildWars and Information
+type GuildWarsResponse struct {
+	GuildWars   GuildWars   `json:"guild_wars"`
+	Information Information `json:"information"`
+}
+
+// The wording of the wars is not checked against a capture of tibia.com yet, the fixtures in testdata/guilds/wars are hand-written.
+var (
+	GuildWarKillsRegex       = regexp.MustCompile(`^(.+) - ([0-9,]+) kills$`)
+	GuildWarFeeRegex         = regexp.MustCompile(`^Fee: ([0-9,]+) gold coins$`)
+	GuildWarStartedRegex     = regexp.MustCompile(`^The war was started on (.+)\.$`)
+	GuildWarEndRegex         = regexp.MustCompile(`^The war will end on (.+) or as soon as one of the guilds has reached a score of ([0-9,]+)\.$`)
+	GuildWarDeclarationRegex = regexp.MustCompile(`^On (.+), (.+) declared war on (.+) with a score limit of ([0-9,]+), a duration of ([0-9]+) days and a fee of ([0-9,]+) gold coins\.$`)
+	GuildWarWonRegex         = regexp.MustCompile(`^The war started on (.+) and ended on (.+)\. (.+) won with ([0-9,]+) kills against ([0-9,]+) kills\.$`)
+	GuildWarDrawRegex        = regexp.MustCompile(`^The war started on (.+) and ended on (.+)\. The war ended in a draw with ([0-9,]+) kills against ([0-9,]+) kills\.$`)
+	GuildWarRejectedRegex    = regexp.MustCompile(`^The declaration was rejected\.$`)
+)
+
+func TibiaGuildsGuildWarsImpl(BoxContentHTML string, url string) (GuildWarsResponse, error) {
+	// Loading HTML data into ReaderHTML for goquery with NewReader
+	ReaderHTML, err := goquery.NewDocumentFromReader(strings.NewReader(BoxContentHTML))
+	if err != nil {
+		return GuildWarsResponse{}, fmt.Errorf("[error] TibiaGuildsGuildWarsImpl failed at goquery.NewDocumentFromReader, err: %s", err)
+	}
+
+	guildName := TibiaDataSanitizeStrings(ReaderHTML.Find("h1").First().Text())
+	if guildName == "" {
+		return GuildWarsResponse{}, validation.ErrorGuildNotFound
+	}
+
+	CurrentData, HistoryData := []GuildWar{}, []GuildWar{}
+
+	// Running query over the tables of the current wars and the war history
+	ReaderHTML.Find(".TableContainer").Each(func(index int, s *goquery.Selection) {
+		caption := TibiaDataSanitizeStrings(s.Find(".CaptionContainer .Text").First().Text())
+
+		s.Find(".TableContent tr").Each(func(index int, s *goquery.Selection) {
+			// lines of the war are separated by line breaks
+			s.Find("br").ReplaceWithHtml("\n")
+			lines := strings.Split(strings.TrimSpace(TibiaDataSanitizeStrings(s.Text())), "\n")
+
+			switch caption {
+			case "Current Wars":
+				if war, ok := tibiaGuildsGuildCurrentWar(guildName, lines); ok {
+					CurrentData = append(CurrentData, war)
+				}
+			case "Guild War History":
+				if war, ok := tibiaGuildsGuildPastWar(guildName, lines); ok {
+					HistoryData = append(HistoryData, war)
+				}
+			}
+		})
+	})
+
+	//
+	// Build the data-blob
+	return GuildWarsResponse{
+		GuildWars{
+			Name:    guildName,
+			Current: CurrentData,
+			History: HistoryData,
+		},
+		Information{
+			APIDetails: TibiaDataAPIDetails,
+			Timestamp:  TibiaDataDatetime(""),
+			TibiaURLs:  []string{url},
+			Status: Status{
+				HTTPCode: http.StatusOK,
+			},
+		},
+	}, nil
+}
+
+// tibiaGuildsGuildCurrentWar parses the lines of an active war
+// The lines start with the kills and fee of both guilds, followed by the start and the conditions of the end.
+func tibiaGuildsGuildCurrentWar(guild string, lines []string) (GuildWar, bool) {
+	war := GuildWar{Status: GuildWarActive}
+
+	var (
+		sides int
+		fee   *int // the fee follows the kills of its guild
+	)
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+
+		if subma := GuildWarKillsRegex.FindStringSubmatch(line); subma != nil {
+			sides++
+			if subma[1] == guild {
+				war.Kills, fee = TibiaDataStringToInteger(subma[2]), &war.Fee
+			} else {
+				war.Opponent, war.OpponentKills, fee = subma[1], TibiaDataStringToInteger(subma[2]), &war.OpponentFee
+			}
+		} else if subma := GuildWarFeeRegex.FindStringSubmatch(line); subma != nil && fee != nil {
+			*fee = TibiaDataStringToInteger(subma[1])
+		} else if subma := GuildWarStartedRegex.FindStringSubmatch(line); subma != nil {
+			war.Started = TibiaDataDate(subma[1])
+		} else if subma := GuildWarEndRegex.FindStringSubmatch(line); subma != nil {
+			war.EndDate = TibiaDataDate(subma[1])
+			war.ScoreLimit = TibiaDataStringToInteger(subma[2])
+		}
+	}
+
+	return war, sides == 2
+}
+
+// tibiaGuildsGuildPastWar parses the lines of a past war or a rejected declaration
+func tibiaGuildsGuildPastWar(guild string, lines []string) (GuildWar, bool) {
+	var war GuildWar
+
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+
+		if subma := GuildWarDeclarationRegex.FindStringSubmatch(line); subma != nil {
+			war.Declared = TibiaDataDate(subma[1])
+			war.DeclaredBy = subma[2]
+			war.Opponent = subma[3]
+			if subma[3] == guild {
+				war.Opponent = subma[2]
+			}
+			war.ScoreLimit = TibiaDataStringToInteger(subma[4])
+			war.Duration = TibiaDataStringToInteger(subma[5])
+			war.Fee = TibiaDataStringToInteger(subma[6])
+			war.OpponentFee = war.Fee
+		} else if subma := GuildWarWonRegex.FindStringSubmatch(line); subma != nil {
+			war.Status = GuildWarEnded
+			war.Started = TibiaDataDate(subma[1])
+			war.EndDate = TibiaDataDate(subma[2])
+			war.Winner = subma[3]
+			war.Kills, war.OpponentKills = TibiaDataStringToInteger(subma[4]), TibiaDataStringToInteger(subma[5])
+			if war.Winner != guild {
+				war.Kills, war.OpponentKills = war.OpponentKills, war.Kills
+			}
+		} else if subma := GuildWarDrawRegex.FindStringSubmatch(line); subma != nil {
+			war.Status = GuildWarEnded
+			war.Started = TibiaDataDate(subma[1])
+			war.EndDate = TibiaDataDate(subma[2])
+			war.Kills, war.OpponentKills = TibiaDataStringToInteger(subma[3]), TibiaDataStringToInteger(subma[4])
+		} else if GuildWarRejectedRegex.MatchString(line) {
+			war.Status = GuildWarRejected
+		}
+	}
+
+	return war, war.Opponent != "" && war.Status != ""
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/static"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+// The test files of the wars are hand-written, the wording of the wars is not verified against tibia.com yet.
+func TestNightsWatchWars(t *testing.T) {
+	file, err := static.TestFiles.Open("testdata/guilds/wars/Nights Watch.html")
+	if err != nil {
+		t.Fatalf("file opening error: %s", err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		t.Fatalf("File reading error: %s", err)
+	}
+
+	warsJson, err := TibiaGuildsGuildWarsImpl(string(data), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert := assert.New(t)
+	wars := warsJson.GuildWars
+
+	assert.Equal("Nights Watch", wars.Name)
+	assert.Equal([]GuildWar{{
+		Status:        GuildWarActive,
+		Opponent:      "Eternal Oblivion",
+		Started:       "2023-09-20",
+		EndDate:       "2023-10-20",
+		Kills:         123,
+		OpponentKills: 98,
+		ScoreLimit:    500,
+		Fee:           1000000,
+		OpponentFee:   1000000,
+	}}, wars.Current)
+
+	assert.Equal(4, len(wars.History))
+	assert.Equal(GuildWar{
+		Status:        GuildWarEnded,
+		Opponent:      "Hardcore Elite",
+		DeclaredBy:    "Nights Watch",
+		Declared:      "2023-08-11",
+		Started:       "2023-08-12",
+		EndDate:       "2023-08-20",
+		Kills:         300,
+		OpponentKills: 187,
+		ScoreLimit:    300,
+		Duration:      14,
+		Winner:        "Nights Watch",
+	}, wars.History[0])
+
+	// the kills are from the point of view of the guild
+	lost := wars.History[1]
+	assert.Equal("Red Alliance", lost.Opponent)
+	assert.Equal("Red Alliance", lost.DeclaredBy)
+	assert.Equal("Red Alliance", lost.Winner)
+	assert.Equal(51, lost.Kills)
+	assert.Equal(64, lost.OpponentKills)
+	assert.Equal(500000, lost.Fee)
+	assert.Equal(7, lost.Duration)
+
+	rejected := wars.History[2]
+	assert.Equal(GuildWarRejected, rejected.Status)
+	assert.Equal("Silent Wolves", rejected.Opponent)
+	assert.Empty(rejected.Started)
+	assert.Empty(rejected.EndDate)
+
+	draw := wars.History[3]
+	assert.Equal(GuildWarEnded, draw.Status)
+	assert.Equal("Dark Pact", draw.Opponent)
+	assert.Empty(draw.Winner)
+	assert.Equal(40, draw.Kills)
+	assert.Equal(40, draw.OpponentKills)
+	assert.Equal("2023-05-13", draw.EndDate)
+}
+
+func TestOrderofGloryWars(t *testing.T) {
+	file, err := static.TestFiles.Open("testdata/guilds/wars/Order of Glory.html")
+	if err != nil {
+		t.Fatalf("file opening error: %s", err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		t.Fatalf("File reading error: %s", err)
+	}
+
+	warsJson, err := TibiaGuildsGuildWarsImpl(string(data), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert := assert.New(t)
+	wars := warsJson.GuildWars
+
+	assert.Equal("Order of Glory", wars.Name)
+	assert.Empty(wars.Current)
+	assert.Empty(wars.History)
+}
+
+func TestGuildWarsNotFound(t *testing.T) {
+	_, err := TibiaGuildsGuildWarsImpl("<html><body></body></html>", "")
+	assert.Equal(t, validation.ErrorGuildNotFound, err)
+}
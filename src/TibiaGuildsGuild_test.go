@@ -86,6 +86,8 @@ func TestElysium(t *testing.T) {
 	assert.Equal("Ab'Dendriel Clanhall", guild.Guildhalls[0].Name)
 	assert.Equal("2023-02-18", guild.Guildhalls[0].PaidUntil)
 	assert.Equal("Vunira", guild.Guildhalls[0].World)
+	assert.Equal("rented", guild.Guildhalls[0].Status)
+	assert.Empty(guild.Guildhalls[0].Owner)
 	assert.True(guild.Active)
 	assert.Equal("2004-05-26", guild.Founded)
 	assert.True(guild.Applications)
@@ -141,6 +143,8 @@ func TestMercenarys(t *testing.T) {
 	assert.Equal("Mercenary Tower", guild.Guildhalls[0].Name)
 	assert.Equal("2023-01-28", guild.Guildhalls[0].PaidUntil)
 	assert.Equal("Antica", guild.Guildhalls[0].World)
+	assert.Equal("rented", guild.Guildhalls[0].Status)
+	assert.Empty(guild.Guildhalls[0].Owner)
 	assert.True(guild.Active)
 	assert.Equal("2002-02-18", guild.Founded)
 	assert.True(guild.Applications)
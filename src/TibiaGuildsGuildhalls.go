@@ -0,0 +1,133 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+// tibiaGuildsGuildhallsCache caches the guildhall lists of the towns searched for guildhalls by their world and town
+var tibiaGuildsGuildhallsCache = newTibiaDataCache[[]HousesHouse](3 * time.Hour)
+
+// TibiaGuildsGuildWithGuildhallsImpl func - fetches a guild and the house pages of its guildhalls
+// The house mapping has no house names, so the guildhalls are searched by name in the guildhall lists of the towns
+// of the guild's world. The guild is returned without the details of a guildhall if they could not be fetched.
+func TibiaGuildsGuildWithGuildhallsImpl(name string, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (GuildResponse, error) {
+	endpoint, err := tibiaGuildsGuildEndpoint(name)
+	if err != nil {
+		return GuildResponse{}, err
+	}
+
+	BoxContentHTML, err := htmlDataCollector(endpoint.Request)
+	if err != nil {
+		return GuildResponse{}, err
+	}
+
+	data, err := endpoint.Parse(BoxContentHTML)
+	if err != nil {
+		return GuildResponse{}, err
+	}
+	guildResponse := data.(GuildResponse)
+
+	for i := range guildResponse.Guild.Guildhalls {
+		urls := tibiaGuildsGuildhallDetails(&guildResponse.Guild.Guildhalls[i], htmlDataCollector)
+		guildResponse.Information.TibiaURLs = append(guildResponse.Information.TibiaURLs, urls...)
+	}
+
+	return guildResponse, nil
+}
+
+// tibiaGuildsGuildhallDetails adds the town, id, owner, rent and status of the house page to a guildhall
+// It returns the tibia urls that were fetched.
+func tibiaGuildsGuildhallDetails(guildhall *Guildhall, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) []string {
+	town, houseID, tibiaURLs := tibiaGuildsGuildhallSearch(guildhall.World, guildhall.Name, htmlDataCollector)
+	if houseID == 0 {
+		return tibiaURLs
+	}
+	guildhall.Town, guildhall.HouseID = town, houseID
+
+	// the house ids of the guildhall lists are not checked against the house mapping
+	request := tibiaHousesHouseRequest(guildhall.World, guildhall.HouseID)
+	BoxContentHTML, err := htmlDataCollector(request)
+	if err != nil {
+		return tibiaURLs
+	}
+	tibiaURLs = append(tibiaURLs, request.URL)
+
+	houseResponse, err := TibiaHousesHouseImpl(guildhall.HouseID, BoxContentHTML, request.URL)
+	if err != nil {
+		return tibiaURLs
+	}
+	house := houseResponse.House
+
+	if house.Town != "" {
+		guildhall.Town = house.Town
+	}
+	guildhall.Owner = house.Status.Rental.Owner
+	guildhall.Rent = house.Rent
+
+	switch {
+	case house.Status.IsAuctioned:
+		guildhall.Status = "auctioned"
+	case house.Status.IsTransfering:
+		guildhall.Status = "transferring"
+	case house.Status.IsMoving:
+		guildhall.Status = "moving"
+	case house.Status.IsRented:
+		guildhall.Status = "rented"
+	}
+
+	return tibiaURLs
+}
+
+// tibiaGuildsGuildhallSearch returns the town and id of a guildhall by searching the guildhall lists of the towns of a world
+// Only towns that have guildhalls in the house mapping are searched, the lists are cached in tibiaGuildsGuildhallsCache.
+func tibiaGuildsGuildhallSearch(world, name string, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (string, int, []string) {
+	houses, err := validation.GetHouses()
+	if err != nil {
+		return "", 0, nil
+	}
+
+	var towns []string
+	for _, house := range houses {
+		if house.Type == "guildhall" && !slices.Contains(towns, house.Town) {
+			towns = append(towns, house.Town)
+		}
+	}
+
+	guildhalls := make([][]HousesHouse, len(towns))
+	urls := make([]string, len(towns))
+	TibiaDataParallel(len(towns), TibiaDataFanOutConcurrency, func(i int) {
+		key := world + "/" + towns[i]
+		if cached, ok := tibiaGuildsGuildhallsCache.Get(key); ok {
+			guildhalls[i] = cached
+			return
+		}
+
+		list, url, err := makeHouseRequest("guildhalls", world, towns[i], htmlDataCollector)
+		if err != nil {
+			return
+		}
+		guildhalls[i], urls[i] = list, url
+		tibiaGuildsGuildhallsCache.Set(key, list)
+	})
+
+	var tibiaURLs []string
+	for _, url := range urls {
+		if url != "" {
+			tibiaURLs = append(tibiaURLs, url)
+		}
+	}
+
+	for i, list := range guildhalls {
+		for _, guildhall := range list {
+			if strings.EqualFold(guildhall.Name, name) {
+				return towns[i], guildhall.HouseID, tibiaURLs
+			}
+		}
+	}
+
+	return "", 0, tibiaURLs
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+func TestGuildWithGuildhalls(t *testing.T) {
+	assert := assert.New(t)
+
+	defer func(cache *tibiaDataCache[[]HousesHouse]) { tibiaGuildsGuildhallsCache = cache }(tibiaGuildsGuildhallsCache)
+	tibiaGuildsGuildhallsCache = newTibiaDataCache[[]HousesHouse](time.Hour)
+
+	// Spiritkeep (10001) is a guildhall of Thais, it is found by name in the guildhall list of Thais
+	guild := testFileCollector(t, "testdata/guilds/guild/Mercenarys.html", nil)
+	guildhalls := testFileCollector(t, "testdata/houses/overview/AnticaThaisGuilds.html", nil)
+	house := testFileCollector(t, "testdata/houses/Premia/Edron/Cormaya10.html", nil)
+
+	var (
+		mu       sync.Mutex
+		requests []string
+	)
+	collector := func(request TibiaDataRequestStruct) (string, error) {
+		mu.Lock()
+		requests = append(requests, request.URL)
+		mu.Unlock()
+
+		switch {
+		case strings.Contains(request.URL, "subtopic=guilds"):
+			html, err := guild(request)
+			return strings.ReplaceAll(html, "Mercenary Tower", "Spiritkeep"), err
+		case strings.HasSuffix(request.URL, "&town=Thais&type=guildhalls"):
+			return guildhalls(request)
+		case strings.HasSuffix(request.URL, "&houseid=10001"):
+			return house(request)
+		}
+		return "", validation.ErrStatusForbidden
+	}
+
+	guildJson, err := TibiaGuildsGuildWithGuildhallsImpl("Mercenarys", collector)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal([]Guildhall{{
+		Name:      "Spiritkeep",
+		World:     "Antica",
+		Town:      "Thais",
+		Status:    "rented",
+		Owner:     "Xendor of Askara",
+		HouseID:   10001,
+		Rent:      300000,
+		PaidUntil: "2023-01-28",
+	}}, guildJson.Guild.Guildhalls)
+
+	assert.Contains(requests, "https://www.tibia.com/community/?subtopic=houses&page=view&world=Antica&houseid=10001")
+	assert.Contains(guildJson.Information.TibiaURLs, "https://www.tibia.com/community/?subtopic=houses&page=view&world=Antica&houseid=10001")
+
+	// a guildhall whose house page can not be fetched keeps the status of the guild page
+	guildJson, err = TibiaGuildsGuildWithGuildhallsImpl("Mercenarys", func(request TibiaDataRequestStruct) (string, error) {
+		switch {
+		case strings.Contains(request.URL, "subtopic=guilds"):
+			return guild(request)
+		case strings.HasSuffix(request.URL, "&town=Thais&type=guildhalls"):
+			return guildhalls(request)
+		}
+		return "", validation.ErrStatusForbidden
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]Guildhall{{
+		Name:      "Mercenary Tower",
+		World:     "Antica",
+		Town:      "Thais",
+		Status:    "rented",
+		HouseID:   12001,
+		PaidUntil: "2023-01-28",
+	}}, guildJson.Guild.Guildhalls)
+}
+
+func TestGuildhallWithoutDetails(t *testing.T) {
+	assert := assert.New(t)
+
+	guild := testFileCollector(t, "testdata/guilds/guild/Mercenarys.html", nil)
+	guildJson, err := TibiaGuildsGuildWithGuildhallsImpl("Mercenarys", func(request TibiaDataRequestStruct) (string, error) {
+		if strings.Contains(request.URL, "subtopic=guilds") {
+			return guild(request)
+		}
+		return "", validation.ErrStatusForbidden
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a guildhall that is not found leaves out the fields of the guildhall details
+	data, err := json.Marshal(guildJson.Guild.Guildhalls)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(`[{"name":"Mercenary Tower","world":"Antica","status":"rented","paid_until":"2023-01-28"}]`, string(data))
+}
@@ -0,0 +1,507 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// TibiaDataGuildsTrackerInterval is the time between two polls of the tracked guilds
+	TibiaDataGuildsTrackerInterval = 30 * time.Minute
+
+	// TibiaDataGuildsTrackerMaxSize is the maximum number of tracked guilds
+	TibiaDataGuildsTrackerMaxSize = 100
+)
+
+var (
+	tibiaGuildsTrackerBucket          = []byte("guilds_tracker")
+	tibiaGuildsTrackerMembersBucket   = []byte("guilds_tracker_members")
+	tibiaGuildsHistoryBucket          = []byte("guilds_history")
+	tibiaGuildsCharacterHistoryBucket = []byte("guilds_character_history")
+)
+
+// Child of GuildTrackerResponse
+type TrackedGuild struct {
+	Name       string  `json:"name"`                  // The name the guild is tracked with.
+	Added      string  `json:"added"`                 // The time the guild was added to the tracker.
+	LastPolled string  `json:"last_polled,omitempty"` // The time the guild was last polled successfully.
+	Error      *Status `json:"error,omitempty"`       // The error of the last poll (if it failed).
+}
+
+// GuildTrackerRequest is the body of adding guilds to the tracker
+type GuildTrackerRequest struct {
+	Names []string `json:"names"` // The guild names.
+}
+
+// The base includes two levels: GuildTracker and Information
+type GuildTrackerResponse struct {
+	GuildTracker []TrackedGuild `json:"guild_tracker"`
+	Information  Information    `json:"information"`
+}
+
+// ListEntries returns the tracked guilds
+func (r GuildTrackerResponse) ListEntries() interface{} {
+	return r.GuildTracker
+}
+
+// Child of GuildHistory and CharacterGuildHistory
+type GuildHistoryEvent struct {
+	Time          string `json:"time"`           // The time of the poll the change was seen in.
+	Guild         string `json:"guild"`          // The name of the guild.
+	Type          string `json:"type"`           // The type of the change (join, leave, rank_change or title_change).
+	Character     string `json:"character"`      // The name of the member.
+	Rank          string `json:"rank"`           // The rank of the member after the change (the last rank on leave).
+	PreviousRank  string `json:"previous_rank"`  // The rank of the member before the change (rank_change).
+	Title         string `json:"title"`          // The title of the member after the change (the last title on leave).
+	PreviousTitle string `json:"previous_title"` // The title of the member before the change (title_change).
+}
+
+// Child of JSONData
+type GuildHistory struct {
+	Name       string              `json:"name"`        // The name the guild is tracked with.
+	From       string              `json:"from"`        // The start of the period.
+	To         string              `json:"to"`          // The end of the period.
+	LastPolled string              `json:"last_polled"` // The time the guild was last polled successfully.
+	Events     []GuildHistoryEvent `json:"events"`      // The changes of the members in the period, the latest first.
+}
+
+// The base includes two levels: GuildHistory and Information
+type GuildHistoryResponse struct {
+	GuildHistory GuildHistory `json:"guild_history"`
+	Information  Information  `json:"information"`
+}
+
+// ListEntries returns the events of the history
+func (r GuildHistoryResponse) ListEntries() interface{} {
+	return r.GuildHistory.Events
+}
+
+// Child of JSONData
+type CharacterGuildHistory struct {
+	Name   string              `json:"name"`   // The name of the character.
+	Events []GuildHistoryEvent `json:"events"` // The changes of the character in all tracked guilds, the latest first.
+}
+
+// The base includes two levels: CharacterGuildHistory and Information
+type CharacterGuildHistoryResponse struct {
+	CharacterGuildHistory CharacterGuildHistory `json:"character_guild_history"`
+	Information           Information           `json:"information"`
+}
+
+// ListEntries returns the events of the history
+func (r CharacterGuildHistoryResponse) ListEntries() interface{} {
+	return r.CharacterGuildHistory.Events
+}
+
+// tibiaGuildsTrackerKey returns the key of a tracked guild or a character, names are not case sensitive
+func tibiaGuildsTrackerKey(name string) []byte {
+	return []byte(strings.ToLower(name))
+}
+
+// tibiaGuildsTrackerResponse returns the response with all tracked guilds
+func tibiaGuildsTrackerResponse(tx *bolt.Tx) (GuildTrackerResponse, error) {
+	// the keys are the lowercase names, so the guilds are sorted by name
+	guilds, err := tibiaDataStoreList[TrackedGuild](tx, tibiaGuildsTrackerBucket)
+	if err != nil {
+		return GuildTrackerResponse{}, err
+	}
+
+	return GuildTrackerResponse{
+		GuildTracker: guilds,
+		Information:  tibiaDataLocalInformation(),
+	}, nil
+}
+
+// TibiaGuildsTrackerImpl func - returns all tracked guilds
+func TibiaGuildsTrackerImpl() (GuildTrackerResponse, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return GuildTrackerResponse{}, err
+	}
+
+	var response GuildTrackerResponse
+	err = db.View(func(tx *bolt.Tx) (err error) {
+		response, err = tibiaGuildsTrackerResponse(tx)
+		return err
+	})
+
+	return response, err
+}
+
+// TibiaGuildsTrackerAddImpl func - adds guilds to the tracker and returns all tracked guilds
+// Guilds that are tracked already are kept as they are.
+func TibiaGuildsTrackerAddImpl(names []string, now time.Time) (GuildTrackerResponse, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return GuildTrackerResponse{}, err
+	}
+
+	if len(names) == 0 {
+		return GuildTrackerResponse{}, validation.ErrorBatchEmpty
+	}
+	for _, name := range names {
+		if err := validation.IsGuildNameValid(name); err != nil {
+			return GuildTrackerResponse{}, err
+		}
+	}
+
+	entries := make([]tibiaDataStoreEntry, 0, len(names))
+	for _, name := range names {
+		entries = append(entries, tibiaDataStoreEntry{
+			Key:   tibiaGuildsTrackerKey(name),
+			Value: TrackedGuild{Name: name, Added: now.UTC().Format(time.RFC3339)},
+		})
+	}
+
+	var response GuildTrackerResponse
+	err = db.Update(func(tx *bolt.Tx) error {
+		if err := tibiaDataStoreAdd(tx, tibiaGuildsTrackerBucket, entries, TibiaDataGuildsTrackerMaxSize, validation.ErrorGuildTrackerFull); err != nil {
+			return err
+		}
+
+		response, err = tibiaGuildsTrackerResponse(tx)
+		return err
+	})
+
+	return response, err
+}
+
+// TibiaGuildsTrackerRemoveImpl func - removes a guild and its history from the tracker and returns all tracked guilds
+// The events of the guild are removed from the guild histories of the characters as well.
+func TibiaGuildsTrackerRemoveImpl(name string) (GuildTrackerResponse, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return GuildTrackerResponse{}, err
+	}
+
+	var response GuildTrackerResponse
+	err = db.Update(func(tx *bolt.Tx) error {
+		key := tibiaGuildsTrackerKey(name)
+		if err := tibiaDataStoreRemove(tx, tibiaGuildsTrackerBucket, key, validation.ErrorGuildNotTracked); err != nil {
+			return err
+		}
+		if members := tx.Bucket(tibiaGuildsTrackerMembersBucket); members != nil {
+			if err := members.Delete(key); err != nil {
+				return err
+			}
+		}
+		if err := tibiaGuildsHistoryRemove(tx, key); err != nil {
+			return err
+		}
+
+		response, err = tibiaGuildsTrackerResponse(tx)
+		return err
+	})
+
+	return response, err
+}
+
+// tibiaGuildsHistoryRemove deletes the events of a guild from its history and the histories of its characters
+func tibiaGuildsHistoryRemove(tx *bolt.Tx, key []byte) error {
+	root := tx.Bucket(tibiaGuildsHistoryBucket)
+	if root == nil || root.Bucket(key) == nil {
+		return nil
+	}
+
+	if characters := tx.Bucket(tibiaGuildsCharacterHistoryBucket); characters != nil {
+		err := root.Bucket(key).ForEach(func(eventKey, value []byte) error {
+			var event GuildHistoryEvent
+			if err := json.Unmarshal(value, &event); err != nil {
+				return err
+			}
+			if bucket := characters.Bucket(tibiaGuildsTrackerKey(event.Character)); bucket != nil {
+				return bucket.Delete(tibiaGuildsHistoryEventKey(eventKey[:8], string(key), event.Type))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return root.DeleteBucket(key)
+}
+
+// runGuildsTracker polls the tracked guilds every interval until stop is closed
+func runGuildsTracker(db *bolt.DB, interval time.Duration, htmlDataCollector func(TibiaDataRequestStruct) (string, error), stop <-chan struct{}) {
+	tibiaDataStoreRun("guild tracker", interval, func(minAge time.Duration, now time.Time) error {
+		return tibiaGuildsTrackerPoll(db, minAge, htmlDataCollector, now)
+	}, stop)
+}
+
+// tibiaGuildsTrackerPoll fetches all tracked guilds that were not polled within minAge and stores the changes of their members
+// The guilds are fetched within TibiaDataFanOutConcurrency, a guild that fails keeps the members of its previous poll for the next comparison.
+func tibiaGuildsTrackerPoll(db *bolt.DB, minAge time.Duration, htmlDataCollector func(TibiaDataRequestStruct) (string, error), now time.Time) error {
+	var guilds []TrackedGuild
+	err := db.View(func(tx *bolt.Tx) (err error) {
+		guilds, err = tibiaDataStoreList[TrackedGuild](tx, tibiaGuildsTrackerBucket)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var due []TrackedGuild
+	for _, guild := range guilds {
+		if tibiaDataStoreDue(guild.LastPolled, minAge, now) {
+			due = append(due, guild)
+		}
+	}
+
+	results := make([]*Guild, len(due))
+	statuses := make([]*Status, len(due))
+	TibiaDataParallel(len(due), TibiaDataFanOutConcurrency, func(i int) {
+		results[i], statuses[i] = tibiaGuildsGuildFetch(due[i].Name, htmlDataCollector)
+	})
+
+	return db.Update(func(tx *bolt.Tx) error {
+		for i, guild := range due {
+			if err := tibiaGuildsTrackerRecord(tx, guild.Name, results[i], statuses[i], now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// tibiaGuildsGuildFetch fetches one guild and returns it or the error status of /v4/guild/:name
+func tibiaGuildsGuildFetch(name string, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (*Guild, *Status) {
+	endpoint, err := tibiaGuildsGuildEndpoint(name)
+	if err != nil {
+		status := TibiaDataErrorInformation(err, 0).Status
+		return nil, &status
+	}
+
+	BoxContentHTML, err := htmlDataCollector(endpoint.Request)
+	if err != nil {
+		status := TibiaDataErrorInformation(err, http.StatusBadGateway).Status
+		return nil, &status
+	}
+
+	data, err := endpoint.Parse(BoxContentHTML)
+	if err != nil {
+		status := TibiaDataErrorInformation(err, 0).Status
+		return nil, &status
+	}
+
+	guild := data.(GuildResponse).Guild
+	return &guild, nil
+}
+
+// tibiaGuildsTrackerRecord stores the result of polling one guild
+// The members of the poll are kept to compare them with the next poll, the first poll of a guild only records them.
+func tibiaGuildsTrackerRecord(tx *bolt.Tx, name string, guild *Guild, status *Status, now time.Time) error {
+	key := tibiaGuildsTrackerKey(name)
+	var tracked TrackedGuild
+	if ok, err := tibiaDataStoreGet(tx, tibiaGuildsTrackerBucket, key, &tracked); !ok || err != nil {
+		return err
+	}
+
+	tracked.Error = status
+	if guild != nil {
+		tracked.LastPolled = now.UTC().Format(time.RFC3339)
+
+		members, err := tx.CreateBucketIfNotExists(tibiaGuildsTrackerMembersBucket)
+		if err != nil {
+			return err
+		}
+
+		if value := members.Get(key); value != nil {
+			var previous []GuildMember
+			if err := json.Unmarshal(value, &previous); err != nil {
+				return err
+			}
+			for _, event := range tibiaGuildsHistoryCompare(guild.Name, previous, guild.Members, now) {
+				if err := tibiaGuildsHistoryRecord(tx, key, event, now); err != nil {
+					return err
+				}
+			}
+		}
+
+		value, err := json.Marshal(guild.Members)
+		if err != nil {
+			return err
+		}
+		if err := members.Put(key, value); err != nil {
+			return err
+		}
+	}
+
+	return tibiaDataStorePut(tx, tibiaGuildsTrackerBucket, key, tracked)
+}
+
+// tibiaGuildsHistoryCompare returns the joins, leaves and rank and title changes between two polls of the members of a guild
+func tibiaGuildsHistoryCompare(guild string, previous, current []GuildMember, now time.Time) []GuildHistoryEvent {
+	var events []GuildHistoryEvent
+	eventTime := now.UTC().Format(time.RFC3339)
+
+	for _, member := range current {
+		index := slices.IndexFunc(previous, func(m GuildMember) bool { return m.Name == member.Name })
+		if index < 0 {
+			events = append(events, GuildHistoryEvent{Time: eventTime, Guild: guild, Type: GuildEventJoin, Character: member.Name, Rank: member.Rank, Title: member.Title})
+			continue
+		}
+
+		before := previous[index]
+		if before.Rank != member.Rank {
+			events = append(events, GuildHistoryEvent{Time: eventTime, Guild: guild, Type: GuildEventRankChange, Character: member.Name, Rank: member.Rank, PreviousRank: before.Rank, Title: member.Title})
+		}
+		if before.Title != member.Title {
+			events = append(events, GuildHistoryEvent{Time: eventTime, Guild: guild, Type: GuildEventTitleChange, Character: member.Name, Rank: member.Rank, Title: member.Title, PreviousTitle: before.Title})
+		}
+	}
+
+	for _, member := range previous {
+		if !slices.ContainsFunc(current, func(m GuildMember) bool { return m.Name == member.Name }) {
+			events = append(events, GuildHistoryEvent{Time: eventTime, Guild: guild, Type: GuildEventLeave, Character: member.Name, Rank: member.Rank, Title: member.Title})
+		}
+	}
+
+	return events
+}
+
+// tibiaGuildsHistoryEventKey returns the key of an event, events are sorted by time and unique per name and type
+func tibiaGuildsHistoryEventKey(timeKey []byte, name, eventType string) []byte {
+	key := append([]byte{}, timeKey...)
+	return append(key, strings.ToLower(name)+"/"+eventType...)
+}
+
+// tibiaGuildsHistoryRecord stores an event in the history of the guild and of the character
+func tibiaGuildsHistoryRecord(tx *bolt.Tx, guildKey []byte, event GuildHistoryEvent, now time.Time) error {
+	value, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	for _, history := range []struct {
+		root, key []byte
+		name      string
+	}{
+		{tibiaGuildsHistoryBucket, guildKey, event.Character},
+		{tibiaGuildsCharacterHistoryBucket, tibiaGuildsTrackerKey(event.Character), string(guildKey)},
+	} {
+		root, err := tx.CreateBucketIfNotExists(history.root)
+		if err != nil {
+			return err
+		}
+		bucket, err := root.CreateBucketIfNotExists(history.key)
+		if err != nil {
+			return err
+		}
+		if err := bucket.Put(tibiaGuildsHistoryEventKey(tibiaDataStoreTimeKey(now), history.name, event.Type), value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TibiaGuildsHistoryImpl func - returns the changes of the members of a tracked guild in a period
+func TibiaGuildsHistoryImpl(name, from, to, period string) (GuildHistoryResponse, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return GuildHistoryResponse{}, err
+	}
+
+	fromTime, toTime, err := tibiaDataStorePeriod(from, to, period, time.Now())
+	if err != nil {
+		return GuildHistoryResponse{}, err
+	}
+
+	history := GuildHistory{
+		From:   fromTime.UTC().Format(time.RFC3339),
+		To:     toTime.UTC().Format(time.RFC3339),
+		Events: []GuildHistoryEvent{},
+	}
+
+	err = db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(tibiaGuildsTrackerBucket)
+		key := tibiaGuildsTrackerKey(name)
+		if bucket == nil || bucket.Get(key) == nil {
+			return validation.ErrorGuildNotTracked
+		}
+
+		var tracked TrackedGuild
+		if err := json.Unmarshal(bucket.Get(key), &tracked); err != nil {
+			return err
+		}
+		history.Name = tracked.Name
+		history.LastPolled = tracked.LastPolled
+
+		root := tx.Bucket(tibiaGuildsHistoryBucket)
+		if root == nil || root.Bucket(key) == nil {
+			return nil
+		}
+
+		cursor := root.Bucket(key).Cursor()
+		for eventKey, value := cursor.Seek(tibiaDataStoreTimeKey(fromTime)); eventKey != nil && !tibiaDataStoreKeyTime(eventKey).After(toTime); eventKey, value = cursor.Next() {
+			var event GuildHistoryEvent
+			if err := json.Unmarshal(value, &event); err != nil {
+				return err
+			}
+			history.Events = append(history.Events, event)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return GuildHistoryResponse{}, err
+	}
+
+	// the events are stored chronologically, like the guild events of tibia.com the latest are first
+	slices.Reverse(history.Events)
+
+	return GuildHistoryResponse{
+		GuildHistory: history,
+		Information:  tibiaDataLocalInformation(),
+	}, nil
+}
+
+// TibiaCharactersGuildHistoryImpl func - returns all changes of a character seen in the tracked guilds
+func TibiaCharactersGuildHistoryImpl(name string) (CharacterGuildHistoryResponse, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return CharacterGuildHistoryResponse{}, err
+	}
+
+	if err := validation.IsCharacterNameValid(name); err != nil {
+		return CharacterGuildHistoryResponse{}, err
+	}
+
+	history := CharacterGuildHistory{Name: name, Events: []GuildHistoryEvent{}}
+	err = db.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket(tibiaGuildsCharacterHistoryBucket)
+		if root == nil || root.Bucket(tibiaGuildsTrackerKey(name)) == nil {
+			return nil
+		}
+
+		return root.Bucket(tibiaGuildsTrackerKey(name)).ForEach(func(_, value []byte) error {
+			var event GuildHistoryEvent
+			if err := json.Unmarshal(value, &event); err != nil {
+				return err
+			}
+			history.Events = append(history.Events, event)
+			return nil
+		})
+	})
+	if err != nil {
+		return CharacterGuildHistoryResponse{}, err
+	}
+
+	// the name of the character as tibia.com lists it
+	if len(history.Events) > 0 {
+		history.Name = history.Events[len(history.Events)-1].Character
+	}
+	slices.Reverse(history.Events)
+
+	return CharacterGuildHistoryResponse{
+		CharacterGuildHistory: history,
+		Information:           tibiaDataLocalInformation(),
+	}, nil
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+func TestGuildsTracker(t *testing.T) {
+	assert := assert.New(t)
+	db := testStore(t)
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	// both tracked guilds are served the Order of Glory test file changed by replacer, renamed for Elysium
+	poll := func(now time.Time, replacer *strings.Replacer) {
+		guild := testFileCollector(t, "testdata/guilds/guild/Order of Glory.html", nil)
+		assert.Nil(tibiaGuildsTrackerPoll(db, 0, func(request TibiaDataRequestStruct) (string, error) {
+			html, err := guild(request)
+			if strings.HasSuffix(request.URL, "GuildName=Elysium") {
+				html = strings.ReplaceAll(html, "Order of Glory", "Elysium")
+			}
+			return replacer.Replace(html), err
+		}, now))
+	}
+
+	_, err := TibiaGuildsTrackerAddImpl([]string{"Order of Glory", "Elysium"}, start)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the first poll only records the members, then Dark Caverat is promoted, Kitarah gets a title and Lilpat is renamed
+	poll(start, strings.NewReplacer())
+	poll(start.Add(30*time.Minute), strings.NewReplacer(
+		`<TD>Alpha</TD>`, `<TD>Polemarch</TD>`,
+		`name=Kitarah">Kitarah</A>`, `name=Kitarah">Kitarah</A> (Healer)`,
+		`name=Lilpat">Lilpat</A>`, `name=Lilpat+Two">Lilpat&#160;Two</A>`,
+	))
+
+	historyJson, err := TibiaGuildsHistoryImpl("ORDER OF GLORY", "2025-01-01", "2025-01-02", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	history := historyJson.GuildHistory
+	assert.Equal("Order of Glory", history.Name)
+	assert.Equal("2025-01-01T10:30:00Z", history.LastPolled)
+	assert.Equal([]GuildHistoryEvent{
+		{Time: "2025-01-01T10:30:00Z", Guild: "Order of Glory", Type: GuildEventLeave, Character: "Lilpat", Rank: "Ourea"},
+		{Time: "2025-01-01T10:30:00Z", Guild: "Order of Glory", Type: GuildEventJoin, Character: "Lilpat Two", Rank: "Ourea"},
+		{Time: "2025-01-01T10:30:00Z", Guild: "Order of Glory", Type: GuildEventTitleChange, Character: "Kitarah", Rank: "Ourea", Title: "Healer"},
+		{Time: "2025-01-01T10:30:00Z", Guild: "Order of Glory", Type: GuildEventRankChange, Character: "Dark Caverat", Rank: "Polemarch", PreviousRank: "Alpha"},
+	}, history.Events)
+
+	historyJson, err = TibiaGuildsHistoryImpl("Order of Glory", "2025-01-01T10:00:00Z", "2025-01-01T10:15:00Z", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(historyJson.GuildHistory.Events)
+
+	// the character history has the events of all tracked guilds
+	characterJson, err := TibiaCharactersGuildHistoryImpl("kitarah")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal("Kitarah", characterJson.CharacterGuildHistory.Name)
+	assert.Equal([]GuildHistoryEvent{
+		{Time: "2025-01-01T10:30:00Z", Guild: "Order of Glory", Type: GuildEventTitleChange, Character: "Kitarah", Rank: "Ourea", Title: "Healer"},
+		{Time: "2025-01-01T10:30:00Z", Guild: "Elysium", Type: GuildEventTitleChange, Character: "Kitarah", Rank: "Ourea", Title: "Healer"},
+	}, characterJson.CharacterGuildHistory.Events)
+
+	trackerJson, err := TibiaGuildsTrackerRemoveImpl("order of glory")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(trackerJson.GuildTracker, 1)
+
+	// only the events of the removed guild are removed from the character histories
+	characterJson, err = TibiaCharactersGuildHistoryImpl("Kitarah")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(characterJson.CharacterGuildHistory.Events, 1) {
+		assert.Equal("Elysium", characterJson.CharacterGuildHistory.Events[0].Guild)
+	}
+	characterJson, err = TibiaCharactersGuildHistoryImpl("Dark Caverat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(characterJson.CharacterGuildHistory.Events, 1)
+
+	_, err = TibiaGuildsTrackerRemoveImpl("Elysium")
+	assert.Nil(err)
+	characterJson, err = TibiaCharactersGuildHistoryImpl("Dark Caverat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(characterJson.CharacterGuildHistory.Events)
+
+	_, err = TibiaGuildsHistoryImpl("Order of Glory", "", "", "")
+	assert.Equal(validation.ErrorGuildNotTracked, err)
+	_, err = TibiaGuildsTrackerRemoveImpl("Order of Glory")
+	assert.Equal(validation.ErrorGuildNotTracked, err)
+	_, err = TibiaCharactersGuildHistoryImpl("a")
+	assert.Equal(validation.ErrorCharacterNameTooSmall, err)
+}
+
+func TestGuildsHistoryCompare(t *testing.T) {
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	previous := []GuildMember{
+		{Name: "Trollefar", Rank: "Leader", Title: "Founder"},
+		{Name: "Durin", Rank: "Member"},
+		{Name: "Bubble", Rank: "Member", Title: "Nice"},
+	}
+	current := []GuildMember{
+		{Name: "Trollefar", Rank: "Leader"},
+		{Name: "Durin", Rank: "Vice Leader", Title: "Right Hand"},
+		{Name: "Arieswar", Rank: "Member"},
+	}
+
+	assert.Equal(t, []GuildHistoryEvent{
+		{Time: "2025-01-01T10:00:00Z", Guild: "Elysium", Type: GuildEventTitleChange, Character: "Trollefar", Rank: "Leader", PreviousTitle: "Founder"},
+		{Time: "2025-01-01T10:00:00Z", Guild: "Elysium", Type: GuildEventRankChange, Character: "Durin", Rank: "Vice Leader", PreviousRank: "Member", Title: "Right Hand"},
+		{Time: "2025-01-01T10:00:00Z", Guild: "Elysium", Type: GuildEventTitleChange, Character: "Durin", Rank: "Vice Leader", Title: "Right Hand"},
+		{Time: "2025-01-01T10:00:00Z", Guild: "Elysium", Type: GuildEventJoin, Character: "Arieswar", Rank: "Member"},
+		{Time: "2025-01-01T10:00:00Z", Guild: "Elysium", Type: GuildEventLeave, Character: "Bubble", Rank: "Member", Title: "Nice"},
+	}, tibiaGuildsHistoryCompare("Elysium", previous, current, now))
+}
+
+func TestGuildsTrackerAdd(t *testing.T) {
+	assert := assert.New(t)
+	testStore(t)
+
+	defer func(maxSize int) { TibiaDataGuildsTrackerMaxSize = maxSize }(TibiaDataGuildsTrackerMaxSize)
+	TibiaDataGuildsTrackerMaxSize = 2
+
+	_, err := TibiaGuildsTrackerAddImpl(nil, time.Now())
+	assert.Equal(validation.ErrorBatchEmpty, err)
+	_, err = TibiaGuildsTrackerAddImpl([]string{"Elysium", "a"}, time.Now())
+	assert.Equal(validation.ErrorGuildNameTooSmall, err)
+
+	_, err = TibiaGuildsTrackerAddImpl([]string{"Elysium", "Nights Watch"}, time.Now())
+	assert.Nil(err)
+	_, err = TibiaGuildsTrackerAddImpl([]string{"elysium"}, time.Now())
+	assert.Nil(err)
+	_, err = TibiaGuildsTrackerAddImpl([]string{"Order of Glory"}, time.Now())
+	assert.Equal(validation.ErrorGuildTrackerFull, err)
+}
+
+func TestGuildsTrackerStoreNotEnabled(t *testing.T) {
+	_, err := TibiaGuildsTrackerImpl()
+	assert.Equal(t, validation.ErrorStoreNotEnabled, err)
+	_, err = TibiaCharactersGuildHistoryImpl("Trollefar")
+	assert.Equal(t, validation.ErrorStoreNotEnabled, err)
+}
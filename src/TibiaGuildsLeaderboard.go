@@ -0,0 +1,116 @@
+package main
+
+import (
+	"cmp"
+	"net/http"
+	"slices"
+
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+// GuildsLeaderboardSorts are the values the guilds of a world can be ranked by on the leaderboard
+var GuildsLeaderboardSorts = []string{"level_total", "members_total", "level_average", "members_online"}
+
+// Child of GuildsLeaderboard
+type GuildsLeaderboardEntry struct {
+	Rank          int     `json:"rank"`           // The rank of the guild on the leaderboard.
+	Name          string  `json:"name"`           // The name of the guild.
+	LogoURL       string  `json:"logo_url"`       // The URL to the guild's logo.
+	MembersTotal  int     `json:"members_total"`  // The number of total members in the guild.
+	MembersOnline int     `json:"members_online"` // The number of members that were online when the guild was fetched.
+	LevelTotal    int     `json:"level_total"`    // The sum of the levels of the members.
+	LevelAverage  float64 `json:"level_average"`  // The average level of the members.
+}
+
+// Child of JSONData
+type GuildsLeaderboard struct {
+	World        string                   `json:"world"`         // The world of the guilds.
+	Sort         string                   `json:"sort"`          // The value the guilds are ranked by.
+	Fetched      string                   `json:"fetched"`       // The time the guilds were fetched from tibia.com.
+	Guilds       []GuildsLeaderboardEntry `json:"guilds"`        // The active guilds of the world, ranked by sort.
+	FailedGuilds []string                 `json:"failed_guilds"` // The names of the guilds that could not be fetched.
+}
+
+// The base includes two levels: GuildsLeaderboard and Information
+type GuildsLeaderboardResponse struct {
+	GuildsLeaderboard GuildsLeaderboard `json:"guilds_leaderboard"`
+	Information       Information       `json:"information"`
+}
+
+// ListEntries returns the guilds of the leaderboard
+func (r GuildsLeaderboardResponse) ListEntries() interface{} {
+	return r.GuildsLeaderboard.Guilds
+}
+
+// TibiaGuildsLeaderboardImpl func - ranks the active guilds of a world by the levels, number and online status of their members
+// The guilds of a world are fetched concurrently and kept in tibiaGuildsWorldGuildsCache, so the leaderboard
+// of all sorts is served from the same fetch until it expires.
+func TibiaGuildsLeaderboardImpl(world, sort string, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (GuildsLeaderboardResponse, error) {
+	if sort == "" {
+		sort = GuildsLeaderboardSorts[0]
+	}
+	if !slices.Contains(GuildsLeaderboardSorts, sort) {
+		return GuildsLeaderboardResponse{}, validation.ErrorGuildLeaderboardSortInvalid
+	}
+
+	worldGuilds, err := tibiaGuildsWorldGuildsCached(world, htmlDataCollector)
+	if err != nil {
+		return GuildsLeaderboardResponse{}, err
+	}
+
+	guilds := make([]GuildsLeaderboardEntry, 0, len(worldGuilds.Guilds))
+	for _, guild := range worldGuilds.Guilds {
+		statistics := tibiaGuildsStatistics(guild)
+		guilds = append(guilds, GuildsLeaderboardEntry{
+			Name:          guild.Name,
+			LogoURL:       guild.LogoURL,
+			MembersTotal:  statistics.MembersTotal,
+			MembersOnline: statistics.MembersOnline,
+			LevelTotal:    statistics.Levels.Total,
+			LevelAverage:  statistics.Levels.Average,
+		})
+	}
+	tibiaGuildsLeaderboardRank(guilds, sort)
+
+	//
+	// Build the data-blob
+	return GuildsLeaderboardResponse{
+		GuildsLeaderboard{
+			World:        worldGuilds.World,
+			Sort:         sort,
+			Fetched:      worldGuilds.Fetched,
+			Guilds:       guilds,
+			FailedGuilds: worldGuilds.Failed,
+		},
+		Information{
+			APIDetails: TibiaDataAPIDetails,
+			Timestamp:  TibiaDataDatetime(""),
+			TibiaURLs:  worldGuilds.TibiaURLs,
+			Status: Status{
+				HTTPCode: http.StatusOK,
+			},
+		},
+	}, nil
+}
+
+// tibiaGuildsLeaderboardRank sorts the guilds by sort (the highest first, ties by name) and sets their ranks
+func tibiaGuildsLeaderboardRank(guilds []GuildsLeaderboardEntry, sort string) {
+	value := func(g GuildsLeaderboardEntry) float64 {
+		switch sort {
+		case "members_total":
+			return float64(g.MembersTotal)
+		case "level_average":
+			return g.LevelAverage
+		case "members_online":
+			return float64(g.MembersOnline)
+		}
+		return float64(g.LevelTotal)
+	}
+
+	slices.SortStableFunc(guilds, func(a, b GuildsLeaderboardEntry) int {
+		return cmp.Or(cmp.Compare(value(b), value(a)), cmp.Compare(a.Name, b.Name))
+	})
+	for i := range guilds {
+		guilds[i].Rank = i + 1
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+func TestGuildsLeaderboard(t *testing.T) {
+	assert := assert.New(t)
+
+	defer func(cache *tibiaDataCache[tibiaGuildsWorldGuildsResult]) { tibiaGuildsWorldGuildsCache = cache }(tibiaGuildsWorldGuildsCache)
+	tibiaGuildsWorldGuildsCache = newTibiaDataCache[tibiaGuildsWorldGuildsResult](time.Hour)
+
+	overview := testFileCollector(t, "testdata/guilds/Premia.html", nil)
+	guild := testFileCollector(t, "testdata/guilds/guild/Order of Glory.html", nil)
+
+	// only Order of Glory of the 38 active guilds of Premia can be fetched
+	var (
+		mu       sync.Mutex
+		requests int
+	)
+	collector := func(request TibiaDataRequestStruct) (string, error) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+
+		switch {
+		case strings.HasSuffix(request.URL, "world=Premia"):
+			return overview(request)
+		case strings.HasSuffix(request.URL, "GuildName=Order+of+Glory"):
+			return guild(request)
+		}
+		return "", validation.ErrStatusForbidden
+	}
+
+	// concurrent requests of a world that is not cached share one fetch
+	var wg sync.WaitGroup
+	responses := make([]GuildsLeaderboardResponse, 3)
+	errs := make([]error, 3)
+	for i := range responses {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			responses[i], errs[i] = TibiaGuildsLeaderboardImpl("premia", "", collector)
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	leaderboardJson := responses[0]
+
+	leaderboard := leaderboardJson.GuildsLeaderboard
+	assert.Equal("Premia", leaderboard.World)
+	assert.Equal("level_total", leaderboard.Sort)
+	assert.NotEmpty(leaderboard.Fetched)
+	assert.Equal(39, requests)
+	assert.Len(leaderboard.FailedGuilds, 37)
+	assert.Equal([]GuildsLeaderboardEntry{{
+		Rank:          1,
+		Name:          "Order of Glory",
+		LogoURL:       "https://static.tibia.com/images/guildlogos/Order_of_Glory.gif",
+		MembersTotal:  33,
+		MembersOnline: 1,
+		LevelTotal:    4954,
+		LevelAverage:  150.12,
+	}}, leaderboard.Guilds)
+
+	// the guilds of the world are cached for all sorts
+	leaderboardJson, err := TibiaGuildsLeaderboardImpl("Premia", "members_online", collector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(39, requests)
+	assert.Equal("members_online", leaderboardJson.GuildsLeaderboard.Sort)
+	assert.Equal(leaderboard.Fetched, leaderboardJson.GuildsLeaderboard.Fetched)
+	assert.Len(leaderboardJson.GuildsLeaderboard.Guilds, 1)
+
+	_, err = TibiaGuildsLeaderboardImpl("Premia", "level_median", collector)
+	assert.Equal(validation.ErrorGuildLeaderboardSortInvalid, err)
+}
+
+func TestGuildsLeaderboardRank(t *testing.T) {
+	guilds := []GuildsLeaderboardEntry{
+		{Name: "Nights Watch", MembersTotal: 10, MembersOnline: 4, LevelTotal: 1205, LevelAverage: 120.5},
+		{Name: "Elysium", MembersTotal: 158, MembersOnline: 4, LevelTotal: 14220, LevelAverage: 90},
+		{Name: "Kotki Antica", MembersTotal: 10, MembersOnline: 2, LevelTotal: 3000, LevelAverage: 300},
+	}
+
+	tibiaGuildsLeaderboardRank(guilds, "level_total")
+	assert.Equal(t, []string{"Elysium", "Kotki Antica", "Nights Watch"}, []string{guilds[0].Name, guilds[1].Name, guilds[2].Name})
+	assert.Equal(t, 3, guilds[2].Rank)
+
+	tibiaGuildsLeaderboardRank(guilds, "level_average")
+	assert.Equal(t, []string{"Kotki Antica", "Nights Watch", "Elysium"}, []string{guilds[0].Name, guilds[1].Name, guilds[2].Name})
+
+	// ties are ranked by name
+	tibiaGuildsLeaderboardRank(guilds, "members_online")
+	assert.Equal(t, []string{"Elysium", "Nights Watch", "Kotki Antica"}, []string{guilds[0].Name, guilds[1].Name, guilds[2].Name})
+	assert.Equal(t, 1, guilds[0].Rank)
+}
@@ -0,0 +1,339 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+// GuildStatisticsSorts are the statistics the guilds of a world can be ranked by
+var GuildStatisticsSorts = []string{"members_total", "members_online", "members_invited", "online_ratio", "level_total", "level_average", "level_median"}
+
+// tibiaGuildsStatisticsLongestServing is the number of longest-serving members of the statistics
+const tibiaGuildsStatisticsLongestServing = 5
+
+// Child of GuildStatistics
+type GuildStatisticsCount struct {
+	Name  string `json:"name"`  // The name of the vocation or rank.
+	Count int    `json:"count"` // The number of members.
+}
+
+// Child of GuildStatistics
+type GuildStatisticsInvites struct {
+	Date  string `json:"date"`  // The date the characters were invited.
+	Count int    `json:"count"` // The number of characters invited on the date.
+}
+
+// Child of GuildStatistics
+type GuildStatisticsLevels struct {
+	Min     int     `json:"min"`     // The lowest level of the members.
+	Max     int     `json:"max"`     // The highest level of the members.
+	Total   int     `json:"total"`   // The sum of the levels of the members.
+	Average float64 `json:"average"` // The average level of the members.
+	P10     int     `json:"p10"`     // The 10th percentile of the levels of the members.
+	P25     int     `json:"p25"`     // The 25th percentile of the levels of the members.
+	Median  int     `json:"median"`  // The median level of the members.
+	P75     int     `json:"p75"`     // The 75th percentile of the levels of the members.
+	P90     int     `json:"p90"`     // The 90th percentile of the levels of the members.
+}
+
+// Child of JSONData and GuildsStatistics
+type GuildStatistics struct {
+	Rank           int                      `json:"rank,omitempty"`  // The rank of the guild on its world (only world statistics).
+	Name           string                   `json:"name"`            // The name of the guild.
+	World          string                   `json:"world"`           // The world the guild belongs to.
+	MembersTotal   int                      `json:"members_total"`   // The number of total members in the guild.
+	MembersOnline  int                      `json:"members_online"`  // The number of online members in the guild.
+	MembersInvited int                      `json:"members_invited"` // The number of invited characters.
+	OnlineRatio    float64                  `json:"online_ratio"`    // The share of the members that are online (0 to 1).
+	Vocations      []GuildStatisticsCount   `json:"vocations"`       // The members per vocation (promotions count to their vocation), the most first.
+	Ranks          []GuildStatisticsCount   `json:"ranks"`           // The members per rank, in the order of the guild's ranks.
+	Levels         GuildStatisticsLevels    `json:"levels"`          // The distribution of the levels of the members.
+	Invites        []GuildStatisticsInvites `json:"invites"`         // The invited characters per day of their invitation, the oldest first.
+	LongestServing []GuildMember            `json:"longest_serving"` // The members who joined the guild first.
+}
+
+// The base includes two levels: GuildStatistics and Information
+type GuildStatisticsResponse struct {
+	GuildStatistics GuildStatistics `json:"guild_statistics"`
+	Information     Information     `json:"information"`
+}
+
+// Child of JSONData
+type GuildsStatistics struct {
+	World        string            `json:"world"`         // The world of the guilds.
+	Sort         string            `json:"sort"`          // The statistic the guilds are ranked by.
+	Fetched      string            `json:"fetched"`       // The time the guilds were fetched from tibia.com.
+	Guilds       []GuildStatistics `json:"guilds"`        // The statistics of the active guilds of the world, ranked by sort.
+	FailedGuilds []string          `json:"failed_guilds"` // The names of the guilds that could not be fetched.
+}
+
+// The base includes two levels: GuildsStatistics and Information
+type GuildsStatisticsResponse struct {
+	GuildsStatistics GuildsStatistics `json:"guilds_statistics"`
+	Information      Information      `json:"information"`
+}
+
+// ListEntries returns the statistics of the guilds
+func (r GuildsStatisticsResponse) ListEntries() interface{} {
+	return r.GuildsStatistics.Guilds
+}
+
+func TibiaGuildsGuildStatisticsImpl(guild string, BoxContentHTML string, url string) (GuildStatisticsResponse, error) {
+	guildResponse, err := TibiaGuildsGuildImpl(guild, BoxContentHTML, url)
+	if err != nil {
+		return GuildStatisticsResponse{}, err
+	}
+
+	//
+	// Build the data-blob
+	return GuildStatisticsResponse{
+		tibiaGuildsStatistics(guildResponse.Guild),
+		guildResponse.Information,
+	}, nil
+}
+
+// TibiaGuildsOverviewStatisticsImpl func - fetches all active guilds of a world and ranks their statistics
+// The guilds are fetched concurrently, guilds that could not be fetched are listed in failed_guilds.
+// The statistics are served from the guilds of the leaderboard in tibiaGuildsWorldGuildsCache.
+func TibiaGuildsOverviewStatisticsImpl(world, sort string, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (GuildsStatisticsResponse, error) {
+	if sort == "" {
+		sort = GuildStatisticsSorts[0]
+	}
+	if !slices.Contains(GuildStatisticsSorts, sort) {
+		return GuildsStatisticsResponse{}, validation.ErrorGuildStatisticsSortInvalid
+	}
+
+	worldGuilds, err := tibiaGuildsWorldGuildsCached(world, htmlDataCollector)
+	if err != nil {
+		return GuildsStatisticsResponse{}, err
+	}
+
+	statistics := make([]GuildStatistics, 0, len(worldGuilds.Guilds))
+	for _, guild := range worldGuilds.Guilds {
+		statistics = append(statistics, tibiaGuildsStatistics(guild))
+	}
+	tibiaGuildsStatisticsRank(statistics, sort)
+
+	//
+	// Build the data-blob
+	return GuildsStatisticsResponse{
+		GuildsStatistics{
+			World:        worldGuilds.World,
+			Sort:         sort,
+			Fetched:      worldGuilds.Fetched,
+			Guilds:       statistics,
+			FailedGuilds: worldGuilds.Failed,
+		},
+		Information{
+			APIDetails: TibiaDataAPIDetails,
+			Timestamp:  TibiaDataDatetime(""),
+			TibiaURLs:  worldGuilds.TibiaURLs,
+			Status: Status{
+				HTTPCode: http.StatusOK,
+			},
+		},
+	}, nil
+}
+
+// tibiaGuildsWorldGuildsCache caches the guilds of the leaderboard and statistics by their world, fetching all guilds of a world is expensive
+var tibiaGuildsWorldGuildsCache = newTibiaDataCache[tibiaGuildsWorldGuildsResult](3 * time.Hour)
+
+// tibiaGuildsWorldGuildsResult are the active guilds of a world
+type tibiaGuildsWorldGuildsResult struct {
+	World     string
+	Fetched   string   // the time the guilds were fetched
+	Guilds    []Guild  // the guilds that could be fetched, in the order of the overview
+	Failed    []string // the names of the guilds that could not be fetched
+	TibiaURLs []string
+}
+
+// tibiaGuildsWorldGuildsCached returns the guilds of a world from tibiaGuildsWorldGuildsCache
+// Concurrent requests of a world that is not cached share one fetch of its guilds.
+func tibiaGuildsWorldGuildsCached(world string, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (tibiaGuildsWorldGuildsResult, error) {
+	return tibiaGuildsWorldGuildsCache.Fetch(TibiaDataStringWorldFormatToTitle(world), func() (tibiaGuildsWorldGuildsResult, error) {
+		return tibiaGuildsWorldGuilds(world, htmlDataCollector)
+	})
+}
+
+// tibiaGuildsWorldGuilds fetches the guild overview of a world and all of its active guilds concurrently
+// Guilds that fail are listed in Failed, but a fetch cut short by the end of a gRPC call fails as a whole.
+func tibiaGuildsWorldGuilds(world string, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (tibiaGuildsWorldGuildsResult, error) {
+	endpoint, err := tibiaGuildsOverviewEndpoint(world)
+	if err != nil {
+		return tibiaGuildsWorldGuildsResult{}, err
+	}
+
+	BoxContentHTML, err := htmlDataCollector(endpoint.Request)
+	if err != nil {
+		return tibiaGuildsWorldGuildsResult{}, err
+	}
+
+	data, err := endpoint.Parse(BoxContentHTML)
+	if err != nil {
+		return tibiaGuildsWorldGuildsResult{}, err
+	}
+	overview := data.(GuildsOverviewResponse)
+
+	active := overview.Guilds.Active
+	guilds := make([]*Guild, len(active))
+	errs := make([]error, len(active))
+	TibiaDataParallel(len(active), TibiaDataFanOutConcurrency, func(i int) {
+		guilds[i], _ = tibiaGuildsGuildFetch(active[i].Name, func(request TibiaDataRequestStruct) (string, error) {
+			BoxContentHTML, err := htmlDataCollector(request)
+			errs[i] = err
+			return BoxContentHTML, err
+		})
+	})
+	for _, err := range errs {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return tibiaGuildsWorldGuildsResult{}, err
+		}
+	}
+
+	result := tibiaGuildsWorldGuildsResult{
+		World:     overview.Guilds.World,
+		Fetched:   TibiaDataDatetime(""),
+		Guilds:    []Guild{},
+		Failed:    []string{},
+		TibiaURLs: overview.Information.TibiaURLs,
+	}
+	for i, guild := range guilds {
+		if guild == nil {
+			result.Failed = append(result.Failed, active[i].Name)
+			continue
+		}
+		result.Guilds = append(result.Guilds, *guild)
+		if endpoint, err := tibiaGuildsGuildEndpoint(active[i].Name); err == nil {
+			result.TibiaURLs = append(result.TibiaURLs, endpoint.Request.URL)
+		}
+	}
+
+	return result, nil
+}
+
+// tibiaGuildsStatistics returns the statistics of the members of a guild
+func tibiaGuildsStatistics(guild Guild) GuildStatistics {
+	statistics := GuildStatistics{
+		Name:           guild.Name,
+		World:          guild.World,
+		MembersTotal:   len(guild.Members),
+		MembersInvited: len(guild.Invited),
+		Vocations:      []GuildStatisticsCount{},
+		Ranks:          []GuildStatisticsCount{},
+		Invites:        []GuildStatisticsInvites{},
+		LongestServing: []GuildMember{},
+	}
+
+	levels := make([]int, 0, len(guild.Members))
+	for _, member := range guild.Members {
+		if member.Status == "online" {
+			statistics.MembersOnline++
+		}
+		levels = append(levels, member.Level)
+
+		// promoted vocations end with their vocation (e.g. Elite Knight)
+		vocation := member.Vocation
+		if index := strings.LastIndex(vocation, " "); index >= 0 {
+			vocation = vocation[index+1:]
+		}
+		statistics.Vocations = tibiaGuildsStatisticsCount(statistics.Vocations, vocation)
+		statistics.Ranks = tibiaGuildsStatisticsCount(statistics.Ranks, member.Rank)
+	}
+	slices.SortStableFunc(statistics.Vocations, func(a, b GuildStatisticsCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Name, b.Name))
+	})
+
+	if len(levels) > 0 {
+		statistics.OnlineRatio = math.Round(float64(statistics.MembersOnline)/float64(len(levels))*10000) / 10000
+		statistics.Levels = tibiaGuildsStatisticsLevels(levels)
+	}
+
+	for _, invite := range guild.Invited {
+		index := slices.IndexFunc(statistics.Invites, func(i GuildStatisticsInvites) bool { return i.Date == invite.Date })
+		if index < 0 {
+			statistics.Invites = append(statistics.Invites, GuildStatisticsInvites{Date: invite.Date})
+			index = len(statistics.Invites) - 1
+		}
+		statistics.Invites[index].Count++
+	}
+	slices.SortFunc(statistics.Invites, func(a, b GuildStatisticsInvites) int { return cmp.Compare(a.Date, b.Date) })
+
+	// the dates of Joined sort chronologically, members joining on the same day keep the order of their ranks
+	members := slices.Clone(guild.Members)
+	slices.SortStableFunc(members, func(a, b GuildMember) int { return cmp.Compare(a.Joined, b.Joined) })
+	statistics.LongestServing = append(statistics.LongestServing, members[:min(len(members), tibiaGuildsStatisticsLongestServing)]...)
+
+	return statistics
+}
+
+// tibiaGuildsStatisticsCount adds a member to the count of name, names keep the order they were first counted in
+func tibiaGuildsStatisticsCount(counts []GuildStatisticsCount, name string) []GuildStatisticsCount {
+	if index := slices.IndexFunc(counts, func(c GuildStatisticsCount) bool { return c.Name == name }); index >= 0 {
+		counts[index].Count++
+		return counts
+	}
+	return append(counts, GuildStatisticsCount{Name: name, Count: 1})
+}
+
+// tibiaGuildsStatisticsLevels returns the distribution of levels, the percentiles use the nearest-rank method
+func tibiaGuildsStatisticsLevels(levels []int) GuildStatisticsLevels {
+	sorted := slices.Clone(levels)
+	slices.Sort(sorted)
+
+	percentile := func(p float64) int {
+		return sorted[max(int(math.Ceil(p/100*float64(len(sorted))))-1, 0)]
+	}
+
+	total := 0
+	for _, level := range sorted {
+		total += level
+	}
+
+	return GuildStatisticsLevels{
+		Min:     sorted[0],
+		Max:     sorted[len(sorted)-1],
+		Total:   total,
+		Average: math.Round(float64(total)/float64(len(sorted))*100) / 100,
+		P10:     percentile(10),
+		P25:     percentile(25),
+		Median:  percentile(50),
+		P75:     percentile(75),
+		P90:     percentile(90),
+	}
+}
+
+// tibiaGuildsStatisticsRank sorts the statistics by sort (the highest first, ties by name) and sets their ranks
+func tibiaGuildsStatisticsRank(statistics []GuildStatistics, sort string) {
+	value := func(s GuildStatistics) float64 {
+		switch sort {
+		case "members_online":
+			return float64(s.MembersOnline)
+		case "members_invited":
+			return float64(s.MembersInvited)
+		case "online_ratio":
+			return s.OnlineRatio
+		case "level_total":
+			return float64(s.Levels.Total)
+		case "level_average":
+			return s.Levels.Average
+		case "level_median":
+			return float64(s.Levels.Median)
+		}
+		return float64(s.MembersTotal)
+	}
+
+	slices.SortStableFunc(statistics, func(a, b GuildStatistics) int {
+		return cmp.Or(cmp.Compare(value(b), value(a)), cmp.Compare(a.Name, b.Name))
+	})
+	for i := range statistics {
+		statistics[i].Rank = i + 1
+	}
+}
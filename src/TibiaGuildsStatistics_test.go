@@ -0,0 +1,176 @@
+package main
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/static"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+func TestGuildStatistics(t *testing.T) {
+	file, err := static.TestFiles.Open("testdata/guilds/guild/Order of Glory.html")
+	if err != nil {
+		t.Fatalf("file opening error: %s", err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		t.Fatalf("File reading error: %s", err)
+	}
+
+	statisticsJson, err := TibiaGuildsGuildStatisticsImpl("Order of Glory", string(data), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert := assert.New(t)
+	statistics := statisticsJson.GuildStatistics
+
+	assert.Zero(statistics.Rank)
+	assert.Equal("Order of Glory", statistics.Name)
+	assert.Equal("Premia", statistics.World)
+	assert.Equal(33, statistics.MembersTotal)
+	assert.Equal(1, statistics.MembersOnline)
+	assert.Equal(0, statistics.MembersInvited)
+	assert.Equal(0.0303, statistics.OnlineRatio)
+	assert.Equal([]GuildStatisticsCount{
+		{Name: "Druid", Count: 9},
+		{Name: "Knight", Count: 8},
+		{Name: "Paladin", Count: 8},
+		{Name: "Sorcerer", Count: 6},
+		{Name: "None", Count: 2},
+	}, statistics.Vocations)
+	assert.Equal([]GuildStatisticsCount{
+		{Name: "Leader", Count: 1},
+		{Name: "Ourea", Count: 7},
+		{Name: "Alpha", Count: 1},
+		{Name: "Polemarch", Count: 4},
+		{Name: "Hoplite", Count: 6},
+		{Name: "Scholar", Count: 2},
+		{Name: "Omega", Count: 12},
+	}, statistics.Ranks)
+	assert.Equal(GuildStatisticsLevels{
+		Min: 15, Max: 385, Total: 4954, Average: 150.12,
+		P10: 47, P25: 76, Median: 107, P75: 245, P90: 282,
+	}, statistics.Levels)
+	assert.Empty(statistics.Invites)
+
+	if assert.Len(statistics.LongestServing, 5) {
+		assert.Equal("Zaokhan", statistics.LongestServing[0].Name)
+		assert.Equal("Raaok", statistics.LongestServing[1].Name)
+		assert.Equal("2020-06-27", statistics.LongestServing[1].Joined)
+		assert.Equal("Fearos", statistics.LongestServing[4].Name)
+	}
+}
+
+func TestGuildStatisticsMembers(t *testing.T) {
+	assert := assert.New(t)
+
+	statistics := tibiaGuildsStatistics(Guild{
+		Name: "Elysium",
+		Members: []GuildMember{
+			{Name: "Trollefar", Rank: "Leader", Vocation: "Elder Druid", Level: 400, Joined: "2020-01-02", Status: "online"},
+			{Name: "Durin", Rank: "Member", Vocation: "Knight", Level: 100, Joined: "2020-01-01", Status: "offline"},
+		},
+		Invited: []InvitedGuildMember{
+			{Name: "Bubble", Date: "2023-01-20"},
+			{Name: "Arieswar", Date: "2023-01-18"},
+			{Name: "Kharsek", Date: "2023-01-20"},
+		},
+	})
+
+	assert.Equal(0.5, statistics.OnlineRatio)
+	assert.Equal([]GuildStatisticsCount{{Name: "Druid", Count: 1}, {Name: "Knight", Count: 1}}, statistics.Vocations)
+	assert.Equal(GuildStatisticsLevels{
+		Min: 100, Max: 400, Total: 500, Average: 250,
+		P10: 100, P25: 100, Median: 100, P75: 400, P90: 400,
+	}, statistics.Levels)
+	assert.Equal(3, statistics.MembersInvited)
+	assert.Equal([]GuildStatisticsInvites{{Date: "2023-01-18", Count: 1}, {Date: "2023-01-20", Count: 2}}, statistics.Invites)
+	if assert.Len(statistics.LongestServing, 2) {
+		assert.Equal("Durin", statistics.LongestServing[0].Name)
+	}
+
+	// a guild without members has empty statistics
+	empty := tibiaGuildsStatistics(Guild{Name: "Elysium"})
+	assert.Zero(empty.OnlineRatio)
+	assert.Zero(empty.Levels)
+	assert.Empty(empty.LongestServing)
+}
+
+func TestGuildStatisticsRank(t *testing.T) {
+	statistics := []GuildStatistics{
+		{Name: "Nights Watch", MembersTotal: 10, Levels: GuildStatisticsLevels{Average: 120.5}},
+		{Name: "Elysium", MembersTotal: 158, Levels: GuildStatisticsLevels{Average: 90}},
+		{Name: "Kotki Antica", MembersTotal: 10, Levels: GuildStatisticsLevels{Average: 300}},
+	}
+
+	tibiaGuildsStatisticsRank(statistics, "members_total")
+	assert.Equal(t, "Elysium", statistics[0].Name)
+	assert.Equal(t, "Kotki Antica", statistics[1].Name)
+	assert.Equal(t, 3, statistics[2].Rank)
+
+	tibiaGuildsStatisticsRank(statistics, "level_average")
+	assert.Equal(t, "Kotki Antica", statistics[0].Name)
+	assert.Equal(t, 1, statistics[0].Rank)
+	assert.Equal(t, "Nights Watch", statistics[1].Name)
+}
+
+func TestGuildsStatistics(t *testing.T) {
+	assert := assert.New(t)
+
+	defer func(cache *tibiaDataCache[tibiaGuildsWorldGuildsResult]) { tibiaGuildsWorldGuildsCache = cache }(tibiaGuildsWorldGuildsCache)
+	tibiaGuildsWorldGuildsCache = newTibiaDataCache[tibiaGuildsWorldGuildsResult](time.Hour)
+
+	var requests []TibiaDataRequestStruct
+	overview := testFileCollector(t, "testdata/guilds/Premia.html", &requests)
+	guild := testFileCollector(t, "testdata/guilds/guild/Order of Glory.html", &requests)
+	collector := func(request TibiaDataRequestStruct) (string, error) {
+		switch {
+		case strings.HasSuffix(request.URL, "world=Premia"):
+			return overview(request)
+		case strings.HasSuffix(request.URL, "GuildName=Order+of+Glory"):
+			return guild(request)
+		}
+		return "", validation.ErrStatusForbidden
+	}
+
+	statisticsJson, err := TibiaGuildsOverviewStatisticsImpl("Premia", "level_average", collector)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	statistics := statisticsJson.GuildsStatistics
+	assert.Equal("Premia", statistics.World)
+	assert.Equal("level_average", statistics.Sort)
+	assert.Len(requests, 2)
+	assert.Len(statistics.FailedGuilds, 37)
+	if assert.Len(statistics.Guilds, 1) {
+		assert.Equal(1, statistics.Guilds[0].Rank)
+		assert.Equal("Order of Glory", statistics.Guilds[0].Name)
+		assert.Equal(150.12, statistics.Guilds[0].Levels.Average)
+	}
+	assert.NotEmpty(statistics.Fetched)
+	assert.Len(statisticsJson.Information.TibiaURLs, 2)
+
+	// the guilds of the world are cached for the statistics of all sorts and the leaderboard
+	statisticsJson, err = TibiaGuildsOverviewStatisticsImpl("premia", "members_total", collector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(statistics.Fetched, statisticsJson.GuildsStatistics.Fetched)
+	leaderboardJson, err := TibiaGuildsLeaderboardImpl("Premia", "", collector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(statistics.Fetched, leaderboardJson.GuildsLeaderboard.Fetched)
+	assert.Len(requests, 2)
+
+	_, err = TibiaGuildsOverviewStatisticsImpl("Premia", "level", collector)
+	assert.Equal(validation.ErrorGuildStatisticsSortInvalid, err)
+}
@@ -0,0 +1,597 @@
+package main
+
+import (
+	"cmp"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// TibiaDataWarTrackerInterval is the time between two polls of the deaths of the members of the tracked wars
+	TibiaDataWarTrackerInterval = 15 * time.Minute
+
+	// TibiaDataWarTrackerMaxSize is the maximum number of tracked wars
+	TibiaDataWarTrackerMaxSize = 10
+)
+
+var (
+	tibiaWarTrackerBucket      = []byte("war_tracker")
+	tibiaWarTrackerKillsBucket = []byte("war_tracker_kills")
+)
+
+// Child of WarTrackerResponse
+type TrackedWar struct {
+	ID         int     `json:"id"`                    // The id of the war.
+	Guild      string  `json:"guild"`                 // The name of the first guild.
+	Opponent   string  `json:"opponent"`              // The name of the second guild.
+	World      string  `json:"world"`                 // The world of the guilds.
+	Added      string  `json:"added"`                 // The time the war was added to the tracker, earlier deaths are not counted.
+	LastPolled string  `json:"last_polled,omitempty"` // The time the war was last polled successfully.
+	Error      *Status `json:"error,omitempty"`       // The error of the last poll (if it failed).
+}
+
+// WarTrackerRequest is the body of adding a war to the tracker
+type WarTrackerRequest struct {
+	Guild    string `json:"guild"`    // The name of the first guild.
+	Opponent string `json:"opponent"` // The name of the second guild.
+}
+
+// The base includes two levels: WarTracker and Information
+type WarTrackerResponse struct {
+	WarTracker  []TrackedWar `json:"war_tracker"`
+	Information Information  `json:"information"`
+}
+
+// ListEntries returns the tracked wars
+func (r WarTrackerResponse) ListEntries() interface{} {
+	return r.WarTracker
+}
+
+// Child of WarKills
+type WarKill struct {
+	Time        string   `json:"time"`         // The time of the death.
+	Guild       string   `json:"guild"`        // The guild that scored the kill.
+	Victim      string   `json:"victim"`       // The name of the member that died.
+	VictimGuild string   `json:"victim_guild"` // The guild of the member that died.
+	Level       int      `json:"level"`        // The level of the member when it died.
+	Killers     []string `json:"killers"`      // The members of the scoring guild that killed the member.
+	Assists     []string `json:"assists"`      // The members of the scoring guild that assisted.
+}
+
+// Child of JSONData
+type WarKills struct {
+	ID         int       `json:"id"`          // The id of the war.
+	Guild      string    `json:"guild"`       // The name of the first guild.
+	Opponent   string    `json:"opponent"`    // The name of the second guild.
+	From       string    `json:"from"`        // The start of the period.
+	To         string    `json:"to"`          // The end of the period.
+	LastPolled string    `json:"last_polled"` // The time the war was last polled successfully.
+	Kills      []WarKill `json:"kills"`       // The kills of the period, the latest first.
+}
+
+// The base includes two levels: WarKills and Information
+type WarKillsResponse struct {
+	WarKills    WarKills    `json:"war_kills"`
+	Information Information `json:"information"`
+}
+
+// ListEntries returns the kills of the war
+func (r WarKillsResponse) ListEntries() interface{} {
+	return r.WarKills.Kills
+}
+
+// Child of WarScoreboard
+type WarScoreboardGuild struct {
+	Name   string `json:"name"`   // The name of the guild.
+	Kills  int    `json:"kills"`  // The number of members of the other guild killed by the guild.
+	Deaths int    `json:"deaths"` // The number of members of the guild killed by the other guild.
+}
+
+// Child of WarScoreboard
+type WarScoreboardCharacter struct {
+	Name    string `json:"name"`    // The name of the character.
+	Guild   string `json:"guild"`   // The guild of the character.
+	Kills   int    `json:"kills"`   // The number of kills the character was a killer of.
+	Assists int    `json:"assists"` // The number of kills the character assisted.
+	Deaths  int    `json:"deaths"`  // The number of times the character was killed.
+}
+
+// Child of JSONData
+type WarScoreboard struct {
+	ID         int                      `json:"id"`          // The id of the war.
+	World      string                   `json:"world"`       // The world of the guilds.
+	Added      string                   `json:"added"`       // The time the war was added to the tracker.
+	LastPolled string                   `json:"last_polled"` // The time the war was last polled successfully.
+	Guilds     []WarScoreboardGuild     `json:"guilds"`      // The kills and deaths of both guilds.
+	Characters []WarScoreboardCharacter `json:"characters"`  // The characters with kills, assists or deaths, the most kills first.
+}
+
+// The base includes two levels: WarScoreboard and Information
+type WarScoreboardResponse struct {
+	WarScoreboard WarScoreboard `json:"war_scoreboard"`
+	Information   Information   `json:"information"`
+}
+
+// ListEntries returns the characters of the scoreboard
+func (r WarScoreboardResponse) ListEntries() interface{} {
+	return r.WarScoreboard.Characters
+}
+
+// tibiaWarTrackerKey returns the key of a tracked war
+func tibiaWarTrackerKey(id int) []byte {
+	return tibiaWebhooksKey(id)
+}
+
+// tibiaWarTrackerKillKey returns the key of a kill, kills are sorted by time and unique per victim
+func tibiaWarTrackerKillKey(deathTime time.Time, victim string) []byte {
+	return append(tibiaDataStoreTimeKey(deathTime), strings.ToLower(victim)...)
+}
+
+// tibiaWarTrackerWar returns a tracked war by the id of the path
+func tibiaWarTrackerWar(tx *bolt.Tx, idStr string) (TrackedWar, error) {
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		return TrackedWar{}, validation.ErrorWarNotTracked
+	}
+
+	var war TrackedWar
+	ok, err := tibiaDataStoreGet(tx, tibiaWarTrackerBucket, tibiaWarTrackerKey(id), &war)
+	if err == nil && !ok {
+		err = validation.ErrorWarNotTracked
+	}
+	return war, err
+}
+
+// tibiaWarTrackerResponse returns the response with all tracked wars
+func tibiaWarTrackerResponse(tx *bolt.Tx) (WarTrackerResponse, error) {
+	// the keys are the big endian ids, so the wars are sorted by id
+	wars, err := tibiaDataStoreList[TrackedWar](tx, tibiaWarTrackerBucket)
+	if err != nil {
+		return WarTrackerResponse{}, err
+	}
+
+	return WarTrackerResponse{
+		WarTracker:  wars,
+		Information: tibiaDataLocalInformation(),
+	}, nil
+}
+
+// TibiaGuildsWarTrackerImpl func - returns all tracked wars
+func TibiaGuildsWarTrackerImpl() (WarTrackerResponse, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return WarTrackerResponse{}, err
+	}
+
+	var response WarTrackerResponse
+	err = db.View(func(tx *bolt.Tx) (err error) {
+		response, err = tibiaWarTrackerResponse(tx)
+		return err
+	})
+
+	return response, err
+}
+
+// TibiaGuildsWarTrackerAddImpl func - adds a war between two guilds of the same world to the tracker and returns all tracked wars
+// Both guilds are fetched to check their world, a war that is tracked already is kept as it is.
+func TibiaGuildsWarTrackerAddImpl(request WarTrackerRequest, now time.Time, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (WarTrackerResponse, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return WarTrackerResponse{}, err
+	}
+
+	for _, name := range []string{request.Guild, request.Opponent} {
+		if err := validation.IsGuildNameValid(name); err != nil {
+			return WarTrackerResponse{}, err
+		}
+	}
+	if strings.EqualFold(request.Guild, request.Opponent) {
+		return WarTrackerResponse{}, validation.ErrorWarGuildsInvalid
+	}
+
+	guilds := make([]Guild, 2)
+	for i, name := range []string{request.Guild, request.Opponent} {
+		endpoint, err := tibiaGuildsGuildEndpoint(name)
+		if err != nil {
+			return WarTrackerResponse{}, err
+		}
+
+		BoxContentHTML, err := htmlDataCollector(endpoint.Request)
+		if err != nil {
+			return WarTrackerResponse{}, err
+		}
+
+		data, err := endpoint.Parse(BoxContentHTML)
+		if err != nil {
+			return WarTrackerResponse{}, err
+		}
+		guilds[i] = data.(GuildResponse).Guild
+	}
+	if guilds[0].World != guilds[1].World {
+		return WarTrackerResponse{}, validation.ErrorWarGuildsDifferentWorlds
+	}
+
+	var response WarTrackerResponse
+	err = db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(tibiaWarTrackerBucket)
+		if err != nil {
+			return err
+		}
+
+		wars, err := tibiaDataStoreList[TrackedWar](tx, tibiaWarTrackerBucket)
+		if err != nil {
+			return err
+		}
+
+		// a war is tracked once, whichever of its guilds is given first
+		tracked := slices.ContainsFunc(wars, func(w TrackedWar) bool {
+			return (w.Guild == guilds[0].Name && w.Opponent == guilds[1].Name) || (w.Guild == guilds[1].Name && w.Opponent == guilds[0].Name)
+		})
+		if !tracked {
+			id, err := bucket.NextSequence()
+			if err != nil {
+				return err
+			}
+
+			entry := tibiaDataStoreEntry{
+				Key: tibiaWarTrackerKey(int(id)),
+				Value: TrackedWar{
+					ID:       int(id),
+					Guild:    guilds[0].Name,
+					Opponent: guilds[1].Name,
+					World:    guilds[0].World,
+					Added:    now.UTC().Format(time.RFC3339),
+				},
+			}
+			if err := tibiaDataStoreAdd(tx, tibiaWarTrackerBucket, []tibiaDataStoreEntry{entry}, TibiaDataWarTrackerMaxSize, validation.ErrorWarTrackerFull); err != nil {
+				return err
+			}
+		}
+
+		response, err = tibiaWarTrackerResponse(tx)
+		return err
+	})
+
+	return response, err
+}
+
+// TibiaGuildsWarTrackerRemoveImpl func - removes a war and its kills from the tracker and returns all tracked wars
+func TibiaGuildsWarTrackerRemoveImpl(idStr string) (WarTrackerResponse, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return WarTrackerResponse{}, err
+	}
+
+	var response WarTrackerResponse
+	err = db.Update(func(tx *bolt.Tx) error {
+		war, err := tibiaWarTrackerWar(tx, idStr)
+		if err != nil {
+			return err
+		}
+
+		key := tibiaWarTrackerKey(war.ID)
+		if err := tibiaDataStoreRemove(tx, tibiaWarTrackerBucket, key, validation.ErrorWarNotTracked); err != nil {
+			return err
+		}
+		if root := tx.Bucket(tibiaWarTrackerKillsBucket); root != nil && root.Bucket(key) != nil {
+			if err := root.DeleteBucket(key); err != nil {
+				return err
+			}
+		}
+
+		response, err = tibiaWarTrackerResponse(tx)
+		return err
+	})
+
+	return response, err
+}
+
+// runGuildsWarTracker polls the tracked wars every interval until stop is closed
+func runGuildsWarTracker(db *bolt.DB, interval time.Duration, htmlDataCollector func(TibiaDataRequestStruct) (string, error), stop <-chan struct{}) {
+	tibiaDataStoreRun("war tracker", interval, func(minAge time.Duration, now time.Time) error {
+		return tibiaWarTrackerPoll(db, minAge, htmlDataCollector, now)
+	}, stop)
+}
+
+// tibiaWarTrackerPoll fetches the guilds and the characters of their members of all tracked wars that were not polled
+// within minAge and stores the kills between the guilds
+// The wars are polled one after another and their characters are fetched with the fan-out concurrency, so polls
+// stay within the upstream limits. Characters that could not be fetched are read again on the next poll.
+func tibiaWarTrackerPoll(db *bolt.DB, minAge time.Duration, htmlDataCollector func(TibiaDataRequestStruct) (string, error), now time.Time) error {
+	var wars []TrackedWar
+	err := db.View(func(tx *bolt.Tx) (err error) {
+		wars, err = tibiaDataStoreList[TrackedWar](tx, tibiaWarTrackerBucket)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, war := range wars {
+		if !tibiaDataStoreDue(war.LastPolled, minAge, now) {
+			continue
+		}
+
+		var kills []WarKill
+		guild, status := tibiaGuildsGuildFetch(war.Guild, htmlDataCollector)
+		opponent, opponentStatus := tibiaGuildsGuildFetch(war.Opponent, htmlDataCollector)
+		if status == nil {
+			status = opponentStatus
+		}
+		if status == nil {
+			var members []string
+			for _, member := range append(slices.Clone(guild.Members), opponent.Members...) {
+				members = append(members, member.Name)
+			}
+
+			characters := make([]CharactersCharacter, len(members))
+			TibiaDataParallel(len(members), TibiaDataFanOutConcurrency, func(i int) {
+				characters[i], _ = tibiaCharactersBatchCharacter(members[i], htmlDataCollector)
+			})
+
+			deaths := make(map[string][]Deaths, len(characters))
+			for _, character := range characters {
+				if character.Character != nil {
+					deaths[character.Name] = character.Character.Deaths
+				}
+			}
+
+			added, _ := time.Parse(time.RFC3339, war.Added)
+			kills = tibiaWarTrackerMatch(*guild, *opponent, deaths, added)
+		}
+
+		err := db.Update(func(tx *bolt.Tx) error {
+			return tibiaWarTrackerRecord(tx, war.ID, kills, status, now)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tibiaWarTrackerMatch returns the kills between two guilds in the deaths of their members since a time
+// A death is a kill if a killer or an assist is a player that is a member of the other guild.
+func tibiaWarTrackerMatch(guild, opponent Guild, deaths map[string][]Deaths, since time.Time) []WarKill {
+	var kills []WarKill
+
+	for _, sides := range [][2]Guild{{guild, opponent}, {opponent, guild}} {
+		victims, scorers := sides[0], sides[1]
+		isScorer := func(killer Killers) bool {
+			return killer.Player && slices.ContainsFunc(scorers.Members, func(m GuildMember) bool { return strings.EqualFold(m.Name, killer.Name) })
+		}
+
+		for _, member := range victims.Members {
+			for _, death := range deaths[member.Name] {
+				deathTime, err := time.Parse(time.RFC3339, death.Time)
+				if err != nil || deathTime.Before(since) {
+					continue
+				}
+
+				kill := WarKill{
+					Time:        death.Time,
+					Guild:       scorers.Name,
+					Victim:      member.Name,
+					VictimGuild: victims.Name,
+					Level:       death.Level,
+					Killers:     []string{},
+					Assists:     []string{},
+				}
+				for _, killer := range death.Killers {
+					if isScorer(killer) && !slices.Contains(kill.Killers, killer.Name) {
+						kill.Killers = append(kill.Killers, killer.Name)
+					}
+				}
+				for _, assist := range death.Assists {
+					if isScorer(assist) && !slices.Contains(kill.Assists, assist.Name) {
+						kill.Assists = append(kill.Assists, assist.Name)
+					}
+				}
+
+				if len(kill.Killers) > 0 || len(kill.Assists) > 0 {
+					kills = append(kills, kill)
+				}
+			}
+		}
+	}
+
+	return kills
+}
+
+// tibiaWarTrackerRecord stores the result of polling one war
+// Kills are keyed by their time and victim, so kills seen in earlier polls are not counted twice.
+func tibiaWarTrackerRecord(tx *bolt.Tx, id int, kills []WarKill, status *Status, now time.Time) error {
+	key := tibiaWarTrackerKey(id)
+	var war TrackedWar
+	if ok, err := tibiaDataStoreGet(tx, tibiaWarTrackerBucket, key, &war); !ok || err != nil {
+		return err
+	}
+
+	war.Error = status
+	if status == nil {
+		war.LastPolled = now.UTC().Format(time.RFC3339)
+
+		root, err := tx.CreateBucketIfNotExists(tibiaWarTrackerKillsBucket)
+		if err != nil {
+			return err
+		}
+		killsBucket, err := root.CreateBucketIfNotExists(key)
+		if err != nil {
+			return err
+		}
+
+		for _, kill := range kills {
+			deathTime, err := time.Parse(time.RFC3339, kill.Time)
+			if err != nil {
+				continue
+			}
+			killKey := tibiaWarTrackerKillKey(deathTime, kill.Victim)
+			if killsBucket.Get(killKey) != nil {
+				continue
+			}
+
+			value, err := json.Marshal(kill)
+			if err != nil {
+				return err
+			}
+			if err := killsBucket.Put(killKey, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return tibiaDataStorePut(tx, tibiaWarTrackerBucket, key, war)
+}
+
+// tibiaWarTrackerKills returns the stored kills of a war between two times in chronological order
+func tibiaWarTrackerKills(tx *bolt.Tx, id int, from, to time.Time) ([]WarKill, error) {
+	kills := []WarKill{}
+
+	root := tx.Bucket(tibiaWarTrackerKillsBucket)
+	if root == nil || root.Bucket(tibiaWarTrackerKey(id)) == nil {
+		return kills, nil
+	}
+
+	cursor := root.Bucket(tibiaWarTrackerKey(id)).Cursor()
+	for killKey, value := cursor.Seek(tibiaDataStoreTimeKey(from)); killKey != nil && !tibiaDataStoreKeyTime(killKey).After(to); killKey, value = cursor.Next() {
+		var kill WarKill
+		if err := json.Unmarshal(value, &kill); err != nil {
+			return nil, err
+		}
+		kills = append(kills, kill)
+	}
+
+	return kills, nil
+}
+
+// TibiaGuildsWarKillsImpl func - returns the kills of a tracked war in a period
+func TibiaGuildsWarKillsImpl(idStr, from, to, period string) (WarKillsResponse, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return WarKillsResponse{}, err
+	}
+
+	fromTime, toTime, err := tibiaDataStorePeriod(from, to, period, time.Now())
+	if err != nil {
+		return WarKillsResponse{}, err
+	}
+
+	kills := WarKills{
+		From: fromTime.UTC().Format(time.RFC3339),
+		To:   toTime.UTC().Format(time.RFC3339),
+	}
+
+	err = db.View(func(tx *bolt.Tx) error {
+		war, err := tibiaWarTrackerWar(tx, idStr)
+		if err != nil {
+			return err
+		}
+		kills.ID, kills.Guild, kills.Opponent, kills.LastPolled = war.ID, war.Guild, war.Opponent, war.LastPolled
+
+		kills.Kills, err = tibiaWarTrackerKills(tx, war.ID, fromTime, toTime)
+		return err
+	})
+	if err != nil {
+		return WarKillsResponse{}, err
+	}
+
+	// the kills are stored chronologically, like the deaths of tibia.com the latest are first
+	slices.Reverse(kills.Kills)
+
+	return WarKillsResponse{
+		WarKills:    kills,
+		Information: tibiaDataLocalInformation(),
+	}, nil
+}
+
+// TibiaGuildsWarScoreboardImpl func - returns the kills and deaths of the guilds and characters of a tracked war
+func TibiaGuildsWarScoreboardImpl(idStr string) (WarScoreboardResponse, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return WarScoreboardResponse{}, err
+	}
+
+	var (
+		war   TrackedWar
+		kills []WarKill
+	)
+	err = db.View(func(tx *bolt.Tx) error {
+		war, err = tibiaWarTrackerWar(tx, idStr)
+		if err != nil {
+			return err
+		}
+
+		// kills before the war was added are not stored
+		added, err := time.Parse(time.RFC3339, war.Added)
+		if err != nil {
+			return err
+		}
+		kills, err = tibiaWarTrackerKills(tx, war.ID, added, time.Now())
+		return err
+	})
+	if err != nil {
+		return WarScoreboardResponse{}, err
+	}
+
+	guilds, characters := tibiaWarTrackerScoreboard(war, kills)
+
+	return WarScoreboardResponse{
+		WarScoreboard: WarScoreboard{
+			ID:         war.ID,
+			World:      war.World,
+			Added:      war.Added,
+			LastPolled: war.LastPolled,
+			Guilds:     guilds,
+			Characters: characters,
+		},
+		Information: tibiaDataLocalInformation(),
+	}, nil
+}
+
+// tibiaWarTrackerScoreboard counts the kills of a war by guild and by character
+// The characters are sorted by kills (the most first), deaths (the fewest first) and name.
+func tibiaWarTrackerScoreboard(war TrackedWar, kills []WarKill) ([]WarScoreboardGuild, []WarScoreboardCharacter) {
+	guilds := []WarScoreboardGuild{{Name: war.Guild}, {Name: war.Opponent}}
+	characters := []WarScoreboardCharacter{}
+
+	character := func(name, guild string) *WarScoreboardCharacter {
+		index := slices.IndexFunc(characters, func(c WarScoreboardCharacter) bool { return c.Name == name })
+		if index < 0 {
+			characters = append(characters, WarScoreboardCharacter{Name: name, Guild: guild})
+			index = len(characters) - 1
+		}
+		return &characters[index]
+	}
+
+	for _, kill := range kills {
+		for i := range guilds {
+			switch guilds[i].Name {
+			case kill.Guild:
+				guilds[i].Kills++
+			case kill.VictimGuild:
+				guilds[i].Deaths++
+			}
+		}
+
+		character(kill.Victim, kill.VictimGuild).Deaths++
+		for _, killer := range kill.Killers {
+			character(killer, kill.Guild).Kills++
+		}
+		for _, assist := range kill.Assists {
+			character(assist, kill.Guild).Assists++
+		}
+	}
+
+	slices.SortStableFunc(characters, func(a, b WarScoreboardCharacter) int {
+		return cmp.Or(cmp.Compare(b.Kills, a.Kills), cmp.Compare(a.Deaths, b.Deaths), cmp.Compare(a.Name, b.Name))
+	})
+
+	return guilds, characters
+}
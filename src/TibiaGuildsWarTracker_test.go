@@ -0,0 +1,161 @@
+package main
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+// testWarTrackerCollector returns the guilds Mercenarys and Kotki Antica of Antica and the Darkside Rafa test file
+// for Fujitora Alm of Mercenarys, killed by Panna Granger of Kotki Antica
+func testWarTrackerCollector(t *testing.T, requests *int) func(TibiaDataRequestStruct) (string, error) {
+	mercenarys := testFileCollector(t, "testdata/guilds/guild/Mercenarys.html", nil)
+	kotki := testFileCollector(t, "testdata/guilds/guild/Kotki Antica.html", nil)
+	character := testFileCollector(t, "testdata/characters/Darkside Rafa.html", nil)
+	death := strings.NewReplacer("Died at Level 787 by a young goanna.", `Killed at Level 787 by <a href="https://www.tibia.com/community/?subtopic=characters&name=Panna+Granger">Panna&#160;Granger</a> and a dragon.<br />`+
+		`Assisted by <a href="https://www.tibia.com/community/?subtopic=characters&name=Amir+Zard">Amir&#160;Zard</a> and <a href="https://www.tibia.com/community/?subtopic=characters&name=Iruz">Iruz</a>.`)
+
+	var mu sync.Mutex
+	return func(request TibiaDataRequestStruct) (string, error) {
+		mu.Lock()
+		*requests++
+		mu.Unlock()
+
+		switch {
+		case strings.HasSuffix(request.URL, "GuildName=Mercenarys"):
+			return mercenarys(request)
+		case strings.HasSuffix(request.URL, "GuildName=Kotki+Antica"):
+			return kotki(request)
+		case strings.HasSuffix(request.URL, "name=Fujitora+Alm"):
+			html, err := character(request)
+			return death.Replace(html), err
+		}
+		return "", validation.ErrStatusForbidden
+	}
+}
+
+func TestWarTracker(t *testing.T) {
+	assert := assert.New(t)
+	testStore(t)
+
+	requests := 0
+	collector := testWarTrackerCollector(t, &requests)
+	start := time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC)
+
+	trackerJson, err := TibiaGuildsWarTrackerAddImpl(WarTrackerRequest{Guild: "Mercenarys", Opponent: "Kotki Antica"}, start, collector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]TrackedWar{{ID: 1, Guild: "Mercenarys", Opponent: "Kotki Antica", World: "Antica", Added: "2021-12-01T10:00:00Z"}}, trackerJson.WarTracker)
+
+	// a war that is tracked already is kept
+	trackerJson, err = TibiaGuildsWarTrackerAddImpl(WarTrackerRequest{Guild: "Kotki Antica", Opponent: "Mercenarys"}, start, collector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(trackerJson.WarTracker, 1)
+
+	_, err = TibiaGuildsWarTrackerAddImpl(WarTrackerRequest{Guild: "Mercenarys", Opponent: "mercenarys"}, start, collector)
+	assert.Equal(validation.ErrorWarGuildsInvalid, err)
+
+	// the second poll sees the same death and does not count it again
+	requests = 0
+	for _, now := range []time.Time{start.AddDate(0, 0, 20), start.AddDate(0, 0, 21)} {
+		if err := tibiaWarTrackerPoll(TibiaDataStore, time.Hour, collector, now); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assert.Equal(2*(2+115+18), requests)
+
+	killsJson, err := TibiaGuildsWarKillsImpl("1", "2021-12-01", "2021-12-31", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal("2021-12-22T10:00:00Z", killsJson.WarKills.LastPolled)
+	assert.Equal([]WarKill{{
+		Time:        "2021-12-17T06:08:24Z",
+		Guild:       "Kotki Antica",
+		Victim:      "Fujitora Alm",
+		VictimGuild: "Mercenarys",
+		Level:       787,
+		Killers:     []string{"Panna Granger"},
+		Assists:     []string{"Amir Zard"},
+	}}, killsJson.WarKills.Kills)
+
+	scoreboardJson, err := TibiaGuildsWarScoreboardImpl("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]WarScoreboardGuild{{Name: "Mercenarys", Deaths: 1}, {Name: "Kotki Antica", Kills: 1}}, scoreboardJson.WarScoreboard.Guilds)
+	assert.Equal([]WarScoreboardCharacter{
+		{Name: "Panna Granger", Guild: "Kotki Antica", Kills: 1},
+		{Name: "Amir Zard", Guild: "Kotki Antica", Assists: 1},
+		{Name: "Fujitora Alm", Guild: "Mercenarys", Deaths: 1},
+	}, scoreboardJson.WarScoreboard.Characters)
+
+	trackerJson, err = TibiaGuildsWarTrackerRemoveImpl("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(trackerJson.WarTracker)
+
+	_, err = TibiaGuildsWarKillsImpl("1", "", "", "")
+	assert.Equal(validation.ErrorWarNotTracked, err)
+	_, err = TibiaGuildsWarScoreboardImpl("war")
+	assert.Equal(validation.ErrorWarNotTracked, err)
+}
+
+func TestWarTrackerLimits(t *testing.T) {
+	assert := assert.New(t)
+	testStore(t)
+
+	defer func(size int) { TibiaDataWarTrackerMaxSize = size }(TibiaDataWarTrackerMaxSize)
+	TibiaDataWarTrackerMaxSize = 0
+
+	requests := 0
+	collector := testWarTrackerCollector(t, &requests)
+
+	_, err := TibiaGuildsWarTrackerAddImpl(WarTrackerRequest{Guild: "Mercenarys", Opponent: "Kotki Antica"}, time.Now(), collector)
+	assert.Equal(validation.ErrorWarTrackerFull, err)
+
+	// Order of Glory is a guild of Premia
+	glory := testFileCollector(t, "testdata/guilds/guild/Order of Glory.html", nil)
+	_, err = TibiaGuildsWarTrackerAddImpl(WarTrackerRequest{Guild: "Mercenarys", Opponent: "Order of Glory"}, time.Now(), func(request TibiaDataRequestStruct) (string, error) {
+		if strings.HasSuffix(request.URL, "GuildName=Order+of+Glory") {
+			return glory(request)
+		}
+		return collector(request)
+	})
+	assert.Equal(validation.ErrorWarGuildsDifferentWorlds, err)
+}
+
+func TestWarTrackerMatch(t *testing.T) {
+	assert := assert.New(t)
+
+	guild := Guild{Name: "Elysium", Members: []GuildMember{{Name: "Trollefar"}, {Name: "Durin"}}}
+	opponent := Guild{Name: "Nights Watch", Members: []GuildMember{{Name: "Bubble"}, {Name: "Kharsek"}}}
+	deaths := map[string][]Deaths{
+		"Trollefar": {
+			// killed by a member of the other guild and a summon of a member of the same guild
+			{Time: "2025-01-02T10:00:00Z", Level: 400, Killers: []Killers{{Name: "Bubble", Player: true}, {Name: "Durin", Player: true, Summon: "fire elemental"}}},
+			// killed by a creature only
+			{Time: "2025-01-02T09:00:00Z", Level: 401, Killers: []Killers{{Name: "dragon"}}},
+			// before the war was added
+			{Time: "2024-12-31T10:00:00Z", Level: 402, Killers: []Killers{{Name: "Bubble", Player: true}}},
+		},
+		"Kharsek": {
+			// a kill of an assist only
+			{Time: "2025-01-03T10:00:00Z", Level: 200, Killers: []Killers{{Name: "demon"}}, Assists: []Killers{{Name: "Durin", Player: true}, {Name: "Durin", Player: true}}},
+		},
+	}
+
+	kills := tibiaWarTrackerMatch(guild, opponent, deaths, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal([]WarKill{
+		{Time: "2025-01-02T10:00:00Z", Guild: "Nights Watch", Victim: "Trollefar", VictimGuild: "Elysium", Level: 400, Killers: []string{"Bubble"}, Assists: []string{}},
+		{Time: "2025-01-03T10:00:00Z", Guild: "Elysium", Victim: "Kharsek", VictimGuild: "Nights Watch", Level: 200, Killers: []string{}, Assists: []string{"Durin"}},
+	}, kills)
+}
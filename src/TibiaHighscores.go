@@ -21,6 +21,8 @@ type Highscore struct {
 	Level    int    `json:"level"`           // The character's level.
 	Value    int    `json:"value"`           // The character's value for the highscores or loyalty points.
 	Title    string `json:"title,omitempty"` // The character's loyalty title. (when category: loyalty)
+
+	LevelProgress *LevelProgress `json:"level_progress,omitempty"` // The progress towards the next level. (when category: experience)
 }
 
 // Child of Highscore
@@ -32,12 +34,20 @@ type HighscorePage struct {
 
 // Child of JSONData
 type Highscores struct {
-	World         string        `json:"world"`          // The world the highscores belong to.
-	Category      string        `json:"category"`       // The selected category being displayed.
-	Vocation      string        `json:"vocation"`       // The selected vocation filtered on.
-	HighscoreAge  int           `json:"highscore_age"`  // The age of the highscore page in minutes.
-	HighscoreList []Highscore   `json:"highscore_list"` // List of highscore records.
-	HighscorePage HighscorePage `json:"highscore_page"` // Information of highscore pages.
+	World         string        `json:"world"`                 // The world the highscores belong to.
+	Category      string        `json:"category"`              // The selected category being displayed.
+	Vocation      string        `json:"vocation"`              // The selected vocation filtered on.
+	HighscoreAge  int           `json:"highscore_age"`         // The age of the highscore page in minutes.
+	HighscoreList []Highscore   `json:"highscore_list"`        // List of highscore records.
+	HighscorePage HighscorePage `json:"highscore_page"`        // Information of highscore pages.
+	WorldTypes    []string      `json:"world_types,omitempty"` // The world types filtered on (only on all worlds).
+	BattlEye      string        `json:"battleye,omitempty"`    // The battleye protection filtered on (only on all worlds).
+}
+
+// HighscoresFilter holds the optional filters of the highscores of all worlds
+type HighscoresFilter struct {
+	WorldTypes []string // The world types (e.g. optional, retro_hardcore), empty for all world types.
+	BattlEye   string   // The battleye protection (e.g. protected), empty for any protection.
 }
 
 // The base includes two levels: Highscores and Information
@@ -139,14 +149,21 @@ func TibiaHighscoresImpl(world string, category validation.HighscoreCategory, vo
 				HighscoreDataValue = TibiaDataStringToInteger(subma1[0][6])
 			}
 
+			var HighscoreDataLevelProgress *LevelProgress
+			if category == validation.HighScoreExperience {
+				progress := TibiaDataLevelProgress(HighscoreDataLevel, HighscoreDataValue)
+				HighscoreDataLevelProgress = &progress
+			}
+
 			HighscoreData = append(HighscoreData, Highscore{
-				Rank:     HighscoreDataRank,
-				Name:     TibiaDataSanitizeEscapedString(subma1[0][2]),
-				Vocation: HighscoreDataVocation,
-				World:    HighscoreDataWorld,
-				Level:    HighscoreDataLevel,
-				Value:    HighscoreDataValue,
-				Title:    HighscoreDataTitle,
+				Rank:          HighscoreDataRank,
+				Name:          TibiaDataSanitizeEscapedString(subma1[0][2]),
+				Vocation:      HighscoreDataVocation,
+				World:         HighscoreDataWorld,
+				Level:         HighscoreDataLevel,
+				Value:         HighscoreDataValue,
+				Title:         HighscoreDataTitle,
+				LevelProgress: HighscoreDataLevelProgress,
 			})
 		}
 
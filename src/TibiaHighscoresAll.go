@@ -0,0 +1,139 @@
+package main
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// Child of HighscoresAllResponse
+type HighscoresFailedPage struct {
+	Page  int    `json:"page"`  // The page that could not be fetched.
+	Error Status `json:"error"` // The error of the page.
+}
+
+// The base includes three levels: Highscores, FailedPages and Information
+type HighscoresAllResponse struct {
+	Highscores  Highscores             `json:"highscores"`             // The highscores of all pages.
+	FailedPages []HighscoresFailedPage `json:"failed_pages,omitempty"` // The pages that could not be fetched.
+	Information Information            `json:"information"`
+}
+
+// ListEntries returns all highscore records of all pages
+func (r HighscoresAllResponse) ListEntries() interface{} {
+	return r.Highscores.HighscoreList
+}
+
+// TibiaHighscoresAllImpl func - fetches all pages of a highscore list and merges them in rank order
+// The first page is needed for the number of pages, the other pages are fetched concurrently and
+// reported in FailedPages if they fail. pageHandler (if not nil) is called for every page in page
+// order as soon as the page and all pages before it are done, with either the entries or the error.
+func TibiaHighscoresAllImpl(world, category, vocation string, filter HighscoresFilter, htmlDataCollector func(TibiaDataRequestStruct) (string, error), pageHandler func(page int, highscores []Highscore, failure *HighscoresFailedPage)) (HighscoresAllResponse, error) {
+	endpoint, err := tibiaHighscoresEndpoint(world, category, vocation, "1", filter)
+	if err != nil {
+		return HighscoresAllResponse{}, err
+	}
+
+	BoxContentHTML, err := htmlDataCollector(endpoint.Request)
+	if err != nil {
+		return HighscoresAllResponse{}, err
+	}
+
+	data, err := endpoint.Parse(BoxContentHTML)
+	if err != nil {
+		return HighscoresAllResponse{}, err
+	}
+	first := data.(HighscoresResponse)
+
+	totalPages := max(first.Highscores.HighscorePage.TotalPages, 1)
+	pages := make([]HighscoresResponse, totalPages)
+	failures := make([]*HighscoresFailedPage, totalPages)
+	pages[0] = first
+
+	// pages are handed to pageHandler in order, finished pages wait for the pages before them
+	var (
+		mu       sync.Mutex
+		done     = make([]bool, totalPages)
+		nextPage = 0
+	)
+	finish := func(i int) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		done[i] = true
+		for ; nextPage < totalPages && done[nextPage]; nextPage++ {
+			if pageHandler != nil {
+				pageHandler(nextPage+1, pages[nextPage].Highscores.HighscoreList, failures[nextPage])
+			}
+		}
+	}
+	finish(0)
+
+	TibiaDataParallel(totalPages-1, TibiaDataFanOutConcurrency, func(i int) {
+		page := i + 1
+		pages[page], failures[page] = tibiaHighscoresAllPage(world, category, vocation, filter, page+1, htmlDataCollector)
+		finish(page)
+	})
+
+	highscores := first.Highscores
+	highscores.HighscoreList = []Highscore{}
+	highscores.HighscorePage.CurrentPage = 0
+
+	var failedPages []HighscoresFailedPage
+	tibiaURLs := []string{}
+	for i, page := range pages {
+		if failures[i] != nil {
+			failedPages = append(failedPages, *failures[i])
+			continue
+		}
+
+		highscores.HighscoreList = append(highscores.HighscoreList, page.Highscores.HighscoreList...)
+		highscores.HighscoreAge = max(highscores.HighscoreAge, page.Highscores.HighscoreAge)
+		tibiaURLs = append(tibiaURLs, page.Information.TibiaURLs...)
+	}
+
+	// the pages can be from different updates of the highscores
+	sort.SliceStable(highscores.HighscoreList, func(i, j int) bool {
+		return highscores.HighscoreList[i].Rank < highscores.HighscoreList[j].Rank
+	})
+
+	//
+	// Build the data-blob
+	return HighscoresAllResponse{
+		highscores,
+		failedPages,
+		Information{
+			APIDetails: TibiaDataAPIDetails,
+			Timestamp:  TibiaDataDatetime(""),
+			TibiaURLs:  tibiaURLs,
+			Status: Status{
+				HTTPCode: http.StatusOK,
+			},
+		},
+	}, nil
+}
+
+// tibiaHighscoresAllPage fetches one page of the highscores or returns its failure
+func tibiaHighscoresAllPage(world, category, vocation string, filter HighscoresFilter, page int, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (HighscoresResponse, *HighscoresFailedPage) {
+	failure := func(err error, httpCode int) *HighscoresFailedPage {
+		return &HighscoresFailedPage{Page: page, Error: TibiaDataErrorInformation(err, httpCode).Status}
+	}
+
+	endpoint, err := tibiaHighscoresEndpoint(world, category, vocation, strconv.Itoa(page), filter)
+	if err != nil {
+		return HighscoresResponse{}, failure(err, 0)
+	}
+
+	BoxContentHTML, err := htmlDataCollector(endpoint.Request)
+	if err != nil {
+		return HighscoresResponse{}, failure(err, http.StatusBadGateway)
+	}
+
+	data, err := endpoint.Parse(BoxContentHTML)
+	if err != nil {
+		return HighscoresResponse{}, failure(err, 0)
+	}
+
+	return data.(HighscoresResponse), nil
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+func TestHighscoresAllPages(t *testing.T) {
+	assert := assert.New(t)
+
+	// the test file has 20 pages, page 5 can not be fetched
+	highscores := testFileCollector(t, "testdata/highscores/all.html", nil)
+	collector := func(request TibiaDataRequestStruct) (string, error) {
+		if strings.HasSuffix(request.URL, "&currentpage=5") {
+			return "", validation.ErrStatusForbidden
+		}
+		return highscores(request)
+	}
+
+	var handledPages []int
+	allJson, err := TibiaHighscoresAllImpl("all", "experience", "all", HighscoresFilter{}, collector, func(page int, highscores []Highscore, failure *HighscoresFailedPage) {
+		handledPages = append(handledPages, page)
+		if page == 5 {
+			assert.NotNil(failure)
+			assert.Empty(highscores)
+		} else {
+			assert.Nil(failure)
+			assert.Len(highscores, 50)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, handledPages)
+
+	assert.Equal("experience", allJson.Highscores.Category)
+	assert.Equal(20, allJson.Highscores.HighscorePage.TotalPages)
+	assert.Equal(0, allJson.Highscores.HighscorePage.CurrentPage)
+	assert.Len(allJson.Highscores.HighscoreList, 19*50)
+	assert.Len(allJson.Information.TibiaURLs, 19)
+
+	for i := 1; i < len(allJson.Highscores.HighscoreList); i++ {
+		assert.LessOrEqual(allJson.Highscores.HighscoreList[i-1].Rank, allJson.Highscores.HighscoreList[i].Rank)
+	}
+
+	if assert.Len(allJson.FailedPages, 1) {
+		assert.Equal(5, allJson.FailedPages[0].Page)
+		assert.Equal(20006, allJson.FailedPages[0].Error.Error)
+	}
+}
+
+func TestHighscoresAllErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests []TibiaDataRequestStruct
+	collector := testFileCollector(t, "testdata/highscores/all.html", &requests)
+
+	_, err := TibiaHighscoresAllImpl("all", "experience", "wizards", HighscoresFilter{}, collector, nil)
+	assert.Equal(validation.ErrorVocationDoesNotExist, err)
+
+	TibiaDataRestrictionMode = true
+	defer func() { TibiaDataRestrictionMode = false }()
+
+	_, err = TibiaHighscoresAllImpl("all", "experience", "knights", HighscoresFilter{}, collector, nil)
+	assert.Equal(validation.ErrorRestrictionMode, err)
+	assert.Empty(requests)
+
+	// the first page is needed for the number of pages
+	_, err = TibiaHighscoresAllImpl("all", "experience", "all", HighscoresFilter{}, func(TibiaDataRequestStruct) (string, error) {
+		return "", validation.ErrorMaintenanceMode
+	}, nil)
+	assert.Equal(validation.ErrorMaintenanceMode, err)
+}
@@ -0,0 +1,410 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// TibiaDataHighscoresSnapshotLists are the highscore lists that are snapshotted
+	TibiaDataHighscoresSnapshotLists []HighscoresSnapshotList
+
+	// TibiaDataHighscoresSnapshotInterval is the time between two snapshots of a highscore list
+	TibiaDataHighscoresSnapshotInterval = time.Hour
+
+	// TibiaDataHighscoresSnapshotRetention is how long snapshots are kept (0 keeps them forever)
+	TibiaDataHighscoresSnapshotRetention = 30 * 24 * time.Hour
+
+	// TibiaDataHighscoresSnapshotMaxCount is the number of snapshots kept per highscore list (0 for no limit)
+	TibiaDataHighscoresSnapshotMaxCount = 0
+)
+
+// tibiaHighscoresSnapshotsBucket is the bucket of the store with a bucket of snapshots per highscore list
+var tibiaHighscoresSnapshotsBucket = []byte("highscores_snapshots")
+
+// HighscoresSnapshotList is a highscore list that is snapshotted
+type HighscoresSnapshotList struct {
+	World    string // The world, all for all worlds.
+	Category string // The category (e.g. experience).
+	Vocation string // The vocation (e.g. knights).
+}
+
+// String returns the list as world/category/vocation, which is also its key in the store
+func (l HighscoresSnapshotList) String() string {
+	return l.World + "/" + l.Category + "/" + l.Vocation
+}
+
+// Child of HighscoresDeltas
+type HighscoreDelta struct {
+	Rank       int    `json:"rank"`        // The character's rank at the end of the period.
+	Name       string `json:"name"`        // The name of the character.
+	Vocation   string `json:"vocation"`    // The character's vocation.
+	World      string `json:"world"`       // The character's world.
+	Level      int    `json:"level"`       // The character's level at the end of the period.
+	Value      int    `json:"value"`       // The character's value at the end of the period.
+	LevelDelta int    `json:"level_delta"` // The levels gained in the period.
+	ValueDelta int    `json:"value_delta"` // The value gained in the period.
+}
+
+// Child of JSONData
+type HighscoresDeltas struct {
+	World    string           `json:"world"`    // The world the highscores belong to.
+	Category string           `json:"category"` // The highscore category.
+	Vocation string           `json:"vocation"` // The vocation filtered on.
+	From     string           `json:"from"`     // The time of the snapshot the period starts with.
+	To       string           `json:"to"`       // The time of the snapshot the period ends with.
+	Deltas   []HighscoreDelta `json:"deltas"`   // The characters on both snapshots, sorted by the value gained.
+}
+
+// The base includes two levels: HighscoresDeltas and Information
+type HighscoresDeltasResponse struct {
+	HighscoresDeltas HighscoresDeltas `json:"highscores_deltas"`
+	Information      Information      `json:"information"`
+}
+
+// ListEntries returns all deltas
+func (r HighscoresDeltasResponse) ListEntries() interface{} {
+	return r.HighscoresDeltas.Deltas
+}
+
+// Child of HighscoresRankChanges
+type HighscoreRankChange struct {
+	Rank         int    `json:"rank"`              // The character's rank at the end of the period (0 if it dropped off).
+	PreviousRank int    `json:"previous_rank"`     // The character's rank at the start of the period (0 if it is new).
+	RankChange   int    `json:"rank_change"`       // The ranks moved up, negative if the character moved down.
+	Name         string `json:"name"`              // The name of the character.
+	Vocation     string `json:"vocation"`          // The character's vocation.
+	World        string `json:"world"`             // The character's world.
+	New          bool   `json:"new,omitempty"`     // Whether the character is only on the snapshot at the end of the period.
+	Dropped      bool   `json:"dropped,omitempty"` // Whether the character is only on the snapshot at the start of the period.
+}
+
+// Child of JSONData
+type HighscoresRankChanges struct {
+	World       string                `json:"world"`        // The world the highscores belong to.
+	Category    string                `json:"category"`     // The highscore category.
+	Vocation    string                `json:"vocation"`     // The vocation filtered on.
+	From        string                `json:"from"`         // The time of the snapshot the period starts with.
+	To          string                `json:"to"`           // The time of the snapshot the period ends with.
+	RankChanges []HighscoreRankChange `json:"rank_changes"` // The characters of both snapshots, sorted by rank (dropped characters last).
+}
+
+// The base includes two levels: HighscoresRankChanges and Information
+type HighscoresRankChangesResponse struct {
+	HighscoresRankChanges HighscoresRankChanges `json:"highscores_rank_changes"`
+	Information           Information           `json:"information"`
+}
+
+// ListEntries returns all rank changes
+func (r HighscoresRankChangesResponse) ListEntries() interface{} {
+	return r.HighscoresRankChanges.RankChanges
+}
+
+// highscoresSnapshot is a stored snapshot of a highscore list
+type highscoresSnapshot struct {
+	Time          time.Time
+	HighscoreList []Highscore
+}
+
+// tibiaHighscoresSnapshotList validates and normalizes the parameters of a highscore list
+func tibiaHighscoresSnapshotList(world, category, vocation string) (HighscoresSnapshotList, error) {
+	err := validation.IsVocationValid(vocation)
+	if err != nil {
+		return HighscoresSnapshotList{}, err
+	}
+
+	err = validation.IsHighscoreCategoryValid(category)
+	if err != nil {
+		return HighscoresSnapshotList{}, err
+	}
+
+	if strings.EqualFold(world, "all") {
+		world = "all"
+	} else {
+		world = TibiaDataStringWorldFormatToTitle(world)
+	}
+	categoryName, _ := validation.HighscoreCategoryFromString(category).String()
+	vocationName, _ := TibiaDataVocationValidator(vocation)
+
+	return HighscoresSnapshotList{World: world, Category: categoryName, Vocation: vocationName}, nil
+}
+
+// ParseHighscoresSnapshotLists func - parses a comma separated list of world/category/vocation highscore lists
+// The vocation can be left out for all vocations, e.g. Antica/experience,all/magiclevel/sorcerers
+func ParseHighscoresSnapshotLists(config string) ([]HighscoresSnapshotList, error) {
+	var lists []HighscoresSnapshotList
+	for _, entry := range strings.Split(config, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, "/")
+		if len(parts) == 2 {
+			parts = append(parts, TibiaDataDefaultVoc)
+		}
+		if len(parts) != 3 || parts[0] == "" {
+			return nil, fmt.Errorf("the highscore list %q is not of the form world/category/vocation", entry)
+		}
+
+		list, err := tibiaHighscoresSnapshotList(parts[0], parts[1], parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("the highscore list %q is invalid: %w", entry, err)
+		}
+		lists = append(lists, list)
+	}
+
+	return lists, nil
+}
+
+// runHighscoresSnapshots snapshots the lists every interval until stop is closed
+// Lists with a snapshot younger than half the interval (e.g. after a restart) are skipped.
+func runHighscoresSnapshots(db *bolt.DB, lists []HighscoresSnapshotList, interval time.Duration, htmlDataCollector func(TibiaDataRequestStruct) (string, error), stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for _, list := range lists {
+			now := time.Now()
+			if latest, ok := tibiaHighscoresLatestSnapshotTime(db, list); ok && now.Sub(latest) < interval/2 {
+				continue
+			}
+
+			if err := tibiaHighscoresSnapshot(db, list, htmlDataCollector, now); err != nil {
+				log.Printf("[error] TibiaData API highscores snapshot of %s failed: %s", list, err)
+			}
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// tibiaHighscoresSnapshot fetches all pages of the list and stores them as snapshot of now
+// Snapshots with pages that could not be fetched are not stored, as they would show wrong changes.
+func tibiaHighscoresSnapshot(db *bolt.DB, list HighscoresSnapshotList, htmlDataCollector func(TibiaDataRequestStruct) (string, error), now time.Time) error {
+	data, err := TibiaHighscoresAllImpl(list.World, list.Category, list.Vocation, HighscoresFilter{}, htmlDataCollector, nil)
+	if err != nil {
+		return err
+	}
+	if len(data.FailedPages) > 0 {
+		return fmt.Errorf("%d of %d pages could not be fetched", len(data.FailedPages), data.Highscores.HighscorePage.TotalPages)
+	}
+
+	value, err := json.Marshal(data.Highscores.HighscoreList)
+	if err != nil {
+		return err
+	}
+
+	return db.Update(func(tx *bolt.Tx) error {
+		root, err := tx.CreateBucketIfNotExists(tibiaHighscoresSnapshotsBucket)
+		if err != nil {
+			return err
+		}
+
+		bucket, err := root.CreateBucketIfNotExists([]byte(list.String()))
+		if err != nil {
+			return err
+		}
+
+		if err := bucket.Put(tibiaDataStoreTimeKey(now), value); err != nil {
+			return err
+		}
+
+		return tibiaDataStorePrune(bucket, now, TibiaDataHighscoresSnapshotRetention, TibiaDataHighscoresSnapshotMaxCount)
+	})
+}
+
+// tibiaHighscoresLatestSnapshotTime returns the time of the latest snapshot of the list
+func tibiaHighscoresLatestSnapshotTime(db *bolt.DB, list HighscoresSnapshotList) (latest time.Time, ok bool) {
+	_ = db.View(func(tx *bolt.Tx) error {
+		bucket := tibiaHighscoresSnapshotListBucket(tx, list)
+		if bucket == nil {
+			return nil
+		}
+
+		if key, _ := bucket.Cursor().Last(); key != nil {
+			latest, ok = tibiaDataStoreKeyTime(key), true
+		}
+		return nil
+	})
+
+	return latest, ok
+}
+
+// tibiaHighscoresSnapshotListBucket returns the bucket of the snapshots of the list (nil if there are none)
+func tibiaHighscoresSnapshotListBucket(tx *bolt.Tx, list HighscoresSnapshotList) *bolt.Bucket {
+	root := tx.Bucket(tibiaHighscoresSnapshotsBucket)
+	if root == nil {
+		return nil
+	}
+	return root.Bucket([]byte(list.String()))
+}
+
+// tibiaHighscoresSnapshotsOfPeriod returns the snapshots the period starts and ends with
+// The period starts with the latest snapshot at or before from (or the first snapshot after it if
+// there is none) and ends with the latest snapshot at or before to.
+func tibiaHighscoresSnapshotsOfPeriod(db *bolt.DB, list HighscoresSnapshotList, from, to time.Time) (first, last highscoresSnapshot, err error) {
+	err = db.View(func(tx *bolt.Tx) error {
+		bucket := tibiaHighscoresSnapshotListBucket(tx, list)
+		if bucket == nil {
+			return validation.ErrorHighscoreSnapshotsNotEnough
+		}
+
+		var firstKey, lastKey []byte
+		cursor := bucket.Cursor()
+		for key, _ := cursor.First(); key != nil && !tibiaDataStoreKeyTime(key).After(to); key, _ = cursor.Next() {
+			if firstKey == nil || !tibiaDataStoreKeyTime(key).After(from) {
+				firstKey = key
+			}
+			lastKey = key
+		}
+
+		if firstKey == nil || string(firstKey) == string(lastKey) {
+			return validation.ErrorHighscoreSnapshotsNotEnough
+		}
+
+		first = highscoresSnapshot{Time: tibiaDataStoreKeyTime(firstKey)}
+		if err := json.Unmarshal(bucket.Get(firstKey), &first.HighscoreList); err != nil {
+			return err
+		}
+
+		last = highscoresSnapshot{Time: tibiaDataStoreKeyTime(lastKey)}
+		return json.Unmarshal(bucket.Get(lastKey), &last.HighscoreList)
+	})
+
+	return first, last, err
+}
+
+// tibiaHighscoresSnapshotsCompare validates the parameters and returns the list and the snapshots of the period
+func tibiaHighscoresSnapshotsCompare(world, category, vocation, from, to, period string) (HighscoresSnapshotList, highscoresSnapshot, highscoresSnapshot, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return HighscoresSnapshotList{}, highscoresSnapshot{}, highscoresSnapshot{}, err
+	}
+
+	list, err := tibiaHighscoresSnapshotList(world, category, vocation)
+	if err != nil {
+		return HighscoresSnapshotList{}, highscoresSnapshot{}, highscoresSnapshot{}, err
+	}
+
+	// Check if restriction mode is enabled
+	if TibiaDataRestrictionMode && list.Vocation != "all" {
+		return HighscoresSnapshotList{}, highscoresSnapshot{}, highscoresSnapshot{}, validation.ErrorRestrictionMode
+	}
+
+	fromTime, toTime, err := tibiaDataStorePeriod(from, to, period, time.Now())
+	if err != nil {
+		return HighscoresSnapshotList{}, highscoresSnapshot{}, highscoresSnapshot{}, err
+	}
+
+	first, last, err := tibiaHighscoresSnapshotsOfPeriod(db, list, fromTime, toTime)
+	return list, first, last, err
+}
+
+// TibiaHighscoresDeltasImpl func - returns the value gained by every character on the snapshots the period starts and ends with
+func TibiaHighscoresDeltasImpl(world, category, vocation, from, to, period string) (HighscoresDeltasResponse, error) {
+	list, first, last, err := tibiaHighscoresSnapshotsCompare(world, category, vocation, from, to, period)
+	if err != nil {
+		return HighscoresDeltasResponse{}, err
+	}
+
+	previous := map[string]Highscore{}
+	for _, entry := range first.HighscoreList {
+		previous[entry.Name] = entry
+	}
+
+	deltas := []HighscoreDelta{}
+	for _, entry := range last.HighscoreList {
+		before, exists := previous[entry.Name]
+		if !exists {
+			continue
+		}
+
+		deltas = append(deltas, HighscoreDelta{
+			Rank:       entry.Rank,
+			Name:       entry.Name,
+			Vocation:   entry.Vocation,
+			World:      entry.World,
+			Level:      entry.Level,
+			Value:      entry.Value,
+			LevelDelta: entry.Level - before.Level,
+			ValueDelta: entry.Value - before.Value,
+		})
+	}
+
+	sort.SliceStable(deltas, func(i, j int) bool {
+		return deltas[i].ValueDelta > deltas[j].ValueDelta
+	})
+
+	//
+	// Build the data-blob
+	return HighscoresDeltasResponse{
+		HighscoresDeltas{
+			World:    list.World,
+			Category: list.Category,
+			Vocation: list.Vocation,
+			From:     first.Time.Format(time.RFC3339),
+			To:       last.Time.Format(time.RFC3339),
+			Deltas:   deltas,
+		},
+		tibiaDataLocalInformation(),
+	}, nil
+}
+
+// TibiaHighscoresRankChangesImpl func - returns the rank changes of all characters on the snapshots the period starts and ends with
+func TibiaHighscoresRankChangesImpl(world, category, vocation, from, to, period string) (HighscoresRankChangesResponse, error) {
+	list, first, last, err := tibiaHighscoresSnapshotsCompare(world, category, vocation, from, to, period)
+	if err != nil {
+		return HighscoresRankChangesResponse{}, err
+	}
+
+	previous := map[string]Highscore{}
+	for _, entry := range first.HighscoreList {
+		previous[entry.Name] = entry
+	}
+
+	rankChanges := []HighscoreRankChange{}
+	for _, entry := range last.HighscoreList {
+		rankChange := HighscoreRankChange{Rank: entry.Rank, Name: entry.Name, Vocation: entry.Vocation, World: entry.World}
+		if before, exists := previous[entry.Name]; exists {
+			rankChange.PreviousRank = before.Rank
+			rankChange.RankChange = before.Rank - entry.Rank
+			delete(previous, entry.Name)
+		} else {
+			rankChange.New = true
+		}
+		rankChanges = append(rankChanges, rankChange)
+	}
+
+	// the characters left dropped off the list, they are added in their previous order
+	for _, entry := range first.HighscoreList {
+		if _, dropped := previous[entry.Name]; dropped {
+			rankChanges = append(rankChanges, HighscoreRankChange{PreviousRank: entry.Rank, Name: entry.Name, Vocation: entry.Vocation, World: entry.World, Dropped: true})
+		}
+	}
+
+	//
+	// Build the data-blob
+	return HighscoresRankChangesResponse{
+		HighscoresRankChanges{
+			World:       list.World,
+			Category:    list.Category,
+			Vocation:    list.Vocation,
+			From:        first.Time.Format(time.RFC3339),
+			To:          last.Time.Format(time.RFC3339),
+			RankChanges: rankChanges,
+		},
+		tibiaDataLocalInformation(),
+	}, nil
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+// testHighscoresSnapshotCollector returns the first page of the highscores test file as the only page, changed by replacer
+func testHighscoresSnapshotCollector(t *testing.T, replacer *strings.Replacer) func(TibiaDataRequestStruct) (string, error) {
+	highscores := testFileCollector(t, "testdata/highscores/all.html", nil)
+
+	return func(request TibiaDataRequestStruct) (string, error) {
+		html, err := highscores(request)
+		html = strings.ReplaceAll(html, `class="PageLink `, `class="Page `)
+		html = strings.ReplaceAll(html, `<span class="Page "><span class="CurrentPageLink">`, `<span class="PageLink "><span class="CurrentPageLink">`)
+		return replacer.Replace(html), err
+	}
+}
+
+func TestHighscoresSnapshots(t *testing.T) {
+	assert := assert.New(t)
+	db := testStore(t)
+
+	list, err := tibiaHighscoresSnapshotList("ALL", "Experience", "all")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// in the second snapshot Goraca gained a level and moved down, Wujo Daro dropped off for New Player
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	assert.Nil(tibiaHighscoresSnapshot(db, list, testHighscoresSnapshotCollector(t, strings.NewReplacer()), start))
+	assert.Nil(tibiaHighscoresSnapshot(db, list, testHighscoresSnapshotCollector(t, strings.NewReplacer(
+		`<td>1</td><td class="DoNotBreak"><a href="https://www.tibia.com/community/?subtopic=characters&name=Goraca">`, `<td>3</td><td class="DoNotBreak"><a href="https://www.tibia.com/community/?subtopic=characters&name=Goraca">`,
+		`>2197<`, `>2198<`,
+		`176,271,164,607`, `176,271,165,607`,
+		`Wujo Daro`, `New Player`,
+	)), start.Add(time.Hour)))
+
+	latest, ok := tibiaHighscoresLatestSnapshotTime(db, list)
+	assert.True(ok)
+	assert.Equal(start.Add(time.Hour), latest)
+
+	deltasJson, err := TibiaHighscoresDeltasImpl("all", "experience", "all", "2025-01-01T10:00:00Z", "2025-01-01T11:30:00Z", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deltas := deltasJson.HighscoresDeltas
+	assert.Equal("all", deltas.World)
+	assert.Equal("experience", deltas.Category)
+	assert.Equal("2025-01-01T10:00:00Z", deltas.From)
+	assert.Equal("2025-01-01T11:00:00Z", deltas.To)
+	assert.Len(deltas.Deltas, 49)
+	assert.Equal(HighscoreDelta{Rank: 3, Name: "Goraca", Vocation: "Master Sorcerer", World: "Bona", Level: 2198, Value: 176271165607, LevelDelta: 1, ValueDelta: 1000}, deltas.Deltas[0])
+	assert.Equal(0, deltas.Deltas[1].ValueDelta)
+
+	rankChangesJson, err := TibiaHighscoresRankChangesImpl("all", "experience", "all", "2025-01-01", "2025-01-02", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rankChanges := rankChangesJson.HighscoresRankChanges.RankChanges
+	assert.Len(rankChanges, 51)
+	assert.Equal(HighscoreRankChange{Rank: 2, PreviousRank: 2, Name: "Bobeek", Vocation: "Elder Druid", World: "Bona"}, rankChanges[0])
+	assert.Equal(HighscoreRankChange{Rank: 3, PreviousRank: 1, RankChange: -2, Name: "Goraca", Vocation: "Master Sorcerer", World: "Bona"}, rankChanges[1])
+	assert.Equal(HighscoreRankChange{Rank: 50, Name: "New Player", Vocation: "Elite Knight", World: "Refugia", New: true}, rankChanges[49])
+	assert.Equal(HighscoreRankChange{PreviousRank: 50, Name: "Wujo Daro", Vocation: "Elite Knight", World: "Refugia", Dropped: true}, rankChanges[50])
+
+	// the period starts with the latest snapshot before it, but has to end with another one
+	_, err = TibiaHighscoresDeltasImpl("all", "experience", "all", "2025-01-01T11:30:00Z", "2025-01-01T12:00:00Z", "")
+	assert.Equal(validation.ErrorHighscoreSnapshotsNotEnough, err)
+	_, err = TibiaHighscoresDeltasImpl("all", "experience", "knights", "", "", "week")
+	assert.Equal(validation.ErrorHighscoreSnapshotsNotEnough, err)
+	_, err = TibiaHighscoresDeltasImpl("all", "experience", "all", "", "", "year")
+	assert.Equal(validation.ErrorPeriodInvalid, err)
+}
+
+func TestHighscoresSnapshotsFailedPages(t *testing.T) {
+	assert := assert.New(t)
+	db := testStore(t)
+
+	list := HighscoresSnapshotList{World: "all", Category: "experience", Vocation: "all"}
+	highscores := testFileCollector(t, "testdata/highscores/all.html", nil)
+	collector := func(request TibiaDataRequestStruct) (string, error) {
+		if strings.HasSuffix(request.URL, "&currentpage=5") {
+			return "", validation.ErrStatusForbidden
+		}
+		return highscores(request)
+	}
+
+	assert.NotNil(tibiaHighscoresSnapshot(db, list, collector, time.Now()))
+
+	_, ok := tibiaHighscoresLatestSnapshotTime(db, list)
+	assert.False(ok)
+}
+
+func TestHighscoresSnapshotsStoreNotEnabled(t *testing.T) {
+	_, err := TibiaHighscoresRankChangesImpl("all", "experience", "all", "", "", "")
+	assert.Equal(t, validation.ErrorStoreNotEnabled, err)
+}
+
+func TestParseHighscoresSnapshotLists(t *testing.T) {
+	assert := assert.New(t)
+
+	lists, err := ParseHighscoresSnapshotLists(" antica/experience, all/mlvl/sorcerer ,")
+	assert.Nil(err)
+	assert.Equal([]HighscoresSnapshotList{
+		{World: "Antica", Category: "experience", Vocation: "all"},
+		{World: "all", Category: "magiclevel", Vocation: "sorcerers"},
+	}, lists)
+	assert.Equal("Antica/experience/all", lists[0].String())
+
+	lists, err = ParseHighscoresSnapshotLists("")
+	assert.Nil(err)
+	assert.Empty(lists)
+
+	_, err = ParseHighscoresSnapshotLists("antica")
+	assert.NotNil(err)
+	_, err = ParseHighscoresSnapshotLists("antica/cooking")
+	assert.ErrorIs(err, validation.ErrorHighscoreCategoryDoesNotExist)
+}
@@ -2,8 +2,11 @@ package main
 
 import (
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/tibiadata/tibiadata-api-go/src/static"
 	"github.com/tibiadata/tibiadata-api-go/src/validation"
@@ -51,6 +54,7 @@ func TestHighscoresAll(t *testing.T) {
 	assert.Equal(2197, firstHighscore.Level)
 	assert.Equal(176271164607, firstHighscore.Value)
 	assert.Empty(firstHighscore.Title)
+	assert.Equal(&LevelProgress{ExperienceCurrentLevel: 176259597600, ExperienceNextLevel: 176500608700, ExperienceRemaining: 229444093, Progress: 4.79}, firstHighscore.LevelProgress)
 
 	lastHighscore := highscoresJson.Highscores.HighscoreList[49]
 	assert.Equal(50, lastHighscore.Rank)
@@ -87,4 +91,67 @@ func TestHighscoresLoyalty(t *testing.T) {
 	assert.Equal(12, highscoresJson.Highscores.HighscoreAge)
 
 	assert.Equal(50, len(highscoresJson.Highscores.HighscoreList))
+	assert.Nil(highscoresJson.Highscores.HighscoreList[0].LevelProgress)
+}
+
+func TestHighscoresFilter(t *testing.T) {
+	assert := assert.New(t)
+
+	endpoint, err := tibiaHighscoresEndpoint("all", "experience", "all", "2", HighscoresFilter{WorldTypes: []string{"retro_hardcore", "optional", "Optional PvP"}, BattlEye: "initially_protected"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the filters are sorted and deduplicated, the page stays the last parameter
+	assert.Equal("https://www.tibia.com/community/?subtopic=highscores&world=&category=6&profession=0&beprotection=2&worldtypes%5B%5D=1&worldtypes%5B%5D=4&currentpage=2", endpoint.Request.URL)
+
+	file, err := static.TestFiles.Open("testdata/highscores/all.html")
+	if err != nil {
+		t.Fatalf("file opening error: %s", err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		t.Fatalf("File reading error: %s", err)
+	}
+
+	response, err := endpoint.Parse(string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	highscoresJson := response.(HighscoresResponse)
+	assert.Equal([]string{"Optional PvP", "Retro Hardcore PvP"}, highscoresJson.Highscores.WorldTypes)
+	assert.Equal("Initially Protected", highscoresJson.Highscores.BattlEye)
+
+	// without filters nothing is added
+	endpoint, err = tibiaHighscoresEndpoint("all", "experience", "all", "1", HighscoresFilter{BattlEye: "any"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal("https://www.tibia.com/community/?subtopic=highscores&world=&category=6&profession=0&currentpage=1", endpoint.Request.URL)
+
+	response, err = endpoint.Parse(string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(response.(HighscoresResponse).Highscores.WorldTypes)
+	assert.Empty(response.(HighscoresResponse).Highscores.BattlEye)
+
+	_, err = tibiaHighscoresEndpoint("all", "experience", "all", "1", HighscoresFilter{WorldTypes: []string{"nopvp"}})
+	assert.Equal(validation.ErrorHighscoreWorldTypeDoesNotExist, err)
+
+	_, err = tibiaHighscoresEndpoint("all", "experience", "all", "1", HighscoresFilter{BattlEye: "yellow"})
+	assert.Equal(validation.ErrorHighscoreBattlEyeDoesNotExist, err)
+}
+
+func TestHighscoresFilterQuery(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	assert := assert.New(t)
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/v4/highscores/all/experience/all/1?world_type=open,%20hardcore&world_type=retro_open&battleye=protected", nil)
+
+	assert.Equal(HighscoresFilter{WorldTypes: []string{"open", "hardcore", "retro_open"}, BattlEye: "protected"}, tibiaHighscoresFilterQuery(c))
 }
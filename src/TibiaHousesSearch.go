@@ -0,0 +1,478 @@
+package main
+
+import (
+	"cmp"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+var (
+	// HousesSearchSorts are the values the houses of a search can be sorted by
+	HousesSearchSorts = []string{"rent", "name", "size", "beds", "current_bid", "auction_end"}
+
+	// HousesSearchTypes are the types of homes a search can be restricted to
+	HousesSearchTypes = []string{"house", "guildhall"}
+
+	// HousesSearchPageSize is the number of houses of a page if no page size is given
+	HousesSearchPageSize = 25
+
+	// HousesSearchMaxPageSize is the maximum number of houses of a page
+	HousesSearchMaxPageSize = 100
+
+	// TibiaDataHousesSearchMaxFetches is the maximum number of house pages fetched by a house search
+	TibiaDataHousesSearchMaxFetches = 100
+)
+
+// tibiaHousesSearchCache caches the house pages of the searched houses without their status by their world and id,
+// the beds of a house rarely change
+var tibiaHousesSearchCache = newTibiaDataCache[House](24 * time.Hour)
+
+// tibiaHousesSearchAuctionCache caches the auctions of the house pages of the searched houses by their world, id and
+// the bid of the house list, so that paging through a search does not fetch the house pages of its auctions again
+var tibiaHousesSearchAuctionCache = newTibiaDataCache[HouseAuction](5 * time.Minute)
+
+// HousesSearchQuery is the search over the houses and guildhalls of a world, zero values do not filter
+type HousesSearchQuery struct {
+	Town     string       // The town to search, all towns of the world if empty.
+	Type     string       // The type of home to search (house or guildhall), both if empty.
+	Filter   HousesFilter // The filter of the size, rent and auction of the house lists.
+	MinBeds  int          // The minimum number of beds.
+	MaxBeds  int          // The maximum number of beds.
+	Sort     string       // The value to sort by, rent if empty.
+	Order    string       // The order to sort in (asc or desc), asc if empty.
+	Page     int          // The page to return, the first if zero.
+	PageSize int          // The number of houses of a page, HousesSearchPageSize if zero.
+}
+
+// Child of HousesSearch
+type HousesSearchHouse struct {
+	HouseID     int          `json:"house_id"`  // The internal ID of the house/guildhall.
+	Name        string       `json:"name"`      // The name of the house/guildhall.
+	Town        string       `json:"town"`      // The town where the house/guildhall is located.
+	Type        string       `json:"type"`      // The type of home. (house or guildhall)
+	Beds        int          `json:"beds"`      // The number of beds it has.
+	Size        int          `json:"size"`      // The number of SQM it has.
+	Rent        int          `json:"rent"`      // The monthly cost in gold coins for the house/guildhall.
+	IsRented    bool         `json:"rented"`    // Whether the house/guildhall is rented or not.
+	IsAuctioned bool         `json:"auctioned"` // Whether the house/guildhall is auctioned or not.
+	Auction     HouseAuction `json:"auction"`   // Details about the auction.
+}
+
+// Child of HousesSearch
+type HousesSearchPage struct {
+	CurrentPage  int `json:"current_page"`  // The current page being displayed.
+	TotalPages   int `json:"total_pages"`   // The total number of pages.
+	TotalResults int `json:"total_results"` // The total number of houses and guildhalls found.
+}
+
+// Child of JSONData
+type HousesSearch struct {
+	World        string              `json:"world"`         // The name of the world.
+	Town         string              `json:"town"`          // The town that was searched, empty if all towns were searched.
+	Type         string              `json:"type"`          // The type of home that was searched, empty if both were searched.
+	Sort         string              `json:"sort"`          // The value the houses are sorted by.
+	Order        string              `json:"order"`         // The order the houses are sorted in.
+	Page         HousesSearchPage    `json:"page"`          // The pagination of the houses.
+	Houses       []HousesSearchHouse `json:"houses"`        // The houses and guildhalls of the page.
+	FailedTowns  []string            `json:"failed_towns"`  // The names of the towns that could not be fetched.
+	FailedHouses []int               `json:"failed_houses"` // The IDs of the houses and guildhalls whose house page could not be fetched.
+}
+
+// The base includes two levels: HousesSearch and Information
+type HousesSearchResponse struct {
+	HousesSearch HousesSearch `json:"houses_search"`
+	Information  Information  `json:"information"`
+}
+
+// ListEntries returns the houses of the page
+func (r HousesSearchResponse) ListEntries() interface{} {
+	return r.HousesSearch.Houses
+}
+
+// tibiaHousesSearchParams returns the house search of the query parameters, the search is validated by TibiaHousesSearchImpl
+func tibiaHousesSearchParams(query func(key string) string) (HousesSearchQuery, error) {
+	filter, err := tibiaHousesFilterParams(query("min_size"), query("max_size"), query("min_rent"), query("max_rent"), query("auctioned"))
+	if err != nil {
+		return HousesSearchQuery{}, err
+	}
+
+	search := HousesSearchQuery{
+		Town:   query("town"),
+		Type:   query("type"),
+		Filter: filter,
+		Sort:   query("sort"),
+		Order:  query("order"),
+	}
+
+	for _, param := range []struct {
+		key   string
+		field *int
+		min   int
+		err   error
+	}{
+		{"min_beds", &search.MinBeds, 0, validation.ErrorHouseFilterInvalid},
+		{"max_beds", &search.MaxBeds, 0, validation.ErrorHouseFilterInvalid},
+		{"page", &search.Page, 1, validation.ErrorHousePageInvalid},
+		{"page_size", &search.PageSize, 1, validation.ErrorHousePageInvalid},
+	} {
+		value := query(param.key)
+		if value == "" {
+			continue
+		}
+		number, err := strconv.Atoi(value)
+		if err != nil || number < param.min {
+			return HousesSearchQuery{}, param.err
+		}
+		*param.field = number
+	}
+
+	return search, nil
+}
+
+// validate returns an error if the filters, the sort or the page of the search are invalid
+func (q HousesSearchQuery) validate() error {
+	if err := q.Filter.validate(); err != nil {
+		return err
+	}
+	if q.MinBeds < 0 || q.MaxBeds < 0 || (q.MaxBeds > 0 && q.MinBeds > q.MaxBeds) {
+		return validation.ErrorHouseFilterInvalid
+	}
+	if q.Type != "" && !slices.Contains(HousesSearchTypes, q.Type) {
+		return validation.ErrorHouseFilterInvalid
+	}
+	if q.Sort != "" && !slices.Contains(HousesSearchSorts, q.Sort) {
+		return validation.ErrorHouseSortInvalid
+	}
+	if q.Order != "" && q.Order != "asc" && q.Order != "desc" {
+		return validation.ErrorHouseSortInvalid
+	}
+	if q.Page < 0 || q.PageSize < 0 || q.PageSize > HousesSearchMaxPageSize {
+		return validation.ErrorHousePageInvalid
+	}
+	return nil
+}
+
+// tibiaHousesSearchCandidate is a house of the house lists that passed the filter of the search
+type tibiaHousesSearchCandidate struct {
+	House HousesHouse
+	Town  string
+	Type  string
+}
+
+// TibiaHousesSearchImpl func - searches the houses and guildhalls of a world by their size, beds, rent and auction
+// The house lists of the towns are filtered first, the house pages of the remaining houses that are not cached in
+// tibiaHousesSearchCache are fetched concurrently with the fan-out concurrency for their beds and auction. At most
+// TibiaDataHousesSearchMaxFetches house pages are fetched, the houses above it are listed in failed_houses like the
+// houses whose house page could not be fetched. Towns that could not be fetched are listed in failed_towns.
+// The current bid is taken from the house lists, the house pages of auctions that are not cached in
+// tibiaHousesSearchAuctionCache are only fetched for the returned page, or for all houses when sorting by auction_end.
+func TibiaHousesSearchImpl(world string, query HousesSearchQuery, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (HousesSearchResponse, error) {
+	query.Type, query.Sort, query.Order = strings.ToLower(query.Type), strings.ToLower(query.Sort), strings.ToLower(query.Order)
+	if err := query.validate(); err != nil {
+		return HousesSearchResponse{}, err
+	}
+	query.Sort = cmp.Or(query.Sort, HousesSearchSorts[0])
+	query.Order = cmp.Or(query.Order, "asc")
+	query.Page = max(query.Page, 1)
+	query.PageSize = cmp.Or(query.PageSize, HousesSearchPageSize)
+
+	var (
+		towns []string
+		err   error
+	)
+	if query.Town != "" {
+		world, query.Town, err = tibiaHousesOverviewParams(world, query.Town)
+		if err != nil {
+			return HousesSearchResponse{}, err
+		}
+		towns = []string{query.Town}
+	} else {
+		// Adding fix for First letter to be upper and rest lower
+		world = TibiaDataStringWorldFormatToTitle(world)
+
+		exists, err := validation.WorldExists(world)
+		if err != nil {
+			return HousesSearchResponse{}, err
+		}
+		if !exists {
+			return HousesSearchResponse{}, validation.ErrorWorldDoesNotExist
+		}
+
+		towns, err = validation.GetTowns()
+		if err != nil {
+			return HousesSearchResponse{}, err
+		}
+	}
+
+	housesSearch := HousesSearch{
+		World:        world,
+		Town:         query.Town,
+		Type:         query.Type,
+		Sort:         query.Sort,
+		Order:        query.Order,
+		Houses:       []HousesSearchHouse{},
+		FailedTowns:  []string{},
+		FailedHouses: []int{},
+	}
+	var (
+		tibiaURLs  []string
+		candidates []tibiaHousesSearchCandidate
+		firstErr   error
+	)
+	for _, town := range tibiaHousesTowns(world, towns, htmlDataCollector) {
+		if town.Err != nil {
+			housesSearch.FailedTowns = append(housesSearch.FailedTowns, town.Town)
+			firstErr = cmp.Or(firstErr, town.Err)
+			continue
+		}
+		tibiaURLs = append(tibiaURLs, town.TibiaURLs...)
+
+		for _, list := range []struct {
+			houseType string
+			houses    []HousesHouse
+		}{
+			{"house", town.Houses},
+			{"guildhall", town.Guildhalls},
+		} {
+			for _, house := range list.houses {
+				// the type of the house mapping is preferred over the list the house is on
+				houseType := list.houseType
+				if rawHouse, err := validation.GetHouseRaw(house.HouseID); err == nil && rawHouse.Type != "" {
+					houseType = rawHouse.Type
+				}
+				if (query.Type == "" || query.Type == houseType) && query.Filter.Match(house) {
+					candidates = append(candidates, tibiaHousesSearchCandidate{House: house, Town: town.Town, Type: houseType})
+				}
+			}
+		}
+	}
+	if len(towns) > 0 && len(housesSearch.FailedTowns) == len(towns) {
+		return HousesSearchResponse{}, firstErr
+	}
+
+	// the beds are served from tibiaHousesSearchCache, only the house pages of houses that are not cached are fetched
+	details := make([]House, len(candidates))
+	fresh := make([]bool, len(candidates))
+	failed := make([]bool, len(candidates))
+	var fetches []int
+	for i, candidate := range candidates {
+		if house, ok := tibiaHousesSearchCache.Get(world + "/" + strconv.Itoa(candidate.House.HouseID)); ok {
+			details[i] = house
+			continue
+		}
+		if len(fetches) == TibiaDataHousesSearchMaxFetches {
+			failed[i] = true
+			continue
+		}
+		fetches = append(fetches, i)
+	}
+
+	urls := make([]string, len(fetches))
+	errs := make([]error, len(fetches))
+	TibiaDataParallel(len(fetches), TibiaDataFanOutConcurrency, func(i int) {
+		details[fetches[i]], urls[i], errs[i] = tibiaHousesSearchDetails(world, candidates[fetches[i]].House, htmlDataCollector)
+	})
+	var fetchErr error
+	for i, url := range urls {
+		if url != "" {
+			tibiaURLs = append(tibiaURLs, url)
+		}
+		if errs[i] != nil {
+			failed[fetches[i]] = true
+			fetchErr = cmp.Or(fetchErr, errs[i])
+		} else {
+			fresh[fetches[i]] = true
+		}
+	}
+
+	// the auctions whose house page was neither fetched nor cached are fetched for the houses that are returned
+	missing := map[int]HousesHouse{}
+	for i, candidate := range candidates {
+		if failed[i] {
+			housesSearch.FailedHouses = append(housesSearch.FailedHouses, candidate.House.HouseID)
+			continue
+		}
+
+		house := details[i]
+		if house.Beds < query.MinBeds || (query.MaxBeds > 0 && house.Beds > query.MaxBeds) {
+			continue
+		}
+
+		auction, ok := tibiaHousesSearchAuction(world, candidate.House)
+		if fresh[i] {
+			auction = tibiaHousesSearchListAuction(house.Status.Auction, candidate.House)
+		} else if !ok {
+			missing[candidate.House.HouseID] = candidate.House
+		}
+		housesSearch.Houses = append(housesSearch.Houses, HousesSearchHouse{
+			HouseID:     candidate.House.HouseID,
+			Name:        candidate.House.Name,
+			Town:        candidate.Town,
+			Type:        candidate.Type,
+			Beds:        house.Beds,
+			Size:        candidate.House.Size,
+			Rent:        candidate.House.Rent,
+			IsRented:    candidate.House.IsRented,
+			IsAuctioned: candidate.House.IsAuctioned,
+			Auction:     auction,
+		})
+	}
+	if fetchErr != nil && len(housesSearch.FailedHouses) == len(candidates) {
+		return HousesSearchResponse{}, fetchErr
+	}
+	slices.Sort(housesSearch.FailedHouses)
+
+	// the current bid is on the house lists, only the auction end needs the house pages of all houses
+	fetchAuctions := func(houses []HousesSearchHouse) {
+		var indexes []int
+		for i, house := range houses {
+			if _, ok := missing[house.HouseID]; ok && len(fetches)+len(indexes) < TibiaDataHousesSearchMaxFetches {
+				indexes = append(indexes, i)
+			}
+		}
+		urls := make([]string, len(indexes))
+		TibiaDataParallel(len(indexes), TibiaDataFanOutConcurrency, func(i int) {
+			listHouse := missing[houses[indexes[i]].HouseID]
+			house, url, err := tibiaHousesSearchDetails(world, listHouse, htmlDataCollector)
+			if err == nil {
+				houses[indexes[i]].Auction = tibiaHousesSearchListAuction(house.Status.Auction, listHouse)
+			}
+			urls[i] = url
+		})
+		for _, url := range urls {
+			if url != "" {
+				tibiaURLs = append(tibiaURLs, url)
+			}
+		}
+	}
+	if query.Sort == "auction_end" {
+		fetchAuctions(housesSearch.Houses)
+	}
+	tibiaHousesSearchSort(housesSearch.Houses, query.Sort, query.Order)
+	housesSearch.Houses, housesSearch.Page = tibiaHousesSearchPage(housesSearch.Houses, query.Page, query.PageSize)
+	if query.Sort != "auction_end" {
+		fetchAuctions(housesSearch.Houses)
+	}
+
+	//
+	// Build the data-blob
+	return HousesSearchResponse{
+		housesSearch,
+		Information{
+			APIDetails: TibiaDataAPIDetails,
+			Timestamp:  TibiaDataDatetime(""),
+			TibiaURLs:  tibiaURLs,
+			Status: Status{
+				HTTPCode: http.StatusOK,
+			},
+		},
+	}, nil
+}
+
+// tibiaHousesSearchAuction returns the auction of a house of the house lists without fetching its house page
+// The current bid is taken from the house list, the bidder and auction end from the house page cached in
+// tibiaHousesSearchAuctionCache. It is false if the house has a bid whose house page is not cached.
+func tibiaHousesSearchAuction(world string, house HousesHouse) (HouseAuction, bool) {
+	auction := HouseAuction{CurrentBid: house.Auction.AuctionBid, AuctionOngoing: house.Auction.AuctionLeft != ""}
+	if house.Auction.AuctionBid == 0 && house.Auction.AuctionLeft == "" && !house.Auction.IsFinished {
+		return auction, true
+	}
+
+	cached, ok := tibiaHousesSearchAuctionCache.Get(tibiaHousesSearchAuctionKey(world, house))
+	if !ok {
+		return auction, false
+	}
+	return tibiaHousesSearchListAuction(cached, house), true
+}
+
+// tibiaHousesSearchListAuction returns the auction of a house page with the current bid of the house list
+func tibiaHousesSearchListAuction(auction HouseAuction, house HousesHouse) HouseAuction {
+	auction.CurrentBid = house.Auction.AuctionBid
+	return auction
+}
+
+// tibiaHousesSearchAuctionKey returns the key of a house in tibiaHousesSearchAuctionCache, a new bid changes the key
+func tibiaHousesSearchAuctionKey(world string, house HousesHouse) string {
+	return world + "/" + strconv.Itoa(house.HouseID) + "/" + strconv.Itoa(house.Auction.AuctionBid) + "/" + strconv.FormatBool(house.Auction.IsFinished)
+}
+
+// tibiaHousesSearchDetails fetches the house page of a house of the house lists and returns it and its tibia url
+// The house page is kept in tibiaHousesSearchCache without its status and its auction in tibiaHousesSearchAuctionCache.
+func tibiaHousesSearchDetails(world string, house HousesHouse, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (House, string, error) {
+	// the house ids of the house lists are not checked against the house mapping
+	request := tibiaHousesHouseRequest(world, house.HouseID)
+	BoxContentHTML, err := htmlDataCollector(request)
+	if err != nil {
+		return House{}, "", err
+	}
+
+	houseResponse, err := TibiaHousesHouseImpl(house.HouseID, BoxContentHTML, request.URL)
+	if err != nil {
+		return House{}, request.URL, err
+	}
+	cached := houseResponse.House
+	cached.Status = HouseStatus{}
+	tibiaHousesSearchCache.Set(world+"/"+strconv.Itoa(house.HouseID), cached)
+	tibiaHousesSearchAuctionCache.Set(tibiaHousesSearchAuctionKey(world, house), houseResponse.House.Status.Auction)
+
+	return houseResponse.House, request.URL, nil
+}
+
+// tibiaHousesSearchSort sorts the houses by sort in order, ties by name and id
+// Houses without an auction end are sorted last in both orders.
+func tibiaHousesSearchSort(houses []HousesSearchHouse, sort, order string) {
+	value := func(h HousesSearchHouse) int {
+		switch sort {
+		case "size":
+			return h.Size
+		case "beds":
+			return h.Beds
+		case "current_bid":
+			return h.Auction.CurrentBid
+		}
+		return h.Rent
+	}
+
+	slices.SortStableFunc(houses, func(a, b HousesSearchHouse) int {
+		var c int
+		switch sort {
+		case "name":
+			c = cmp.Compare(a.Name, b.Name)
+		case "auction_end":
+			switch {
+			case a.Auction.AuctionEnd == b.Auction.AuctionEnd:
+			case a.Auction.AuctionEnd == "":
+				return 1
+			case b.Auction.AuctionEnd == "":
+				return -1
+			default:
+				c = strings.Compare(a.Auction.AuctionEnd, b.Auction.AuctionEnd)
+			}
+		default:
+			c = cmp.Compare(value(a), value(b))
+		}
+		if order == "desc" {
+			c = -c
+		}
+		return cmp.Or(c, cmp.Compare(a.Name, b.Name), cmp.Compare(a.HouseID, b.HouseID))
+	})
+}
+
+// tibiaHousesSearchPage returns the houses of a page and the pagination, never nil
+func tibiaHousesSearchPage(houses []HousesSearchHouse, page, pageSize int) ([]HousesSearchHouse, HousesSearchPage) {
+	pagination := HousesSearchPage{
+		CurrentPage:  page,
+		TotalPages:   (len(houses) + pageSize - 1) / pageSize,
+		TotalResults: len(houses),
+	}
+
+	start := min((page-1)*pageSize, len(houses))
+	end := min(start+pageSize, len(houses))
+
+	return append([]HousesSearchHouse{}, houses[start:end]...), pagination
+}
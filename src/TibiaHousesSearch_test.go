@@ -0,0 +1,233 @@
+package main
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+func TestHousesSearch(t *testing.T) {
+	assert := assert.New(t)
+
+	defer func(cache *tibiaDataCache[House]) { tibiaHousesSearchCache = cache }(tibiaHousesSearchCache)
+	tibiaHousesSearchCache = newTibiaDataCache[House](time.Hour)
+	defer func(cache *tibiaDataCache[HouseAuction]) { tibiaHousesSearchAuctionCache = cache }(tibiaHousesSearchAuctionCache)
+	tibiaHousesSearchAuctionCache = newTibiaDataCache[HouseAuction](time.Hour)
+
+	// only the house lists of Edron and the house pages of Cormaya 9c, 10 and 11 can be fetched
+	files := map[string]func(TibiaDataRequestStruct) (string, error){
+		"&town=Edron&type=houses":     testFileCollector(t, "testdata/houses/overview/PremiaEdronHouses.html", nil),
+		"&town=Edron&type=guildhalls": testFileCollector(t, "testdata/houses/overview/PremiaEdronGuilds.html", nil),
+		"&houseid=54023":              testFileCollector(t, "testdata/houses/Premia/Edron/Cormaya9c.html", nil),
+		"&houseid=54025":              testFileCollector(t, "testdata/houses/Premia/Edron/Cormaya10.html", nil),
+		"&houseid=54026":              testFileCollector(t, "testdata/houses/Premia/Edron/Cormaya11.html", nil),
+	}
+	var (
+		mu       sync.Mutex
+		requests int
+	)
+	collector := func(request TibiaDataRequestStruct) (string, error) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+
+		for suffix, file := range files {
+			if strings.HasSuffix(request.URL, suffix) {
+				return file(request)
+			}
+		}
+		return "", validation.ErrStatusForbidden
+	}
+
+	query := HousesSearchQuery{
+		Town:    "edron",
+		Type:    "house",
+		Filter:  HousesFilter{MinSize: 25, MaxSize: 43, MinRent: 80000, Auctioned: true},
+		MinBeds: 2,
+		Sort:    "current_bid",
+		Order:   "desc",
+	}
+	searchJson, err := TibiaHousesSearchImpl("premia", query, collector)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	search := searchJson.HousesSearch
+	assert.Equal("Premia", search.World)
+	assert.Equal("Edron", search.Town)
+	assert.Equal(HousesSearchPage{CurrentPage: 1, TotalPages: 1, TotalResults: 2}, search.Page)
+	assert.Equal([]HousesSearchHouse{
+		{
+			HouseID: 54026, Name: "Cormaya 11", Town: "Edron", Type: "house", Beds: 2, Size: 43, Rent: 150000, IsAuctioned: true,
+			Auction: HouseAuction{CurrentBid: 200000, CurrentBidder: "Ciuchy Szajba", AuctionOngoing: true, AuctionEnd: "2022-01-21T09:00:00Z"},
+		},
+		{
+			HouseID: 54023, Name: "Cormaya 9c", Town: "Edron", Type: "house", Beds: 2, Size: 25, Rent: 80000, IsAuctioned: true,
+			Auction: HouseAuction{CurrentBid: 12345, CurrentBidder: "Ciuchy Szajba", AuctionEnd: "2022-01-21T09:00:00Z"},
+		},
+	}, search.Houses)
+	assert.Empty(search.FailedTowns)
+	assert.Equal([]int{50315, 50325, 50702, 52005, 52007, 54021}, search.FailedHouses)
+	assert.Equal(2+8, requests)
+	assert.Contains(searchJson.Information.TibiaURLs, "https://www.tibia.com/community/?subtopic=houses&page=view&world=Premia&houseid=54026")
+
+	// the beds and auctions of the house pages are cached, only the failed house pages are fetched again
+	requests = 0
+	query.MinBeds, query.Page, query.PageSize = 0, 2, 1
+	searchJson, err = TibiaHousesSearchImpl("Premia", query, collector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(2+6, requests)
+	assert.Equal(HousesSearchPage{CurrentPage: 2, TotalPages: 2, TotalResults: 2}, searchJson.HousesSearch.Page)
+	if assert.Len(searchJson.HousesSearch.Houses, 1) {
+		assert.Equal("Cormaya 9c", searchJson.HousesSearch.Houses[0].Name)
+		assert.Equal("Ciuchy Szajba", searchJson.HousesSearch.Houses[0].Auction.CurrentBidder)
+	}
+
+	// without cached auctions only the house pages of the auctions of the page are fetched, unless sorted by auction_end
+	for _, search := range []struct {
+		sort string
+		want int
+	}{
+		{"current_bid", 2 + 6 + 1},
+		{"auction_end", 2 + 6 + 2},
+	} {
+		tibiaHousesSearchAuctionCache = newTibiaDataCache[HouseAuction](time.Hour)
+		requests = 0
+		query.Sort, query.Page = search.sort, 1
+		searchJson, err = TibiaHousesSearchImpl("Premia", query, collector)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(search.want, requests, search.sort)
+		if assert.Len(searchJson.HousesSearch.Houses, 1) {
+			assert.Equal("Ciuchy Szajba", searchJson.HousesSearch.Houses[0].Auction.CurrentBidder)
+			assert.Equal("2022-01-21T09:00:00Z", searchJson.HousesSearch.Houses[0].Auction.AuctionEnd)
+		}
+	}
+	query.Sort = "current_bid"
+
+	// both houses have 2 beds
+	query.MinBeds, query.Page = 3, 0
+	searchJson, err = TibiaHousesSearchImpl("Premia", query, collector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(searchJson.HousesSearch.Houses)
+	assert.Equal(HousesSearchPage{CurrentPage: 1}, searchJson.HousesSearch.Page)
+
+	// the house page of the rented Cormaya 10 is cached without its status, 50402 failed and is fetched again
+	for _, want := range []int{2 + 2, 2 + 1} {
+		requests = 0
+		searchJson, err = TibiaHousesSearchImpl("Premia", HousesSearchQuery{Town: "Edron", Filter: HousesFilter{MinSize: 80, MaxSize: 80}}, collector)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(want, requests)
+		assert.Equal([]int{50402}, searchJson.HousesSearch.FailedHouses)
+		assert.Equal([]HousesSearchHouse{{HouseID: 54025, Name: "Cormaya 10", Town: "Edron", Type: "house", Beds: 3, Size: 80, Rent: 300000, IsRented: true}}, searchJson.HousesSearch.Houses)
+	}
+
+	// the houses above the maximum number of fetched house pages are listed as failed
+	defer func(fetches int) { TibiaDataHousesSearchMaxFetches = fetches }(TibiaDataHousesSearchMaxFetches)
+	TibiaDataHousesSearchMaxFetches = 1
+	requests = 0
+	query.MinBeds = 0
+	searchJson, err = TibiaHousesSearchImpl("Premia", query, collector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(2+1, requests)
+	if assert.Len(searchJson.HousesSearch.Houses, 1) {
+		assert.Equal("Cormaya 11", searchJson.HousesSearch.Houses[0].Name)
+	}
+	assert.Equal([]int{50315, 50325, 50702, 52005, 52007, 54021}, searchJson.HousesSearch.FailedHouses)
+	TibiaDataHousesSearchMaxFetches = 100
+
+	// a search whose house pages all fail fails with the error of the first house
+	_, err = TibiaHousesSearchImpl("Premia", HousesSearchQuery{Town: "Edron", Filter: HousesFilter{MaxRent: 25000}}, collector)
+	assert.Equal(validation.ErrStatusForbidden, err)
+
+	for _, invalid := range []struct {
+		query HousesSearchQuery
+		err   error
+	}{
+		{HousesSearchQuery{Type: "castle"}, validation.ErrorHouseFilterInvalid},
+		{HousesSearchQuery{MinBeds: 4, MaxBeds: 2}, validation.ErrorHouseFilterInvalid},
+		{HousesSearchQuery{Filter: HousesFilter{MinRent: -1}}, validation.ErrorHouseFilterInvalid},
+		{HousesSearchQuery{Sort: "price"}, validation.ErrorHouseSortInvalid},
+		{HousesSearchQuery{Order: "up"}, validation.ErrorHouseSortInvalid},
+		{HousesSearchQuery{PageSize: HousesSearchMaxPageSize + 1}, validation.ErrorHousePageInvalid},
+		{HousesSearchQuery{Town: "Nowhere"}, validation.ErrorTownDoesNotExist},
+	} {
+		_, err := TibiaHousesSearchImpl("Premia", invalid.query, collector)
+		assert.Equal(invalid.err, err, invalid.query)
+	}
+
+	_, err = TibiaHousesSearchImpl("Nowhere", HousesSearchQuery{}, collector)
+	assert.Equal(validation.ErrorWorldDoesNotExist, err)
+}
+
+func TestHousesSearchParams(t *testing.T) {
+	assert := assert.New(t)
+
+	params := func(values map[string]string) func(string) string {
+		return func(key string) string { return values[key] }
+	}
+
+	query, err := tibiaHousesSearchParams(params(map[string]string{"town": "Thais", "min_beds": "3", "max_rent": "100000", "auctioned": "true", "sort": "auction_end", "page": "2"}))
+	if assert.NoError(err) {
+		assert.Equal(HousesSearchQuery{Town: "Thais", Filter: HousesFilter{MaxRent: 100000, Auctioned: true}, MinBeds: 3, Sort: "auction_end", Page: 2}, query)
+	}
+
+	for _, invalid := range []struct {
+		values map[string]string
+		err    error
+	}{
+		{map[string]string{"min_size": "big"}, validation.ErrorHouseFilterInvalid},
+		{map[string]string{"max_beds": "three"}, validation.ErrorHouseFilterInvalid},
+		{map[string]string{"min_beds": "-1"}, validation.ErrorHouseFilterInvalid},
+		{map[string]string{"page": "0"}, validation.ErrorHousePageInvalid},
+		{map[string]string{"page_size": "all"}, validation.ErrorHousePageInvalid},
+	} {
+		_, err := tibiaHousesSearchParams(params(invalid.values))
+		assert.Equal(invalid.err, err, invalid.values)
+	}
+}
+
+func TestHousesSearchSort(t *testing.T) {
+	assert := assert.New(t)
+
+	houses := []HousesSearchHouse{
+		{HouseID: 3, Name: "Rented", Rent: 50000},
+		{HouseID: 2, Name: "Later", Rent: 80000, Auction: HouseAuction{AuctionEnd: "2025-01-03T09:00:00Z"}},
+		{HouseID: 1, Name: "Sooner", Rent: 50000, Auction: HouseAuction{AuctionEnd: "2025-01-02T09:00:00Z"}},
+	}
+	names := func() []string {
+		var names []string
+		for _, house := range houses {
+			names = append(names, house.Name)
+		}
+		return names
+	}
+
+	// houses without an auction end are last in both orders
+	tibiaHousesSearchSort(houses, "auction_end", "asc")
+	assert.Equal([]string{"Sooner", "Later", "Rented"}, names())
+	tibiaHousesSearchSort(houses, "auction_end", "desc")
+	assert.Equal([]string{"Later", "Sooner", "Rented"}, names())
+
+	// ties are sorted by name
+	tibiaHousesSearchSort(houses, "rent", "desc")
+	assert.Equal([]string{"Later", "Rented", "Sooner"}, names())
+
+	page, pagination := tibiaHousesSearchPage(houses, 3, 2)
+	assert.Empty(page)
+	assert.NotNil(page)
+	assert.Equal(HousesSearchPage{CurrentPage: 3, TotalPages: 2, TotalResults: 3}, pagination)
+}
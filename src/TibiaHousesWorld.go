@@ -0,0 +1,235 @@
+package main
+
+import (
+	"cmp"
+	"net/http"
+	"strconv"
+
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+// HousesFilter filters houses and guildhalls by their size, rent and auction, zero values do not filter
+type HousesFilter struct {
+	MinSize   int  // The minimum size in SQM.
+	MaxSize   int  // The maximum size in SQM.
+	MinRent   int  // The minimum monthly rent in gold coins.
+	MaxRent   int  // The maximum monthly rent in gold coins.
+	Auctioned bool // Whether to only include auctioned houses.
+}
+
+// Child of HousesWorld
+type HousesWorldTown struct {
+	Town          string        `json:"town"`           // The name of the town.
+	HouseList     []HousesHouse `json:"house_list"`     // List of the houses of the town.
+	GuildhallList []HousesHouse `json:"guildhall_list"` // List of the guildhalls of the town.
+}
+
+// Child of HousesWorld
+type HousesWorldCounts struct {
+	Total     int `json:"total"`     // The number of houses and guildhalls.
+	Rented    int `json:"rented"`    // The number of rented houses and guildhalls.
+	Auctioned int `json:"auctioned"` // The number of auctioned houses and guildhalls.
+	Free      int `json:"free"`      // The number of houses and guildhalls that are neither rented nor auctioned.
+}
+
+// Child of JSONData
+type HousesWorld struct {
+	World       string            `json:"world"`        // The name of the world.
+	Towns       []HousesWorldTown `json:"towns"`        // The houses and guildhalls grouped by town.
+	Counts      HousesWorldCounts `json:"counts"`       // The counts of the listed houses and guildhalls of all towns.
+	FailedTowns []string          `json:"failed_towns"` // The names of the towns that could not be fetched.
+}
+
+// The base includes two levels: HousesWorld and Information
+type HousesWorldResponse struct {
+	HousesWorld HousesWorld `json:"houses_world"`
+	Information Information `json:"information"`
+}
+
+// ListEntries returns the towns of the world
+func (r HousesWorldResponse) ListEntries() interface{} {
+	return r.HousesWorld.Towns
+}
+
+// tibiaHousesFilterParams returns the houses filter of the min_size, max_size, min_rent, max_rent and auctioned parameters
+func tibiaHousesFilterParams(minSize, maxSize, minRent, maxRent, auctioned string) (HousesFilter, error) {
+	var filter HousesFilter
+
+	for _, param := range []struct {
+		value string
+		field *int
+	}{
+		{minSize, &filter.MinSize},
+		{maxSize, &filter.MaxSize},
+		{minRent, &filter.MinRent},
+		{maxRent, &filter.MaxRent},
+	} {
+		if param.value == "" {
+			continue
+		}
+		value, err := strconv.Atoi(param.value)
+		if err != nil {
+			return HousesFilter{}, validation.ErrorHouseFilterInvalid
+		}
+		*param.field = value
+	}
+
+	if auctioned != "" {
+		value, err := strconv.ParseBool(auctioned)
+		if err != nil {
+			return HousesFilter{}, validation.ErrorHouseFilterInvalid
+		}
+		filter.Auctioned = value
+	}
+
+	return filter, filter.validate()
+}
+
+// validate returns an error if a value of the filter is negative or a minimum is above its maximum
+func (f HousesFilter) validate() error {
+	if f.MinSize < 0 || f.MaxSize < 0 || f.MinRent < 0 || f.MaxRent < 0 {
+		return validation.ErrorHouseFilterInvalid
+	}
+	if (f.MaxSize > 0 && f.MinSize > f.MaxSize) || (f.MaxRent > 0 && f.MinRent > f.MaxRent) {
+		return validation.ErrorHouseFilterInvalid
+	}
+	return nil
+}
+
+// Match returns whether a house passes the filter
+func (f HousesFilter) Match(house HousesHouse) bool {
+	switch {
+	case house.Size < f.MinSize, f.MaxSize > 0 && house.Size > f.MaxSize:
+		return false
+	case house.Rent < f.MinRent, f.MaxRent > 0 && house.Rent > f.MaxRent:
+		return false
+	case f.Auctioned && !house.IsAuctioned:
+		return false
+	}
+	return true
+}
+
+// tibiaHousesFilter returns the houses that pass the filter, never nil
+func tibiaHousesFilter(houses []HousesHouse, filter HousesFilter) []HousesHouse {
+	filtered := []HousesHouse{}
+	for _, house := range houses {
+		if filter.Match(house) {
+			filtered = append(filtered, house)
+		}
+	}
+	return filtered
+}
+
+// TibiaHousesWorldImpl func - returns the houses and guildhalls of all towns of a world
+// The house and guildhall lists of the towns are fetched concurrently with the fan-out concurrency. Towns that
+// could not be fetched are listed in failed_towns, the world fails only if no town could be fetched.
+func TibiaHousesWorldImpl(world string, filter HousesFilter, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (HousesWorldResponse, error) {
+	// Adding fix for First letter to be upper and rest lower
+	world = TibiaDataStringWorldFormatToTitle(world)
+
+	if err := filter.validate(); err != nil {
+		return HousesWorldResponse{}, err
+	}
+
+	exists, err := validation.WorldExists(world)
+	if err != nil {
+		return HousesWorldResponse{}, err
+	}
+	if !exists {
+		return HousesWorldResponse{}, validation.ErrorWorldDoesNotExist
+	}
+
+	towns, err := validation.GetTowns()
+	if err != nil {
+		return HousesWorldResponse{}, err
+	}
+
+	housesWorld := HousesWorld{
+		World:       world,
+		Towns:       []HousesWorldTown{},
+		FailedTowns: []string{},
+	}
+	var (
+		tibiaURLs []string
+		firstErr  error
+	)
+	for _, town := range tibiaHousesTowns(world, towns, htmlDataCollector) {
+		if town.Err != nil {
+			housesWorld.FailedTowns = append(housesWorld.FailedTowns, town.Town)
+			if firstErr == nil {
+				firstErr = town.Err
+			}
+			continue
+		}
+		tibiaURLs = append(tibiaURLs, town.TibiaURLs...)
+
+		worldTown := HousesWorldTown{
+			Town:          town.Town,
+			HouseList:     tibiaHousesFilter(town.Houses, filter),
+			GuildhallList: tibiaHousesFilter(town.Guildhalls, filter),
+		}
+		for _, house := range append(append([]HousesHouse{}, worldTown.HouseList...), worldTown.GuildhallList...) {
+			housesWorld.Counts.Total++
+			switch {
+			case house.IsRented:
+				housesWorld.Counts.Rented++
+			case house.IsAuctioned:
+				housesWorld.Counts.Auctioned++
+			default:
+				housesWorld.Counts.Free++
+			}
+		}
+		housesWorld.Towns = append(housesWorld.Towns, worldTown)
+	}
+
+	if len(towns) > 0 && len(housesWorld.Towns) == 0 {
+		return HousesWorldResponse{}, firstErr
+	}
+
+	//
+	// Build the data-blob
+	return HousesWorldResponse{
+		housesWorld,
+		Information{
+			APIDetails: TibiaDataAPIDetails,
+			Timestamp:  TibiaDataDatetime(""),
+			TibiaURLs:  tibiaURLs,
+			Status: Status{
+				HTTPCode: http.StatusOK,
+			},
+		},
+	}, nil
+}
+
+// tibiaHousesTown are the house and guildhall lists of a town or the error of fetching them
+type tibiaHousesTown struct {
+	Town       string
+	Houses     []HousesHouse
+	Guildhalls []HousesHouse
+	TibiaURLs  []string
+	Err        error
+}
+
+// tibiaHousesTowns fetches the house and guildhall lists of towns of a world concurrently with the fan-out concurrency
+func tibiaHousesTowns(world string, towns []string, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) []tibiaHousesTown {
+	// every town has a house and a guildhall list
+	houseTypes := []string{"houses", "guildhalls"}
+	lists := make([][]HousesHouse, len(towns)*len(houseTypes))
+	urls := make([]string, len(lists))
+	errs := make([]error, len(lists))
+	TibiaDataParallel(len(lists), TibiaDataFanOutConcurrency, func(i int) {
+		lists[i], urls[i], errs[i] = makeHouseRequest(houseTypes[i%len(houseTypes)], world, towns[i/len(houseTypes)], htmlDataCollector)
+	})
+
+	result := make([]tibiaHousesTown, len(towns))
+	for i, town := range towns {
+		houses, guildhalls := i*len(houseTypes), i*len(houseTypes)+1
+		result[i] = tibiaHousesTown{Town: town, Err: cmp.Or(errs[houses], errs[guildhalls])}
+		if result[i].Err == nil {
+			result[i].Houses, result[i].Guildhalls = lists[houses], lists[guildhalls]
+			result[i].TibiaURLs = []string{urls[houses], urls[guildhalls]}
+		}
+	}
+
+	return result
+}
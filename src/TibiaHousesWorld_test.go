@@ -0,0 +1,100 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+func TestHousesWorld(t *testing.T) {
+	assert := assert.New(t)
+
+	// only the houses and guildhalls of Edron can be fetched
+	houses := testFileCollector(t, "testdata/houses/overview/PremiaEdronHouses.html", nil)
+	guildhalls := testFileCollector(t, "testdata/houses/overview/PremiaEdronGuilds.html", nil)
+	collector := func(request TibiaDataRequestStruct) (string, error) {
+		switch {
+		case strings.HasSuffix(request.URL, "&town=Edron&type=houses"):
+			return houses(request)
+		case strings.HasSuffix(request.URL, "&town=Edron&type=guildhalls"):
+			return guildhalls(request)
+		}
+		return "", validation.ErrStatusForbidden
+	}
+
+	towns, err := validation.GetTowns()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	housesJson, err := TibiaHousesWorldImpl("premia", HousesFilter{}, collector)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	world := housesJson.HousesWorld
+	assert.Equal("Premia", world.World)
+	assert.Len(world.FailedTowns, len(towns)-1)
+	assert.NotContains(world.FailedTowns, "Edron")
+	if assert.Len(world.Towns, 1) {
+		assert.Equal("Edron", world.Towns[0].Town)
+		assert.Equal(HousesWorldCounts{Total: 135, Rented: 89, Auctioned: 46, Free: 0}, world.Counts)
+		assert.Len(world.Towns[0].GuildhallList, 6)
+	}
+	assert.Equal([]string{
+		"https://www.tibia.com/community/?subtopic=houses&world=Premia&town=Edron&type=houses",
+		"https://www.tibia.com/community/?subtopic=houses&world=Premia&town=Edron&type=guildhalls",
+	}, housesJson.Information.TibiaURLs)
+
+	// the counts are of the filtered houses
+	housesJson, err = TibiaHousesWorldImpl("Premia", HousesFilter{MinSize: 20, MaxRent: 80000, Auctioned: true}, collector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(housesJson.HousesWorld.Towns[0].HouseList, 8)
+	assert.Empty(housesJson.HousesWorld.Towns[0].GuildhallList)
+	for _, house := range housesJson.HousesWorld.Towns[0].HouseList {
+		assert.True(house.IsAuctioned)
+		assert.GreaterOrEqual(house.Size, 20)
+		assert.LessOrEqual(house.Rent, 80000)
+	}
+	assert.Equal(HousesWorldCounts{Total: 8, Auctioned: 8}, housesJson.HousesWorld.Counts)
+
+	_, err = TibiaHousesWorldImpl("Antica", HousesFilter{MinSize: 200, MaxSize: 100}, collector)
+	assert.Equal(validation.ErrorHouseFilterInvalid, err)
+
+	_, err = TibiaHousesWorldImpl("Nowhere", HousesFilter{}, collector)
+	assert.Equal(validation.ErrorWorldDoesNotExist, err)
+
+	// a world without any town fails with the error of the first town
+	_, err = TibiaHousesWorldImpl("Antica", HousesFilter{}, func(request TibiaDataRequestStruct) (string, error) {
+		return "", validation.ErrStatusForbidden
+	})
+	assert.Equal(validation.ErrStatusForbidden, err)
+}
+
+func TestHousesFilterParams(t *testing.T) {
+	assert := assert.New(t)
+
+	filter, err := tibiaHousesFilterParams("50", "", "", "100000", "true")
+	if assert.NoError(err) {
+		assert.Equal(HousesFilter{MinSize: 50, MaxRent: 100000, Auctioned: true}, filter)
+	}
+
+	assert.True(filter.Match(HousesHouse{Size: 50, Rent: 100000, IsAuctioned: true}))
+	assert.False(filter.Match(HousesHouse{Size: 49, Rent: 100000, IsAuctioned: true}))
+	assert.False(filter.Match(HousesHouse{Size: 50, Rent: 100001, IsAuctioned: true}))
+	assert.False(filter.Match(HousesHouse{Size: 50, Rent: 100000, IsRented: true}))
+
+	for _, params := range [][5]string{
+		{"fifty", "", "", "", ""},
+		{"-1", "", "", "", ""},
+		{"", "", "200", "100", ""},
+		{"", "", "", "", "maybe"},
+	} {
+		_, err := tibiaHousesFilterParams(params[0], params[1], params[2], params[3], params[4])
+		assert.Equal(validation.ErrorHouseFilterInvalid, err, params)
+	}
+}
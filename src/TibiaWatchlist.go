@@ -0,0 +1,495 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// TibiaDataWatchlistInterval is the time between two polls of the watched characters
+	TibiaDataWatchlistInterval = 15 * time.Minute
+
+	// TibiaDataWatchlistRetention is how long snapshots of watched characters are kept (deaths are kept forever)
+	TibiaDataWatchlistRetention = 90 * 24 * time.Hour
+
+	// TibiaDataWatchlistMaxSize is the maximum number of watched characters
+	TibiaDataWatchlistMaxSize = 500
+)
+
+var (
+	tibiaWatchlistBucket          = []byte("watchlist")
+	tibiaWatchlistSnapshotsBucket = []byte("watchlist_snapshots")
+	tibiaWatchlistDeathsBucket    = []byte("watchlist_deaths")
+)
+
+// tibiaWatchlistSnapshotMaxAge is the age of the latest snapshot after which an unchanged character is stored again
+// It keeps the latest snapshot from being pruned, as long as the retention is longer.
+const tibiaWatchlistSnapshotMaxAge = 24 * time.Hour
+
+// Child of WatchlistResponse
+type WatchlistCharacter struct {
+	Name       string  `json:"name"`                  // The name the character is watched with.
+	Added      string  `json:"added"`                 // The time the character was added to the watchlist.
+	LastPolled string  `json:"last_polled,omitempty"` // The time the character was last polled successfully.
+	Error      *Status `json:"error,omitempty"`       // The error of the last poll (if it failed).
+}
+
+// The base includes two levels: Watchlist and Information
+type WatchlistResponse struct {
+	Watchlist   []WatchlistCharacter `json:"watchlist"`
+	Information Information          `json:"information"`
+}
+
+// ListEntries returns the watched characters
+func (r WatchlistResponse) ListEntries() interface{} {
+	return r.Watchlist
+}
+
+// Child of WatchlistTimeline
+type WatchlistSnapshot struct {
+	Time          string         `json:"time"`                   // The time of the snapshot.
+	Name          string         `json:"name"`                   // The name of the character.
+	Level         int            `json:"level"`                  // The character's level.
+	Vocation      string         `json:"vocation"`               // The character's vocation.
+	World         string         `json:"world"`                  // The character's world.
+	Guild         CharacterGuild `json:"guild"`                  // The guild that the character is member of.
+	Residence     string         `json:"residence"`              // The character's residence.
+	FormerNames   []string       `json:"former_names,omitempty"` // List of former names of the character.
+	AccountStatus string         `json:"account_status"`         // Whether account is Free or Premium.
+}
+
+// Child of WatchlistTimeline
+type WatchlistLevel struct {
+	Time  string `json:"time"`  // The time the level was first seen.
+	Level int    `json:"level"` // The character's level.
+}
+
+// Child of JSONData
+type WatchlistTimeline struct {
+	Name         string              `json:"name"`          // The name the character is watched with.
+	From         string              `json:"from"`          // The start of the period.
+	To           string              `json:"to"`            // The end of the period.
+	LastPolled   string              `json:"last_polled"`   // The time the character was last polled successfully.
+	Snapshots    []WatchlistSnapshot `json:"snapshots"`     // The snapshots of the period, starting with the state at its start.
+	LevelHistory []WatchlistLevel    `json:"level_history"` // The levels of the period.
+}
+
+// The base includes two levels: WatchlistTimeline and Information
+type WatchlistTimelineResponse struct {
+	WatchlistTimeline WatchlistTimeline `json:"watchlist_timeline"`
+	Information       Information       `json:"information"`
+}
+
+// ListEntries returns the snapshots of the timeline
+func (r WatchlistTimelineResponse) ListEntries() interface{} {
+	return r.WatchlistTimeline.Snapshots
+}
+
+// Child of JSONData
+type WatchlistDeaths struct {
+	Name   string   `json:"name"`   // The name the character is watched with.
+	Deaths []Deaths `json:"deaths"` // All deaths seen since the character was added, the newest first.
+}
+
+// The base includes two levels: WatchlistDeaths and Information
+type WatchlistDeathsResponse struct {
+	WatchlistDeaths WatchlistDeaths `json:"watchlist_deaths"`
+	Information     Information     `json:"information"`
+}
+
+// tibiaWatchlistKey returns the key of a watched character, names are not case sensitive
+func tibiaWatchlistKey(name string) []byte {
+	return []byte(strings.ToLower(name))
+}
+
+// tibiaWatchlistResponse returns the response with all watched characters
+func tibiaWatchlistResponse(tx *bolt.Tx) (WatchlistResponse, error) {
+	// the keys are the lowercase names, so the characters are sorted by name
+	characters, err := tibiaDataStoreList[WatchlistCharacter](tx, tibiaWatchlistBucket)
+	if err != nil {
+		return WatchlistResponse{}, err
+	}
+
+	return WatchlistResponse{
+		Watchlist:   characters,
+		Information: tibiaDataLocalInformation(),
+	}, nil
+}
+
+// TibiaWatchlistImpl func - returns all watched characters
+func TibiaWatchlistImpl() (WatchlistResponse, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return WatchlistResponse{}, err
+	}
+
+	var response WatchlistResponse
+	err = db.View(func(tx *bolt.Tx) (err error) {
+		response, err = tibiaWatchlistResponse(tx)
+		return err
+	})
+
+	return response, err
+}
+
+// TibiaWatchlistAddImpl func - adds characters to the watchlist and returns all watched characters
+// Characters that are watched already are kept as they are.
+func TibiaWatchlistAddImpl(names []string, now time.Time) (WatchlistResponse, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return WatchlistResponse{}, err
+	}
+
+	if len(names) == 0 {
+		return WatchlistResponse{}, validation.ErrorBatchEmpty
+	}
+	for _, name := range names {
+		if err := validation.IsCharacterNameValid(name); err != nil {
+			return WatchlistResponse{}, err
+		}
+	}
+
+	entries := make([]tibiaDataStoreEntry, 0, len(names))
+	for _, name := range names {
+		entries = append(entries, tibiaDataStoreEntry{
+			Key:   tibiaWatchlistKey(name),
+			Value: WatchlistCharacter{Name: name, Added: now.UTC().Format(time.RFC3339)},
+		})
+	}
+
+	var response WatchlistResponse
+	err = db.Update(func(tx *bolt.Tx) error {
+		if err := tibiaDataStoreAdd(tx, tibiaWatchlistBucket, entries, TibiaDataWatchlistMaxSize, validation.ErrorWatchlistFull); err != nil {
+			return err
+		}
+
+		response, err = tibiaWatchlistResponse(tx)
+		return err
+	})
+
+	return response, err
+}
+
+// TibiaWatchlistRemoveImpl func - removes a character and its history from the watchlist and returns all watched characters
+func TibiaWatchlistRemoveImpl(name string) (WatchlistResponse, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return WatchlistResponse{}, err
+	}
+
+	var response WatchlistResponse
+	err = db.Update(func(tx *bolt.Tx) error {
+		key := tibiaWatchlistKey(name)
+		if err := tibiaDataStoreRemove(tx, tibiaWatchlistBucket, key, validation.ErrorCharacterNotWatched); err != nil {
+			return err
+		}
+		for _, history := range [][]byte{tibiaWatchlistSnapshotsBucket, tibiaWatchlistDeathsBucket} {
+			if root := tx.Bucket(history); root != nil && root.Bucket(key) != nil {
+				if err := root.DeleteBucket(key); err != nil {
+					return err
+				}
+			}
+		}
+
+		response, err = tibiaWatchlistResponse(tx)
+		return err
+	})
+
+	return response, err
+}
+
+// runWatchlist polls the watched characters every interval until stop is closed
+func runWatchlist(db *bolt.DB, interval time.Duration, htmlDataCollector func(TibiaDataRequestStruct) (string, error), stop <-chan struct{}) {
+	tibiaDataStoreRun("watchlist", interval, func(minAge time.Duration, now time.Time) error {
+		return tibiaWatchlistPoll(db, minAge, htmlDataCollector, now)
+	}, stop)
+}
+
+// tibiaWatchlistPoll fetches all watched characters that were not polled within minAge and stores their snapshots and deaths
+// The characters are fetched concurrently with the fan-out concurrency, so polls stay within the upstream limits.
+func tibiaWatchlistPoll(db *bolt.DB, minAge time.Duration, htmlDataCollector func(TibiaDataRequestStruct) (string, error), now time.Time) error {
+	var characters []WatchlistCharacter
+	err := db.View(func(tx *bolt.Tx) (err error) {
+		characters, err = tibiaDataStoreList[WatchlistCharacter](tx, tibiaWatchlistBucket)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var due []WatchlistCharacter
+	for _, character := range characters {
+		if tibiaDataStoreDue(character.LastPolled, minAge, now) {
+			due = append(due, character)
+		}
+	}
+
+	results := make([]CharactersCharacter, len(due))
+	TibiaDataParallel(len(due), TibiaDataFanOutConcurrency, func(i int) {
+		results[i], _ = tibiaCharactersBatchCharacter(due[i].Name, htmlDataCollector)
+	})
+
+	return db.Update(func(tx *bolt.Tx) error {
+		for _, result := range results {
+			if err := tibiaWatchlistRecord(tx, result, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// tibiaWatchlistRecord stores the result of polling one character
+// The snapshot is only stored if something changed or the latest snapshot is older than tibiaWatchlistSnapshotMaxAge.
+func tibiaWatchlistRecord(tx *bolt.Tx, result CharactersCharacter, now time.Time) error {
+	key := tibiaWatchlistKey(result.Name)
+	var character WatchlistCharacter
+	if ok, err := tibiaDataStoreGet(tx, tibiaWatchlistBucket, key, &character); !ok || err != nil {
+		return err
+	}
+
+	character.Error = result.Error
+	if result.Character != nil {
+		character.LastPolled = now.UTC().Format(time.RFC3339)
+
+		previous, current, err := tibiaWatchlistRecordSnapshot(tx, key, result.Character, now)
+		if err != nil {
+			return err
+		}
+		deaths, err := tibiaWatchlistRecordDeaths(tx, key, result.Character.Deaths)
+		if err != nil {
+			return err
+		}
+
+		// the first poll of a character only records its state
+		if previous != nil {
+			if err := tibiaWebhooksCharacterEvents(tx, character.Name, *previous, current, deaths, now); err != nil {
+				return err
+			}
+		}
+	}
+
+	return tibiaDataStorePut(tx, tibiaWatchlistBucket, key, character)
+}
+
+// tibiaWatchlistRecordSnapshot stores the snapshot of a polled character and returns the latest snapshot before it (if any)
+func tibiaWatchlistRecordSnapshot(tx *bolt.Tx, key []byte, character *Character, now time.Time) (*WatchlistSnapshot, WatchlistSnapshot, error) {
+	root, err := tx.CreateBucketIfNotExists(tibiaWatchlistSnapshotsBucket)
+	if err != nil {
+		return nil, WatchlistSnapshot{}, err
+	}
+	bucket, err := root.CreateBucketIfNotExists(key)
+	if err != nil {
+		return nil, WatchlistSnapshot{}, err
+	}
+
+	info := character.CharacterInfo
+	snapshot := WatchlistSnapshot{
+		Name:          info.Name,
+		Level:         info.Level,
+		Vocation:      info.Vocation,
+		World:         info.World,
+		Guild:         info.Guild,
+		Residence:     info.Residence,
+		FormerNames:   info.FormerNames,
+		AccountStatus: info.AccountStatus,
+	}
+
+	var latest *WatchlistSnapshot
+	if latestKey, latestValue := bucket.Cursor().Last(); latestKey != nil {
+		latest = &WatchlistSnapshot{}
+		if err := json.Unmarshal(latestValue, latest); err != nil {
+			return nil, snapshot, err
+		}
+
+		unchanged := *latest
+		unchanged.Time = ""
+		if reflect.DeepEqual(unchanged, snapshot) && now.Sub(tibiaDataStoreKeyTime(latestKey)) < tibiaWatchlistSnapshotMaxAge {
+			return latest, snapshot, nil
+		}
+	}
+
+	snapshot.Time = now.UTC().Format(time.RFC3339)
+	value, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, snapshot, err
+	}
+	if err := bucket.Put(tibiaDataStoreTimeKey(now), value); err != nil {
+		return nil, snapshot, err
+	}
+
+	return latest, snapshot, tibiaDataStorePrune(bucket, now, TibiaDataWatchlistRetention, 0)
+}
+
+// tibiaWatchlistRecordDeaths adds the deaths of a polled character that were not seen before and returns them
+// Deaths are keyed by their time and reason, so deaths of earlier polls are kept after tibia.com truncated them.
+func tibiaWatchlistRecordDeaths(tx *bolt.Tx, key []byte, deaths []Deaths) ([]Deaths, error) {
+	if len(deaths) == 0 {
+		return nil, nil
+	}
+
+	root, err := tx.CreateBucketIfNotExists(tibiaWatchlistDeathsBucket)
+	if err != nil {
+		return nil, err
+	}
+	bucket, err := root.CreateBucketIfNotExists(key)
+	if err != nil {
+		return nil, err
+	}
+
+	var added []Deaths
+
+	for _, death := range deaths {
+		deathTime, err := time.Parse(time.RFC3339, death.Time)
+		if err != nil {
+			continue
+		}
+
+		deathKey := append(tibiaDataStoreTimeKey(deathTime), death.Reason...)
+		if bucket.Get(deathKey) != nil {
+			continue
+		}
+
+		value, err := json.Marshal(death)
+		if err != nil {
+			return nil, err
+		}
+		if err := bucket.Put(deathKey, value); err != nil {
+			return nil, err
+		}
+		added = append(added, death)
+	}
+
+	return added, nil
+}
+
+// tibiaWatchlistWatched returns the watched character of a name or ErrorCharacterNotWatched
+func tibiaWatchlistWatched(tx *bolt.Tx, name string) (WatchlistCharacter, error) {
+	var character WatchlistCharacter
+
+	bucket := tx.Bucket(tibiaWatchlistBucket)
+	if bucket == nil || bucket.Get(tibiaWatchlistKey(name)) == nil {
+		return character, validation.ErrorCharacterNotWatched
+	}
+
+	err := json.Unmarshal(bucket.Get(tibiaWatchlistKey(name)), &character)
+	return character, err
+}
+
+// TibiaWatchlistTimelineImpl func - returns the snapshots and levels of a watched character in a period
+func TibiaWatchlistTimelineImpl(name, from, to, period string) (WatchlistTimelineResponse, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return WatchlistTimelineResponse{}, err
+	}
+
+	fromTime, toTime, err := tibiaDataStorePeriod(from, to, period, time.Now())
+	if err != nil {
+		return WatchlistTimelineResponse{}, err
+	}
+
+	timeline := WatchlistTimeline{
+		From:         fromTime.UTC().Format(time.RFC3339),
+		To:           toTime.UTC().Format(time.RFC3339),
+		Snapshots:    []WatchlistSnapshot{},
+		LevelHistory: []WatchlistLevel{},
+	}
+
+	err = db.View(func(tx *bolt.Tx) error {
+		character, err := tibiaWatchlistWatched(tx, name)
+		if err != nil {
+			return err
+		}
+		timeline.Name = character.Name
+		timeline.LastPolled = character.LastPolled
+
+		root := tx.Bucket(tibiaWatchlistSnapshotsBucket)
+		if root == nil || root.Bucket(tibiaWatchlistKey(name)) == nil {
+			return nil
+		}
+
+		// the period starts with the latest snapshot before it, as snapshots are only stored on changes
+		cursor := root.Bucket(tibiaWatchlistKey(name)).Cursor()
+		key, value := cursor.Seek(tibiaDataStoreTimeKey(fromTime))
+		switch {
+		case key == nil:
+			key, value = cursor.Last()
+		case tibiaDataStoreKeyTime(key).After(fromTime):
+			if previousKey, previousValue := cursor.Prev(); previousKey != nil {
+				key, value = previousKey, previousValue
+			} else {
+				key, value = cursor.First()
+			}
+		}
+
+		for ; key != nil && !tibiaDataStoreKeyTime(key).After(toTime); key, value = cursor.Next() {
+			var snapshot WatchlistSnapshot
+			if err := json.Unmarshal(value, &snapshot); err != nil {
+				return err
+			}
+			timeline.Snapshots = append(timeline.Snapshots, snapshot)
+
+			if len(timeline.LevelHistory) == 0 || timeline.LevelHistory[len(timeline.LevelHistory)-1].Level != snapshot.Level {
+				timeline.LevelHistory = append(timeline.LevelHistory, WatchlistLevel{Time: snapshot.Time, Level: snapshot.Level})
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return WatchlistTimelineResponse{}, err
+	}
+
+	return WatchlistTimelineResponse{
+		WatchlistTimeline: timeline,
+		Information:       tibiaDataLocalInformation(),
+	}, nil
+}
+
+// TibiaWatchlistDeathsImpl func - returns all deaths of a watched character seen since it was added
+func TibiaWatchlistDeathsImpl(name string) (WatchlistDeathsResponse, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return WatchlistDeathsResponse{}, err
+	}
+
+	deaths := WatchlistDeaths{Deaths: []Deaths{}}
+	err = db.View(func(tx *bolt.Tx) error {
+		character, err := tibiaWatchlistWatched(tx, name)
+		if err != nil {
+			return err
+		}
+		deaths.Name = character.Name
+
+		root := tx.Bucket(tibiaWatchlistDeathsBucket)
+		if root == nil || root.Bucket(tibiaWatchlistKey(name)) == nil {
+			return nil
+		}
+
+		return root.Bucket(tibiaWatchlistKey(name)).ForEach(func(_, value []byte) error {
+			var death Deaths
+			if err := json.Unmarshal(value, &death); err != nil {
+				return err
+			}
+			deaths.Deaths = append(deaths.Deaths, death)
+			return nil
+		})
+	})
+	if err != nil {
+		return WatchlistDeathsResponse{}, err
+	}
+
+	// the deaths are stored chronologically, tibia.com lists the newest first
+	slices.Reverse(deaths.Deaths)
+
+	return WatchlistDeathsResponse{
+		WatchlistDeaths: deaths,
+		Information:     tibiaDataLocalInformation(),
+	}, nil
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+// testWatchlistCollector returns the Orca Kaoksh test file changed by replacer for all characters but Durin
+func testWatchlistCollector(t *testing.T, replacer *strings.Replacer, requests *[]TibiaDataRequestStruct) func(TibiaDataRequestStruct) (string, error) {
+	character := testFileCollector(t, "testdata/characters/Orca Kaoksh.html", requests)
+
+	return func(request TibiaDataRequestStruct) (string, error) {
+		if strings.HasSuffix(request.URL, "name=Durin") {
+			return "", validation.ErrStatusForbidden
+		}
+		html, err := character(request)
+		return replacer.Replace(html), err
+	}
+}
+
+func TestWatchlist(t *testing.T) {
+	assert := assert.New(t)
+	db := testStore(t)
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	watchlistJson, err := TibiaWatchlistAddImpl([]string{"Orca Kaoksh", "Durin", "orca kaoksh"}, start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]WatchlistCharacter{
+		{Name: "Durin", Added: "2025-01-01T10:00:00Z"},
+		{Name: "Orca Kaoksh", Added: "2025-01-01T10:00:00Z"},
+	}, watchlistJson.Watchlist)
+
+	// the second poll is unchanged and does not store a snapshot
+	var requests []TibiaDataRequestStruct
+	assert.Nil(tibiaWatchlistPoll(db, 0, testWatchlistCollector(t, strings.NewReplacer(), &requests), start))
+	assert.Nil(tibiaWatchlistPoll(db, 0, testWatchlistCollector(t, strings.NewReplacer(), &requests), start.Add(15*time.Minute)))
+	assert.Len(requests, 2)
+
+	// the character gained a level, died and tibia.com no longer lists the oldest death
+	assert.Nil(tibiaWatchlistPoll(db, 0, testWatchlistCollector(t, strings.NewReplacer(
+		`Level:</td><td>10<`, `Level:</td><td>11<`,
+		`Oct&#160;07&#160;2023,&#160;02:27:38&#160;CEST</td><td>Died at Level 8 by wasp.`, `Oct&#160;09&#160;2023,&#160;10:00:00&#160;CEST</td><td>Died at Level 11 by rat.`,
+	), nil), start.Add(30*time.Minute)))
+
+	// polled characters are skipped within minAge
+	requests = nil
+	assert.Nil(tibiaWatchlistPoll(db, time.Hour, testWatchlistCollector(t, strings.NewReplacer(), &requests), start.Add(45*time.Minute)))
+	assert.Empty(requests)
+
+	watchlistJson, err = TibiaWatchlistImpl()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(watchlistJson.Watchlist, 2) {
+		assert.Empty(watchlistJson.Watchlist[0].LastPolled)
+		assert.Equal(http.StatusBadGateway, watchlistJson.Watchlist[0].Error.HTTPCode)
+		assert.Equal("2025-01-01T10:30:00Z", watchlistJson.Watchlist[1].LastPolled)
+		assert.Nil(watchlistJson.Watchlist[1].Error)
+	}
+
+	timelineJson, err := TibiaWatchlistTimelineImpl("ORCA KAOKSH", "2025-01-01", "2025-01-02", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	timeline := timelineJson.WatchlistTimeline
+	assert.Equal("Orca Kaoksh", timeline.Name)
+	assert.Equal("2025-01-01T10:30:00Z", timeline.LastPolled)
+	if assert.Len(timeline.Snapshots, 2) {
+		assert.Equal(WatchlistSnapshot{Time: "2025-01-01T10:00:00Z", Name: "Orca Kaoksh", Level: 10, Vocation: "None", World: "Kalibra", Residence: "Rookgaard", AccountStatus: "Free Account"}, timeline.Snapshots[0])
+		assert.Equal(11, timeline.Snapshots[1].Level)
+	}
+	assert.Equal([]WatchlistLevel{{Time: "2025-01-01T10:00:00Z", Level: 10}, {Time: "2025-01-01T10:30:00Z", Level: 11}}, timeline.LevelHistory)
+
+	// the period starts with the state at its start
+	timelineJson, err = TibiaWatchlistTimelineImpl("Orca Kaoksh", "2025-01-01T10:20:00Z", "2025-01-01T10:25:00Z", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal([]WatchlistLevel{{Time: "2025-01-01T10:00:00Z", Level: 10}}, timelineJson.WatchlistTimeline.LevelHistory)
+
+	deathsJson, err := TibiaWatchlistDeathsImpl("Orca Kaoksh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	deaths := deathsJson.WatchlistDeaths.Deaths
+	if assert.Len(deaths, 3) {
+		assert.Equal("Died at Level 11 by rat.", deaths[0].Reason)
+		assert.Equal("2023-10-09T08:00:00Z", deaths[0].Time)
+		assert.Equal("Died at Level 10 by fire.", deaths[1].Reason)
+		assert.Equal("Died at Level 8 by wasp.", deaths[2].Reason)
+	}
+
+	watchlistJson, err = TibiaWatchlistRemoveImpl("orca kaoksh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(watchlistJson.Watchlist, 1)
+
+	_, err = TibiaWatchlistDeathsImpl("Orca Kaoksh")
+	assert.Equal(validation.ErrorCharacterNotWatched, err)
+	_, err = TibiaWatchlistTimelineImpl("Orca Kaoksh", "", "", "")
+	assert.Equal(validation.ErrorCharacterNotWatched, err)
+	_, err = TibiaWatchlistRemoveImpl("Orca Kaoksh")
+	assert.Equal(validation.ErrorCharacterNotWatched, err)
+}
+
+func TestWatchlistAdd(t *testing.T) {
+	assert := assert.New(t)
+	testStore(t)
+
+	defer func(maxSize int) { TibiaDataWatchlistMaxSize = maxSize }(TibiaDataWatchlistMaxSize)
+	TibiaDataWatchlistMaxSize = 2
+
+	_, err := TibiaWatchlistAddImpl(nil, time.Now())
+	assert.Equal(validation.ErrorBatchEmpty, err)
+	_, err = TibiaWatchlistAddImpl([]string{"Durin", "a"}, time.Now())
+	assert.Equal(validation.ErrorCharacterNameTooSmall, err)
+
+	_, err = TibiaWatchlistAddImpl([]string{"Durin", "Trollefar"}, time.Now())
+	assert.Nil(err)
+	_, err = TibiaWatchlistAddImpl([]string{"durin"}, time.Now())
+	assert.Nil(err)
+	_, err = TibiaWatchlistAddImpl([]string{"Orca Kaoksh"}, time.Now())
+	assert.Equal(validation.ErrorWatchlistFull, err)
+}
+
+func TestWatchlistStoreNotEnabled(t *testing.T) {
+	_, err := TibiaWatchlistImpl()
+	assert.Equal(t, validation.ErrorStoreNotEnabled, err)
+}
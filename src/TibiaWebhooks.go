@@ -0,0 +1,990 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// TibiaDataWebhooksInterval is the time between two polls of the guilds and houses of the webhooks
+	TibiaDataWebhooksInterval = 5 * time.Minute
+
+	// TibiaDataWebhooksMaxAttempts is the number of attempts of a delivery before it is failed
+	TibiaDataWebhooksMaxAttempts = 6
+
+	// TibiaDataWebhooksRetryDelay is the delay before the first retry of a delivery, it doubles with every attempt
+	TibiaDataWebhooksRetryDelay = 30 * time.Second
+
+	// TibiaDataWebhooksRetention is how long deliveries are kept in the delivery log
+	TibiaDataWebhooksRetention = 7 * 24 * time.Hour
+
+	// TibiaDataWebhooksMaxSize is the maximum number of webhooks
+	TibiaDataWebhooksMaxSize = 50
+
+	// TibiaDataWebhooksAllowLoopback allows webhook urls on loopback addresses (for receivers on the same host)
+	TibiaDataWebhooksAllowLoopback = false
+)
+
+// tibiaWebhooksLookupIP resolves the host of a webhook url
+var tibiaWebhooksLookupIP = net.DefaultResolver.LookupIP
+
+var (
+	tibiaWebhooksBucket           = []byte("webhooks")
+	tibiaWebhooksDeliveriesBucket = []byte("webhooks_deliveries")
+	tibiaWebhooksStateBucket      = []byte("webhooks_state")
+)
+
+const (
+	// tibiaWebhooksDeliveryTick is the time between two runs of the due deliveries
+	tibiaWebhooksDeliveryTick = 5 * time.Second
+
+	// tibiaWebhooksDeliveryTimeout is the timeout of a single delivery attempt
+	tibiaWebhooksDeliveryTimeout = 10 * time.Second
+)
+
+// The event types of webhooks
+// Character events are emitted by the watchlist, guild and house events by the webhooks poll.
+const (
+	WebhookEventCharacterLevelUp    = "character.level_up"
+	WebhookEventCharacterDeath      = "character.death"
+	WebhookEventCharacterNameChange = "character.name_change"
+	WebhookEventCharacterGuildJoin  = "character.guild_join"
+	WebhookEventCharacterGuildLeave = "character.guild_leave"
+	WebhookEventGuildMemberJoin     = "guild.member_join"
+	WebhookEventGuildMemberLeave    = "guild.member_leave"
+	WebhookEventHouseAuctionBid     = "house.auction_bid"
+	WebhookEventHouseOwnerChange    = "house.owner_change"
+)
+
+// WebhookEvents are all event types of webhooks
+var WebhookEvents = []string{
+	WebhookEventCharacterLevelUp,
+	WebhookEventCharacterDeath,
+	WebhookEventCharacterNameChange,
+	WebhookEventCharacterGuildJoin,
+	WebhookEventCharacterGuildLeave,
+	WebhookEventGuildMemberJoin,
+	WebhookEventGuildMemberLeave,
+	WebhookEventHouseAuctionBid,
+	WebhookEventHouseOwnerChange,
+}
+
+// The statuses of webhook deliveries
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// Child of Webhook
+type WebhookHouse struct {
+	World   string `json:"world"`    // The world of the house.
+	HouseID int    `json:"house_id"` // The internal ID of the house.
+}
+
+// WebhookRequest is the body of a webhook subscription
+type WebhookRequest struct {
+	URL        string         `json:"url"`        // The url the events are posted to.
+	Secret     string         `json:"secret"`     // The secret of the signatures (generated if empty).
+	Events     []string       `json:"events"`     // The event types to deliver (all if empty).
+	Characters []string       `json:"characters"` // The watched characters to deliver events of (all if empty).
+	Guilds     []string       `json:"guilds"`     // The guilds to deliver member events of.
+	Houses     []WebhookHouse `json:"houses"`     // The houses to deliver auction and owner events of.
+}
+
+// Child of WebhooksResponse
+type Webhook struct {
+	ID         int            `json:"id"`               // The ID of the webhook.
+	URL        string         `json:"url"`              // The url the events are posted to.
+	Secret     string         `json:"secret,omitempty"` // The secret of the signatures (only returned when the webhook is added).
+	Events     []string       `json:"events"`           // The event types to deliver (all if empty).
+	Characters []string       `json:"characters"`       // The watched characters to deliver events of (all if empty).
+	Guilds     []string       `json:"guilds"`           // The guilds to deliver member events of.
+	Houses     []WebhookHouse `json:"houses"`           // The houses to deliver auction and owner events of.
+	Created    string         `json:"created"`          // The time the webhook was added.
+}
+
+// The base includes two levels: Webhooks and Information
+type WebhooksResponse struct {
+	Webhooks    []Webhook   `json:"webhooks"`
+	Information Information `json:"information"`
+}
+
+// ListEntries returns the webhooks
+func (r WebhooksResponse) ListEntries() interface{} {
+	return r.Webhooks
+}
+
+// The base includes two levels: Webhook and Information
+type WebhookResponse struct {
+	Webhook     Webhook     `json:"webhook"`
+	Information Information `json:"information"`
+}
+
+// WebhookEvent is the body posted to webhooks
+type WebhookEvent struct {
+	ID        string      `json:"id"`                  // The ID of the event (the same for all webhooks).
+	Type      string      `json:"type"`                // The type of the event.
+	Time      string      `json:"time"`                // The time the event was seen.
+	Character string      `json:"character,omitempty"` // The name the character is watched with (character events).
+	Guild     string      `json:"guild,omitempty"`     // The name of the guild (guild events).
+	House     *House      `json:"house,omitempty"`     // The house (house events).
+	Data      interface{} `json:"data"`                // The data of the event, depending on its type.
+}
+
+// WebhookLevelChange is the data of character.level_up events
+type WebhookLevelChange struct {
+	PreviousLevel int `json:"previous_level"`
+	Level         int `json:"level"`
+}
+
+// WebhookNameChange is the data of character.name_change events
+type WebhookNameChange struct {
+	PreviousName string `json:"previous_name"`
+	Name         string `json:"name"`
+}
+
+// WebhookOwnerChange is the data of house.owner_change events
+type WebhookOwnerChange struct {
+	PreviousOwner string `json:"previous_owner"`
+	Owner         string `json:"owner"`
+}
+
+// Child of WebhookDeliveries
+type WebhookDelivery struct {
+	ID          int    `json:"id"`                     // The ID of the delivery.
+	EventID     string `json:"event_id"`               // The ID of the event.
+	EventType   string `json:"event_type"`             // The type of the event.
+	Created     string `json:"created"`                // The time the event was seen.
+	Status      string `json:"status"`                 // The status of the delivery (pending, delivered or failed).
+	Attempts    int    `json:"attempts"`               // The number of attempts so far.
+	LastAttempt string `json:"last_attempt,omitempty"` // The time of the last attempt.
+	NextAttempt string `json:"next_attempt,omitempty"` // The time of the next attempt (if pending).
+	HTTPCode    int    `json:"http_code,omitempty"`    // The response code of the last attempt.
+	Error       string `json:"error,omitempty"`        // The error of the last attempt (if it failed).
+	Payload     string `json:"payload"`                // The body that is posted.
+}
+
+// Child of JSONData
+type WebhookDeliveries struct {
+	WebhookID  int               `json:"webhook_id"`       // The ID of the webhook.
+	Status     string            `json:"status,omitempty"` // The status the deliveries are filtered on.
+	Deliveries []WebhookDelivery `json:"deliveries"`       // The deliveries, the newest first.
+}
+
+// The base includes two levels: WebhookDeliveries and Information
+type WebhookDeliveriesResponse struct {
+	WebhookDeliveries WebhookDeliveries `json:"webhook_deliveries"`
+	Information       Information       `json:"information"`
+}
+
+// ListEntries returns the deliveries
+func (r WebhookDeliveriesResponse) ListEntries() interface{} {
+	return r.WebhookDeliveries.Deliveries
+}
+
+// tibiaWebhooksKey returns the key of a webhook ID
+func tibiaWebhooksKey(id int) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(id))
+	return key
+}
+
+// tibiaWebhooksWebhooks returns all webhooks (including their secrets) sorted by ID
+func tibiaWebhooksWebhooks(tx *bolt.Tx) ([]Webhook, error) {
+	webhooks := []Webhook{}
+
+	bucket := tx.Bucket(tibiaWebhooksBucket)
+	if bucket == nil {
+		return webhooks, nil
+	}
+
+	err := bucket.ForEach(func(_, value []byte) error {
+		var webhook Webhook
+		if err := json.Unmarshal(value, &webhook); err != nil {
+			return err
+		}
+		webhooks = append(webhooks, webhook)
+		return nil
+	})
+
+	return webhooks, err
+}
+
+// tibiaWebhooksResponse returns the response with all webhooks without their secrets
+func tibiaWebhooksResponse(tx *bolt.Tx) (WebhooksResponse, error) {
+	webhooks, err := tibiaWebhooksWebhooks(tx)
+	if err != nil {
+		return WebhooksResponse{}, err
+	}
+	for i := range webhooks {
+		webhooks[i].Secret = ""
+	}
+
+	return WebhooksResponse{
+		Webhooks:    webhooks,
+		Information: tibiaDataLocalInformation(),
+	}, nil
+}
+
+// TibiaWebhooksImpl func - returns all webhooks
+func TibiaWebhooksImpl() (WebhooksResponse, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return WebhooksResponse{}, err
+	}
+
+	var response WebhooksResponse
+	err = db.View(func(tx *bolt.Tx) (err error) {
+		response, err = tibiaWebhooksResponse(tx)
+		return err
+	})
+
+	return response, err
+}
+
+// tibiaWebhooksBlockedNetworks are the networks webhooks are not delivered to besides private and link-local addresses
+// 0.0.0.0/8 is "this network" and 100.64.0.0/10 the shared address space of carrier-grade NAT, which is often internal.
+var tibiaWebhooksBlockedNetworks = []*net.IPNet{
+	{IP: net.IPv4(0, 0, 0, 0), Mask: net.CIDRMask(8, 32)},
+	{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)},
+}
+
+// tibiaWebhooksAddressAllowed returns whether webhooks may be delivered to an address
+// Loopback, private, link-local, unspecified and tibiaWebhooksBlockedNetworks addresses are not allowed, loopback only with TibiaDataWebhooksAllowLoopback.
+func tibiaWebhooksAddressAllowed(ip net.IP) bool {
+	if ip.IsLoopback() {
+		return TibiaDataWebhooksAllowLoopback
+	}
+	if ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, network := range tibiaWebhooksBlockedNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// tibiaWebhooksValidateHost resolves the host of a webhook url and checks that all of its addresses are allowed
+func tibiaWebhooksValidateHost(host string) error {
+	ips := []net.IP{net.ParseIP(host)}
+	if ips[0] == nil {
+		ctx, cancel := context.WithTimeout(context.Background(), tibiaWebhooksDeliveryTimeout)
+		defer cancel()
+
+		var err error
+		ips, err = tibiaWebhooksLookupIP(ctx, "ip", host)
+		if err != nil || len(ips) == 0 {
+			return validation.ErrorWebhookURLInvalid
+		}
+	}
+
+	for _, ip := range ips {
+		if !tibiaWebhooksAddressAllowed(ip) {
+			return validation.ErrorWebhookURLNotAllowed
+		}
+	}
+	return nil
+}
+
+// tibiaWebhooksDialControl refuses connections to addresses that are not allowed
+// It is checked when connecting, so that a host resolving to another address than when it was validated is refused.
+func tibiaWebhooksDialControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !tibiaWebhooksAddressAllowed(ip) {
+		return validation.ErrorWebhookURLNotAllowed
+	}
+	return nil
+}
+
+// tibiaWebhooksClient returns the http client of the deliveries, which only connects to allowed addresses
+// Proxies are not used, as the address of the proxy would be checked instead of the one of the webhook.
+func tibiaWebhooksClient() *http.Client {
+	dialer := &net.Dialer{Timeout: tibiaWebhooksDeliveryTimeout, Control: tibiaWebhooksDialControl}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{Timeout: tibiaWebhooksDeliveryTimeout, Transport: transport}
+}
+
+// tibiaWebhooksValidate validates a webhook subscription
+func tibiaWebhooksValidate(request WebhookRequest) error {
+	target, err := url.Parse(request.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Hostname() == "" {
+		return validation.ErrorWebhookURLInvalid
+	}
+	if err := tibiaWebhooksValidateHost(target.Hostname()); err != nil {
+		return err
+	}
+
+	for _, event := range request.Events {
+		if !slices.Contains(WebhookEvents, event) {
+			return validation.ErrorWebhookEventInvalid
+		}
+	}
+	for _, name := range request.Characters {
+		if err := validation.IsCharacterNameValid(name); err != nil {
+			return err
+		}
+	}
+	for _, name := range request.Guilds {
+		if err := validation.IsGuildNameValid(name); err != nil {
+			return err
+		}
+	}
+	for _, house := range request.Houses {
+		if house.World == "" || house.HouseID <= 0 {
+			return validation.ErrorWebhookHouseInvalid
+		}
+		if _, err := tibiaHousesHouseEndpoint(house.World, strconv.Itoa(house.HouseID)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TibiaWebhooksAddImpl func - adds a webhook and returns it with its secret
+func TibiaWebhooksAddImpl(request WebhookRequest, now time.Time) (WebhookResponse, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return WebhookResponse{}, err
+	}
+
+	if err := tibiaWebhooksValidate(request); err != nil {
+		return WebhookResponse{}, err
+	}
+
+	webhook := Webhook{
+		URL:        request.URL,
+		Secret:     request.Secret,
+		Events:     request.Events,
+		Characters: request.Characters,
+		Guilds:     request.Guilds,
+		Houses:     request.Houses,
+		Created:    now.UTC().Format(time.RFC3339),
+	}
+	if webhook.Events == nil {
+		webhook.Events = []string{}
+	}
+	if webhook.Characters == nil {
+		webhook.Characters = []string{}
+	}
+	if webhook.Guilds == nil {
+		webhook.Guilds = []string{}
+	}
+	if webhook.Houses == nil {
+		webhook.Houses = []WebhookHouse{}
+	}
+	for i := range webhook.Houses {
+		webhook.Houses[i].World = TibiaDataStringWorldFormatToTitle(webhook.Houses[i].World)
+	}
+	if webhook.Secret == "" {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return WebhookResponse{}, err
+		}
+		webhook.Secret = hex.EncodeToString(secret)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(tibiaWebhooksBucket)
+		if err != nil {
+			return err
+		}
+
+		size := 0
+		if err := bucket.ForEach(func(_, _ []byte) error { size++; return nil }); err != nil {
+			return err
+		}
+		if size >= TibiaDataWebhooksMaxSize {
+			return validation.ErrorWebhooksTooMany
+		}
+
+		id, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		webhook.ID = int(id)
+
+		value, err := json.Marshal(webhook)
+		if err != nil {
+			return err
+		}
+		return bucket.Put(tibiaWebhooksKey(webhook.ID), value)
+	})
+	if err != nil {
+		return WebhookResponse{}, err
+	}
+
+	return WebhookResponse{
+		Webhook:     webhook,
+		Information: tibiaDataLocalInformation(),
+	}, nil
+}
+
+// tibiaWebhooksID converts a webhook ID of a path and checks that the webhook exists
+func tibiaWebhooksID(tx *bolt.Tx, idStr string) (int, error) {
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		return 0, validation.ErrorWebhookNotFound
+	}
+
+	bucket := tx.Bucket(tibiaWebhooksBucket)
+	if bucket == nil || bucket.Get(tibiaWebhooksKey(id)) == nil {
+		return 0, validation.ErrorWebhookNotFound
+	}
+
+	return id, nil
+}
+
+// TibiaWebhooksRemoveImpl func - removes a webhook and its deliveries and returns all webhooks
+func TibiaWebhooksRemoveImpl(idStr string) (WebhooksResponse, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return WebhooksResponse{}, err
+	}
+
+	var response WebhooksResponse
+	err = db.Update(func(tx *bolt.Tx) error {
+		id, err := tibiaWebhooksID(tx, idStr)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Bucket(tibiaWebhooksBucket).Delete(tibiaWebhooksKey(id)); err != nil {
+			return err
+		}
+		if root := tx.Bucket(tibiaWebhooksDeliveriesBucket); root != nil && root.Bucket(tibiaWebhooksKey(id)) != nil {
+			if err := root.DeleteBucket(tibiaWebhooksKey(id)); err != nil {
+				return err
+			}
+		}
+
+		response, err = tibiaWebhooksResponse(tx)
+		return err
+	})
+
+	return response, err
+}
+
+// TibiaWebhooksDeliveriesImpl func - returns the delivery log of a webhook, optionally filtered on a status
+func TibiaWebhooksDeliveriesImpl(idStr, status string) (WebhookDeliveriesResponse, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return WebhookDeliveriesResponse{}, err
+	}
+
+	status = strings.ToLower(status)
+	switch status {
+	case "", WebhookDeliveryPending, WebhookDeliveryDelivered, WebhookDeliveryFailed:
+	default:
+		return WebhookDeliveriesResponse{}, validation.ErrorWebhookDeliveryStatusInvalid
+	}
+
+	deliveries := WebhookDeliveries{Status: status, Deliveries: []WebhookDelivery{}}
+	err = db.View(func(tx *bolt.Tx) error {
+		id, err := tibiaWebhooksID(tx, idStr)
+		if err != nil {
+			return err
+		}
+		deliveries.WebhookID = id
+
+		root := tx.Bucket(tibiaWebhooksDeliveriesBucket)
+		if root == nil || root.Bucket(tibiaWebhooksKey(id)) == nil {
+			return nil
+		}
+
+		cursor := root.Bucket(tibiaWebhooksKey(id)).Cursor()
+		for key, value := cursor.Last(); key != nil; key, value = cursor.Prev() {
+			var delivery WebhookDelivery
+			if err := json.Unmarshal(value, &delivery); err != nil {
+				return err
+			}
+			if status == "" || delivery.Status == status {
+				deliveries.Deliveries = append(deliveries.Deliveries, delivery)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return WebhookDeliveriesResponse{}, err
+	}
+
+	return WebhookDeliveriesResponse{
+		WebhookDeliveries: deliveries,
+		Information:       tibiaDataLocalInformation(),
+	}, nil
+}
+
+// tibiaWebhooksSubscribed returns whether a webhook subscribed to an event
+func tibiaWebhooksSubscribed(webhook Webhook, event WebhookEvent) bool {
+	if len(webhook.Events) > 0 && !slices.Contains(webhook.Events, event.Type) {
+		return false
+	}
+
+	switch {
+	case event.Character != "":
+		return len(webhook.Characters) == 0 || slices.ContainsFunc(webhook.Characters, func(name string) bool {
+			return strings.EqualFold(name, event.Character)
+		})
+	case event.Guild != "":
+		return slices.ContainsFunc(webhook.Guilds, func(name string) bool {
+			return strings.EqualFold(name, event.Guild)
+		})
+	case event.House != nil:
+		return slices.Contains(webhook.Houses, WebhookHouse{World: event.House.World, HouseID: event.House.Houseid})
+	}
+
+	return false
+}
+
+// tibiaWebhooksEmit queues the deliveries of an event to all webhooks subscribed to it
+func tibiaWebhooksEmit(tx *bolt.Tx, event WebhookEvent, now time.Time) error {
+	webhooks, err := tibiaWebhooksWebhooks(tx)
+	if err != nil {
+		return err
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return err
+	}
+	event.ID = hex.EncodeToString(id)
+	event.Time = now.UTC().Format(time.RFC3339)
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	for _, webhook := range webhooks {
+		if !tibiaWebhooksSubscribed(webhook, event) {
+			continue
+		}
+
+		root, err := tx.CreateBucketIfNotExists(tibiaWebhooksDeliveriesBucket)
+		if err != nil {
+			return err
+		}
+		bucket, err := root.CreateBucketIfNotExists(tibiaWebhooksKey(webhook.ID))
+		if err != nil {
+			return err
+		}
+
+		sequence, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		delivery := WebhookDelivery{
+			ID:          int(sequence),
+			EventID:     event.ID,
+			EventType:   event.Type,
+			Created:     event.Time,
+			Status:      WebhookDeliveryPending,
+			NextAttempt: event.Time,
+			Payload:     string(payload),
+		}
+		if err := tibiaWebhooksPutDelivery(bucket, delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tibiaWebhooksDeliveryKey returns the key of a delivery, ordered by the time of its event
+func tibiaWebhooksDeliveryKey(delivery WebhookDelivery) []byte {
+	created, _ := time.Parse(time.RFC3339, delivery.Created)
+	return binary.BigEndian.AppendUint64(tibiaDataStoreTimeKey(created), uint64(delivery.ID))
+}
+
+// tibiaWebhooksPutDelivery stores a delivery in the delivery log of its webhook
+func tibiaWebhooksPutDelivery(bucket *bolt.Bucket, delivery WebhookDelivery) error {
+	value, err := json.Marshal(delivery)
+	if err != nil {
+		return err
+	}
+	return bucket.Put(tibiaWebhooksDeliveryKey(delivery), value)
+}
+
+// tibiaWebhooksCharacterEvents emits the events of a watched character between two snapshots
+func tibiaWebhooksCharacterEvents(tx *bolt.Tx, name string, previous, current WatchlistSnapshot, deaths []Deaths, now time.Time) error {
+	var events []WebhookEvent
+
+	if current.Level > previous.Level {
+		events = append(events, WebhookEvent{Type: WebhookEventCharacterLevelUp, Data: WebhookLevelChange{PreviousLevel: previous.Level, Level: current.Level}})
+	}
+	// tibia.com lists the newest death first
+	for i := len(deaths) - 1; i >= 0; i-- {
+		events = append(events, WebhookEvent{Type: WebhookEventCharacterDeath, Data: deaths[i]})
+	}
+	if current.Name != previous.Name {
+		events = append(events, WebhookEvent{Type: WebhookEventCharacterNameChange, Data: WebhookNameChange{PreviousName: previous.Name, Name: current.Name}})
+	}
+	if previous.Guild.GuildName != current.Guild.GuildName {
+		if previous.Guild.GuildName != "" {
+			events = append(events, WebhookEvent{Type: WebhookEventCharacterGuildLeave, Data: previous.Guild})
+		}
+		if current.Guild.GuildName != "" {
+			events = append(events, WebhookEvent{Type: WebhookEventCharacterGuildJoin, Data: current.Guild})
+		}
+	}
+
+	for _, event := range events {
+		event.Character = name
+		if err := tibiaWebhooksEmit(tx, event, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runWebhooks polls the guilds and houses of the webhooks every interval and delivers the events until stop is closed
+func runWebhooks(db *bolt.DB, interval time.Duration, htmlDataCollector func(TibiaDataRequestStruct) (string, error), stop <-chan struct{}) {
+	client := tibiaWebhooksClient()
+
+	poll := time.NewTicker(interval)
+	defer poll.Stop()
+	deliver := time.NewTicker(tibiaWebhooksDeliveryTick)
+	defer deliver.Stop()
+
+	if err := tibiaWebhooksPoll(db, htmlDataCollector, time.Now()); err != nil {
+		log.Printf("[error] TibiaData API webhooks poll failed: %s", err)
+	}
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-poll.C:
+			if err := tibiaWebhooksPoll(db, htmlDataCollector, time.Now()); err != nil {
+				log.Printf("[error] TibiaData API webhooks poll failed: %s", err)
+			}
+		case <-deliver.C:
+			if err := tibiaWebhooksDeliver(db, client, time.Now()); err != nil {
+				log.Printf("[error] TibiaData API webhooks delivery failed: %s", err)
+			}
+		}
+	}
+}
+
+// tibiaWebhooksStateKey returns the key of the last seen state of a guild or house
+func tibiaWebhooksStateKey(kind, name string) []byte {
+	return []byte(kind + "/" + strings.ToLower(name))
+}
+
+// tibiaWebhooksPoll fetches the guilds and houses of all webhooks and emits the changes since the last poll
+// The first poll of a guild or house only stores its state.
+func tibiaWebhooksPoll(db *bolt.DB, htmlDataCollector func(TibiaDataRequestStruct) (string, error), now time.Time) error {
+	var webhooks []Webhook
+	err := db.View(func(tx *bolt.Tx) (err error) {
+		webhooks, err = tibiaWebhooksWebhooks(tx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var endpoints []tibiaDataEndpoint
+	seen := map[string]bool{}
+	for _, webhook := range webhooks {
+		for _, guild := range webhook.Guilds {
+			if key := string(tibiaWebhooksStateKey("guild", guild)); !seen[key] {
+				seen[key] = true
+				if endpoint, err := tibiaGuildsGuildEndpoint(guild); err == nil {
+					endpoints = append(endpoints, endpoint)
+				}
+			}
+		}
+		for _, house := range webhook.Houses {
+			if key := string(tibiaWebhooksStateKey("house", fmt.Sprintf("%s/%d", house.World, house.HouseID))); !seen[key] {
+				seen[key] = true
+				if endpoint, err := tibiaHousesHouseEndpoint(house.World, strconv.Itoa(house.HouseID)); err == nil {
+					endpoints = append(endpoints, endpoint)
+				}
+			}
+		}
+	}
+
+	results := make([]interface{}, len(endpoints))
+	TibiaDataParallel(len(endpoints), TibiaDataFanOutConcurrency, func(i int) {
+		content, err := htmlDataCollector(endpoints[i].Request)
+		if err != nil {
+			log.Printf("[error] TibiaData API webhooks poll of %s failed: %s", endpoints[i].Request.URL, err)
+			return
+		}
+		if results[i], err = endpoints[i].Parse(content); err != nil {
+			log.Printf("[error] TibiaData API webhooks poll of %s failed: %s", endpoints[i].Request.URL, err)
+		}
+	})
+
+	return db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(tibiaWebhooksStateBucket)
+		if err != nil {
+			return err
+		}
+
+		for _, result := range results {
+			switch result := result.(type) {
+			case GuildResponse:
+				err = tibiaWebhooksRecordGuild(tx, bucket, result.Guild, now)
+			case HouseResponse:
+				err = tibiaWebhooksRecordHouse(tx, bucket, result.House, now)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		// forget the state of guilds and houses that are no longer subscribed to
+		var stale [][]byte
+		if err := bucket.ForEach(func(key, _ []byte) error {
+			if !seen[string(key)] {
+				stale = append(stale, key)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, key := range stale {
+			if err := bucket.Delete(key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// tibiaWebhooksRecordGuild stores the members of a polled guild and emits the joined and left members
+func tibiaWebhooksRecordGuild(tx *bolt.Tx, bucket *bolt.Bucket, guild Guild, now time.Time) error {
+	key := tibiaWebhooksStateKey("guild", guild.Name)
+
+	if value := bucket.Get(key); value != nil {
+		var previous []GuildMember
+		if err := json.Unmarshal(value, &previous); err != nil {
+			return err
+		}
+
+		for _, member := range guild.Members {
+			if !slices.ContainsFunc(previous, func(m GuildMember) bool { return m.Name == member.Name }) {
+				if err := tibiaWebhooksEmit(tx, WebhookEvent{Type: WebhookEventGuildMemberJoin, Guild: guild.Name, Data: member}, now); err != nil {
+					return err
+				}
+			}
+		}
+		for _, member := range previous {
+			if !slices.ContainsFunc(guild.Members, func(m GuildMember) bool { return m.Name == member.Name }) {
+				if err := tibiaWebhooksEmit(tx, WebhookEvent{Type: WebhookEventGuildMemberLeave, Guild: guild.Name, Data: member}, now); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	value, err := json.Marshal(guild.Members)
+	if err != nil {
+		return err
+	}
+	return bucket.Put(key, value)
+}
+
+// tibiaWebhooksRecordHouse stores the status of a polled house and emits new auction bids and owners
+func tibiaWebhooksRecordHouse(tx *bolt.Tx, bucket *bolt.Bucket, house House, now time.Time) error {
+	key := tibiaWebhooksStateKey("house", fmt.Sprintf("%s/%d", house.World, house.Houseid))
+
+	if value := bucket.Get(key); value != nil {
+		var previous HouseStatus
+		if err := json.Unmarshal(value, &previous); err != nil {
+			return err
+		}
+
+		auction := house.Status.Auction
+		if house.Status.IsAuctioned && auction.CurrentBidder != "" && (auction.CurrentBid != previous.Auction.CurrentBid || auction.CurrentBidder != previous.Auction.CurrentBidder) {
+			if err := tibiaWebhooksEmit(tx, WebhookEvent{Type: WebhookEventHouseAuctionBid, House: &house, Data: auction}, now); err != nil {
+				return err
+			}
+		}
+		if house.Status.Rental.Owner != previous.Rental.Owner {
+			if err := tibiaWebhooksEmit(tx, WebhookEvent{Type: WebhookEventHouseOwnerChange, House: &house, Data: WebhookOwnerChange{PreviousOwner: previous.Rental.Owner, Owner: house.Status.Rental.Owner}}, now); err != nil {
+				return err
+			}
+		}
+	}
+
+	value, err := json.Marshal(house.Status)
+	if err != nil {
+		return err
+	}
+	return bucket.Put(key, value)
+}
+
+// tibiaWebhooksSignature returns the signature of a payload, the hex encoded HMAC-SHA256 with the secret of the webhook
+func tibiaWebhooksSignature(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// tibiaWebhooksDue are the pending deliveries of a webhook that are due
+type tibiaWebhooksDue struct {
+	webhook    Webhook
+	deliveries []WebhookDelivery
+}
+
+// tibiaWebhooksDeliver posts all pending deliveries that are due and stores their results
+// Webhooks are delivered concurrently, the deliveries of one webhook in the order of their events:
+// the deliveries after a failed one wait until it was delivered or finally failed.
+// Failed attempts are retried with an exponential backoff until TibiaDataWebhooksMaxAttempts.
+func tibiaWebhooksDeliver(db *bolt.DB, client *http.Client, now time.Time) error {
+	var due []tibiaWebhooksDue
+	err := db.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket(tibiaWebhooksDeliveriesBucket)
+		if root == nil {
+			return nil
+		}
+
+		webhooks, err := tibiaWebhooksWebhooks(tx)
+		if err != nil {
+			return err
+		}
+		for _, webhook := range webhooks {
+			bucket := root.Bucket(tibiaWebhooksKey(webhook.ID))
+			if bucket == nil {
+				continue
+			}
+
+			pending := tibiaWebhooksDue{webhook: webhook}
+			waiting := false
+			err := bucket.ForEach(func(_, value []byte) error {
+				var delivery WebhookDelivery
+				if err := json.Unmarshal(value, &delivery); err != nil {
+					return err
+				}
+				if delivery.Status != WebhookDeliveryPending || waiting {
+					return nil
+				}
+				if next, err := time.Parse(time.RFC3339, delivery.NextAttempt); err == nil && !next.After(now) {
+					pending.deliveries = append(pending.deliveries, delivery)
+				} else {
+					// a delivery waiting for its retry holds back the later ones
+					waiting = true
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			if len(pending.deliveries) > 0 {
+				due = append(due, pending)
+			}
+		}
+		return nil
+	})
+	if err != nil || len(due) == 0 {
+		return err
+	}
+
+	TibiaDataParallel(len(due), TibiaDataFanOutConcurrency, func(i int) {
+		for j := range due[i].deliveries {
+			delivery := &due[i].deliveries[j]
+			delivery.Attempts++
+			delivery.LastAttempt = now.UTC().Format(time.RFC3339)
+			delivery.HTTPCode, delivery.Error = tibiaWebhooksPost(client, due[i].webhook, *delivery)
+
+			switch {
+			case delivery.Error == "":
+				delivery.Status = WebhookDeliveryDelivered
+				delivery.NextAttempt = ""
+			case delivery.Attempts >= TibiaDataWebhooksMaxAttempts:
+				delivery.Status = WebhookDeliveryFailed
+				delivery.NextAttempt = ""
+			default:
+				delivery.NextAttempt = now.Add(TibiaDataWebhooksRetryDelay << (delivery.Attempts - 1)).UTC().Format(time.RFC3339)
+				// the later deliveries are posted after this one
+				due[i].deliveries = due[i].deliveries[:j+1]
+				return
+			}
+		}
+	})
+
+	return db.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket(tibiaWebhooksDeliveriesBucket)
+		if root == nil {
+			return nil
+		}
+
+		for _, pending := range due {
+			bucket := root.Bucket(tibiaWebhooksKey(pending.webhook.ID))
+			if bucket == nil {
+				// the webhook was removed while it was delivered
+				continue
+			}
+
+			for _, delivery := range pending.deliveries {
+				if err := tibiaWebhooksPutDelivery(bucket, delivery); err != nil {
+					return err
+				}
+			}
+			if err := tibiaDataStorePrune(bucket, now, TibiaDataWebhooksRetention, 0); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// tibiaWebhooksPost posts the payload of a delivery and returns the response code and the error (if it failed)
+func tibiaWebhooksPost(client *http.Client, webhook Webhook, delivery WebhookDelivery) (int, string) {
+	payload := []byte(delivery.Payload)
+
+	req, err := http.NewRequest(http.MethodPost, webhook.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err.Error()
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", TibiaDataUserAgent)
+	req.Header.Set("X-TibiaData-Event", delivery.EventType)
+	req.Header.Set("X-TibiaData-Delivery", delivery.EventID)
+	req.Header.Set("X-TibiaData-Signature", tibiaWebhooksSignature(webhook.Secret, payload))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err.Error()
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, "unexpected response status " + resp.Status
+	}
+	return resp.StatusCode, ""
+}
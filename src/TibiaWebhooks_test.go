@@ -0,0 +1,392 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+	bolt "go.etcd.io/bbolt"
+)
+
+// testWebhookReceiver is a local receiver of webhooks that answers with the given status codes (200 after the last one)
+type testWebhookReceiver struct {
+	*httptest.Server
+	codes    []int
+	received []*http.Request
+	bodies   []string
+}
+
+// newTestWebhookReceiver starts a receiver on the loopback address, which is allowed for the duration of the test
+func newTestWebhookReceiver(t *testing.T, codes ...int) *testWebhookReceiver {
+	allowLoopback := TibiaDataWebhooksAllowLoopback
+	TibiaDataWebhooksAllowLoopback = true
+	t.Cleanup(func() { TibiaDataWebhooksAllowLoopback = allowLoopback })
+
+	receiver := &testWebhookReceiver{codes: codes}
+	receiver.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		receiver.received = append(receiver.received, r)
+		receiver.bodies = append(receiver.bodies, string(body))
+
+		code := http.StatusOK
+		if len(receiver.codes) > 0 {
+			code, receiver.codes = receiver.codes[0], receiver.codes[1:]
+		}
+		w.WriteHeader(code)
+	}))
+	t.Cleanup(receiver.Close)
+	return receiver
+}
+
+// testWebhooksLookupIP resolves localhost and the hosts of example.com for the duration of the test
+func testWebhooksLookupIP(t *testing.T) {
+	lookupIP := tibiaWebhooksLookupIP
+	tibiaWebhooksLookupIP = func(_ context.Context, _, host string) ([]net.IP, error) {
+		switch host {
+		case "example.com":
+			return []net.IP{net.ParseIP("93.184.215.14"), net.ParseIP("2606:2800:21f:cb07:6820:80da:af6b:8b2c")}, nil
+		case "localhost":
+			return []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("::1")}, nil
+		case "internal.example.com":
+			return []net.IP{net.ParseIP("93.184.215.14"), net.ParseIP("10.0.0.1")}, nil
+		}
+		return nil, errors.New("no such host")
+	}
+	t.Cleanup(func() { tibiaWebhooksLookupIP = lookupIP })
+}
+
+func TestWebhooksCharacterEvents(t *testing.T) {
+	assert := assert.New(t)
+	db := testStore(t)
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	receiver := newTestWebhookReceiver(t)
+
+	webhookJson, err := TibiaWebhooksAddImpl(WebhookRequest{URL: receiver.URL, Secret: "secret", Events: []string{WebhookEventCharacterLevelUp, WebhookEventCharacterDeath}}, start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(1, webhookJson.Webhook.ID)
+	assert.Equal("secret", webhookJson.Webhook.Secret)
+
+	if _, err := TibiaWatchlistAddImpl([]string{"Orca Kaoksh"}, start); err != nil {
+		t.Fatal(err)
+	}
+
+	// the first poll only records the state
+	assert.Nil(tibiaWatchlistPoll(db, 0, testWatchlistCollector(t, strings.NewReplacer(), nil), start))
+	assert.Nil(tibiaWatchlistPoll(db, 0, testWatchlistCollector(t, strings.NewReplacer(
+		`Level:</td><td>10<`, `Level:</td><td>11<`,
+		`Oct&#160;07&#160;2023,&#160;02:27:38&#160;CEST</td><td>Died at Level 8 by wasp.`, `Oct&#160;09&#160;2023,&#160;10:00:00&#160;CEST</td><td>Died at Level 11 by rat.`,
+	), nil), start.Add(15*time.Minute)))
+
+	deliveriesJson, err := TibiaWebhooksDeliveriesImpl("1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	deliveries := deliveriesJson.WebhookDeliveries.Deliveries
+	if assert.Len(deliveries, 2) {
+		assert.Equal(WebhookEventCharacterDeath, deliveries[0].EventType)
+		assert.Equal(WebhookEventCharacterLevelUp, deliveries[1].EventType)
+		assert.Equal(WebhookDeliveryPending, deliveries[1].Status)
+		assert.Equal("2025-01-01T10:15:00Z", deliveries[1].NextAttempt)
+	}
+
+	assert.Nil(tibiaWebhooksDeliver(db, tibiaWebhooksClient(), start.Add(15*time.Minute)))
+	if assert.Len(receiver.received, 2) {
+		request := receiver.received[0]
+		assert.Equal(http.MethodPost, request.Method)
+		assert.Equal(WebhookEventCharacterLevelUp, request.Header.Get("X-TibiaData-Event"))
+		assert.Equal(tibiaWebhooksSignature("secret", []byte(receiver.bodies[0])), request.Header.Get("X-TibiaData-Signature"))
+
+		var event struct {
+			WebhookEvent
+			Data WebhookLevelChange `json:"data"`
+		}
+		assert.Nil(json.Unmarshal([]byte(receiver.bodies[0]), &event))
+		assert.Equal(request.Header.Get("X-TibiaData-Delivery"), event.ID)
+		assert.Equal("Orca Kaoksh", event.Character)
+		assert.Equal("2025-01-01T10:15:00Z", event.Time)
+		assert.Equal(WebhookLevelChange{PreviousLevel: 10, Level: 11}, event.Data)
+
+		assert.Contains(receiver.bodies[1], `"reason":"Died at Level 11 by rat."`)
+	}
+
+	deliveriesJson, err = TibiaWebhooksDeliveriesImpl("1", "delivered")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(deliveriesJson.WebhookDeliveries.Deliveries, 2)
+	assert.Equal(1, deliveriesJson.WebhookDeliveries.Deliveries[1].Attempts)
+	assert.Equal(http.StatusOK, deliveriesJson.WebhookDeliveries.Deliveries[1].HTTPCode)
+
+	// delivered events are not posted again
+	assert.Nil(tibiaWebhooksDeliver(db, tibiaWebhooksClient(), start.Add(time.Hour)))
+	assert.Len(receiver.received, 2)
+}
+
+func TestWebhooksRetry(t *testing.T) {
+	assert := assert.New(t)
+	db := testStore(t)
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	receiver := newTestWebhookReceiver(t, http.StatusInternalServerError, http.StatusServiceUnavailable, http.StatusInternalServerError)
+
+	defer func(maxAttempts int) { TibiaDataWebhooksMaxAttempts = maxAttempts }(TibiaDataWebhooksMaxAttempts)
+	TibiaDataWebhooksMaxAttempts = 3
+
+	if _, err := TibiaWebhooksAddImpl(WebhookRequest{URL: receiver.URL}, start); err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(db.Update(func(tx *bolt.Tx) error {
+		return tibiaWebhooksEmit(tx, WebhookEvent{Type: WebhookEventCharacterLevelUp, Character: "Durin", Data: WebhookLevelChange{PreviousLevel: 1, Level: 2}}, start)
+	}))
+
+	// the delay doubles with every attempt
+	for _, attempt := range []time.Duration{0, 10 * time.Second, 30 * time.Second, 60 * time.Second, 90 * time.Second, time.Hour} {
+		assert.Nil(tibiaWebhooksDeliver(db, tibiaWebhooksClient(), start.Add(attempt)))
+	}
+	assert.Len(receiver.received, 3)
+
+	deliveriesJson, err := TibiaWebhooksDeliveriesImpl("1", "failed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(deliveriesJson.WebhookDeliveries.Deliveries, 1) {
+		delivery := deliveriesJson.WebhookDeliveries.Deliveries[0]
+		assert.Equal(3, delivery.Attempts)
+		assert.Equal("2025-01-01T10:01:30Z", delivery.LastAttempt)
+		assert.Empty(delivery.NextAttempt)
+		assert.Equal(http.StatusInternalServerError, delivery.HTTPCode)
+		assert.Equal("unexpected response status 500 Internal Server Error", delivery.Error)
+	}
+
+	deliveriesJson, err = TibiaWebhooksDeliveriesImpl("1", "pending")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(deliveriesJson.WebhookDeliveries.Deliveries)
+}
+
+func TestWebhooksDeliveryOrder(t *testing.T) {
+	assert := assert.New(t)
+	db := testStore(t)
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	receiver := newTestWebhookReceiver(t, http.StatusInternalServerError)
+
+	if _, err := TibiaWebhooksAddImpl(WebhookRequest{URL: receiver.URL}, start); err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(db.Update(func(tx *bolt.Tx) error {
+		for level := 2; level <= 3; level++ {
+			if err := tibiaWebhooksEmit(tx, WebhookEvent{Type: WebhookEventCharacterLevelUp, Character: "Durin", Data: WebhookLevelChange{PreviousLevel: level - 1, Level: level}}, start); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	// the second event waits for the retry of the first one
+	assert.Nil(tibiaWebhooksDeliver(db, tibiaWebhooksClient(), start))
+	assert.Nil(tibiaWebhooksDeliver(db, tibiaWebhooksClient(), start.Add(10*time.Second)))
+	assert.Len(receiver.received, 1)
+
+	assert.Nil(tibiaWebhooksDeliver(db, tibiaWebhooksClient(), start.Add(TibiaDataWebhooksRetryDelay)))
+	if assert.Len(receiver.bodies, 3) {
+		assert.Contains(receiver.bodies[1], `"level":2`)
+		assert.Contains(receiver.bodies[2], `"level":3`)
+	}
+}
+
+func TestWebhooksGuildEvents(t *testing.T) {
+	assert := assert.New(t)
+	db := testStore(t)
+	testWebhooksLookupIP(t)
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	if _, err := TibiaWebhooksAddImpl(WebhookRequest{URL: "https://example.com/hook", Guilds: []string{"order of glory"}}, start); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := TibiaWebhooksAddImpl(WebhookRequest{URL: "https://example.com/other", Guilds: []string{"Elysium"}}, start); err != nil {
+		t.Fatal(err)
+	}
+
+	guild := testFileCollector(t, "testdata/guilds/guild/Order of Glory.html", nil)
+	collector := func(replacer *strings.Replacer) func(TibiaDataRequestStruct) (string, error) {
+		return func(request TibiaDataRequestStruct) (string, error) {
+			if !strings.HasSuffix(request.URL, "GuildName=order+of+glory") {
+				return "", validation.ErrorGuildNotFound
+			}
+			html, err := guild(request)
+			return replacer.Replace(html), err
+		}
+	}
+
+	assert.Nil(tibiaWebhooksPoll(db, collector(strings.NewReplacer()), start))
+	assert.Nil(tibiaWebhooksPoll(db, collector(strings.NewReplacer(`>Zyb&#160;the&#160;Warrior<`, `>Zyb&#160;the&#160;Wizard<`)), start.Add(5*time.Minute)))
+
+	deliveriesJson, err := TibiaWebhooksDeliveriesImpl("1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	deliveries := deliveriesJson.WebhookDeliveries.Deliveries
+	if assert.Len(deliveries, 2) {
+		assert.Equal(WebhookEventGuildMemberLeave, deliveries[0].EventType)
+		assert.Contains(deliveries[0].Payload, `"guild":"Order of Glory","data":{"name":"Zyb the Warrior"`)
+		assert.Equal(WebhookEventGuildMemberJoin, deliveries[1].EventType)
+		assert.Contains(deliveries[1].Payload, `"name":"Zyb the Wizard"`)
+	}
+
+	deliveriesJson, err = TibiaWebhooksDeliveriesImpl("2", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(deliveriesJson.WebhookDeliveries.Deliveries)
+}
+
+func TestWebhooksHouseEvents(t *testing.T) {
+	assert := assert.New(t)
+	db := testStore(t)
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	// houses are validated against tibia.com when added, so the webhook is stored directly
+	webhook, _ := json.Marshal(Webhook{ID: 1, URL: "https://example.com/hook", Houses: []WebhookHouse{{World: "Premia", HouseID: 10201}}})
+	house := House{Houseid: 10201, World: "Premia", Name: "Theater Avenue 14"}
+	house.Status.IsAuctioned = true
+
+	assert.Nil(db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(tibiaWebhooksBucket)
+		if err != nil {
+			return err
+		}
+		if err := bucket.Put(tibiaWebhooksKey(1), webhook); err != nil {
+			return err
+		}
+		state, err := tx.CreateBucketIfNotExists(tibiaWebhooksStateBucket)
+		if err != nil {
+			return err
+		}
+
+		if err := tibiaWebhooksRecordHouse(tx, state, house, start); err != nil {
+			return err
+		}
+		house.Status.Auction = HouseAuction{CurrentBid: 50000, CurrentBidder: "Trollefar", AuctionOngoing: true}
+		if err := tibiaWebhooksRecordHouse(tx, state, house, start.Add(5*time.Minute)); err != nil {
+			return err
+		}
+		house.Status = HouseStatus{IsRented: true, Rental: HouseRental{Owner: "Trollefar"}}
+		return tibiaWebhooksRecordHouse(tx, state, house, start.Add(10*time.Minute))
+	}))
+
+	deliveriesJson, err := TibiaWebhooksDeliveriesImpl("1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	deliveries := deliveriesJson.WebhookDeliveries.Deliveries
+	if assert.Len(deliveries, 2) {
+		assert.Equal(WebhookEventHouseOwnerChange, deliveries[0].EventType)
+		assert.Contains(deliveries[0].Payload, `"data":{"previous_owner":"","owner":"Trollefar"}`)
+		assert.Equal(WebhookEventHouseAuctionBid, deliveries[1].EventType)
+		assert.Contains(deliveries[1].Payload, `"data":{"current_bid":50000,"current_bidder":"Trollefar"`)
+	}
+}
+
+func TestWebhooksAdd(t *testing.T) {
+	assert := assert.New(t)
+	testStore(t)
+	testWebhooksLookupIP(t)
+
+	defer func(maxSize int) { TibiaDataWebhooksMaxSize = maxSize }(TibiaDataWebhooksMaxSize)
+	TibiaDataWebhooksMaxSize = 1
+
+	_, err := TibiaWebhooksAddImpl(WebhookRequest{URL: "ftp://example.com"}, time.Now())
+	assert.Equal(validation.ErrorWebhookURLInvalid, err)
+	_, err = TibiaWebhooksAddImpl(WebhookRequest{URL: "https://example.com", Events: []string{"character.login"}}, time.Now())
+	assert.Equal(validation.ErrorWebhookEventInvalid, err)
+	_, err = TibiaWebhooksAddImpl(WebhookRequest{URL: "https://example.com", Characters: []string{"a"}}, time.Now())
+	assert.Equal(validation.ErrorCharacterNameTooSmall, err)
+	_, err = TibiaWebhooksAddImpl(WebhookRequest{URL: "https://example.com", Houses: []WebhookHouse{{World: "Antica"}}}, time.Now())
+	assert.Equal(validation.ErrorWebhookHouseInvalid, err)
+
+	webhookJson, err := TibiaWebhooksAddImpl(WebhookRequest{URL: "https://example.com"}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(webhookJson.Webhook.Secret, 64)
+	assert.Equal([]string{}, webhookJson.Webhook.Events)
+
+	_, err = TibiaWebhooksAddImpl(WebhookRequest{URL: "https://example.com"}, time.Now())
+	assert.Equal(validation.ErrorWebhooksTooMany, err)
+
+	webhooksJson, err := TibiaWebhooksImpl()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(webhooksJson.Webhooks, 1) {
+		assert.Equal("https://example.com", webhooksJson.Webhooks[0].URL)
+		assert.Empty(webhooksJson.Webhooks[0].Secret)
+	}
+
+	_, err = TibiaWebhooksDeliveriesImpl("1", "unknown")
+	assert.Equal(validation.ErrorWebhookDeliveryStatusInvalid, err)
+
+	webhooksJson, err = TibiaWebhooksRemoveImpl("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(webhooksJson.Webhooks)
+
+	_, err = TibiaWebhooksRemoveImpl("1")
+	assert.Equal(validation.ErrorWebhookNotFound, err)
+	_, err = TibiaWebhooksDeliveriesImpl("abc", "")
+	assert.Equal(validation.ErrorWebhookNotFound, err)
+}
+
+func TestWebhooksURLNotAllowed(t *testing.T) {
+	assert := assert.New(t)
+	db := testStore(t)
+	testWebhooksLookupIP(t)
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	// urls resolving to a loopback, private, link-local, unspecified, "this network" or carrier-grade NAT address are refused
+	for _, url := range []string{"http://127.0.0.1:8080", "http://localhost", "http://[::1]/hook", "http://10.1.2.3", "http://192.168.0.1", "http://[fd00::1]", "http://169.254.169.254/latest", "http://0.0.0.0", "http://0.1.2.3", "http://100.64.1.1", "http://[::ffff:100.127.0.1]", "http://internal.example.com"} {
+		_, err := TibiaWebhooksAddImpl(WebhookRequest{URL: url}, start)
+		assert.Equal(validation.ErrorWebhookURLNotAllowed, err, url)
+	}
+	_, err := TibiaWebhooksAddImpl(WebhookRequest{URL: "https://unknown.example.com"}, start)
+	assert.Equal(validation.ErrorWebhookURLInvalid, err)
+
+	// loopback is allowed with TibiaDataWebhooksAllowLoopback, but not after it is disabled again
+	receiver := newTestWebhookReceiver(t)
+	if _, err := TibiaWebhooksAddImpl(WebhookRequest{URL: receiver.URL}, start); err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(db.Update(func(tx *bolt.Tx) error {
+		return tibiaWebhooksEmit(tx, WebhookEvent{Type: WebhookEventCharacterLevelUp, Character: "Durin", Data: WebhookLevelChange{PreviousLevel: 1, Level: 2}}, start)
+	}))
+
+	// the address is checked again when connecting
+	TibiaDataWebhooksAllowLoopback = false
+	assert.Nil(tibiaWebhooksDeliver(db, tibiaWebhooksClient(), start))
+	assert.Empty(receiver.received)
+
+	deliveriesJson, err := TibiaWebhooksDeliveriesImpl("1", "pending")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(deliveriesJson.WebhookDeliveries.Deliveries, 1) {
+		assert.Contains(deliveriesJson.WebhookDeliveries.Deliveries[0].Error, validation.ErrorWebhookURLNotAllowed.Error())
+	}
+}
+
+func TestWebhooksStoreNotEnabled(t *testing.T) {
+	_, err := TibiaWebhooksImpl()
+	assert.Equal(t, validation.ErrorStoreNotEnabled, err)
+}
@@ -0,0 +1,322 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// TibiaDataWorldsTracked are the worlds whose online players are snapshotted
+	TibiaDataWorldsTracked []string
+
+	// TibiaDataWorldsSnapshotInterval is the time between two snapshots of the online players of a world
+	TibiaDataWorldsSnapshotInterval = 5 * time.Minute
+
+	// TibiaDataWorldsSnapshotRetention is how long snapshots of the online players are kept
+	TibiaDataWorldsSnapshotRetention = 2 * 24 * time.Hour
+)
+
+// tibiaWorldsSnapshotsBucket is the bucket of the store with a bucket of online snapshots per world
+var tibiaWorldsSnapshotsBucket = []byte("worlds_snapshots")
+
+// Child of WorldOnlineDiff
+type WorldOnlineChange struct {
+	Name     string `json:"name"`     // The name of the character.
+	Level    int    `json:"level"`    // The character's level.
+	Vocation string `json:"vocation"` // The character's vocation.
+	Time     string `json:"time"`     // The time of the snapshot the login or logout was seen in.
+}
+
+// Child of WorldOnlineDiff
+type WorldOnlineLevelChange struct {
+	Name          string `json:"name"`           // The name of the character.
+	Vocation      string `json:"vocation"`       // The character's vocation.
+	Level         int    `json:"level"`          // The character's level when it was last seen online in the period.
+	PreviousLevel int    `json:"previous_level"` // The character's level when it was first seen online in the period.
+}
+
+// Child of WorldOnlineDiff
+type WorldOnlineSession struct {
+	Name     string `json:"name"`     // The name of the character.
+	Vocation string `json:"vocation"` // The character's vocation.
+	Login    string `json:"login"`    // The time of the snapshot the login was seen in.
+	Logout   string `json:"logout"`   // The time of the snapshot the logout was seen in.
+	Duration int    `json:"duration"` // The duration of the session in seconds.
+}
+
+// Child of JSONData
+type WorldOnlineDiff struct {
+	World        string                   `json:"world"`         // The name of the world.
+	From         string                   `json:"from"`          // The time of the snapshot the period starts with.
+	To           string                   `json:"to"`            // The time of the snapshot the period ends with.
+	Snapshots    int                      `json:"snapshots"`     // The number of snapshots of the period.
+	LoggedIn     []WorldOnlineChange      `json:"logged_in"`     // The logins seen in the period, in the order they were seen.
+	LoggedOut    []WorldOnlineChange      `json:"logged_out"`    // The logouts seen in the period, in the order they were seen.
+	LevelChanges []WorldOnlineLevelChange `json:"level_changes"` // The characters whose level changed while they were seen online, sorted by name.
+	Sessions     []WorldOnlineSession     `json:"sessions"`      // The sessions whose login and logout were both seen, in the order of their logouts.
+}
+
+// The base includes two levels: WorldOnlineDiff and Information
+type WorldOnlineDiffResponse struct {
+	WorldOnlineDiff WorldOnlineDiff `json:"world_online_diff"`
+	Information     Information     `json:"information"`
+}
+
+// ListEntries returns the sessions
+func (r WorldOnlineDiffResponse) ListEntries() interface{} {
+	return r.WorldOnlineDiff.Sessions
+}
+
+// worldsSnapshot is a stored snapshot of the online players of a world
+type worldsSnapshot struct {
+	Time          time.Time
+	OnlinePlayers []OnlinePlayers
+}
+
+// ParseWorldsTracked func - parses a comma separated list of worlds
+func ParseWorldsTracked(config string) []string {
+	var worlds []string
+	for _, world := range strings.Split(config, ",") {
+		world = strings.TrimSpace(world)
+		if world == "" {
+			continue
+		}
+
+		world = TibiaDataStringWorldFormatToTitle(world)
+		if !slices.Contains(worlds, world) {
+			worlds = append(worlds, world)
+		}
+	}
+
+	return worlds
+}
+
+// runWorldsSnapshots snapshots the online players of the worlds every interval until stop is closed
+// Worlds with a snapshot younger than half the interval (e.g. after a restart) are skipped.
+func runWorldsSnapshots(db *bolt.DB, worlds []string, interval time.Duration, htmlDataCollector func(TibiaDataRequestStruct) (string, error), stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for _, world := range worlds {
+			now := time.Now()
+			if latest, ok := tibiaWorldsLatestSnapshotTime(db, world); ok && now.Sub(latest) < interval/2 {
+				continue
+			}
+
+			if err := tibiaWorldsSnapshot(db, world, htmlDataCollector, now); err != nil {
+				log.Printf("[error] TibiaData API online snapshot of %s failed: %s", world, err)
+			}
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// tibiaWorldsSnapshot fetches the online players of the world and stores them as snapshot of now
+func tibiaWorldsSnapshot(db *bolt.DB, world string, htmlDataCollector func(TibiaDataRequestStruct) (string, error), now time.Time) error {
+	endpoint, err := tibiaWorldsWorldEndpoint(world)
+	if err != nil {
+		return err
+	}
+
+	players, err := tibiaWorldStreamFetch(endpoint, htmlDataCollector)()
+	if err != nil {
+		return err
+	}
+
+	return tibiaWorldsStoreSnapshot(db, world, players, now)
+}
+
+// tibiaWorldsStoreSnapshot stores the online players of a world as snapshot of now
+func tibiaWorldsStoreSnapshot(db *bolt.DB, world string, players []OnlinePlayers, now time.Time) error {
+	value, err := json.Marshal(players)
+	if err != nil {
+		return err
+	}
+
+	return db.Update(func(tx *bolt.Tx) error {
+		root, err := tx.CreateBucketIfNotExists(tibiaWorldsSnapshotsBucket)
+		if err != nil {
+			return err
+		}
+
+		bucket, err := root.CreateBucketIfNotExists([]byte(world))
+		if err != nil {
+			return err
+		}
+
+		if err := bucket.Put(tibiaDataStoreTimeKey(now), value); err != nil {
+			return err
+		}
+
+		return tibiaDataStorePrune(bucket, now, TibiaDataWorldsSnapshotRetention, 0)
+	})
+}
+
+// tibiaWorldsLatestSnapshotTime returns the time of the latest online snapshot of the world
+func tibiaWorldsLatestSnapshotTime(db *bolt.DB, world string) (latest time.Time, ok bool) {
+	_ = db.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket(tibiaWorldsSnapshotsBucket)
+		if root == nil || root.Bucket([]byte(world)) == nil {
+			return nil
+		}
+
+		if key, _ := root.Bucket([]byte(world)).Cursor().Last(); key != nil {
+			latest, ok = tibiaDataStoreKeyTime(key), true
+		}
+		return nil
+	})
+
+	return latest, ok
+}
+
+// tibiaWorldsSnapshotsOfPeriod returns all snapshots of the period
+// The period starts with the latest snapshot at or before from (or the first snapshot after it if there is none).
+func tibiaWorldsSnapshotsOfPeriod(db *bolt.DB, world string, from, to time.Time) ([]worldsSnapshot, error) {
+	var snapshots []worldsSnapshot
+
+	err := db.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket(tibiaWorldsSnapshotsBucket)
+		if root == nil || root.Bucket([]byte(world)) == nil {
+			return validation.ErrorWorldSnapshotsNotEnough
+		}
+
+		cursor := root.Bucket([]byte(world)).Cursor()
+		key, value := cursor.Seek(tibiaDataStoreTimeKey(from))
+		switch {
+		case key == nil:
+			key, value = cursor.Last()
+		case tibiaDataStoreKeyTime(key).After(from):
+			if previousKey, previousValue := cursor.Prev(); previousKey != nil {
+				key, value = previousKey, previousValue
+			} else {
+				key, value = cursor.First()
+			}
+		}
+
+		for ; key != nil && !tibiaDataStoreKeyTime(key).After(to); key, value = cursor.Next() {
+			snapshot := worldsSnapshot{Time: tibiaDataStoreKeyTime(key)}
+			if err := json.Unmarshal(value, &snapshot.OnlinePlayers); err != nil {
+				return err
+			}
+			snapshots = append(snapshots, snapshot)
+		}
+
+		if len(snapshots) < 2 {
+			return validation.ErrorWorldSnapshotsNotEnough
+		}
+		return nil
+	})
+
+	return snapshots, err
+}
+
+// TibiaWorldOnlineDiffImpl func - returns the logins, logouts, level changes and sessions of a tracked world in a period
+func TibiaWorldOnlineDiffImpl(world, from, to, period string) (WorldOnlineDiffResponse, error) {
+	db, err := tibiaDataStoreDB()
+	if err != nil {
+		return WorldOnlineDiffResponse{}, err
+	}
+
+	world = TibiaDataStringWorldFormatToTitle(world)
+	if !slices.Contains(TibiaDataWorldsTracked, world) {
+		return WorldOnlineDiffResponse{}, validation.ErrorWorldNotTracked
+	}
+
+	fromTime, toTime, err := tibiaDataStorePeriod(from, to, period, time.Now())
+	if err != nil {
+		return WorldOnlineDiffResponse{}, err
+	}
+
+	snapshots, err := tibiaWorldsSnapshotsOfPeriod(db, world, fromTime, toTime)
+	if err != nil {
+		return WorldOnlineDiffResponse{}, err
+	}
+
+	return WorldOnlineDiffResponse{
+		WorldOnlineDiff: tibiaWorldsOnlineCompare(world, snapshots),
+		Information:     tibiaDataLocalInformation(),
+	}, nil
+}
+
+// tibiaWorldsOnlineCompare compares consecutive snapshots of a world
+func tibiaWorldsOnlineCompare(world string, snapshots []worldsSnapshot) WorldOnlineDiff {
+	diff := WorldOnlineDiff{
+		World:        world,
+		From:         snapshots[0].Time.Format(time.RFC3339),
+		To:           snapshots[len(snapshots)-1].Time.Format(time.RFC3339),
+		Snapshots:    len(snapshots),
+		LoggedIn:     []WorldOnlineChange{},
+		LoggedOut:    []WorldOnlineChange{},
+		LevelChanges: []WorldOnlineLevelChange{},
+		Sessions:     []WorldOnlineSession{},
+	}
+
+	online := map[string]OnlinePlayers{}
+	logins := map[string]time.Time{} // the logins seen in the period of the online players
+	firstLevels := map[string]int{}
+	lastSeen := map[string]OnlinePlayers{}
+
+	for i, snapshot := range snapshots {
+		// logins and logouts of one snapshot are listed by name
+		players := slices.Clone(snapshot.OnlinePlayers)
+		slices.SortFunc(players, func(a, b OnlinePlayers) int { return strings.Compare(a.Name, b.Name) })
+
+		current := make(map[string]OnlinePlayers, len(players))
+		for _, player := range players {
+			current[player.Name] = player
+			if _, ok := firstLevels[player.Name]; !ok {
+				firstLevels[player.Name] = player.Level
+			}
+			lastSeen[player.Name] = player
+
+			if _, ok := online[player.Name]; !ok && i > 0 {
+				diff.LoggedIn = append(diff.LoggedIn, WorldOnlineChange{Name: player.Name, Level: player.Level, Vocation: player.Vocation, Time: snapshot.Time.Format(time.RFC3339)})
+				logins[player.Name] = snapshot.Time
+			}
+		}
+
+		var loggedOut []OnlinePlayers
+		for name, player := range online {
+			if _, ok := current[name]; !ok {
+				loggedOut = append(loggedOut, player)
+			}
+		}
+		slices.SortFunc(loggedOut, func(a, b OnlinePlayers) int { return strings.Compare(a.Name, b.Name) })
+		for _, player := range loggedOut {
+			diff.LoggedOut = append(diff.LoggedOut, WorldOnlineChange{Name: player.Name, Level: player.Level, Vocation: player.Vocation, Time: snapshot.Time.Format(time.RFC3339)})
+			if login, ok := logins[player.Name]; ok {
+				diff.Sessions = append(diff.Sessions, WorldOnlineSession{
+					Name:     player.Name,
+					Vocation: player.Vocation,
+					Login:    login.Format(time.RFC3339),
+					Logout:   snapshot.Time.Format(time.RFC3339),
+					Duration: int(snapshot.Time.Sub(login).Seconds()),
+				})
+				delete(logins, player.Name)
+			}
+		}
+
+		online = current
+	}
+
+	for name, player := range lastSeen {
+		if player.Level != firstLevels[name] {
+			diff.LevelChanges = append(diff.LevelChanges, WorldOnlineLevelChange{Name: name, Vocation: player.Vocation, Level: player.Level, PreviousLevel: firstLevels[name]})
+		}
+	}
+	slices.SortFunc(diff.LevelChanges, func(a, b WorldOnlineLevelChange) int { return strings.Compare(a.Name, b.Name) })
+
+	return diff
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+func TestWorldsOnlineDiff(t *testing.T) {
+	assert := assert.New(t)
+	db := testStore(t)
+
+	defer func(worlds []string) { TibiaDataWorldsTracked = worlds }(TibiaDataWorldsTracked)
+	TibiaDataWorldsTracked = []string{"Antica"}
+
+	// Durin logs out, Bubble plays a session of ten minutes and Trollefar gains a level
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	for i, players := range [][]OnlinePlayers{
+		{{Name: "Trollefar", Level: 100, Vocation: "Knight"}, {Name: "Durin", Level: 50, Vocation: "Druid"}},
+		{{Name: "Trollefar", Level: 100, Vocation: "Knight"}, {Name: "Bubble", Level: 8, Vocation: "None"}},
+		{{Name: "Trollefar", Level: 101, Vocation: "Knight"}, {Name: "Bubble", Level: 9, Vocation: "None"}, {Name: "Arieswar", Level: 20, Vocation: "Sorcerer"}},
+		{{Name: "Trollefar", Level: 101, Vocation: "Knight"}, {Name: "Arieswar", Level: 20, Vocation: "Sorcerer"}},
+	} {
+		assert.Nil(tibiaWorldsStoreSnapshot(db, "Antica", players, start.Add(time.Duration(i)*5*time.Minute)))
+	}
+
+	latest, ok := tibiaWorldsLatestSnapshotTime(db, "Antica")
+	assert.True(ok)
+	assert.Equal(start.Add(15*time.Minute), latest)
+
+	diffJson, err := TibiaWorldOnlineDiffImpl("antica", "2025-01-01T10:00:00Z", "2025-01-01T11:00:00Z", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff := diffJson.WorldOnlineDiff
+	assert.Equal("Antica", diff.World)
+	assert.Equal("2025-01-01T10:00:00Z", diff.From)
+	assert.Equal("2025-01-01T10:15:00Z", diff.To)
+	assert.Equal(4, diff.Snapshots)
+	assert.Equal([]WorldOnlineChange{
+		{Name: "Bubble", Level: 8, Vocation: "None", Time: "2025-01-01T10:05:00Z"},
+		{Name: "Arieswar", Level: 20, Vocation: "Sorcerer", Time: "2025-01-01T10:10:00Z"},
+	}, diff.LoggedIn)
+	assert.Equal([]WorldOnlineChange{
+		{Name: "Durin", Level: 50, Vocation: "Druid", Time: "2025-01-01T10:05:00Z"},
+		{Name: "Bubble", Level: 9, Vocation: "None", Time: "2025-01-01T10:15:00Z"},
+	}, diff.LoggedOut)
+	assert.Equal([]WorldOnlineLevelChange{
+		{Name: "Bubble", Vocation: "None", Level: 9, PreviousLevel: 8},
+		{Name: "Trollefar", Vocation: "Knight", Level: 101, PreviousLevel: 100},
+	}, diff.LevelChanges)
+	assert.Equal([]WorldOnlineSession{
+		{Name: "Bubble", Vocation: "None", Login: "2025-01-01T10:05:00Z", Logout: "2025-01-01T10:15:00Z", Duration: 600},
+	}, diff.Sessions)
+
+	// the period starts with the latest snapshot before it
+	diffJson, err = TibiaWorldOnlineDiffImpl("Antica", "2025-01-01T10:12:00Z", "2025-01-01T10:20:00Z", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal("2025-01-01T10:10:00Z", diffJson.WorldOnlineDiff.From)
+	assert.Equal(2, diffJson.WorldOnlineDiff.Snapshots)
+	assert.Empty(diffJson.WorldOnlineDiff.LoggedIn)
+	assert.Empty(diffJson.WorldOnlineDiff.Sessions)
+	assert.Len(diffJson.WorldOnlineDiff.LoggedOut, 1)
+
+	_, err = TibiaWorldOnlineDiffImpl("Antica", "2025-01-01T10:20:00Z", "2025-01-01T11:00:00Z", "")
+	assert.Equal(validation.ErrorWorldSnapshotsNotEnough, err)
+	_, err = TibiaWorldOnlineDiffImpl("Antica", "", "", "week")
+	assert.Equal(validation.ErrorWorldSnapshotsNotEnough, err)
+	_, err = TibiaWorldOnlineDiffImpl("Secura", "", "", "")
+	assert.Equal(validation.ErrorWorldNotTracked, err)
+	_, err = TibiaWorldOnlineDiffImpl("Antica", "", "", "year")
+	assert.Equal(validation.ErrorPeriodInvalid, err)
+}
+
+func TestWorldsOnlineDiffStoreNotEnabled(t *testing.T) {
+	_, err := TibiaWorldOnlineDiffImpl("Antica", "", "", "")
+	assert.Equal(t, validation.ErrorStoreNotEnabled, err)
+}
+
+func TestParseWorldsTracked(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(ParseWorldsTracked(""))
+	assert.Equal([]string{"Antica", "Secura"}, ParseWorldsTracked(" antica, SECURA ,,Antica"))
+}
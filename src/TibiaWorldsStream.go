@@ -0,0 +1,363 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+var (
+	// TibiaDataWorldStreamInterval is the time between two polls of a streamed world
+	TibiaDataWorldStreamInterval = 30 * time.Second
+
+	// TibiaDataWorldStreamReplaySize is the number of events of a world that are kept for reconnecting clients
+	TibiaDataWorldStreamReplaySize = 1000
+
+	// TibiaDataWorldStreamIdle is how long a world is still polled after its last client disconnected
+	// Clients reconnecting within it can resume with the ID of their last event.
+	TibiaDataWorldStreamIdle = 5 * time.Minute
+)
+
+const (
+	// tibiaWorldStreamClientBuffer is the number of events that are buffered for a client, slower clients are disconnected
+	tibiaWorldStreamClientBuffer = 1024
+
+	// tibiaWorldStreamKeepAlive is the time between two keep-alive messages to the clients
+	tibiaWorldStreamKeepAlive = 15 * time.Second
+)
+
+// The event types of the world streams
+const (
+	WorldStreamEventSnapshot    = "snapshot"
+	WorldStreamEventLogin       = "login"
+	WorldStreamEventLogout      = "logout"
+	WorldStreamEventLevelChange = "level_change"
+)
+
+// WorldStreamEvent is an event of a world stream
+type WorldStreamEvent struct {
+	ID            int64           `json:"id"`                       // The ID of the event, the ID of the latest event for snapshots.
+	Type          string          `json:"type"`                     // The type of the event (snapshot, login, logout or level_change).
+	Time          string          `json:"time"`                     // The time of the poll the event was seen in.
+	World         string          `json:"world"`                    // The name of the world.
+	Name          string          `json:"name,omitempty"`           // The name of the character.
+	Level         int             `json:"level,omitempty"`          // The character's level.
+	PreviousLevel int             `json:"previous_level,omitempty"` // The character's level before (level_change).
+	Vocation      string          `json:"vocation,omitempty"`       // The character's vocation.
+	Players       []OnlinePlayers `json:"players,omitempty"`        // All online players (snapshot).
+}
+
+// tibiaWorldStream polls the online list of one world once for all of its clients
+type tibiaWorldStream struct {
+	world string
+	fetch func() ([]OnlinePlayers, error)
+
+	mu       sync.Mutex
+	online   map[string]OnlinePlayers // nil until the first poll succeeded
+	polled   time.Time
+	events   []WorldStreamEvent // the latest events, their IDs are consecutive
+	lastID   int64
+	clients  map[chan WorldStreamEvent]bool // whether the client still waits for its snapshot
+	lastLeft time.Time
+}
+
+// tibiaWorldStreams are the running world streams by world name
+var tibiaWorldStreams = struct {
+	sync.Mutex
+	streams map[string]*tibiaWorldStream
+}{streams: map[string]*tibiaWorldStream{}}
+
+// tibiaWorldStreamFetch returns the fetch of the online players of a world endpoint
+func tibiaWorldStreamFetch(endpoint tibiaDataEndpoint, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) func() ([]OnlinePlayers, error) {
+	return func() ([]OnlinePlayers, error) {
+		BoxContentHTML, err := htmlDataCollector(endpoint.Request)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := endpoint.Parse(BoxContentHTML)
+		if err != nil {
+			return nil, err
+		}
+		return response.(WorldResponse).World.OnlinePlayers, nil
+	}
+}
+
+// tibiaWorldStreamSubscribe subscribes to the stream of a world, which is started if it is not running yet
+// Events after lastEventID are replayed if they are still kept, otherwise the client starts with a snapshot.
+// The returned channel is closed if the client is too slow, unsubscribe must be called when the client is done.
+func tibiaWorldStreamSubscribe(world string, fetch func() ([]OnlinePlayers, error), lastEventID string, now time.Time) (<-chan WorldStreamEvent, func()) {
+	tibiaWorldStreams.Lock()
+	stream, ok := tibiaWorldStreams.streams[world]
+	if !ok {
+		stream = &tibiaWorldStream{
+			world:   world,
+			fetch:   fetch,
+			lastID:  now.UnixMicro(), // keeps the IDs increasing when a stream is started again
+			clients: map[chan WorldStreamEvent]bool{},
+		}
+		tibiaWorldStreams.streams[world] = stream
+		go stream.run()
+	}
+
+	client := make(chan WorldStreamEvent, tibiaWorldStreamClientBuffer)
+	stream.mu.Lock()
+	stream.clients[client] = !stream.replay(client, lastEventID)
+	stream.mu.Unlock()
+	tibiaWorldStreams.Unlock()
+
+	return client, func() {
+		stream.mu.Lock()
+		defer stream.mu.Unlock()
+		if _, ok := stream.clients[client]; ok {
+			delete(stream.clients, client)
+			close(client)
+		}
+		stream.lastLeft = time.Now()
+	}
+}
+
+// replay sends the events after lastEventID or a snapshot to a new client and returns whether it is up to date
+func (s *tibiaWorldStream) replay(client chan WorldStreamEvent, lastEventID string) bool {
+	if s.online == nil {
+		return false
+	}
+
+	last, err := strconv.ParseInt(lastEventID, 10, 64)
+	if oldest := s.lastID - int64(len(s.events)); err != nil || last < oldest || last > s.lastID {
+		client <- s.snapshot()
+		return true
+	}
+
+	for _, event := range s.events[len(s.events)-int(s.lastID-last):] {
+		select {
+		case client <- event:
+		default:
+			// more events are missing than a client can buffer
+			for len(client) > 0 {
+				<-client
+			}
+			client <- s.snapshot()
+			return true
+		}
+	}
+	return true
+}
+
+// snapshot returns the snapshot event of the current online players sorted by name
+func (s *tibiaWorldStream) snapshot() WorldStreamEvent {
+	players := make([]OnlinePlayers, 0, len(s.online))
+	for _, player := range s.online {
+		players = append(players, player)
+	}
+	slices.SortFunc(players, func(a, b OnlinePlayers) int { return strings.Compare(a.Name, b.Name) })
+
+	return WorldStreamEvent{
+		ID:      s.lastID,
+		Type:    WorldStreamEventSnapshot,
+		Time:    s.polled.UTC().Format(time.RFC3339),
+		World:   s.world,
+		Players: players,
+	}
+}
+
+// run polls the world every TibiaDataWorldStreamInterval until it had no clients for TibiaDataWorldStreamIdle
+func (s *tibiaWorldStream) run() {
+	ticker := time.NewTicker(max(TibiaDataWorldStreamInterval, time.Second))
+	defer ticker.Stop()
+
+	for {
+		players, err := s.fetch()
+		if err != nil {
+			log.Printf("[error] TibiaData API world stream of %s failed: %s", s.world, err)
+		} else {
+			s.update(players, time.Now())
+		}
+
+		<-ticker.C
+		if s.stopIdle(time.Now()) {
+			return
+		}
+	}
+}
+
+// stopIdle removes the stream if it had no clients for TibiaDataWorldStreamIdle and returns whether it did
+func (s *tibiaWorldStream) stopIdle(now time.Time) bool {
+	tibiaWorldStreams.Lock()
+	defer tibiaWorldStreams.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.clients) > 0 || now.Sub(s.lastLeft) < TibiaDataWorldStreamIdle {
+		return false
+	}
+	delete(tibiaWorldStreams.streams, s.world)
+	return true
+}
+
+// update compares a poll with the previous one and sends the logins, logouts and level changes to all clients
+// The first poll only sends the snapshots.
+func (s *tibiaWorldStream) update(players []OnlinePlayers, now time.Time) {
+	online := make(map[string]OnlinePlayers, len(players))
+	for _, player := range players {
+		online[player.Name] = player
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var events []WorldStreamEvent
+	if s.online != nil {
+		for _, player := range players {
+			previous, ok := s.online[player.Name]
+			switch {
+			case !ok:
+				events = append(events, WorldStreamEvent{Type: WorldStreamEventLogin, Name: player.Name, Level: player.Level, Vocation: player.Vocation})
+			case previous.Level != player.Level:
+				events = append(events, WorldStreamEvent{Type: WorldStreamEventLevelChange, Name: player.Name, Level: player.Level, PreviousLevel: previous.Level, Vocation: player.Vocation})
+			}
+		}
+		for _, player := range s.online {
+			if _, ok := online[player.Name]; !ok {
+				events = append(events, WorldStreamEvent{Type: WorldStreamEventLogout, Name: player.Name, Level: player.Level, Vocation: player.Vocation})
+			}
+		}
+		slices.SortStableFunc(events, func(a, b WorldStreamEvent) int { return strings.Compare(a.Name, b.Name) })
+	}
+	s.online = online
+	s.polled = now
+
+	for i := range events {
+		s.lastID++
+		events[i].ID = s.lastID
+		events[i].Time = now.UTC().Format(time.RFC3339)
+		events[i].World = s.world
+	}
+	s.events = append(s.events, events...)
+	if excess := len(s.events) - TibiaDataWorldStreamReplaySize; excess > 0 {
+		s.events = slices.Clone(s.events[excess:])
+	}
+
+	for client, waiting := range s.clients {
+		if waiting {
+			s.send(client, s.snapshot())
+			s.clients[client] = false
+			continue
+		}
+		for _, event := range events {
+			if !s.send(client, event) {
+				break
+			}
+		}
+	}
+}
+
+// send sends an event to a client without blocking and disconnects the client if its buffer is full
+func (s *tibiaWorldStream) send(client chan WorldStreamEvent, event WorldStreamEvent) bool {
+	select {
+	case client <- event:
+		return true
+	default:
+		delete(s.clients, client)
+		close(client)
+		s.lastLeft = time.Now()
+		return false
+	}
+}
+
+// tibiaWorldStreamSSE writes the events of a stream as server-sent events until the client disconnects
+func tibiaWorldStreamSSE(ctx context.Context, w http.ResponseWriter, events <-chan WorldStreamEvent) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+
+	keepAlive := time.NewTicker(tibiaWorldStreamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		var err error
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			_, err = io.WriteString(w, ": keep-alive\n\n")
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			err = tibiaWorldStreamWriteSSE(w, event)
+		}
+		if err != nil {
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
+
+// tibiaWorldStreamWriteSSE writes one server-sent event
+func tibiaWorldStreamWriteSSE(w io.Writer, event WorldStreamEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	return err
+}
+
+// tibiaWorldStreamUpgrader upgrades the websocket connections of the world streams
+// The streams are public and read-only, so connections from all origins are allowed.
+var tibiaWorldStreamUpgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// tibiaWorldStreamWebSocket writes the events of a stream as json messages until the client disconnects
+func tibiaWorldStreamWebSocket(conn *websocket.Conn, events <-chan WorldStreamEvent) {
+	defer conn.Close()
+
+	// messages of the client are discarded, reading is needed to notice the close
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	keepAlive := time.NewTicker(tibiaWorldStreamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		var err error
+		select {
+		case <-closed:
+			return
+		case <-keepAlive.C:
+			err = conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(tibiaWorldStreamKeepAlive))
+		case event, ok := <-events:
+			if !ok {
+				_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "too slow"), time.Now().Add(time.Second))
+				return
+			}
+			err = conn.WriteJSON(event)
+		}
+		if err != nil {
+			return
+		}
+	}
+}
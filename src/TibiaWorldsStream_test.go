@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorldStreamUpdate(t *testing.T) {
+	assert := assert.New(t)
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	stream := &tibiaWorldStream{world: "Antica", lastID: 100, clients: map[chan WorldStreamEvent]bool{}}
+	client := make(chan WorldStreamEvent, 10)
+	stream.clients[client] = !stream.replay(client, "")
+	assert.Empty(client)
+
+	// the first poll only sends the snapshot
+	stream.update([]OnlinePlayers{{Name: "Trollefar", Level: 100, Vocation: "Knight"}, {Name: "Durin", Level: 50, Vocation: "Druid"}}, start)
+	if assert.Len(client, 1) {
+		assert.Equal(WorldStreamEvent{ID: 100, Type: WorldStreamEventSnapshot, Time: "2025-01-01T10:00:00Z", World: "Antica", Players: []OnlinePlayers{
+			{Name: "Durin", Level: 50, Vocation: "Druid"},
+			{Name: "Trollefar", Level: 100, Vocation: "Knight"},
+		}}, <-client)
+	}
+
+	stream.update([]OnlinePlayers{{Name: "Trollefar", Level: 101, Vocation: "Knight"}, {Name: "Bubble", Level: 8, Vocation: "None"}}, start.Add(time.Minute))
+	if assert.Len(client, 3) {
+		assert.Equal(WorldStreamEvent{ID: 101, Type: WorldStreamEventLogin, Time: "2025-01-01T10:01:00Z", World: "Antica", Name: "Bubble", Level: 8, Vocation: "None"}, <-client)
+		assert.Equal(WorldStreamEvent{ID: 102, Type: WorldStreamEventLogout, Time: "2025-01-01T10:01:00Z", World: "Antica", Name: "Durin", Level: 50, Vocation: "Druid"}, <-client)
+		assert.Equal(WorldStreamEvent{ID: 103, Type: WorldStreamEventLevelChange, Time: "2025-01-01T10:01:00Z", World: "Antica", Name: "Trollefar", Level: 101, PreviousLevel: 100, Vocation: "Knight"}, <-client)
+	}
+
+	// reconnecting clients get the events they missed
+	resumed := make(chan WorldStreamEvent, 10)
+	assert.True(stream.replay(resumed, "101"))
+	if assert.Len(resumed, 2) {
+		assert.Equal(int64(102), (<-resumed).ID)
+		assert.Equal(int64(103), (<-resumed).ID)
+	}
+	assert.True(stream.replay(resumed, "103"))
+	assert.Empty(resumed)
+
+	// clients with unknown event IDs start with a snapshot
+	for _, lastEventID := range []string{"", "abc", "99", "104"} {
+		assert.True(stream.replay(resumed, lastEventID))
+		if assert.Len(resumed, 1) {
+			snapshot := <-resumed
+			assert.Equal(WorldStreamEventSnapshot, snapshot.Type)
+			assert.Equal(int64(103), snapshot.ID)
+			assert.Len(snapshot.Players, 2)
+		}
+	}
+}
+
+func TestWorldStreamReplaySize(t *testing.T) {
+	assert := assert.New(t)
+
+	defer func(size int) { TibiaDataWorldStreamReplaySize = size }(TibiaDataWorldStreamReplaySize)
+	TibiaDataWorldStreamReplaySize = 2
+
+	stream := &tibiaWorldStream{world: "Antica", clients: map[chan WorldStreamEvent]bool{}}
+	stream.update(nil, time.Now())
+	stream.update([]OnlinePlayers{{Name: "A"}, {Name: "B"}, {Name: "C"}}, time.Now())
+	assert.Equal(int64(3), stream.lastID)
+	if assert.Len(stream.events, 2) {
+		assert.Equal("B", stream.events[0].Name)
+	}
+
+	client := make(chan WorldStreamEvent, 10)
+	assert.True(stream.replay(client, "1"))
+	assert.Len(client, 2)
+	client = make(chan WorldStreamEvent, 10)
+	assert.True(stream.replay(client, "0"))
+	assert.Equal(WorldStreamEventSnapshot, (<-client).Type)
+}
+
+func TestWorldStreamSlowClient(t *testing.T) {
+	assert := assert.New(t)
+
+	stream := &tibiaWorldStream{world: "Antica", clients: map[chan WorldStreamEvent]bool{}}
+	stream.update(nil, time.Now())
+
+	client := make(chan WorldStreamEvent, 1)
+	stream.clients[client] = false
+	stream.update([]OnlinePlayers{{Name: "A"}, {Name: "B"}}, time.Now())
+
+	assert.Empty(stream.clients)
+	assert.Equal("A", (<-client).Name)
+	_, ok := <-client
+	assert.False(ok)
+}
+
+// testWorldStreamFetch returns a fetch of fixed online players that counts its calls
+func testWorldStreamFetch(calls *int32) func() ([]OnlinePlayers, error) {
+	return func() ([]OnlinePlayers, error) {
+		atomic.AddInt32(calls, 1)
+		return []OnlinePlayers{{Name: "Trollefar", Level: 100, Vocation: "Knight"}}, nil
+	}
+}
+
+func TestWorldStreamSSE(t *testing.T) {
+	assert := assert.New(t)
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		events, unsubscribe := tibiaWorldStreamSubscribe("Streamtest", testWorldStreamFetch(&calls), r.Header.Get("Last-Event-ID"), time.Now())
+		defer unsubscribe()
+		tibiaWorldStreamSSE(r.Context(), w, events)
+	}))
+	defer server.Close()
+
+	// both clients share one poll of the world
+	for i := 0; i < 2; i++ {
+		resp, err := http.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal("text/event-stream", resp.Header.Get("Content-Type"))
+
+		reader := bufio.NewReader(resp.Body)
+		var lines []string
+		for len(lines) < 3 {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				t.Fatal(err)
+			}
+			lines = append(lines, strings.TrimSuffix(line, "\n"))
+		}
+		resp.Body.Close()
+
+		assert.True(strings.HasPrefix(lines[0], "id: "))
+		assert.Equal("event: snapshot", lines[1])
+
+		var event WorldStreamEvent
+		assert.Nil(json.Unmarshal([]byte(strings.TrimPrefix(lines[2], "data: ")), &event))
+		assert.Equal(lines[0], "id: "+strconv.FormatInt(event.ID, 10))
+		assert.Equal("Streamtest", event.World)
+		assert.Equal([]OnlinePlayers{{Name: "Trollefar", Level: 100, Vocation: "Knight"}}, event.Players)
+	}
+	assert.Equal(int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestWorldStreamWebSocket(t *testing.T) {
+	assert := assert.New(t)
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := tibiaWorldStreamUpgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		events, unsubscribe := tibiaWorldStreamSubscribe("Websockettest", testWorldStreamFetch(&calls), r.URL.Query().Get("last_event_id"), time.Now())
+		defer unsubscribe()
+		tibiaWorldStreamWebSocket(conn, events)
+	}))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	var event WorldStreamEvent
+	assert.Nil(conn.ReadJSON(&event))
+	assert.Equal(WorldStreamEventSnapshot, event.Type)
+	assert.Equal("Websockettest", event.World)
+	assert.Len(event.Players, 1)
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// tibiaDataCache is a concurrency safe in-memory cache whose entries expire after ttl
+// Expired entries are removed when new entries are set, at most once per ttl.
+type tibiaDataCache[T any] struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	entries   map[string]tibiaDataCacheEntry[T]
+	calls     map[string]*tibiaDataCacheCall[T]
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type tibiaDataCacheEntry[T any] struct {
+	value   T
+	expires time.Time
+}
+
+// tibiaDataCacheCall is a running fetch of Fetch, done is closed when value and err are set
+type tibiaDataCacheCall[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+// newTibiaDataCache returns an empty cache, a ttl of zero or less disables the cache
+func newTibiaDataCache[T any](ttl time.Duration) *tibiaDataCache[T] {
+	return &tibiaDataCache[T]{
+		ttl:     ttl,
+		entries: map[string]tibiaDataCacheEntry[T]{},
+		calls:   map[string]*tibiaDataCacheCall[T]{},
+		now:     time.Now,
+	}
+}
+
+// Get returns the value of key if it is cached and not expired
+func (c *tibiaDataCache[T]) Get(key string) (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, exists := c.entries[key]
+	if !exists || !c.now().Before(entry.expires) {
+		var zero T
+		return zero, false
+	}
+
+	return entry.value, true
+}
+
+// Fetch returns the value of key if it is cached and not expired, otherwise the value returned by fetch
+// Concurrent callers of a key that is not cached wait for one fetch instead of fetching the value themselves,
+// its value is cached unless it failed. Callers wait again for a fetch that ended with the context of its caller.
+func (c *tibiaDataCache[T]) Fetch(key string, fetch func() (T, error)) (T, error) {
+	for {
+		c.mu.Lock()
+		if entry, exists := c.entries[key]; exists && c.now().Before(entry.expires) {
+			c.mu.Unlock()
+			return entry.value, nil
+		}
+
+		call, running := c.calls[key]
+		if !running {
+			call = &tibiaDataCacheCall[T]{done: make(chan struct{})}
+			c.calls[key] = call
+		}
+		c.mu.Unlock()
+
+		if running {
+			<-call.done
+			if errors.Is(call.err, context.Canceled) || errors.Is(call.err, context.DeadlineExceeded) {
+				continue
+			}
+			return call.value, call.err
+		}
+
+		func() {
+			defer func() {
+				c.mu.Lock()
+				delete(c.calls, key)
+				c.mu.Unlock()
+				close(call.done)
+			}()
+
+			call.value, call.err = fetch()
+			if call.err == nil {
+				c.Set(key, call.value)
+			}
+		}()
+
+		return call.value, call.err
+	}
+}
+
+// Set caches the value of key for ttl
+func (c *tibiaDataCache[T]) Set(key string, value T) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if now.Sub(c.lastSweep) >= c.ttl {
+		for k, entry := range c.entries {
+			if !now.Before(entry.expires) {
+				delete(c.entries, k)
+			}
+		}
+		c.lastSweep = now
+	}
+
+	c.entries[key] = tibiaDataCacheEntry[T]{value: value, expires: now.Add(c.ttl)}
+}
+
+// SetTTL changes the ttl of entries set from now on
+func (c *tibiaDataCache[T]) SetTTL(ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ttl = ttl
+}
+
+// Len returns the number of entries, including expired entries that were not removed yet
+func (c *tibiaDataCache[T]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
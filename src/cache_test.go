@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTibiaDataCache(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	cache := newTibiaDataCache[int](time.Minute)
+	cache.now = func() time.Time { return now }
+
+	_, ok := cache.Get("a")
+	assert.False(ok)
+
+	cache.Set("a", 1)
+	value, ok := cache.Get("a")
+	assert.True(ok)
+	assert.Equal(1, value)
+
+	now = now.Add(30 * time.Second)
+	cache.Set("b", 2)
+
+	// a expires, b is still cached
+	now = now.Add(30 * time.Second)
+	_, ok = cache.Get("a")
+	assert.False(ok)
+	value, ok = cache.Get("b")
+	assert.True(ok)
+	assert.Equal(2, value)
+
+	// expired entries are removed on the next sweep
+	assert.Equal(2, cache.Len())
+	cache.Set("c", 3)
+	assert.Equal(2, cache.Len())
+}
+
+func TestTibiaDataCacheDisabled(t *testing.T) {
+	assert := assert.New(t)
+
+	cache := newTibiaDataCache[string](0)
+	cache.Set("a", "value")
+
+	_, ok := cache.Get("a")
+	assert.False(ok)
+	assert.Equal(0, cache.Len())
+}
+
+func TestTibiaDataCacheFetch(t *testing.T) {
+	assert := assert.New(t)
+
+	cache := newTibiaDataCache[int](time.Minute)
+
+	// concurrent callers of a key share one fetch
+	var (
+		fetches atomic.Int32
+		wg      sync.WaitGroup
+	)
+	release := make(chan struct{})
+	values := make([]int, 5)
+	for i := range values {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			values[i], _ = cache.Fetch("a", func() (int, error) {
+				fetches.Add(1)
+				<-release
+				return 1, nil
+			})
+		}(i)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(int32(1), fetches.Load())
+	assert.Equal([]int{1, 1, 1, 1, 1}, values)
+
+	// the value is cached
+	value, err := cache.Fetch("a", func() (int, error) { return 2, nil })
+	assert.Nil(err)
+	assert.Equal(1, value)
+
+	// failed fetches are not cached
+	_, err = cache.Fetch("b", func() (int, error) { return 0, errors.New("failed") })
+	assert.EqualError(err, "failed")
+	value, err = cache.Fetch("b", func() (int, error) { return 3, nil })
+	assert.Nil(err)
+	assert.Equal(3, value)
+}
+
+func TestTibiaDataCacheFetchCancelled(t *testing.T) {
+	assert := assert.New(t)
+
+	cache := newTibiaDataCache[int](time.Minute)
+
+	// a caller waiting for a fetch ended by the context of its caller fetches again
+	started := make(chan struct{})
+	release := make(chan struct{})
+	go func() {
+		_, _ = cache.Fetch("a", func() (int, error) {
+			close(started)
+			<-release
+			return 0, context.Canceled
+		})
+	}()
+	<-started
+
+	done := make(chan struct{})
+	var (
+		value int
+		err   error
+	)
+	go func() {
+		defer close(done)
+		value, err = cache.Fetch("a", func() (int, error) { return 1, nil })
+	}()
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	<-done
+
+	assert.Nil(err)
+	assert.Equal(1, value)
+}
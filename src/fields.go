@@ -0,0 +1,166 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+// fieldsTree is a tree of selected json keys built from the fields query parameter
+// a node without children means that the whole value below it is selected
+type fieldsTree map[string]fieldsTree
+
+// TibiaDataParseFields func - parses a comma separated list of dot-paths into a fieldsTree
+func TibiaDataParseFields(fields string) (fieldsTree, error) {
+	tree := fieldsTree{}
+
+	for _, path := range strings.Split(fields, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			return nil, validation.ErrorFieldsPathInvalid
+		}
+
+		node := tree
+		keys := strings.Split(path, ".")
+		for i, key := range keys {
+			if key == "" {
+				return nil, validation.ErrorFieldsPathInvalid
+			}
+
+			child, exists := node[key]
+			if exists && child == nil {
+				// a parent of this path is already selected as a whole
+				break
+			}
+
+			if i == len(keys)-1 {
+				// selecting the whole value overrides narrower paths
+				node[key] = nil
+				break
+			}
+
+			if !exists {
+				child = fieldsTree{}
+				node[key] = child
+			}
+			node = child
+		}
+	}
+
+	return tree, nil
+}
+
+// validate reports whether all paths of the tree exist in the json representation of t
+func (tree fieldsTree) validate(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		for key, child := range tree {
+			field, found := jsonFieldByName(t, key)
+			if !found {
+				return false
+			}
+			if child != nil && !child.validate(field.Type) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map, reflect.Interface:
+		// keys of maps and interfaces are only known during runtime
+		return true
+	default:
+		// a scalar value has no keys to select from
+		return len(tree) == 0
+	}
+}
+
+// jsonFieldByName returns the struct field that is encoded with the given json key
+func jsonFieldByName(t reflect.Type, key string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if name, ok := jsonFieldName(field); ok && name == key {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+// jsonFieldName returns the json key of a struct field and whether it is encoded at all
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	if !field.IsExported() {
+		return "", false
+	}
+
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return "", false
+	case "":
+		return field.Name, true
+	}
+
+	return name, true
+}
+
+// prune returns a copy of the decoded json value only containing the selected keys
+func (tree fieldsTree) prune(data interface{}) interface{} {
+	if tree == nil {
+		return data
+	}
+
+	switch v := data.(type) {
+	case map[string]interface{}:
+		output := make(map[string]interface{}, len(tree))
+		for key, child := range tree {
+			if value, exists := v[key]; exists {
+				output[key] = child.prune(value)
+			}
+		}
+		return output
+	case []interface{}:
+		output := make([]interface{}, len(v))
+		for i, value := range v {
+			output[i] = tree.prune(value)
+		}
+		return output
+	default:
+		return data
+	}
+}
+
+// TibiaDataFilterFields func - reduces the json output of data to the paths given in fields
+// The information block is always kept, so that the status of the response is still visible
+func TibiaDataFilterFields(data interface{}, fields string) (interface{}, error) {
+	tree, err := TibiaDataParseFields(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if !tree.validate(reflect.TypeOf(data)) {
+		return nil, validation.ErrorFieldsPathInvalid
+	}
+
+	tree["information"] = nil
+
+	js, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// using json.Number to not lose precision on big values (e.g. experience)
+	var decoded interface{}
+	decoder := json.NewDecoder(bytes.NewReader(js))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	return tree.prune(decoded), nil
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/static"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+func TestFieldsParse(t *testing.T) {
+	assert := assert.New(t)
+
+	tree, err := TibiaDataParseFields("character.character.level, character.deaths,character.character")
+	assert.Nil(err)
+	assert.Equal(fieldsTree{"character": {"character": nil, "deaths": nil}}, tree)
+
+	tree, err = TibiaDataParseFields("world.online_players,world.online_players.name")
+	assert.Nil(err)
+	assert.Equal(fieldsTree{"world": {"online_players": nil}}, tree)
+
+	for _, fields := range []string{",", "world..name", "world.", " "} {
+		_, err = TibiaDataParseFields(fields)
+		assert.Equal(validation.ErrorFieldsPathInvalid, err, fields)
+	}
+}
+
+func TestFieldsFilterCharacter(t *testing.T) {
+	file, err := static.TestFiles.Open("testdata/characters/Darkside Rafa.html")
+	if err != nil {
+		t.Fatalf("file opening error: %s", err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		t.Fatalf("File reading error: %s", err)
+	}
+
+	characterJson, err := TibiaCharactersCharacterImpl(string(data), "https://www.tibia.com/community/?subtopic=characters&name=Darkside+Rafa")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert := assert.New(t)
+
+	filtered, err := TibiaDataFilterFields(characterJson, "character.character.level,character.character.vocation,character.deaths.level")
+	assert.Nil(err)
+
+	js, err := json.Marshal(filtered)
+	assert.Nil(err)
+
+	var output struct {
+		Character   map[string]json.RawMessage `json:"character"`
+		Information Information                `json:"information"`
+	}
+	assert.Nil(json.Unmarshal(js, &output))
+
+	assert.Len(output.Character, 2)
+	assert.JSONEq(`{"level":790,"vocation":"Elite Knight"}`, string(output.Character["character"]))
+
+	var deaths []map[string]interface{}
+	assert.Nil(json.Unmarshal(output.Character["deaths"], &deaths))
+	assert.Equal(len(characterJson.Character.Deaths), len(deaths))
+	for _, death := range deaths {
+		assert.Len(death, 1)
+		assert.Contains(death, "level")
+	}
+
+	assert.Equal("https://www.tibia.com/community/?subtopic=characters&name=Darkside+Rafa", output.Information.TibiaURLs[0])
+
+	_, err = TibiaDataFilterFields(characterJson, "character.character.levels")
+	assert.Equal(validation.ErrorFieldsPathInvalid, err)
+
+	_, err = TibiaDataFilterFields(characterJson, "character.character.level.value")
+	assert.Equal(validation.ErrorFieldsPathInvalid, err)
+}
+
+func TestFieldsFilterKeepsBigNumbers(t *testing.T) {
+	assert := assert.New(t)
+
+	filtered, err := TibiaDataFilterFields(HighscoresResponse{
+		Highscores: Highscores{
+			HighscoreList: []Highscore{{Name: "Goraca", Value: 176271164607}},
+		},
+	}, "highscores.highscore_list.value")
+	assert.Nil(err)
+
+	js, err := json.Marshal(filtered)
+	assert.Nil(err)
+	assert.Contains(string(js), `"highscores":{"highscore_list":[{"value":176271164607}]}`)
+}
+
+func TestFieldsHandleResponse(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	assert := assert.New(t)
+
+	type test struct {
+		T string `json:"t"`
+		U string `json:"u"`
+	}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v4/test?fields=u", nil)
+
+	TibiaDataAPIHandleResponse(c, "", test{T: "abc", U: "def"})
+	assert.Equal(http.StatusOK, w.Code)
+	assert.JSONEq(`{"u":"def"}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v4/test?fields=v", nil)
+
+	TibiaDataAPIHandleResponse(c, "", test{T: "abc", U: "def"})
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Contains(w.Body.String(), `"error":9003`)
+}
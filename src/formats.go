@@ -0,0 +1,324 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/proto"
+)
+
+// Output formats that can be requested through the format query parameter
+const (
+	TibiaDataFormatJSON     = "json"
+	TibiaDataFormatCSV      = "csv"
+	TibiaDataFormatNDJSON   = "ndjson"
+	TibiaDataFormatMsgpack  = "msgpack"
+	TibiaDataFormatProtobuf = "protobuf"
+)
+
+// tibiaDataFormatContentTypes maps the output formats to their content type
+var tibiaDataFormatContentTypes = map[string]string{
+	TibiaDataFormatJSON:     "application/json",
+	TibiaDataFormatCSV:      "text/csv",
+	TibiaDataFormatNDJSON:   "application/x-ndjson",
+	TibiaDataFormatMsgpack:  "application/msgpack",
+	TibiaDataFormatProtobuf: "application/x-protobuf",
+}
+
+// TibiaDataListResponse is implemented by responses that contain a list
+// which can be represented as rows (e.g. in csv or ndjson output)
+type TibiaDataListResponse interface {
+	// ListEntries returns a slice of structs, where each struct is one row
+	ListEntries() interface{}
+}
+
+// housesListEntry is one row of the houses list output
+type housesListEntry struct {
+	Type string `json:"type"` // The type of home. (house or guildhall)
+	HousesHouse
+}
+
+// ListEntries returns all highscore records
+func (r HighscoresResponse) ListEntries() interface{} {
+	return r.Highscores.HighscoreList
+}
+
+// ListEntries returns all killstatistic entries
+func (r KillStatisticsResponse) ListEntries() interface{} {
+	return r.KillStatistics.Entries
+}
+
+// ListEntries returns all members of the guild
+func (r GuildResponse) ListEntries() interface{} {
+	return r.Guild.Members
+}
+
+// ListEntries returns all online players of the world
+func (r WorldResponse) ListEntries() interface{} {
+	return r.World.OnlinePlayers
+}
+
+// ListEntries returns all houses followed by all guildhalls
+func (r HousesOverviewResponse) ListEntries() interface{} {
+	entries := make([]housesListEntry, 0, len(r.Houses.HouseList)+len(r.Houses.GuildhallList))
+	for _, house := range r.Houses.HouseList {
+		entries = append(entries, housesListEntry{Type: "house", HousesHouse: house})
+	}
+	for _, guildhall := range r.Houses.GuildhallList {
+		entries = append(entries, housesListEntry{Type: "guildhall", HousesHouse: guildhall})
+	}
+	return entries
+}
+
+// tibiaDataFormatMediaTypes are the formats of the media types of the Accept header
+var tibiaDataFormatMediaTypes = map[string]string{
+	"application/json":       TibiaDataFormatJSON,
+	"*/*":                    TibiaDataFormatJSON,
+	"text/csv":               TibiaDataFormatCSV,
+	"application/x-ndjson":   TibiaDataFormatNDJSON,
+	"application/ndjson":     TibiaDataFormatNDJSON,
+	"application/msgpack":    TibiaDataFormatMsgpack,
+	"application/x-msgpack":  TibiaDataFormatMsgpack,
+	"application/x-protobuf": TibiaDataFormatProtobuf,
+	"application/protobuf":   TibiaDataFormatProtobuf,
+}
+
+// TibiaDataResponseFormat func - returns the output format requested by the client
+// The format query parameter has precedence over the Accept header, media types with q=0 are not accepted
+func TibiaDataResponseFormat(c *gin.Context) (string, error) {
+	if c.Request == nil {
+		return TibiaDataFormatJSON, nil
+	}
+
+	if format := strings.ToLower(c.Query("format")); format != "" {
+		if _, exists := tibiaDataFormatContentTypes[format]; !exists {
+			return "", validation.ErrorFormatNotSupported
+		}
+		return format, nil
+	}
+
+	// the known media type with the highest quality wins (the first one on equal quality), everything else falls back to json
+	format, quality := TibiaDataFormatJSON, 0.0
+	for _, accept := range strings.Split(c.GetHeader("Accept"), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err != nil {
+			continue
+		}
+
+		q := 1.0
+		if value, exists := params["q"]; exists {
+			if q, err = strconv.ParseFloat(value, 64); err != nil {
+				continue
+			}
+		}
+
+		if mediaFormat, known := tibiaDataFormatMediaTypes[mediaType]; known && q > quality {
+			format, quality = mediaFormat, q
+		}
+	}
+
+	return format, nil
+}
+
+// TibiaDataRender func - writes the response in the requested format
+// j is the complete response and data the (possibly by fields reduced) value to write,
+// list formats always contain the whole list of j
+func TibiaDataRender(c *gin.Context, httpCode int, format string, j interface{}, data interface{}) error {
+	var (
+		body []byte
+		err  error
+	)
+
+	switch format {
+	case TibiaDataFormatJSON:
+		c.JSON(httpCode, data)
+		return nil
+	case TibiaDataFormatCSV, TibiaDataFormatNDJSON:
+		list, ok := j.(TibiaDataListResponse)
+		if !ok {
+			return validation.ErrorFormatNotSupported
+		}
+
+		if format == TibiaDataFormatCSV {
+			body, err = TibiaDataEncodeCSV(list.ListEntries())
+		} else {
+			body, err = TibiaDataEncodeNDJSON(list.ListEntries())
+		}
+	case TibiaDataFormatMsgpack:
+		body, err = TibiaDataEncodeMsgpack(data)
+	case TibiaDataFormatProtobuf:
+		var message proto.Message
+		if message, err = TibiaDataProtoMessage(j); err == nil {
+			body, err = TibiaDataEncodeProtobuf(data, message)
+		}
+	default:
+		return validation.ErrorFormatNotSupported
+	}
+	if err != nil {
+		return err
+	}
+
+	contentType := tibiaDataFormatContentTypes[format]
+	if format == TibiaDataFormatCSV || format == TibiaDataFormatNDJSON {
+		contentType += "; charset=utf-8"
+	}
+
+	c.Data(httpCode, contentType, body)
+	return nil
+}
+
+// TibiaDataEncodeMsgpack func - encodes data as MessagePack using the json keys
+func TibiaDataEncodeMsgpack(data interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+
+	encoder := msgpack.NewEncoder(&buf)
+	encoder.SetCustomStructTag("json")
+	if err := encoder.Encode(msgpackNormalize(data)); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// msgpackNormalize converts json.Number values of decoded json (see TibiaDataFilterFields)
+// into numbers, as MessagePack would encode them as strings otherwise
+func msgpackNormalize(data interface{}) interface{} {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = msgpackNormalize(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = msgpackNormalize(value)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+	}
+
+	return data
+}
+
+// TibiaDataEncodeNDJSON func - encodes every element of a slice as one json line
+func TibiaDataEncodeNDJSON(entries interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+
+	// json.Encoder terminates every value with a newline
+	encoder := json.NewEncoder(&buf)
+	v := reflect.ValueOf(entries)
+	for i := 0; i < v.Len(); i++ {
+		if err := encoder.Encode(v.Index(i).Interface()); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// TibiaDataEncodeCSV func - encodes a slice of structs as csv
+// The columns are the json keys of the struct in field order, nested structs
+// are flattened with a dot (e.g. auction.current_bid)
+func TibiaDataEncodeCSV(entries interface{}) ([]byte, error) {
+	v := reflect.ValueOf(entries)
+	if v.Kind() != reflect.Slice || v.Type().Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("[error] TibiaDataEncodeCSV needs a slice of structs, got %s", v.Type())
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	if err := writer.Write(csvHeader(v.Type().Elem(), "")); err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < v.Len(); i++ {
+		if err := writer.Write(csvRow(v.Index(i), nil)); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	return buf.Bytes(), writer.Error()
+}
+
+// csvHeader returns the column names of a struct type
+func csvHeader(t reflect.Type, prefix string) []string {
+	var header []string
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, ok := jsonFieldName(field)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case field.Anonymous && field.Type.Kind() == reflect.Struct:
+			header = append(header, csvHeader(field.Type, prefix)...)
+		case field.Type.Kind() == reflect.Struct:
+			header = append(header, csvHeader(field.Type, prefix+name+".")...)
+		case field.Type.Kind() == reflect.Pointer && field.Type.Elem().Kind() == reflect.Struct:
+			header = append(header, csvHeader(field.Type.Elem(), prefix+name+".")...)
+		default:
+			header = append(header, prefix+name)
+		}
+	}
+
+	return header
+}
+
+// csvRow appends the values of a struct in the same order as csvHeader
+func csvRow(v reflect.Value, row []string) []string {
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if _, ok := jsonFieldName(field); !ok {
+			continue
+		}
+
+		value := v.Field(i)
+		switch value.Kind() {
+		case reflect.Struct:
+			row = csvRow(value, row)
+		case reflect.Pointer:
+			if value.Type().Elem().Kind() != reflect.Struct {
+				js, _ := json.Marshal(value.Interface())
+				row = append(row, string(js))
+			} else if value.IsNil() {
+				// optional structs have empty cells if they are not set
+				row = append(row, make([]string, len(csvHeader(value.Type().Elem(), "")))...)
+			} else {
+				row = csvRow(value.Elem(), row)
+			}
+		case reflect.String:
+			row = append(row, value.String())
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			row = append(row, strconv.FormatInt(value.Int(), 10))
+		case reflect.Float32, reflect.Float64:
+			row = append(row, strconv.FormatFloat(value.Float(), 'f', -1, 64))
+		case reflect.Bool:
+			row = append(row, strconv.FormatBool(value.Bool()))
+		default:
+			// lists and other complex values are kept as json in one cell
+			js, _ := json.Marshal(value.Interface())
+			row = append(row, string(js))
+		}
+	}
+
+	return row
+}
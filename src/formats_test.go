@@ -0,0 +1,201 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/static"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func TestFormatsNegotiation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	assert := assert.New(t)
+
+	tests := []struct {
+		url, accept, format string
+		err                 error
+	}{
+		{"/v4/test", "", TibiaDataFormatJSON, nil},
+		{"/v4/test", "text/csv", TibiaDataFormatCSV, nil},
+		{"/v4/test", "application/x-ndjson", TibiaDataFormatNDJSON, nil},
+		{"/v4/test", "text/html, application/xhtml+xml, */*;q=0.8", TibiaDataFormatJSON, nil},
+		{"/v4/test", "application/xml, text/csv;q=0.9", TibiaDataFormatCSV, nil},
+		{"/v4/test", "application/json;q=1, text/csv;q=0.1", TibiaDataFormatJSON, nil},
+		{"/v4/test", "text/csv;q=0.5, application/x-ndjson;q=0.9", TibiaDataFormatNDJSON, nil},
+		{"/v4/test", "text/csv;q=0", TibiaDataFormatJSON, nil},
+		{"/v4/test?format=NDJSON", "text/csv", TibiaDataFormatNDJSON, nil},
+		{"/v4/test?format=xml", "", "", validation.ErrorFormatNotSupported},
+	}
+
+	for _, test := range tests {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, test.url, nil)
+		c.Request.Header.Set("Accept", test.accept)
+
+		format, err := TibiaDataResponseFormat(c)
+		assert.Equal(test.err, err, test.url)
+		assert.Equal(test.format, format, test.url)
+	}
+}
+
+func TestFormatsHighscoresCSV(t *testing.T) {
+	file, err := static.TestFiles.Open("testdata/highscores/loyalty.html")
+	if err != nil {
+		t.Fatalf("file opening error: %s", err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		t.Fatalf("File reading error: %s", err)
+	}
+
+	highscoresJson, err := TibiaHighscoresImpl("Vunira", validation.HighScoreLoyaltypoints, "druids", 4, string(data), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert := assert.New(t)
+
+	body, err := TibiaDataEncodeCSV(highscoresJson.ListEntries())
+	assert.Nil(err)
+
+	records, err := csv.NewReader(strings.NewReader(string(body))).ReadAll()
+	assert.Nil(err)
+	assert.Equal(51, len(records))
+	assert.Equal([]string{
+		"rank", "name", "vocation", "world", "level", "value", "title",
+		"level_progress.experience_current_level", "level_progress.experience_next_level", "level_progress.experience_remaining", "level_progress.progress",
+	}, records[0])
+
+	first := highscoresJson.Highscores.HighscoreList[0]
+	assert.Equal(first.Name, records[1][1])
+	assert.Equal(first.Title, records[1][6])
+	assert.Equal([]string{"", "", "", ""}, records[1][7:])
+
+	// the level progress of experience highscores is split into columns
+	body, err = TibiaDataEncodeCSV([]Highscore{{Rank: 1, Level: 100, Value: 16000000, LevelProgress: &LevelProgress{ExperienceCurrentLevel: 15694800, ExperienceNextLevel: 16180000, ExperienceRemaining: 180000, Progress: 62.9}}})
+	assert.Nil(err)
+	assert.Equal("rank,name,vocation,world,level,value,title,level_progress.experience_current_level,level_progress.experience_next_level,level_progress.experience_remaining,level_progress.progress\n1,,,,100,16000000,,15694800,16180000,180000,62.9\n", string(body))
+}
+
+func TestFormatsHousesCSV(t *testing.T) {
+	assert := assert.New(t)
+
+	houses := HousesOverviewResponse{
+		Houses: HousesHouses{
+			HouseList: []HousesHouse{
+				{Name: "Alai Flats, Flat 01", HouseID: 10301, Size: 17, Rent: 50000, IsRented: true},
+			},
+			GuildhallList: []HousesHouse{
+				{Name: "Guildhall", HouseID: 10001, Size: 300, IsAuctioned: true, Auction: HousesAuction{AuctionBid: 1000, AuctionLeft: "1 day"}},
+			},
+		},
+	}
+
+	body, err := TibiaDataEncodeCSV(houses.ListEntries())
+	assert.Nil(err)
+	assert.Equal(
+		"type,name,house_id,size,rent,rented,auctioned,auction.current_bid,auction.time_left,auction.finished\n"+
+			"house,\"Alai Flats, Flat 01\",10301,17,50000,true,false,0,,false\n"+
+			"guildhall,Guildhall,10001,300,0,false,true,1000,1 day,false\n",
+		string(body))
+}
+
+func TestFormatsHandleResponse(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	assert := assert.New(t)
+
+	world := WorldResponse{
+		World: World{
+			Name: "Antica",
+			OnlinePlayers: []OnlinePlayers{
+				{Name: "Durin", Level: 1000, Vocation: "Elite Knight"},
+				{Name: "Trollefar", Level: 500, Vocation: "Royal Paladin"},
+			},
+		},
+	}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v4/world/antica?format=ndjson", nil)
+
+	TibiaDataAPIHandleResponse(c, "", world)
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("application/x-ndjson; charset=utf-8", w.Header().Get("Content-Type"))
+
+	lines := strings.Split(strings.TrimSuffix(w.Body.String(), "\n"), "\n")
+	assert.Len(lines, 2)
+
+	var player OnlinePlayers
+	assert.Nil(json.Unmarshal([]byte(lines[1]), &player))
+	assert.Equal(world.World.OnlinePlayers[1], player)
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v4/world/antica", nil)
+	c.Request.Header.Set("Accept", "text/csv")
+
+	TibiaDataAPIHandleResponse(c, "", world)
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal("name,level,vocation\nDurin,1000,Elite Knight\nTrollefar,500,Royal Paladin\n", w.Body.String())
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v4/news/latest?format=csv", nil)
+
+	TibiaDataAPIHandleResponse(c, "", NewsListResponse{})
+	assert.Equal(http.StatusNotAcceptable, w.Code)
+	assert.Contains(w.Body.String(), `"error":9004`)
+}
+
+func TestFormatsMsgpack(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	assert := assert.New(t)
+
+	world := WorldResponse{
+		World: World{
+			Name:          "Antica",
+			RecordPlayers: 1234,
+			OnlinePlayers: []OnlinePlayers{{Name: "Durin", Level: 1000, Vocation: "Elite Knight"}},
+		},
+	}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v4/world/antica?format=msgpack", nil)
+
+	TibiaDataAPIHandleResponse(c, "", world)
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("application/msgpack", w.Header().Get("Content-Type"))
+
+	var decoded map[string]map[string]interface{}
+	assert.Nil(msgpack.Unmarshal(w.Body.Bytes(), &decoded))
+	assert.Equal("Antica", decoded["world"]["name"])
+	assert.EqualValues(1234, decoded["world"]["record_players"])
+	assert.Len(decoded["world"]["online_players"], 1)
+
+	// numbers of a reduced response are kept as numbers
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v4/world/antica?fields=world.record_players", nil)
+	c.Request.Header.Set("Accept", "application/msgpack")
+
+	TibiaDataAPIHandleResponse(c, "", world)
+	assert.Equal(http.StatusOK, w.Code)
+
+	decoded = nil
+	assert.Nil(msgpack.Unmarshal(w.Body.Bytes(), &decoded))
+	assert.EqualValues(1234, decoded["world"]["record_players"])
+	assert.NotContains(decoded["world"], "name")
+}
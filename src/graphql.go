@@ -0,0 +1,795 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+var (
+	// TibiaDataGraphQLMaxCost is the maximum number of requests to tibia.com a graphql query may cause
+	TibiaDataGraphQLMaxCost = 100
+
+	// TibiaDataGraphQLConcurrency is the number of concurrent requests to tibia.com of one graphql query
+	TibiaDataGraphQLConcurrency = 5
+
+	// graphqlDefaultListSize is the assumed length of a list without limit argument when calculating the cost
+	graphqlDefaultListSize = 100
+
+	// tibiaDataGraphQLSchema is the schema of the graphql endpoint
+	tibiaDataGraphQLSchema = sync.OnceValue(newGraphQLSchema)
+)
+
+// graphqlRequest is the body of a graphql request
+type graphqlRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// graphqlFetch fetches and parses a response by using the given collector
+type graphqlFetch func(htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (interface{}, error)
+
+// graphqlEndpointFetch returns the fetch of an endpoint or the error of its validation
+func graphqlEndpointFetch(endpoint tibiaDataEndpoint, err error) (graphqlFetch, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return func(htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (interface{}, error) {
+		BoxContentHTML, err := htmlDataCollector(endpoint.Request)
+		if err != nil {
+			return nil, err
+		}
+
+		return endpoint.Parse(BoxContentHTML)
+	}, nil
+}
+
+// graphqlSource is the value of a graphql object
+// The parent is kept, so that links can use values of the enclosing objects (e.g. the world of a house list)
+type graphqlSource struct {
+	value  reflect.Value
+	parent *graphqlSource
+}
+
+// graphqlResponseSource returns the source of the data of a response (e.g. Character of CharacterResponse)
+func graphqlResponseSource(response interface{}) *graphqlSource {
+	return &graphqlSource{value: reflect.ValueOf(response).Field(0)}
+}
+
+// child returns the graphql value of a field of the source
+func (s *graphqlSource) child(value reflect.Value, args map[string]interface{}) interface{} {
+	switch value.Kind() {
+	case reflect.Struct:
+		return &graphqlSource{value: value, parent: s}
+	case reflect.Pointer:
+		// optional objects are null if they are not set
+		if value.IsNil() {
+			return nil
+		}
+		return s.child(value.Elem(), args)
+	case reflect.Slice:
+		if value.Type().Elem().Kind() != reflect.Struct {
+			return value.Interface()
+		}
+
+		start, end := graphqlListBounds(value.Len(), args)
+		list := make([]interface{}, 0, end-start)
+		for i := start; i < end; i++ {
+			list = append(list, &graphqlSource{value: value.Index(i), parent: s})
+		}
+		return list
+	}
+
+	return value.Interface()
+}
+
+// field returns the value of the field with the json key of the source
+func (s *graphqlSource) field(key string) reflect.Value {
+	if s == nil {
+		return reflect.Value{}
+	}
+
+	field, ok := jsonFieldByName(s.value.Type(), key)
+	if !ok {
+		return reflect.Value{}
+	}
+
+	return s.value.FieldByIndex(field.Index)
+}
+
+// string returns the string with the json key of the source
+func (s *graphqlSource) string(key string) string {
+	if value := s.field(key); value.Kind() == reflect.String {
+		return value.String()
+	}
+	return ""
+}
+
+// int returns the int with the json key of the source
+func (s *graphqlSource) int(key string) int {
+	if value := s.field(key); value.Kind() == reflect.Int {
+		return int(value.Int())
+	}
+	return 0
+}
+
+// graphqlListBounds returns the range of a list of the given length selected by the limit and offset arguments
+func graphqlListBounds(length int, args map[string]interface{}) (int, int) {
+	start, end := 0, length
+
+	if offset, ok := args["offset"].(int); ok && offset > 0 {
+		start = min(offset, length)
+	}
+	if limit, ok := args["limit"].(int); ok {
+		end = min(start+max(limit, 0), length)
+	}
+
+	return start, end
+}
+
+// graphqlLink is a field of an object that fetches a related entity (e.g. the character of a guild member)
+type graphqlLink struct {
+	Name     string                                            // The name of the field.
+	Response reflect.Type                                      // The response type of the related entity.
+	Fetch    func(source *graphqlSource) (graphqlFetch, error) // The fetch of the related entity, nil if there is none.
+}
+
+// graphqlRootField is a field of the query type
+type graphqlRootField struct {
+	Name     string                                                  // The name of the field.
+	Args     graphql.FieldConfigArgument                             // The arguments of the field.
+	Response reflect.Type                                            // The response type of the field.
+	Cost     int                                                     // The number of requests to tibia.com.
+	Fetch    func(args map[string]interface{}) (graphqlFetch, error) // The fetch of the response.
+}
+
+// graphqlLong is a signed 64-bit integer, as some values (e.g. experience points) are too big for Int
+var graphqlLong = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "Long",
+	Description: "The `Long` scalar type represents a signed 64-bit integer.",
+	Serialize: func(value interface{}) interface{} {
+		if v := reflect.ValueOf(value); v.CanInt() {
+			return v.Int()
+		}
+		return nil
+	},
+})
+
+// graphqlSchemaBuilder derives the graphql objects from the response types
+// The fields of an object are the json keys of the struct and the links of the type.
+type graphqlSchemaBuilder struct {
+	objects map[reflect.Type]*graphql.Object
+	links   map[reflect.Type][]graphqlLink
+	costs   map[string]int // cost of the fields fetching from tibia.com, by Object.field
+}
+
+// object returns the graphql object of a struct type
+func (b *graphqlSchemaBuilder) object(t reflect.Type) *graphql.Object {
+	if object, exists := b.objects[t]; exists {
+		return object
+	}
+
+	object := graphql.NewObject(graphql.ObjectConfig{
+		Name: t.Name(),
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return b.fields(t)
+		}),
+	})
+	b.objects[t] = object
+
+	return object
+}
+
+// fields returns the graphql fields of a struct type
+func (b *graphqlSchemaBuilder) fields(t reflect.Type) graphql.Fields {
+	fields := graphql.Fields{}
+
+	for _, field := range reflect.VisibleFields(t) {
+		name, ok := jsonFieldName(field)
+		if !ok || (field.Anonymous && field.Type.Kind() == reflect.Struct) {
+			continue
+		}
+
+		output := b.output(field.Type)
+		if output == nil {
+			continue
+		}
+
+		index := field.Index
+		fields[name] = &graphql.Field{
+			Type: output,
+			Args: graphqlListArgs(field.Type),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				source, ok := p.Source.(*graphqlSource)
+				if !ok {
+					return nil, nil
+				}
+				return source.child(source.value.FieldByIndex(index), p.Args), nil
+			},
+		}
+	}
+
+	for _, link := range b.links[t] {
+		if _, exists := fields[link.Name]; exists {
+			panic(fmt.Sprintf("graphql link %s.%s hides a field", t.Name(), link.Name))
+		}
+
+		fetch := link.Fetch
+		fields[link.Name] = &graphql.Field{
+			Type: b.object(link.Response.Field(0).Type),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				source, ok := p.Source.(*graphqlSource)
+				if !ok {
+					return nil, nil
+				}
+
+				f, err := fetch(source)
+				if err != nil || f == nil {
+					return nil, err
+				}
+				return graphqlLoaderFromContext(p.Context).thunk(f), nil
+			},
+		}
+		b.costs[t.Name()+"."+link.Name] = 1
+	}
+
+	return fields
+}
+
+// output returns the graphql type of a go type, nil if it is not supported
+func (b *graphqlSchemaBuilder) output(t reflect.Type) graphql.Output {
+	switch t.Kind() {
+	case reflect.String:
+		return graphql.String
+	case reflect.Bool:
+		return graphql.Boolean
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return graphqlLong
+	case reflect.Float32, reflect.Float64:
+		return graphql.Float
+	case reflect.Struct:
+		return b.object(t)
+	case reflect.Pointer:
+		return b.output(t.Elem())
+	case reflect.Slice:
+		if elem := b.output(t.Elem()); elem != nil {
+			return graphql.NewList(elem)
+		}
+	}
+
+	return nil
+}
+
+// graphqlListArgs returns the limit and offset arguments of lists of objects
+func graphqlListArgs(t reflect.Type) graphql.FieldConfigArgument {
+	if t.Kind() != reflect.Slice || t.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+
+	return graphql.FieldConfigArgument{
+		"limit":  &graphql.ArgumentConfig{Type: graphql.Int, Description: "The maximum number of entries."},
+		"offset": &graphql.ArgumentConfig{Type: graphql.Int, Description: "The number of entries to skip."},
+	}
+}
+
+// newGraphQLSchema builds the schema of the graphql endpoint
+func newGraphQLSchema() graphql.Schema {
+	characterResponse := reflect.TypeOf(CharacterResponse{})
+	characterLink := graphqlLink{
+		Name:     "character",
+		Response: characterResponse,
+		Fetch: func(source *graphqlSource) (graphqlFetch, error) {
+			return graphqlEndpointFetch(tibiaCharactersCharacterEndpoint(source.string("name")))
+		},
+	}
+	guildLink := graphqlLink{
+		Name:     "guild",
+		Response: reflect.TypeOf(GuildResponse{}),
+		Fetch: func(source *graphqlSource) (graphqlFetch, error) {
+			// characters without guild have an empty guild name
+			if source.string("name") == "" {
+				return nil, nil
+			}
+			return graphqlEndpointFetch(tibiaGuildsGuildEndpoint(source.string("name")))
+		},
+	}
+
+	builder := &graphqlSchemaBuilder{
+		objects: map[reflect.Type]*graphql.Object{},
+		costs:   map[string]int{},
+		links: map[reflect.Type][]graphqlLink{
+			reflect.TypeOf(GuildMember{}):     {characterLink},
+			reflect.TypeOf(OnlinePlayers{}):   {characterLink},
+			reflect.TypeOf(Highscore{}):       {characterLink},
+			reflect.TypeOf(OtherCharacters{}): {characterLink},
+			reflect.TypeOf(CharacterGuild{}):  {guildLink},
+			reflect.TypeOf(OverviewGuild{}):   {guildLink},
+			reflect.TypeOf(Houses{}): {{
+				Name:     "house",
+				Response: reflect.TypeOf(HouseResponse{}),
+				Fetch: func(source *graphqlSource) (graphqlFetch, error) {
+					// the houses of a character are on the world of the character
+					return graphqlEndpointFetch(tibiaHousesHouseEndpoint(source.parent.string("world"), strconv.Itoa(source.int("houseid"))))
+				},
+			}},
+			reflect.TypeOf(HousesHouse{}): {{
+				Name:     "house",
+				Response: reflect.TypeOf(HouseResponse{}),
+				Fetch: func(source *graphqlSource) (graphqlFetch, error) {
+					return graphqlEndpointFetch(tibiaHousesHouseEndpoint(source.parent.string("world"), strconv.Itoa(source.int("house_id"))))
+				},
+			}},
+			reflect.TypeOf(OverviewWorld{}): {{
+				Name:     "world",
+				Response: reflect.TypeOf(WorldResponse{}),
+				Fetch: func(source *graphqlSource) (graphqlFetch, error) {
+					return graphqlEndpointFetch(tibiaWorldsWorldEndpoint(source.string("name")))
+				},
+			}},
+			reflect.TypeOf(OverviewCreature{}): {{
+				Name:     "creature",
+				Response: reflect.TypeOf(CreatureResponse{}),
+				Fetch: func(source *graphqlSource) (graphqlFetch, error) {
+					return graphqlEndpointFetch(tibiaCreaturesCreatureEndpoint(source.string("race")))
+				},
+			}},
+		},
+	}
+
+	required := func(names ...string) graphql.FieldConfigArgument {
+		args := graphql.FieldConfigArgument{}
+		for _, name := range names {
+			args[name] = &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)}
+		}
+		return args
+	}
+
+	roots := []graphqlRootField{
+		{
+			Name:     "character",
+			Args:     required("name"),
+			Response: characterResponse,
+			Fetch: func(args map[string]interface{}) (graphqlFetch, error) {
+				return graphqlEndpointFetch(tibiaCharactersCharacterEndpoint(args["name"].(string)))
+			},
+		},
+		{
+			Name:     "creature",
+			Args:     required("race"),
+			Response: reflect.TypeOf(CreatureResponse{}),
+			Fetch: func(args map[string]interface{}) (graphqlFetch, error) {
+				return graphqlEndpointFetch(tibiaCreaturesCreatureEndpoint(args["race"].(string)))
+			},
+		},
+		{
+			Name:     "creatures",
+			Response: reflect.TypeOf(CreaturesOverviewResponse{}),
+			Fetch: func(args map[string]interface{}) (graphqlFetch, error) {
+				return graphqlEndpointFetch(tibiaCreaturesOverviewEndpoint(), nil)
+			},
+		},
+		{
+			Name:     "guild",
+			Args:     required("name"),
+			Response: reflect.TypeOf(GuildResponse{}),
+			Fetch: func(args map[string]interface{}) (graphqlFetch, error) {
+				return graphqlEndpointFetch(tibiaGuildsGuildEndpoint(args["name"].(string)))
+			},
+		},
+		{
+			Name:     "guilds",
+			Args:     required("world"),
+			Response: reflect.TypeOf(GuildsOverviewResponse{}),
+			Fetch: func(args map[string]interface{}) (graphqlFetch, error) {
+				return graphqlEndpointFetch(tibiaGuildsOverviewEndpoint(args["world"].(string)))
+			},
+		},
+		{
+			Name: "highscores",
+			Args: graphql.FieldConfigArgument{
+				"world":       &graphql.ArgumentConfig{Type: graphql.String, DefaultValue: "all"},
+				"category":    &graphql.ArgumentConfig{Type: graphql.String, DefaultValue: "experience"},
+				"vocation":    &graphql.ArgumentConfig{Type: graphql.String, DefaultValue: TibiaDataDefaultVoc},
+				"page":        &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 1},
+				"world_types": &graphql.ArgumentConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+				"battleye":    &graphql.ArgumentConfig{Type: graphql.String, DefaultValue: ""},
+			},
+			Response: reflect.TypeOf(HighscoresResponse{}),
+			Fetch: func(args map[string]interface{}) (graphqlFetch, error) {
+				filter := HighscoresFilter{BattlEye: args["battleye"].(string)}
+				if worldTypes, ok := args["world_types"].([]interface{}); ok {
+					for _, worldType := range worldTypes {
+						filter.WorldTypes = append(filter.WorldTypes, worldType.(string))
+					}
+				}
+				return graphqlEndpointFetch(tibiaHighscoresEndpoint(args["world"].(string), args["category"].(string), args["vocation"].(string), strconv.Itoa(args["page"].(int)), filter))
+			},
+		},
+		{
+			Name: "house",
+			Args: graphql.FieldConfigArgument{
+				"world":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"house_id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+			},
+			Response: reflect.TypeOf(HouseResponse{}),
+			Fetch: func(args map[string]interface{}) (graphqlFetch, error) {
+				return graphqlEndpointFetch(tibiaHousesHouseEndpoint(args["world"].(string), strconv.Itoa(args["house_id"].(int))))
+			},
+		},
+		{
+			Name:     "houses",
+			Args:     required("world", "town"),
+			Response: reflect.TypeOf(HousesOverviewResponse{}),
+			Cost:     2, // houses and guildhalls
+			Fetch: func(args map[string]interface{}) (graphqlFetch, error) {
+				world, town, err := tibiaHousesOverviewParams(args["world"].(string), args["town"].(string))
+				if err != nil {
+					return nil, err
+				}
+
+				return func(htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (interface{}, error) {
+					return TibiaHousesOverviewImpl(nil, world, town, htmlDataCollector)
+				}, nil
+			},
+		},
+		{
+			Name:     "world",
+			Args:     required("name"),
+			Response: reflect.TypeOf(WorldResponse{}),
+			Fetch: func(args map[string]interface{}) (graphqlFetch, error) {
+				return graphqlEndpointFetch(tibiaWorldsWorldEndpoint(args["name"].(string)))
+			},
+		},
+		{
+			Name:     "worlds",
+			Response: reflect.TypeOf(WorldsOverviewResponse{}),
+			Fetch: func(args map[string]interface{}) (graphqlFetch, error) {
+				return graphqlEndpointFetch(tibiaWorldsOverviewEndpoint(), nil)
+			},
+		},
+	}
+
+	queryFields := graphql.Fields{}
+	for _, root := range roots {
+		fetch := root.Fetch
+		queryFields[root.Name] = &graphql.Field{
+			Type: builder.object(root.Response.Field(0).Type),
+			Args: root.Args,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				f, err := fetch(p.Args)
+				if err != nil {
+					return nil, err
+				}
+				return graphqlLoaderFromContext(p.Context).thunk(f), nil
+			},
+		}
+		builder.costs["Query."+root.Name] = max(root.Cost, 1)
+	}
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{Name: "Query", Fields: queryFields}),
+	})
+	if err != nil {
+		panic(fmt.Sprintf("graphql schema could not be built: %s", err))
+	}
+
+	// the cost of the links is known after the fields of all objects have been built
+	graphqlFieldCosts = builder.costs
+
+	return schema
+}
+
+// graphqlFieldCosts is the number of requests to tibia.com of the fields, by Object.field
+var graphqlFieldCosts map[string]int
+
+// graphqlLoader fetches the responses of one graphql query
+// Requests to tibia.com are deduplicated, run concurrently up to TibiaDataGraphQLConcurrency
+// and are limited to the cost budget of the query.
+type graphqlLoader struct {
+	htmlDataCollector func(TibiaDataRequestStruct) (string, error)
+	budget            atomic.Int64
+	parallel          chan struct{}
+
+	mu    sync.Mutex
+	calls map[string]*graphqlCall
+}
+
+// graphqlCall is one request to tibia.com of a graphqlLoader
+type graphqlCall struct {
+	done           chan struct{}
+	BoxContentHTML string
+	err            error
+}
+
+type graphqlLoaderKey struct{}
+
+// newGraphQLLoader returns a loader with the given budget of requests to tibia.com
+func newGraphQLLoader(htmlDataCollector func(TibiaDataRequestStruct) (string, error), budget int) *graphqlLoader {
+	loader := &graphqlLoader{
+		htmlDataCollector: htmlDataCollector,
+		parallel:          make(chan struct{}, max(TibiaDataGraphQLConcurrency, 1)),
+		calls:             map[string]*graphqlCall{},
+	}
+	loader.budget.Store(int64(budget))
+
+	return loader
+}
+
+// graphqlLoaderFromContext returns the loader of the query
+func graphqlLoaderFromContext(ctx context.Context) *graphqlLoader {
+	return ctx.Value(graphqlLoaderKey{}).(*graphqlLoader)
+}
+
+// collect is the collector of the query, the same request is only sent once
+func (l *graphqlLoader) collect(request TibiaDataRequestStruct) (string, error) {
+	key := fmt.Sprint(request.Method, request.URL, request.FormData, request.RawBody)
+
+	l.mu.Lock()
+	if call, exists := l.calls[key]; exists {
+		l.mu.Unlock()
+		<-call.done
+		return call.BoxContentHTML, call.err
+	}
+	call := &graphqlCall{done: make(chan struct{})}
+	l.calls[key] = call
+	l.mu.Unlock()
+
+	defer close(call.done)
+
+	// the budget stops queries whose lists were longer than assumed by the cost analysis
+	if l.budget.Add(-1) < 0 {
+		call.err = validation.ErrorGraphQLQueryTooExpensive
+		return "", call.err
+	}
+
+	l.parallel <- struct{}{}
+	call.BoxContentHTML, call.err = l.htmlDataCollector(request)
+	<-l.parallel
+
+	return call.BoxContentHTML, call.err
+}
+
+// thunk starts the fetch and returns a graphql thunk waiting for its response
+func (l *graphqlLoader) thunk(fetch graphqlFetch) func() (interface{}, error) {
+	var (
+		response interface{}
+		err      error
+		done     = make(chan struct{})
+	)
+
+	go func() {
+		defer close(done)
+		response, err = fetch(l.collect)
+	}()
+
+	return func() (interface{}, error) {
+		<-done
+		if err != nil {
+			return nil, err
+		}
+		return graphqlResponseSource(response), nil
+	}
+}
+
+// parseGraphQL parses the document of a graphql request
+func parseGraphQL(query string) (*ast.Document, error) {
+	return parser.Parse(parser.ParseParams{Source: source.NewSource(&source.Source{Body: []byte(query), Name: "GraphQL request"})})
+}
+
+// graphqlCost returns the maximum number of requests to tibia.com of the operation
+// Lists are assumed to have the length of their limit argument or graphqlDefaultListSize.
+func graphqlCost(schema graphql.Schema, document *ast.Document, operationName string, variables map[string]interface{}) int {
+	fragments := map[string]*ast.FragmentDefinition{}
+	var operation *ast.OperationDefinition
+
+	for _, definition := range document.Definitions {
+		switch d := definition.(type) {
+		case *ast.FragmentDefinition:
+			fragments[d.Name.Value] = d
+		case *ast.OperationDefinition:
+			if operation == nil && (operationName == "" || (d.Name != nil && d.Name.Value == operationName)) {
+				operation = d
+			}
+		}
+	}
+	if operation == nil || operation.Operation != ast.OperationTypeQuery {
+		return 0
+	}
+
+	var cost func(selectionSet *ast.SelectionSet, object *graphql.Object) int
+	cost = func(selectionSet *ast.SelectionSet, object *graphql.Object) int {
+		if selectionSet == nil {
+			return 0
+		}
+
+		total := 0
+		for _, selection := range selectionSet.Selections {
+			switch s := selection.(type) {
+			case *ast.InlineFragment:
+				total += cost(s.SelectionSet, object)
+			case *ast.FragmentSpread:
+				if fragment, exists := fragments[s.Name.Value]; exists {
+					total += cost(fragment.SelectionSet, object)
+				}
+			case *ast.Field:
+				definition, exists := object.Fields()[s.Name.Value]
+				if !exists {
+					continue
+				}
+
+				fieldCost := graphqlFieldCosts[object.Name()+"."+s.Name.Value]
+				multiplier := 1
+
+				fieldType := definition.Type
+				if nonNull, ok := fieldType.(*graphql.NonNull); ok {
+					fieldType = nonNull.OfType
+				}
+				if list, ok := fieldType.(*graphql.List); ok {
+					fieldType = list.OfType
+					multiplier = graphqlDefaultListSize
+					if limit, ok := graphqlIntArgument(s, "limit", variables); ok {
+						multiplier = max(limit, 0)
+					}
+				}
+
+				if child, ok := fieldType.(*graphql.Object); ok {
+					fieldCost += cost(s.SelectionSet, child)
+				}
+
+				total += multiplier * fieldCost
+			}
+		}
+
+		return total
+	}
+
+	return cost(operation.SelectionSet, schema.QueryType())
+}
+
+// graphqlIntArgument returns the int value of an argument of a field
+func graphqlIntArgument(field *ast.Field, name string, variables map[string]interface{}) (int, bool) {
+	for _, argument := range field.Arguments {
+		if argument.Name.Value != name {
+			continue
+		}
+
+		switch value := argument.Value.(type) {
+		case *ast.IntValue:
+			i, err := strconv.Atoi(value.Value)
+			return i, err == nil
+		case *ast.Variable:
+			switch v := variables[value.Name.Value].(type) {
+			case float64:
+				return int(v), true
+			case int:
+				return v, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// graphqlErrorExtensions adds the error code and http code of validation errors to the errors of a result
+func graphqlErrorExtensions(errs []gqlerrors.FormattedError) {
+	for i := range errs {
+		var err error = errs[i]
+		for err != nil {
+			switch e := err.(type) {
+			case validation.Error:
+				info := TibiaDataErrorInformation(e, 0)
+				errs[i].Extensions = map[string]interface{}{
+					"error":     info.Status.Error,
+					"http_code": info.Status.HTTPCode,
+				}
+				err = nil
+			case gqlerrors.FormattedError:
+				err = e.OriginalError()
+			case *gqlerrors.Error:
+				err = e.OriginalError
+			default:
+				err = nil
+			}
+		}
+	}
+}
+
+// TibiaDataGraphQL func - executes a graphql query
+// Queries exceeding TibiaDataGraphQLMaxCost are rejected before any request to tibia.com is sent.
+func TibiaDataGraphQL(ctx context.Context, request graphqlRequest, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) (*graphql.Result, int) {
+	if strings.TrimSpace(request.Query) == "" {
+		result := &graphql.Result{Errors: []gqlerrors.FormattedError{gqlerrors.FormatError(validation.ErrorGraphQLQueryInvalid)}}
+		graphqlErrorExtensions(result.Errors)
+		return result, http.StatusBadRequest
+	}
+
+	schema := tibiaDataGraphQLSchema()
+
+	document, err := parseGraphQL(request.Query)
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}, http.StatusBadRequest
+	}
+
+	if validationResult := graphql.ValidateDocument(&schema, document, nil); !validationResult.IsValid {
+		return &graphql.Result{Errors: validationResult.Errors}, http.StatusBadRequest
+	}
+
+	cost := graphqlCost(schema, document, request.OperationName, request.Variables)
+	if cost > TibiaDataGraphQLMaxCost {
+		formatted := gqlerrors.FormatError(validation.ErrorGraphQLQueryTooExpensive)
+		formatted.Message = fmt.Sprintf("%s (cost %d, maximum %d), lists of related entities need a limit", formatted.Message, cost, TibiaDataGraphQLMaxCost)
+		result := &graphql.Result{Errors: []gqlerrors.FormattedError{formatted}}
+		graphqlErrorExtensions(result.Errors)
+		return result, http.StatusBadRequest
+	}
+
+	loader := newGraphQLLoader(htmlDataCollector, TibiaDataGraphQLMaxCost)
+	result := graphql.Execute(graphql.ExecuteParams{
+		Schema:        schema,
+		AST:           document,
+		OperationName: request.OperationName,
+		Args:          request.Variables,
+		Context:       context.WithValue(ctx, graphqlLoaderKey{}, loader),
+	})
+	graphqlErrorExtensions(result.Errors)
+
+	return result, http.StatusOK
+}
+
+// GraphQL godoc
+// @Summary      GraphQL query
+// @Description  Query characters, guilds, worlds, houses, highscores and creatures including their related entities
+// @Description  Lists of related entities need a limit argument, as a query may cause at most 100 requests to tibia.com.
+// @Tags         graphql
+// @Accept       json
+// @Produce      json
+// @Param        request body graphqlRequest true "The GraphQL request"
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  map[string]interface{}
+// @Router       /graphql [post]
+func tibiaGraphQL(c *gin.Context) {
+	var request graphqlRequest
+
+	switch {
+	case c.Request.Method == http.MethodGet:
+		request.Query = c.Query("query")
+		request.OperationName = c.Query("operationName")
+		if variables := c.Query("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+				TibiaDataErrorHandler(c, validation.ErrorGraphQLQueryInvalid, http.StatusBadRequest)
+				return
+			}
+		}
+	case strings.HasPrefix(c.ContentType(), "application/graphql"):
+		body, err := c.GetRawData()
+		if err != nil {
+			TibiaDataErrorHandler(c, validation.ErrorGraphQLQueryInvalid, http.StatusBadRequest)
+			return
+		}
+		request.Query = string(body)
+	default:
+		if err := json.NewDecoder(c.Request.Body).Decode(&request); err != nil {
+			TibiaDataErrorHandler(c, validation.ErrorGraphQLQueryInvalid, http.StatusBadRequest)
+			return
+		}
+	}
+
+	result, httpCode := TibiaDataGraphQL(c.Request.Context(), request, TibiaDataHTMLDataCollector)
+	c.JSON(httpCode, result)
+}
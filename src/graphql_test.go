@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// testGraphQLCollector returns a collector answering guild and character requests with test files
+func testGraphQLCollector(t *testing.T, requests *[]TibiaDataRequestStruct) func(TibiaDataRequestStruct) (string, error) {
+	guild := testFileCollector(t, "testdata/guilds/guild/Order of Glory.html", nil)
+	character := testFileCollector(t, "testdata/characters/Darkside Rafa.html", nil)
+
+	var mu sync.Mutex
+	return func(request TibiaDataRequestStruct) (string, error) {
+		mu.Lock()
+		*requests = append(*requests, request)
+		mu.Unlock()
+
+		if strings.Contains(request.URL, "subtopic=guilds") {
+			return guild(request)
+		}
+		return character(request)
+	}
+}
+
+func TestGraphQLGuildMembers(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests []TibiaDataRequestStruct
+	result, httpCode := TibiaDataGraphQL(context.Background(), graphqlRequest{
+		Query: `query ($limit: Int) {
+			guild(name: "Order of Glory") {
+				name
+				world
+				members(limit: $limit, offset: 1) { name level character { character { name world level } } }
+			}
+		}`,
+		Variables: map[string]interface{}{"limit": float64(3)},
+	}, testGraphQLCollector(t, &requests))
+
+	assert.Equal(http.StatusOK, httpCode)
+	assert.Empty(result.Errors)
+
+	data, _ := json.Marshal(result.Data)
+	var response struct {
+		Guild struct {
+			Name    string `json:"name"`
+			World   string `json:"world"`
+			Members []struct {
+				Name      string `json:"name"`
+				Character struct {
+					Character CharacterInfo `json:"character"`
+				} `json:"character"`
+			} `json:"members"`
+		} `json:"guild"`
+	}
+	assert.Nil(json.Unmarshal(data, &response))
+
+	assert.Equal("Order of Glory", response.Guild.Name)
+	assert.Equal("Premia", response.Guild.World)
+	if assert.Len(response.Guild.Members, 3) {
+		assert.NotEqual("Zyb the Warrior", response.Guild.Members[0].Name)
+	}
+	for _, member := range response.Guild.Members {
+		assert.Equal("Darkside Rafa", member.Character.Character.Name)
+		assert.Equal("Gladera", member.Character.Character.World)
+	}
+
+	// one request for the guild and one for each member
+	assert.Len(requests, 4)
+}
+
+func TestGraphQLHighscoresLevelProgress(t *testing.T) {
+	assert := assert.New(t)
+
+	result, httpCode := TibiaDataGraphQL(context.Background(), graphqlRequest{
+		Query: `{
+			highscores(world: "all", category: "experience", vocation: "all", page: 1) {
+				highscore_list(limit: 1) { name level_progress { experience_remaining progress } }
+			}
+		}`,
+	}, testFileCollector(t, "testdata/highscores/all.html", nil))
+
+	assert.Equal(http.StatusOK, httpCode)
+	assert.Empty(result.Errors)
+
+	data, _ := json.Marshal(result.Data)
+	assert.JSONEq(`{"highscores":{"highscore_list":[{"name":"Goraca","level_progress":{"experience_remaining":229444093,"progress":4.79}}]}}`, string(data))
+}
+
+func TestGraphQLDeduplication(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests []TibiaDataRequestStruct
+	result, httpCode := TibiaDataGraphQL(context.Background(), graphqlRequest{
+		Query: `{
+			a: character(name: "Darkside Rafa") { character { name } }
+			b: character(name: "Darkside Rafa") { character { level } }
+		}`,
+	}, testGraphQLCollector(t, &requests))
+
+	assert.Equal(http.StatusOK, httpCode)
+	assert.Empty(result.Errors)
+	assert.Len(requests, 1)
+}
+
+func TestGraphQLQueryCost(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests []TibiaDataRequestStruct
+	collector := testGraphQLCollector(t, &requests)
+
+	// lists without limit are assumed to be long
+	result, httpCode := TibiaDataGraphQL(context.Background(), graphqlRequest{
+		Query: `{ guild(name: "Order of Glory") { ...members } }
+			fragment members on Guild { members { character { character { name } } } }`,
+	}, collector)
+
+	assert.Equal(http.StatusBadRequest, httpCode)
+	if assert.Len(result.Errors, 1) {
+		assert.Equal(9006, result.Errors[0].Extensions["error"])
+	}
+	assert.Empty(requests)
+
+	// fields without requests to tibia.com cost nothing
+	schema := tibiaDataGraphQLSchema()
+	for query, cost := range map[string]int{
+		`{ guild(name: "a") { members { name } } }`:                                               1,
+		`{ guild(name: "a") { members(limit: 5) { character { character { name } } } } }`:         6,
+		`{ houses(world: "a", town: "b") { house_list(limit: 2) { house { house { name } } } } }`: 4,
+	} {
+		document, err := parseGraphQL(query)
+		if assert.Nil(err) {
+			assert.Equal(cost, graphqlCost(schema, document, "", nil), query)
+		}
+	}
+}
+
+func TestGraphQLErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests []TibiaDataRequestStruct
+	result, httpCode := TibiaDataGraphQL(context.Background(), graphqlRequest{
+		Query: `{ character(name: "a") { character { name } } }`,
+	}, testGraphQLCollector(t, &requests))
+
+	assert.Equal(http.StatusOK, httpCode)
+	if assert.Len(result.Errors, 1) {
+		assert.Equal(10002, result.Errors[0].Extensions["error"])
+		assert.Equal(http.StatusBadRequest, result.Errors[0].Extensions["http_code"])
+	}
+	assert.Empty(requests)
+
+	// invalid queries
+	_, httpCode = TibiaDataGraphQL(context.Background(), graphqlRequest{}, nil)
+	assert.Equal(http.StatusBadRequest, httpCode)
+
+	_, httpCode = TibiaDataGraphQL(context.Background(), graphqlRequest{Query: `{ character { name } }`}, nil)
+	assert.Equal(http.StatusBadRequest, httpCode)
+}
+
+func TestGraphQLHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	assert := assert.New(t)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query": "{ __typename }"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	tibiaGraphQL(c)
+	assert.Equal(http.StatusOK, w.Code)
+	assert.JSONEq(`{"data": {"__typename": "Query"}}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query": `))
+
+	tibiaGraphQL(c)
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Contains(w.Body.String(), "9005")
+}
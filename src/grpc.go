@@ -0,0 +1,833 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tibiadata/tibiadata-api-go/src/tibiadatapb"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// tibiaDataGRPCServer implements the TibiaData service of tibiadatapb
+// The RPCs use the same endpoints (validation, request and parser) as the gin handlers
+type tibiaDataGRPCServer struct {
+	tibiadatapb.UnimplementedTibiaDataServer
+
+	htmlDataCollector func(TibiaDataRequestStruct) (string, error)
+}
+
+// tibiaDataGRPCManagementMethods are the RPCs managing the store, they need the management token
+var tibiaDataGRPCManagementMethods = map[string]bool{
+	tibiadatapb.TibiaData_AddToWatchlist_FullMethodName:         true,
+	tibiadatapb.TibiaData_RemoveFromWatchlist_FullMethodName:    true,
+	tibiadatapb.TibiaData_AddToGuildTracker_FullMethodName:      true,
+	tibiadatapb.TibiaData_RemoveFromGuildTracker_FullMethodName: true,
+	tibiadatapb.TibiaData_AddToWarTracker_FullMethodName:        true,
+	tibiadatapb.TibiaData_RemoveFromWarTracker_FullMethodName:   true,
+	tibiadatapb.TibiaData_GetWebhooks_FullMethodName:            true,
+	tibiadatapb.TibiaData_AddWebhook_FullMethodName:             true,
+	tibiadatapb.TibiaData_RemoveWebhook_FullMethodName:          true,
+	tibiadatapb.TibiaData_GetWebhookDeliveries_FullMethodName:   true,
+}
+
+// newGRPCServer returns a gRPC server with the TibiaData service registered
+func newGRPCServer(htmlDataCollector func(TibiaDataRequestStruct) (string, error)) *grpc.Server {
+	server := grpc.NewServer(grpc.UnaryInterceptor(tibiaDataGRPCManagementInterceptor))
+	tibiadatapb.RegisterTibiaDataServer(server, &tibiaDataGRPCServer{htmlDataCollector: htmlDataCollector})
+	return server
+}
+
+// tibiaDataGRPCManagementInterceptor rejects calls of tibiaDataGRPCManagementMethods without the management token
+// The token is sent as in the REST API, in the authorization metadata (Bearer <token>).
+func tibiaDataGRPCManagementInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if tibiaDataGRPCManagementMethods[info.FullMethod] {
+		var authorization string
+		if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get("authorization")) > 0 {
+			authorization = md.Get("authorization")[0]
+		}
+		if err := tibiaDataManagementAuthorize(authorization); err != nil {
+			return nil, TibiaDataGRPCError(err, codes.PermissionDenied)
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+// runGRPCServer starts the gRPC server on the given address
+// It blocks the code and will only finish execution when the server is stopped
+func runGRPCServer(server *grpc.Server, addr string) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatal("[error] TibiaData API gRPC server listen error:", err)
+	}
+
+	log.Printf("[info] TibiaData API starting gRPC server on %s", addr)
+
+	if err := server.Serve(listener); err != nil {
+		log.Fatal("[error] TibiaData API gRPC server closed unexpectedly:", err)
+	}
+}
+
+// collector returns the htmlDataCollector of the server bound to the context of a call
+// Once the call is cancelled or past its deadline no more requests are sent to tibia.com, the
+// fan-out RPCs then run out quickly instead of fetching every page for a client that is gone.
+func (s *tibiaDataGRPCServer) collector(ctx context.Context) func(TibiaDataRequestStruct) (string, error) {
+	return func(request TibiaDataRequestStruct) (string, error) {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		return s.htmlDataCollector(request)
+	}
+}
+
+// fetch requests the endpoint from tibia.com and converts the parsed response into message
+// err is the error of the endpoint validation, so that the RPCs can pass it on directly
+func (s *tibiaDataGRPCServer) fetch(ctx context.Context, endpoint tibiaDataEndpoint, err error, message proto.Message) error {
+	if err != nil {
+		return TibiaDataGRPCError(err, codes.InvalidArgument)
+	}
+
+	BoxContentHTML, err := s.collector(ctx)(endpoint.Request)
+	if err != nil {
+		return TibiaDataGRPCError(err, codes.Unavailable)
+	}
+
+	data, err := endpoint.Parse(BoxContentHTML)
+	if err != nil {
+		return TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return tibiaDataGRPCResponse(ctx, endpoint.Name, data, message)
+}
+
+// tibiaDataGRPCResponse converts the response of a handler into message
+// A fan-out that ran into the end of the call has skipped its remaining fetches, so the error of ctx is returned instead.
+func tibiaDataGRPCResponse(ctx context.Context, name string, data interface{}, message proto.Message) error {
+	if err := ctx.Err(); err != nil {
+		return TibiaDataGRPCError(err, codes.Canceled)
+	}
+
+	if err := TibiaDataToProto(data, message); err != nil {
+		return TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	if TibiaDataDebug {
+		log.Println("[info] " + name + " - (gRPC) executed successfully.")
+	}
+
+	return nil
+}
+
+// TibiaDataGRPCError func - converts an error into a gRPC status error
+// The code of a validation.Error decides on the status code, all other errors get the fallback code.
+// The errors of a cancelled or expired call keep their own status (Canceled or DeadlineExceeded).
+// The status carries the Information of the error (as in the REST API) and for validation errors an ErrorInfo.
+func TibiaDataGRPCError(err error, fallback codes.Code) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	info := TibiaDataErrorInformation(err, 0)
+
+	code := fallback
+	if t, ok := err.(validation.Error); ok {
+		code = tibiaDataGRPCCode(t)
+	}
+
+	st := status.New(code, info.Status.Message)
+
+	information := &tibiadatapb.Information{}
+	if TibiaDataToProto(info, information) == nil {
+		if detailed, err := st.WithDetails(information); err == nil {
+			st = detailed
+		}
+	}
+
+	if info.Status.Error != 0 {
+		errorInfo := &errdetails.ErrorInfo{
+			Reason: "ERROR_" + strconv.Itoa(info.Status.Error),
+			Domain: "tibiadata.com",
+			Metadata: map[string]string{
+				"error":     strconv.Itoa(info.Status.Error),
+				"http_code": strconv.Itoa(info.Status.HTTPCode),
+			},
+		}
+		if detailed, err := st.WithDetails(errorInfo); err == nil {
+			st = detailed
+		}
+	}
+
+	return st.Err()
+}
+
+// tibiaDataGRPCCode returns the gRPC status code of a validation.Error
+func tibiaDataGRPCCode(err validation.Error) codes.Code {
+	switch err {
+	case validation.ErrorAlreadyRunning, validation.ErrorValidatorNotInitiated:
+		return codes.Internal
+	case validation.ErrorRestrictionMode, validation.ErrorStoreNotEnabled, validation.ErrorHighscoreSnapshotsNotEnough, validation.ErrorWatchlistFull, validation.ErrorWebhooksTooMany, validation.ErrorWorldSnapshotsNotEnough, validation.ErrorGuildTrackerFull, validation.ErrorWarTrackerFull, validation.ErrorGuildTooManyMembers:
+		return codes.FailedPrecondition
+	case validation.ErrorCharacterNotFound, validation.ErrorCreatureNotFound, validation.ErrorSpellNotFound, validation.ErrorGuildNotFound, validation.ErrorCharacterNotWatched, validation.ErrorWebhookNotFound, validation.ErrorWorldNotTracked, validation.ErrorGuildNotTracked, validation.ErrorWarNotTracked:
+		return codes.NotFound
+	case validation.ErrorManagementNotEnabled:
+		return codes.PermissionDenied
+	case validation.ErrorManagementTokenInvalid:
+		return codes.Unauthenticated
+	case validation.ErrStatusForbidden:
+		return codes.ResourceExhausted
+	}
+
+	// An error occurred at tibia.com
+	if err.Code() > 20000 {
+		return codes.Unavailable
+	}
+
+	return codes.InvalidArgument
+}
+
+// GetBoostableBosses returns the boostable bosses
+func (s *tibiaDataGRPCServer) GetBoostableBosses(ctx context.Context, req *tibiadatapb.BoostableBossesRequest) (*tibiadatapb.BoostableBossesOverviewResponse, error) {
+	response := &tibiadatapb.BoostableBossesOverviewResponse{}
+	return response, s.fetch(ctx, tibiaBoostableBossesEndpoint(), nil, response)
+}
+
+// GetCharacter returns one character
+func (s *tibiaDataGRPCServer) GetCharacter(ctx context.Context, req *tibiadatapb.CharacterRequest) (*tibiadatapb.CharacterResponse, error) {
+	endpoint, err := tibiaCharactersCharacterEndpoint(req.GetName())
+	response := &tibiadatapb.CharacterResponse{}
+	return response, s.fetch(ctx, endpoint, err, response)
+}
+
+// GetCharacterGuildHistory returns the changes of a character seen in the tracked guilds
+func (s *tibiaDataGRPCServer) GetCharacterGuildHistory(ctx context.Context, req *tibiadatapb.CharacterRequest) (*tibiadatapb.CharacterGuildHistoryResponse, error) {
+	response := &tibiadatapb.CharacterGuildHistoryResponse{}
+
+	data, err := TibiaCharactersGuildHistoryImpl(req.GetName())
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaCharactersGuildHistory", data, response)
+}
+
+// GetCharacterRanks returns the rank of one character in every highscore category
+func (s *tibiaDataGRPCServer) GetCharacterRanks(ctx context.Context, req *tibiadatapb.CharacterRequest) (*tibiadatapb.CharacterRanksResponse, error) {
+	response := &tibiadatapb.CharacterRanksResponse{}
+
+	data, err := TibiaCharactersRanksImpl(req.GetName(), s.collector(ctx))
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Unavailable)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaCharactersRanks", data, response)
+}
+
+// GetCharacters returns many characters, with a result or an error per name
+func (s *tibiaDataGRPCServer) GetCharacters(ctx context.Context, req *tibiadatapb.CharactersRequest) (*tibiadatapb.CharactersResponse, error) {
+	response := &tibiadatapb.CharactersResponse{}
+
+	data, err := TibiaCharactersBatchImpl(req.GetNames(), s.collector(ctx))
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.InvalidArgument)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaCharactersBatch", data, response)
+}
+
+// GetCreature returns one creature
+func (s *tibiaDataGRPCServer) GetCreature(ctx context.Context, req *tibiadatapb.CreatureRequest) (*tibiadatapb.CreatureResponse, error) {
+	endpoint, err := tibiaCreaturesCreatureEndpoint(req.GetRace())
+	response := &tibiadatapb.CreatureResponse{}
+	return response, s.fetch(ctx, endpoint, err, response)
+}
+
+// GetCreatures returns the creatures overview
+func (s *tibiaDataGRPCServer) GetCreatures(ctx context.Context, req *tibiadatapb.CreaturesRequest) (*tibiadatapb.CreaturesOverviewResponse, error) {
+	response := &tibiadatapb.CreaturesOverviewResponse{}
+	return response, s.fetch(ctx, tibiaCreaturesOverviewEndpoint(), nil, response)
+}
+
+// GetExperience returns the level progress of a level or an amount of experience
+func (s *tibiaDataGRPCServer) GetExperience(ctx context.Context, req *tibiadatapb.ExperienceRequest) (*tibiadatapb.ExperienceResponse, error) {
+	response := &tibiadatapb.ExperienceResponse{}
+
+	var level, experience string
+	if req.Level != nil {
+		level = strconv.FormatInt(req.GetLevel(), 10)
+	}
+	if req.Experience != nil {
+		experience = strconv.FormatInt(req.GetExperience(), 10)
+	}
+
+	data, err := TibiaExperienceImpl(level, experience)
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaExperience", data, response)
+}
+
+// GetFansites returns the promoted and supported fansites
+func (s *tibiaDataGRPCServer) GetFansites(ctx context.Context, req *tibiadatapb.FansitesRequest) (*tibiadatapb.FansitesResponse, error) {
+	response := &tibiadatapb.FansitesResponse{}
+	return response, s.fetch(ctx, tibiaFansitesEndpoint(), nil, response)
+}
+
+// GetGuild returns one guild
+func (s *tibiaDataGRPCServer) GetGuild(ctx context.Context, req *tibiadatapb.GuildRequest) (*tibiadatapb.GuildResponse, error) {
+	if req.GetGuildhallDetails() {
+		response := &tibiadatapb.GuildResponse{}
+
+		data, err := TibiaGuildsGuildWithGuildhallsImpl(req.GetName(), s.collector(ctx))
+		if err != nil {
+			return response, TibiaDataGRPCError(err, codes.Unavailable)
+		}
+
+		return response, tibiaDataGRPCResponse(ctx, "TibiaGuildsGuild", data, response)
+	}
+
+	endpoint, err := tibiaGuildsGuildEndpoint(req.GetName())
+	response := &tibiadatapb.GuildResponse{}
+	return response, s.fetch(ctx, endpoint, err, response)
+}
+
+// GetGuildEvents returns the event history of one guild
+func (s *tibiaDataGRPCServer) GetGuildEvents(ctx context.Context, req *tibiadatapb.GuildRequest) (*tibiadatapb.GuildEventsResponse, error) {
+	endpoint, err := tibiaGuildsGuildEventsEndpoint(req.GetName())
+	response := &tibiadatapb.GuildEventsResponse{}
+	return response, s.fetch(ctx, endpoint, err, response)
+}
+
+// GetGuildExpanded returns one guild with the characters of its members
+func (s *tibiaDataGRPCServer) GetGuildExpanded(ctx context.Context, req *tibiadatapb.GuildExpandedRequest) (*tibiadatapb.GuildExpandedResponse, error) {
+	response := &tibiadatapb.GuildExpandedResponse{}
+
+	data, err := TibiaGuildsGuildExpandedImpl(req.GetName(), req.GetInclude(), s.collector(ctx))
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Unavailable)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaGuildsGuildExpanded", data, response)
+}
+
+// GetGuildStatistics returns the statistics of the members of one guild
+func (s *tibiaDataGRPCServer) GetGuildStatistics(ctx context.Context, req *tibiadatapb.GuildRequest) (*tibiadatapb.GuildStatisticsResponse, error) {
+	endpoint, err := tibiaGuildsGuildStatisticsEndpoint(req.GetName())
+	response := &tibiadatapb.GuildStatisticsResponse{}
+	return response, s.fetch(ctx, endpoint, err, response)
+}
+
+// GetGuildWars returns the active and past wars of one guild
+func (s *tibiaDataGRPCServer) GetGuildWars(ctx context.Context, req *tibiadatapb.GuildRequest) (*tibiadatapb.GuildWarsResponse, error) {
+	endpoint, err := tibiaGuildsGuildWarsEndpoint(req.GetName())
+	response := &tibiadatapb.GuildWarsResponse{}
+	return response, s.fetch(ctx, endpoint, err, response)
+}
+
+// GetGuilds returns all guilds of a world
+func (s *tibiaDataGRPCServer) GetGuilds(ctx context.Context, req *tibiadatapb.GuildsRequest) (*tibiadatapb.GuildsOverviewResponse, error) {
+	endpoint, err := tibiaGuildsOverviewEndpoint(req.GetWorld())
+	response := &tibiadatapb.GuildsOverviewResponse{}
+	return response, s.fetch(ctx, endpoint, err, response)
+}
+
+// GetGuildsLeaderboard returns the active guilds of a world ranked by the levels, number or online status of their members
+func (s *tibiaDataGRPCServer) GetGuildsLeaderboard(ctx context.Context, req *tibiadatapb.GuildsLeaderboardRequest) (*tibiadatapb.GuildsLeaderboardResponse, error) {
+	response := &tibiadatapb.GuildsLeaderboardResponse{}
+
+	data, err := TibiaGuildsLeaderboardImpl(req.GetWorld(), req.GetSort(), s.collector(ctx))
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Unavailable)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaGuildsLeaderboard", data, response)
+}
+
+// GetGuildsStatistics returns the statistics of all active guilds of a world, ranked by one of them
+func (s *tibiaDataGRPCServer) GetGuildsStatistics(ctx context.Context, req *tibiadatapb.GuildsStatisticsRequest) (*tibiadatapb.GuildsStatisticsResponse, error) {
+	response := &tibiadatapb.GuildsStatisticsResponse{}
+
+	data, err := TibiaGuildsOverviewStatisticsImpl(req.GetWorld(), req.GetSort(), s.collector(ctx))
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Unavailable)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaGuildsOverviewStatistics", data, response)
+}
+
+// GetGuildTracker returns all tracked guilds
+func (s *tibiaDataGRPCServer) GetGuildTracker(ctx context.Context, req *tibiadatapb.GuildTrackerListRequest) (*tibiadatapb.GuildTrackerResponse, error) {
+	response := &tibiadatapb.GuildTrackerResponse{}
+
+	data, err := TibiaGuildsTrackerImpl()
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaGuildsTracker", data, response)
+}
+
+// AddToGuildTracker adds guilds to the guild tracker
+func (s *tibiaDataGRPCServer) AddToGuildTracker(ctx context.Context, req *tibiadatapb.GuildTrackerRequest) (*tibiadatapb.GuildTrackerResponse, error) {
+	response := &tibiadatapb.GuildTrackerResponse{}
+
+	data, err := TibiaGuildsTrackerAddImpl(req.GetNames(), time.Now())
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaGuildsTrackerAdd", data, response)
+}
+
+// RemoveFromGuildTracker removes a guild and its history from the guild tracker
+func (s *tibiaDataGRPCServer) RemoveFromGuildTracker(ctx context.Context, req *tibiadatapb.GuildRequest) (*tibiadatapb.GuildTrackerResponse, error) {
+	response := &tibiadatapb.GuildTrackerResponse{}
+
+	data, err := TibiaGuildsTrackerRemoveImpl(req.GetName())
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaGuildsTrackerRemove", data, response)
+}
+
+// GetGuildHistory returns the changes of the members of a tracked guild
+func (s *tibiaDataGRPCServer) GetGuildHistory(ctx context.Context, req *tibiadatapb.GuildHistoryRequest) (*tibiadatapb.GuildHistoryResponse, error) {
+	response := &tibiadatapb.GuildHistoryResponse{}
+
+	data, err := TibiaGuildsHistoryImpl(req.GetName(), req.GetFrom(), req.GetTo(), req.GetPeriod())
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaGuildsHistory", data, response)
+}
+
+// highscoresRequestParams returns the parameters of a highscores request with the defaults of the REST API
+func highscoresRequestParams(req *tibiadatapb.HighscoresRequest) (world, category, vocation string, page int, filter HighscoresFilter) {
+	world, category, vocation, page = req.GetWorld(), req.GetCategory(), req.GetVocation(), int(req.GetPage())
+	if world == "" {
+		world = "all"
+	}
+	if category == "" {
+		category = "experience"
+	}
+	if vocation == "" {
+		vocation = TibiaDataDefaultVoc
+	}
+	if page == 0 {
+		page = 1
+	}
+	filter = HighscoresFilter{WorldTypes: req.GetWorldTypes(), BattlEye: req.GetBattleye()}
+
+	return world, category, vocation, page, filter
+}
+
+// GetHighscores returns one highscore page
+func (s *tibiaDataGRPCServer) GetHighscores(ctx context.Context, req *tibiadatapb.HighscoresRequest) (*tibiadatapb.HighscoresResponse, error) {
+	world, category, vocation, page, filter := highscoresRequestParams(req)
+	endpoint, err := tibiaHighscoresEndpoint(world, category, vocation, strconv.Itoa(page), filter)
+	response := &tibiadatapb.HighscoresResponse{}
+	return response, s.fetch(ctx, endpoint, err, response)
+}
+
+// StreamHighscores sends all highscore pages from the requested page until the last page
+func (s *tibiaDataGRPCServer) StreamHighscores(req *tibiadatapb.HighscoresRequest, stream grpc.ServerStreamingServer[tibiadatapb.HighscoresResponse]) error {
+	ctx := stream.Context()
+	world, category, vocation, page, filter := highscoresRequestParams(req)
+
+	for ; ; page++ {
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		endpoint, err := tibiaHighscoresEndpoint(world, category, vocation, strconv.Itoa(page), filter)
+		response := &tibiadatapb.HighscoresResponse{}
+		if err := s.fetch(ctx, endpoint, err, response); err != nil {
+			return err
+		}
+
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+
+		if page >= int(response.GetHighscores().GetHighscorePage().GetTotalPages()) {
+			return nil
+		}
+	}
+}
+
+// GetAllHighscores returns the highscores of all pages merged in rank order (the page is ignored)
+func (s *tibiaDataGRPCServer) GetAllHighscores(ctx context.Context, req *tibiadatapb.HighscoresRequest) (*tibiadatapb.HighscoresAllResponse, error) {
+	response := &tibiadatapb.HighscoresAllResponse{}
+
+	world, category, vocation, _, filter := highscoresRequestParams(req)
+	data, err := TibiaHighscoresAllImpl(world, category, vocation, filter, s.collector(ctx), nil)
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Unavailable)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaHighscoresAll", data, response)
+}
+
+// highscoresSnapshotsRequestParams returns the parameters of a highscores snapshots request with the defaults of the REST API
+func highscoresSnapshotsRequestParams(req *tibiadatapb.HighscoresSnapshotsRequest) (world, category, vocation string) {
+	world, category, vocation, _, _ = highscoresRequestParams(&tibiadatapb.HighscoresRequest{World: req.GetWorld(), Category: req.GetCategory(), Vocation: req.GetVocation()})
+	return world, category, vocation
+}
+
+// GetHighscoresDeltas returns the value gained by every character between two snapshots of a highscore list
+func (s *tibiaDataGRPCServer) GetHighscoresDeltas(ctx context.Context, req *tibiadatapb.HighscoresSnapshotsRequest) (*tibiadatapb.HighscoresDeltasResponse, error) {
+	response := &tibiadatapb.HighscoresDeltasResponse{}
+
+	world, category, vocation := highscoresSnapshotsRequestParams(req)
+	data, err := TibiaHighscoresDeltasImpl(world, category, vocation, req.GetFrom(), req.GetTo(), req.GetPeriod())
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaHighscoresDeltas", data, response)
+}
+
+// GetHighscoresRankChanges returns the rank changes of all characters between two snapshots of a highscore list
+func (s *tibiaDataGRPCServer) GetHighscoresRankChanges(ctx context.Context, req *tibiadatapb.HighscoresSnapshotsRequest) (*tibiadatapb.HighscoresRankChangesResponse, error) {
+	response := &tibiadatapb.HighscoresRankChangesResponse{}
+
+	world, category, vocation := highscoresSnapshotsRequestParams(req)
+	data, err := TibiaHighscoresRankChangesImpl(world, category, vocation, req.GetFrom(), req.GetTo(), req.GetPeriod())
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaHighscoresRankChanges", data, response)
+}
+
+// GetHouse returns one house
+func (s *tibiaDataGRPCServer) GetHouse(ctx context.Context, req *tibiadatapb.HouseRequest) (*tibiadatapb.HouseResponse, error) {
+	endpoint, err := tibiaHousesHouseEndpoint(req.GetWorld(), strconv.FormatInt(req.GetHouseId(), 10))
+	response := &tibiadatapb.HouseResponse{}
+	return response, s.fetch(ctx, endpoint, err, response)
+}
+
+// GetHousesWorld returns the houses and guildhalls of all towns of a world
+func (s *tibiaDataGRPCServer) GetHousesWorld(ctx context.Context, req *tibiadatapb.HousesWorldRequest) (*tibiadatapb.HousesWorldResponse, error) {
+	response := &tibiadatapb.HousesWorldResponse{}
+
+	filter := HousesFilter{
+		MinSize:   int(req.GetMinSize()),
+		MaxSize:   int(req.GetMaxSize()),
+		MinRent:   int(req.GetMinRent()),
+		MaxRent:   int(req.GetMaxRent()),
+		Auctioned: req.GetAuctioned(),
+	}
+	data, err := TibiaHousesWorldImpl(req.GetWorld(), filter, s.collector(ctx))
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Unavailable)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaHousesWorld", data, response)
+}
+
+// GetHouses returns all houses and guildhalls of a town
+func (s *tibiaDataGRPCServer) GetHouses(ctx context.Context, req *tibiadatapb.HousesRequest) (*tibiadatapb.HousesOverviewResponse, error) {
+	response := &tibiadatapb.HousesOverviewResponse{}
+
+	world, town, err := tibiaHousesOverviewParams(req.GetWorld(), req.GetTown())
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.InvalidArgument)
+	}
+
+	data, err := TibiaHousesOverviewImpl(nil, world, town, s.collector(ctx))
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Unavailable)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaHousesOverview", data, response)
+}
+
+// GetHousesSearch searches the houses and guildhalls of a world by their size, beds, rent and auction
+func (s *tibiaDataGRPCServer) GetHousesSearch(ctx context.Context, req *tibiadatapb.HousesSearchRequest) (*tibiadatapb.HousesSearchResponse, error) {
+	response := &tibiadatapb.HousesSearchResponse{}
+
+	query := HousesSearchQuery{
+		Town: req.GetTown(),
+		Type: req.GetType(),
+		Filter: HousesFilter{
+			MinSize:   int(req.GetMinSize()),
+			MaxSize:   int(req.GetMaxSize()),
+			MinRent:   int(req.GetMinRent()),
+			MaxRent:   int(req.GetMaxRent()),
+			Auctioned: req.GetAuctioned(),
+		},
+		MinBeds:  int(req.GetMinBeds()),
+		MaxBeds:  int(req.GetMaxBeds()),
+		Sort:     req.GetSort(),
+		Order:    req.GetOrder(),
+		Page:     int(req.GetPage()),
+		PageSize: int(req.GetPageSize()),
+	}
+	data, err := TibiaHousesSearchImpl(req.GetWorld(), query, s.collector(ctx))
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Unavailable)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaHousesSearch", data, response)
+}
+
+// GetKillStatistics returns the killstatistics of a world
+func (s *tibiaDataGRPCServer) GetKillStatistics(ctx context.Context, req *tibiadatapb.KillStatisticsRequest) (*tibiadatapb.KillStatisticsResponse, error) {
+	endpoint, err := tibiaKillstatisticsEndpoint(req.GetWorld())
+	response := &tibiadatapb.KillStatisticsResponse{}
+	return response, s.fetch(ctx, endpoint, err, response)
+}
+
+// GetNews returns one news entry
+func (s *tibiaDataGRPCServer) GetNews(ctx context.Context, req *tibiadatapb.NewsRequest) (*tibiadatapb.NewsResponse, error) {
+	endpoint, err := tibiaNewsEndpoint(strconv.FormatInt(req.GetNewsId(), 10))
+	response := &tibiadatapb.NewsResponse{}
+	return response, s.fetch(ctx, endpoint, err, response)
+}
+
+// GetNewsList returns the news list of the requested type
+func (s *tibiaDataGRPCServer) GetNewsList(ctx context.Context, req *tibiadatapb.NewsListRequest) (*tibiadatapb.NewsListResponse, error) {
+	// NEWS_LIST_TYPE_LATEST is the type latest of the REST API
+	newsType := strings.ToLower(strings.TrimPrefix(req.GetType().String(), "NEWS_LIST_TYPE_"))
+
+	var days string
+	if req.GetDays() != 0 {
+		days = strconv.FormatInt(req.GetDays(), 10)
+	}
+
+	endpoint, err := tibiaNewslistEndpoint(newsType, days)
+	response := &tibiadatapb.NewsListResponse{}
+	return response, s.fetch(ctx, endpoint, err, response)
+}
+
+// GetSpell returns one spell
+func (s *tibiaDataGRPCServer) GetSpell(ctx context.Context, req *tibiadatapb.SpellRequest) (*tibiadatapb.SpellInformationResponse, error) {
+	endpoint, err := tibiaSpellsSpellEndpoint(req.GetSpellId())
+	response := &tibiadatapb.SpellInformationResponse{}
+	return response, s.fetch(ctx, endpoint, err, response)
+}
+
+// GetSpells returns all spells of a vocation
+func (s *tibiaDataGRPCServer) GetSpells(ctx context.Context, req *tibiadatapb.SpellsRequest) (*tibiadatapb.SpellsOverviewResponse, error) {
+	endpoint, err := tibiaSpellsOverviewEndpoint(req.GetVocation())
+	response := &tibiadatapb.SpellsOverviewResponse{}
+	return response, s.fetch(ctx, endpoint, err, response)
+}
+
+// GetWarTracker returns all tracked wars
+func (s *tibiaDataGRPCServer) GetWarTracker(ctx context.Context, req *tibiadatapb.WarTrackerListRequest) (*tibiadatapb.WarTrackerResponse, error) {
+	response := &tibiadatapb.WarTrackerResponse{}
+
+	data, err := TibiaGuildsWarTrackerImpl()
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaGuildsWarTracker", data, response)
+}
+
+// AddToWarTracker adds a war between two guilds to the war tracker
+func (s *tibiaDataGRPCServer) AddToWarTracker(ctx context.Context, req *tibiadatapb.WarTrackerRequest) (*tibiadatapb.WarTrackerResponse, error) {
+	response := &tibiadatapb.WarTrackerResponse{}
+
+	data, err := TibiaGuildsWarTrackerAddImpl(WarTrackerRequest{Guild: req.GetGuild(), Opponent: req.GetOpponent()}, time.Now(), s.collector(ctx))
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Unavailable)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaGuildsWarTrackerAdd", data, response)
+}
+
+// RemoveFromWarTracker removes a war and its kills from the war tracker
+func (s *tibiaDataGRPCServer) RemoveFromWarTracker(ctx context.Context, req *tibiadatapb.WarTrackerIDRequest) (*tibiadatapb.WarTrackerResponse, error) {
+	response := &tibiadatapb.WarTrackerResponse{}
+
+	data, err := TibiaGuildsWarTrackerRemoveImpl(strconv.FormatInt(req.GetId(), 10))
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaGuildsWarTrackerRemove", data, response)
+}
+
+// GetWarKills returns the kill feed of a tracked war
+func (s *tibiaDataGRPCServer) GetWarKills(ctx context.Context, req *tibiadatapb.WarKillsRequest) (*tibiadatapb.WarKillsResponse, error) {
+	response := &tibiadatapb.WarKillsResponse{}
+
+	data, err := TibiaGuildsWarKillsImpl(strconv.FormatInt(req.GetId(), 10), req.GetFrom(), req.GetTo(), req.GetPeriod())
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaGuildsWarKills", data, response)
+}
+
+// GetWarScoreboard returns the scoreboard of a tracked war
+func (s *tibiaDataGRPCServer) GetWarScoreboard(ctx context.Context, req *tibiadatapb.WarTrackerIDRequest) (*tibiadatapb.WarScoreboardResponse, error) {
+	response := &tibiadatapb.WarScoreboardResponse{}
+
+	data, err := TibiaGuildsWarScoreboardImpl(strconv.FormatInt(req.GetId(), 10))
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaGuildsWarScoreboard", data, response)
+}
+
+// GetWatchlist returns all watched characters
+func (s *tibiaDataGRPCServer) GetWatchlist(ctx context.Context, req *tibiadatapb.WatchlistRequest) (*tibiadatapb.WatchlistResponse, error) {
+	response := &tibiadatapb.WatchlistResponse{}
+
+	data, err := TibiaWatchlistImpl()
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaWatchlist", data, response)
+}
+
+// AddToWatchlist adds characters to the watchlist
+func (s *tibiaDataGRPCServer) AddToWatchlist(ctx context.Context, req *tibiadatapb.CharactersRequest) (*tibiadatapb.WatchlistResponse, error) {
+	response := &tibiadatapb.WatchlistResponse{}
+
+	data, err := TibiaWatchlistAddImpl(req.GetNames(), time.Now())
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaWatchlistAdd", data, response)
+}
+
+// RemoveFromWatchlist removes a character and its history from the watchlist
+func (s *tibiaDataGRPCServer) RemoveFromWatchlist(ctx context.Context, req *tibiadatapb.CharacterRequest) (*tibiadatapb.WatchlistResponse, error) {
+	response := &tibiadatapb.WatchlistResponse{}
+
+	data, err := TibiaWatchlistRemoveImpl(req.GetName())
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaWatchlistRemove", data, response)
+}
+
+// GetWatchlistTimeline returns the snapshots and levels of a watched character
+func (s *tibiaDataGRPCServer) GetWatchlistTimeline(ctx context.Context, req *tibiadatapb.WatchlistTimelineRequest) (*tibiadatapb.WatchlistTimelineResponse, error) {
+	response := &tibiadatapb.WatchlistTimelineResponse{}
+
+	data, err := TibiaWatchlistTimelineImpl(req.GetName(), req.GetFrom(), req.GetTo(), req.GetPeriod())
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaWatchlistTimeline", data, response)
+}
+
+// GetWatchlistDeaths returns all deaths of a watched character
+func (s *tibiaDataGRPCServer) GetWatchlistDeaths(ctx context.Context, req *tibiadatapb.CharacterRequest) (*tibiadatapb.WatchlistDeathsResponse, error) {
+	response := &tibiadatapb.WatchlistDeathsResponse{}
+
+	data, err := TibiaWatchlistDeathsImpl(req.GetName())
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaWatchlistDeaths", data, response)
+}
+
+// GetWebhooks returns all webhooks
+func (s *tibiaDataGRPCServer) GetWebhooks(ctx context.Context, req *tibiadatapb.WebhooksRequest) (*tibiadatapb.WebhooksResponse, error) {
+	response := &tibiadatapb.WebhooksResponse{}
+
+	data, err := TibiaWebhooksImpl()
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaWebhooks", data, response)
+}
+
+// AddWebhook adds a webhook and returns it with its secret
+func (s *tibiaDataGRPCServer) AddWebhook(ctx context.Context, req *tibiadatapb.WebhookRequest) (*tibiadatapb.WebhookResponse, error) {
+	response := &tibiadatapb.WebhookResponse{}
+
+	request := WebhookRequest{
+		URL:        req.GetUrl(),
+		Secret:     req.GetSecret(),
+		Events:     req.GetEvents(),
+		Characters: req.GetCharacters(),
+		Guilds:     req.GetGuilds(),
+	}
+	for _, house := range req.GetHouses() {
+		request.Houses = append(request.Houses, WebhookHouse{World: house.GetWorld(), HouseID: int(house.GetHouseId())})
+	}
+
+	data, err := TibiaWebhooksAddImpl(request, time.Now())
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaWebhooksAdd", data, response)
+}
+
+// RemoveWebhook removes a webhook and its delivery log
+func (s *tibiaDataGRPCServer) RemoveWebhook(ctx context.Context, req *tibiadatapb.WebhookIDRequest) (*tibiadatapb.WebhooksResponse, error) {
+	response := &tibiadatapb.WebhooksResponse{}
+
+	data, err := TibiaWebhooksRemoveImpl(strconv.FormatInt(req.GetId(), 10))
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaWebhooksRemove", data, response)
+}
+
+// GetWebhookDeliveries returns the delivery log of a webhook
+func (s *tibiaDataGRPCServer) GetWebhookDeliveries(ctx context.Context, req *tibiadatapb.WebhookDeliveriesRequest) (*tibiadatapb.WebhookDeliveriesResponse, error) {
+	response := &tibiadatapb.WebhookDeliveriesResponse{}
+
+	data, err := TibiaWebhooksDeliveriesImpl(strconv.FormatInt(req.GetId(), 10), req.GetStatus())
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaWebhooksDeliveries", data, response)
+}
+
+// GetWorld returns one world
+func (s *tibiaDataGRPCServer) GetWorld(ctx context.Context, req *tibiadatapb.WorldRequest) (*tibiadatapb.WorldResponse, error) {
+	endpoint, err := tibiaWorldsWorldEndpoint(req.GetName())
+	response := &tibiadatapb.WorldResponse{}
+	return response, s.fetch(ctx, endpoint, err, response)
+}
+
+// GetWorldOnlineDiff returns the logins, logouts, level changes and sessions of a tracked world
+func (s *tibiaDataGRPCServer) GetWorldOnlineDiff(ctx context.Context, req *tibiadatapb.WorldOnlineDiffRequest) (*tibiadatapb.WorldOnlineDiffResponse, error) {
+	response := &tibiadatapb.WorldOnlineDiffResponse{}
+
+	data, err := TibiaWorldOnlineDiffImpl(req.GetName(), req.GetFrom(), req.GetTo(), req.GetPeriod())
+	if err != nil {
+		return response, TibiaDataGRPCError(err, codes.Internal)
+	}
+
+	return response, tibiaDataGRPCResponse(ctx, "TibiaWorldOnlineDiff", data, response)
+}
+
+// GetWorlds returns all worlds
+func (s *tibiaDataGRPCServer) GetWorlds(ctx context.Context, req *tibiadatapb.WorldsRequest) (*tibiadatapb.WorldsOverviewResponse, error) {
+	response := &tibiadatapb.WorldsOverviewResponse{}
+	return response, s.fetch(ctx, tibiaWorldsOverviewEndpoint(), nil, response)
+}
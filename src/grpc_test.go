@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/static"
+	"github.com/tibiadata/tibiadata-api-go/src/tibiadatapb"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestGRPCClient starts a gRPC server in memory that uses the given collector
+func newTestGRPCClient(t *testing.T, htmlDataCollector func(TibiaDataRequestStruct) (string, error)) tibiadatapb.TibiaDataClient {
+	listener := bufconn.Listen(1024 * 1024)
+	server := newGRPCServer(htmlDataCollector)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return tibiadatapb.NewTibiaDataClient(conn)
+}
+
+// testFileCollector returns a collector always returning the content of a test file
+func testFileCollector(t *testing.T, name string, requests *[]TibiaDataRequestStruct) func(TibiaDataRequestStruct) (string, error) {
+	file, err := static.TestFiles.Open(name)
+	if err != nil {
+		t.Fatalf("file opening error: %s", err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		t.Fatalf("File reading error: %s", err)
+	}
+
+	return func(request TibiaDataRequestStruct) (string, error) {
+		if requests != nil {
+			*requests = append(*requests, request)
+		}
+		return string(data), nil
+	}
+}
+
+func TestGRPCGetCharacter(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests []TibiaDataRequestStruct
+	client := newTestGRPCClient(t, testFileCollector(t, "testdata/characters/Darkside Rafa.html", &requests))
+
+	response, err := client.GetCharacter(context.Background(), &tibiadatapb.CharacterRequest{Name: "Darkside Rafa"})
+	assert.Nil(err)
+	assert.Equal("Darkside Rafa", response.GetCharacter().GetCharacter().GetName())
+	assert.Equal("Gladera", response.GetCharacter().GetCharacter().GetWorld())
+	assert.NotEmpty(response.GetCharacter().GetDeaths())
+
+	assert.Len(requests, 1)
+	assert.Equal("https://www.tibia.com/community/?subtopic=characters&name=Darkside+Rafa", requests[0].URL)
+}
+
+func TestGRPCStreamHighscores(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests []TibiaDataRequestStruct
+	client := newTestGRPCClient(t, testFileCollector(t, "testdata/highscores/all.html", &requests))
+
+	// the test file has 20 pages
+	stream, err := client.StreamHighscores(context.Background(), &tibiadatapb.HighscoresRequest{Page: 18})
+	assert.Nil(err)
+
+	var pages []int64
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if !assert.Nil(err) {
+			return
+		}
+
+		assert.Len(response.GetHighscores().GetHighscoreList(), 50)
+		pages = append(pages, response.GetHighscores().GetHighscorePage().GetCurrentPage())
+	}
+
+	assert.Equal([]int64{18, 19, 20}, pages)
+	assert.Len(requests, 3)
+	assert.True(strings.HasSuffix(requests[2].URL, "&currentpage=20"))
+}
+
+func TestGRPCErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	client := newTestGRPCClient(t, func(TibiaDataRequestStruct) (string, error) {
+		return "", validation.ErrorMaintenanceMode
+	})
+
+	// validation errors are invalid arguments and carry the error code
+	_, err := client.GetCharacter(context.Background(), &tibiadatapb.CharacterRequest{Name: "a"})
+	st := status.Convert(err)
+	assert.Equal(codes.InvalidArgument, st.Code())
+	assert.Equal(validation.ErrorCharacterNameTooSmall.Error(), st.Message())
+
+	var (
+		information *tibiadatapb.Information
+		errorInfo   *errdetails.ErrorInfo
+	)
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *tibiadatapb.Information:
+			information = d
+		case *errdetails.ErrorInfo:
+			errorInfo = d
+		}
+	}
+	if assert.NotNil(information) {
+		assert.Equal(int64(10002), information.GetStatus().GetError())
+		assert.Equal(int64(400), information.GetStatus().GetHttpCode())
+	}
+	if assert.NotNil(errorInfo) {
+		assert.Equal("ERROR_10002", errorInfo.GetReason())
+	}
+
+	// errors of tibia.com are passed on
+	_, err = client.GetFansites(context.Background(), &tibiadatapb.FansitesRequest{})
+	assert.Equal(codes.Unavailable, status.Code(err))
+
+	// restriction mode
+	TibiaDataRestrictionMode = true
+	defer func() { TibiaDataRestrictionMode = false }()
+
+	_, err = client.GetHighscores(context.Background(), &tibiadatapb.HighscoresRequest{Vocation: "knights"})
+	assert.Equal(codes.FailedPrecondition, status.Code(err))
+}
+
+func TestGRPCDeadline(t *testing.T) {
+	assert := assert.New(t)
+
+	defer func(concurrency int) { TibiaDataFanOutConcurrency = concurrency }(TibiaDataFanOutConcurrency)
+	TibiaDataFanOutConcurrency = 1
+
+	var requests atomic.Int32
+	collector := testFileCollector(t, "testdata/characters/Darkside Rafa.html", nil)
+	client := newTestGRPCClient(t, func(request TibiaDataRequestStruct) (string, error) {
+		requests.Add(1)
+		time.Sleep(100 * time.Millisecond)
+		return collector(request)
+	})
+
+	// the first character outlasts the deadline, the others are not requested anymore
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := client.GetCharacters(ctx, &tibiadatapb.CharactersRequest{Names: []string{"Darkside Rafa", "Trollefar", "Bobeek", "Goraca"}})
+	assert.Equal(codes.DeadlineExceeded, status.Code(err))
+
+	time.Sleep(200 * time.Millisecond)
+	assert.Equal(int32(1), requests.Load())
+}
+
+func TestGRPCManagementToken(t *testing.T) {
+	assert := assert.New(t)
+	testStore(t)
+	client := newTestGRPCClient(t, nil)
+
+	defer func(token string) { TibiaDataManagementToken = token }(TibiaDataManagementToken)
+	TibiaDataManagementToken = ""
+
+	// without a configured token the RPCs managing the store are disabled, the others are not
+	_, err := client.GetWebhooks(context.Background(), &tibiadatapb.WebhooksRequest{})
+	assert.Equal(codes.PermissionDenied, status.Code(err))
+	_, err = client.GetWatchlist(context.Background(), &tibiadatapb.WatchlistRequest{})
+	assert.Nil(err)
+
+	TibiaDataManagementToken = "token"
+	_, err = client.AddToWatchlist(context.Background(), &tibiadatapb.CharactersRequest{Names: []string{"Durin"}})
+	assert.Equal(codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer token")
+	_, err = client.AddToWatchlist(ctx, &tibiadatapb.CharactersRequest{Names: []string{"Durin"}})
+	assert.Nil(err)
+}
+
+func TestGRPCCode(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(codes.Internal, tibiaDataGRPCCode(validation.ErrorValidatorNotInitiated))
+	assert.Equal(codes.InvalidArgument, tibiaDataGRPCCode(validation.ErrorWorldDoesNotExist))
+	assert.Equal(codes.NotFound, tibiaDataGRPCCode(validation.ErrorGuildNotFound))
+	assert.Equal(codes.ResourceExhausted, tibiaDataGRPCCode(validation.ErrStatusForbidden))
+	assert.Equal(codes.Unavailable, tibiaDataGRPCCode(validation.ErrStatusUnknown))
+}
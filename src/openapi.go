@@ -0,0 +1,811 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tibiadata/tibiadata-api-go/src/static"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+// openAPIDocument is an OpenAPI 3 document
+type openAPIDocument struct {
+	OpenAPI    string                                  `json:"openapi"`
+	Info       openAPIInfo                             `json:"info"`
+	Tags       []openAPITag                            `json:"tags"`
+	Paths      map[string]map[string]*openAPIOperation `json:"paths"`
+	Components openAPIComponents                       `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Version     string         `json:"version"`
+	License     openAPILicense `json:"license"`
+}
+
+type openAPILicense struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+type openAPITag struct {
+	Name string `json:"name"`
+}
+
+type openAPIComponents struct {
+	Schemas         map[string]*openAPISchema        `json:"schemas"`
+	Parameters      map[string]openAPIParameter      `json:"parameters"`
+	Responses       map[string]openAPIResponse       `json:"responses"`
+	SecuritySchemes map[string]openAPISecurityScheme `json:"securitySchemes"`
+}
+
+type openAPISecurityScheme struct {
+	Type        string `json:"type"`
+	Scheme      string `json:"scheme"`
+	Description string `json:"description,omitempty"`
+}
+
+type openAPIOperation struct {
+	Summary     string                     `json:"summary"`
+	Description string                     `json:"description,omitempty"`
+	OperationID string                     `json:"operationId"`
+	Tags        []string                   `json:"tags"`
+	Deprecated  bool                       `json:"deprecated,omitempty"`
+	Parameters  []openAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]openAPIResponse `json:"responses"`
+	Security    []map[string][]string      `json:"security,omitempty"`
+}
+
+type openAPIParameter struct {
+	Ref         string         `json:"$ref,omitempty"`
+	Name        string         `json:"name,omitempty"`
+	In          string         `json:"in,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Required    bool           `json:"required,omitempty"`
+	Schema      *openAPISchema `json:"schema,omitempty"`
+	Example     interface{}    `json:"example,omitempty"`
+}
+
+type openAPIRequestBody struct {
+	Required bool                        `json:"required"`
+	Content  map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Ref         string                      `json:"$ref,omitempty"`
+	Description string                      `json:"description,omitempty"`
+	Content     map[string]openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema   *openAPISchema            `json:"schema,omitempty"`
+	Examples map[string]openAPIExample `json:"examples,omitempty"`
+}
+
+type openAPIExample struct {
+	Summary string      `json:"summary"`
+	Value   interface{} `json:"value"`
+}
+
+type openAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Description          string                    `json:"description,omitempty"`
+	Enum                 []interface{}             `json:"enum,omitempty"`
+	Default              interface{}               `json:"default,omitempty"`
+	Minimum              *int                      `json:"minimum,omitempty"`
+	Items                *openAPISchema            `json:"items,omitempty"`
+	Properties           map[string]*openAPISchema `json:"properties,omitempty"`
+	Required             []string                  `json:"required,omitempty"`
+	AdditionalProperties *openAPISchema            `json:"additionalProperties,omitempty"`
+}
+
+// openAPIRoute is the documentation of a route registered in tibiaDataRoutes
+type openAPIRoute struct {
+	Method      string             // The http method of the route.
+	Path        string             // The gin path of the route.
+	Summary     string             // The summary of the operation.
+	Description string             // The description of the operation.
+	Tag         string             // The tag the operation is grouped by.
+	Parameters  []openAPIParameter // The path and query parameters.
+	RequestBody interface{}        // The json request body, nil if there is none.
+	Response    interface{}        // The json response, nil if there is none.
+	MediaType   string             // The media type of the response (default application/json).
+	StatusCode  int                // The status code of a successful response (default 200).
+	V4          bool               // Whether the endpoint has the query parameters and errors of the v4 endpoints.
+	Management  bool               // Whether the endpoint manages the store and needs the management token.
+	Deprecated  bool               // Whether the endpoint is deprecated.
+}
+
+// openAPIPathParam returns a required path parameter
+func openAPIPathParam(name, description string, schema *openAPISchema, example interface{}) openAPIParameter {
+	return openAPIParameter{Name: name, In: "path", Description: description, Required: true, Schema: schema, Example: example}
+}
+
+func openAPIString() *openAPISchema {
+	return &openAPISchema{Type: "string"}
+}
+
+func openAPIInteger(minimum int) *openAPISchema {
+	return &openAPISchema{Type: "integer", Minimum: &minimum}
+}
+
+func openAPIBoolean() *openAPISchema {
+	return &openAPISchema{Type: "boolean"}
+}
+
+func openAPIEnum(values ...string) *openAPISchema {
+	schema := &openAPISchema{Type: "string"}
+	for _, value := range values {
+		schema.Enum = append(schema.Enum, value)
+	}
+	return schema
+}
+
+var (
+	// openAPIHighscoreParams are the path parameters of the highscores
+	openAPIHighscoreParams = []openAPIParameter{
+		openAPIPathParam("world", "The world, all for all worlds", openAPIString(), "Antica"),
+		openAPIPathParam("category", "The category", openAPIEnum("achievements", "axefighting", "charmpoints", "clubfighting", "distancefighting", "experience", "fishing", "fistfighting", "goshnarstaint", "loyaltypoints", "magiclevel", "shielding", "swordfighting", "dromescore", "bosspoints"), "fishing"),
+		openAPIPathParam("vocation", "The vocation (only all in restriction mode)", openAPIEnum("all", "knights", "paladins", "sorcerers", "druids", "monks"), "all"),
+	}
+
+	// openAPIHighscoreFilterParams are the query parameters of the highscores of all worlds
+	openAPIHighscoreFilterParams = []openAPIParameter{
+		{
+			Name: "world_type", In: "query", Schema: openAPIEnum("open", "optional", "hardcore", "retro_open", "retro_hardcore"), Example: "optional",
+			Description: "The world types, repeated or comma separated (only on all worlds)",
+		},
+		{
+			Name: "battleye", In: "query", Schema: openAPIEnum("any", "unprotected", "protected", "initially_protected"), Example: "protected",
+			Description: "The battleye protection (only on all worlds)",
+		},
+	}
+
+	// openAPIHousesFilterParams are the query parameters filtering houses
+	openAPIHousesFilterParams = []openAPIParameter{
+		{Name: "min_size", In: "query", Description: "The minimum size in SQM", Schema: openAPIInteger(0), Example: 50},
+		{Name: "max_size", In: "query", Description: "The maximum size in SQM", Schema: openAPIInteger(0), Example: 200},
+		{Name: "min_rent", In: "query", Description: "The minimum monthly rent in gold coins", Schema: openAPIInteger(0), Example: 10000},
+		{Name: "max_rent", In: "query", Description: "The maximum monthly rent in gold coins", Schema: openAPIInteger(0), Example: 100000},
+		{Name: "auctioned", In: "query", Description: "Whether to only include auctioned houses", Schema: openAPIBoolean(), Example: true},
+	}
+
+	// openAPIHousesSearchParams are the query parameters of the house search besides the house filter
+	openAPIHousesSearchParams = []openAPIParameter{
+		{Name: "town", In: "query", Description: "The town to search, all towns if not given", Schema: openAPIString(), Example: "Thais"},
+		{Name: "type", In: "query", Description: "The type of home to search, both if not given", Schema: openAPIEnum(HousesSearchTypes...), Example: "house"},
+		{Name: "min_beds", In: "query", Description: "The minimum number of beds", Schema: openAPIInteger(0), Example: 3},
+		{Name: "max_beds", In: "query", Description: "The maximum number of beds", Schema: openAPIInteger(0), Example: 6},
+		{Name: "sort", In: "query", Description: "The value to sort by, rent if not given", Schema: openAPIEnum(HousesSearchSorts...), Example: "auction_end"},
+		{Name: "order", In: "query", Description: "The order to sort in, asc if not given", Schema: openAPIEnum("asc", "desc"), Example: "desc"},
+		{Name: "page", In: "query", Description: "The page to show, the first if not given", Schema: openAPIInteger(1), Example: 2},
+		{Name: "page_size", In: "query", Description: "The number of houses of a page, 25 if not given (at most 100)", Schema: openAPIInteger(1), Example: 50},
+	}
+
+	// openAPIPeriodParams are the query parameters of the period of endpoints using the store
+	openAPIPeriodParams = []openAPIParameter{
+		{Name: "period", In: "query", Description: "The period ending now, the last day if no parameter is given", Schema: openAPIEnum("day", "week"), Example: "week"},
+		{Name: "from", In: "query", Description: "The start of the period, as RFC 3339 time or date", Schema: openAPIString(), Example: "2025-01-01"},
+		{Name: "to", In: "query", Description: "The end of the period, as RFC 3339 time or date (default: now)", Schema: openAPIString(), Example: "2025-01-08T12:00:00Z"},
+	}
+
+	// openAPIRoutes is the documentation of all routes
+	openAPIRoutes = []openAPIRoute{
+		{Method: http.MethodGet, Path: "/", Summary: "API status", Tag: "health", Response: gin.H{}},
+		{Method: http.MethodGet, Path: "/ping", Summary: "Ping the API", Tag: "health", Response: OutInformation{}},
+		{Method: http.MethodGet, Path: "/health", Summary: "Liveness probe", Tag: "health", Response: gin.H{}, Deprecated: true},
+		{Method: http.MethodGet, Path: "/healthz", Summary: "Liveness probe", Tag: "health", Response: gin.H{}},
+		{Method: http.MethodGet, Path: "/readyz", Summary: "Readiness probe", Tag: "health", Response: gin.H{}},
+		{Method: http.MethodGet, Path: "/debug", Summary: "Debug information", Description: "Show the user agent and the checksums of the validation data", Tag: "health", Response: DebugOutInformation{}},
+		{Method: http.MethodGet, Path: "/versions", Summary: "Container version details", Tag: "health", Response: gin.H{}},
+		{
+			Method: http.MethodGet, Path: "/v3/*action", Summary: "TibiaData API version 3", Description: "TibiaData v3 is deprecated, all requests are answered with status 299.",
+			Tag: "v3", Parameters: []openAPIParameter{openAPIPathParam("action", "Any v3 endpoint", openAPIString(), "worlds")},
+			Response: gin.H{}, StatusCode: 299, Deprecated: true,
+		},
+		{Method: http.MethodGet, Path: "/openapi.json", Summary: "OpenAPI document", Description: "This document", Tag: "documentation", Response: gin.H{}},
+		{Method: http.MethodGet, Path: "/docs", Summary: "Interactive API documentation", Tag: "documentation"},
+		{
+			Method: http.MethodGet, Path: "/graphql", Summary: "GraphQL query", Tag: "graphql",
+			Description: "Query characters, guilds, worlds, houses, highscores and creatures including their related entities. Lists of related entities need a limit argument, as the cost of a query is limited.",
+			Parameters: []openAPIParameter{
+				{Name: "query", In: "query", Description: "The GraphQL query", Required: true, Schema: openAPIString(), Example: "{ world(name: \"Antica\") { players_online } }"},
+				{Name: "operationName", In: "query", Description: "The operation to execute", Schema: openAPIString()},
+				{Name: "variables", In: "query", Description: "The variables as json object", Schema: openAPIString()},
+			},
+			Response: gin.H{},
+		},
+		{
+			Method: http.MethodPost, Path: "/graphql", Summary: "GraphQL query", Tag: "graphql",
+			Description: "Query characters, guilds, worlds, houses, highscores and creatures including their related entities. Lists of related entities need a limit argument, as the cost of a query is limited.",
+			RequestBody: graphqlRequest{}, Response: gin.H{},
+		},
+		{Method: http.MethodGet, Path: "/v4/boostablebosses", Summary: "List of boostable bosses", Description: "Show all boostable bosses listed", Tag: "boostable bosses", Response: BoostableBossesOverviewResponse{}, V4: true},
+		{
+			Method: http.MethodGet, Path: "/v4/character/:name", Summary: "Show one character", Description: "Show all information about one character available", Tag: "characters",
+			Parameters: []openAPIParameter{openAPIPathParam("name", "The character name", openAPIString(), "Trollefar")},
+			Response:   CharacterResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/character/:name/guildhistory", Summary: "Guild history of one character", Tag: "guildtracker",
+			Description: "Show all joins, leaves and rank and title changes of one character seen in the tracked guilds, the latest first. Needs the persistence store (TIBIADATA_STORE_PATH).",
+			Parameters:  []openAPIParameter{openAPIPathParam("name", "The character name", openAPIString(), "Trollefar")},
+			Response:    CharacterGuildHistoryResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/character/:name/ranks", Summary: "Highscore ranks of one character", Tag: "characters",
+			Description: "Show the rank, value and page of one character in every highscore category of its world and vocation. Categories the character is not on have ranked false, categories whose first TIBIADATA_CHARACTER_RANKS_MAX_PAGES pages do not decide it have ranked null. In restriction mode, the highscores of all vocations are searched.",
+			Parameters:  []openAPIParameter{openAPIPathParam("name", "The character name", openAPIString(), "Trollefar")},
+			Response:    CharacterRanksResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodPost, Path: "/v4/characters", Summary: "Show many characters", Tag: "characters",
+			Description: "Show all information about many characters at once. Every name is validated and fetched on its own, so the response has a result or an error per name.",
+			RequestBody: CharactersRequest{}, Response: CharactersResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/creature/:race", Summary: "Show one creature", Description: "Show all information about one creature", Tag: "creatures",
+			Parameters: []openAPIParameter{openAPIPathParam("race", "The race of creature", openAPIString(), "nightmare")},
+			Response:   CreatureResponse{}, V4: true,
+		},
+		{Method: http.MethodGet, Path: "/v4/creatures", Summary: "List of creatures", Description: "Show all creatures listed", Tag: "creatures", Response: CreaturesOverviewResponse{}, V4: true},
+		{
+			Method: http.MethodGet, Path: "/v4/experience", Summary: "Experience calculator", Tag: "experience",
+			Description: "Show the experience of a level or the level of an amount of experience, and the progress towards the next level. Exactly one of level and experience has to be provided.",
+			Parameters: []openAPIParameter{
+				{Name: "level", In: "query", Description: "The level", Schema: openAPIInteger(1), Example: 100},
+				{Name: "experience", In: "query", Description: "The experience", Schema: openAPIInteger(0), Example: 15694800},
+			},
+			Response: ExperienceResponse{}, V4: true,
+		},
+		{Method: http.MethodGet, Path: "/v4/fansites", Summary: "Promoted and supported fansites", Description: "List of all promoted and supported fansites", Tag: "fansites", Response: FansitesResponse{}, V4: true},
+		{
+			Method: http.MethodGet, Path: "/v4/guild/:name", Summary: "Show one guild", Tag: "guilds",
+			Description: "Show all information about one guild. With guildhall_details the guildhalls are searched in the guildhall lists of the world for their town and houseid and their house pages are fetched for their owner, rent and status.",
+			Parameters: []openAPIParameter{
+				openAPIPathParam("name", "The name of guild", openAPIString(), "Elysium"),
+				{Name: "guildhall_details", In: "query", Description: "Whether to fetch the house pages of the guildhalls", Schema: openAPIBoolean(), Example: true},
+			},
+			Response: GuildResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/guild/:name/events", Summary: "Show the events of one guild", Tag: "guilds",
+			Description: "Show the event history of one guild: members joining, leaving and being kicked, invitations and rank and title changes. Events that are not recognized have the type other and only a description.",
+			Parameters:  []openAPIParameter{openAPIPathParam("name", "The name of guild", openAPIString(), "Elysium")},
+			Response:    GuildEventsResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/guild/:name/expanded", Summary: "Show one guild with the characters of its members", Tag: "guilds",
+			Description: "Show one guild with selected fields of the character of every member. The characters are fetched concurrently, members whose characters could not be fetched have an error and are listed in failed_members. Without include the deaths, houses, last_login and residence are included, fields that are not included have their zero value. Guilds with more members than TIBIADATA_GUILD_EXPANDED_MAX_MEMBERS are not expanded.",
+			Parameters: []openAPIParameter{
+				openAPIPathParam("name", "The name of guild", openAPIString(), "Elysium"),
+				{Name: "include", In: "query", Description: "The character fields to include, can be repeated or comma separated", Schema: openAPIEnum(GuildMemberFields...), Example: "deaths,residence"},
+			},
+			Response: GuildExpandedResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/guild/:name/statistics", Summary: "Show the statistics of one guild", Tag: "guilds",
+			Description: "Show the members of one guild per vocation (promotions count to their vocation) and rank, the distribution of their levels, the share of online members, the invited characters per day and the five longest-serving members.",
+			Parameters:  []openAPIParameter{openAPIPathParam("name", "The name of guild", openAPIString(), "Elysium")},
+			Response:    GuildStatisticsResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/guild/:name/wars", Summary: "Show the wars of one guild", Tag: "guilds",
+			Description: "Show the active and past wars of one guild with the opponent, the kills of both guilds, the score limit, duration and fees, and the start and end dates. Rejected declarations are part of the history.",
+			Parameters:  []openAPIParameter{openAPIPathParam("name", "The name of guild", openAPIString(), "Elysium")},
+			Response:    GuildWarsResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/guilds/:world", Summary: "List all guilds from a world", Description: "Show all guilds on a certain world", Tag: "guilds",
+			Parameters: []openAPIParameter{openAPIPathParam("world", "The world", openAPIString(), "Antica")},
+			Response:   GuildsOverviewResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/guilds/:world/leaderboard", Summary: "Leaderboard of the guilds of a world", Tag: "guilds",
+			Description: "Rank all active guilds on a certain world by the total or average level of their members, their number of members or their online members (the highest first). The guilds of a world are fetched concurrently and cached for TIBIADATA_GUILDS_LEADERBOARD_CACHE_TTL seconds, fetched is the time they were fetched. Guilds that could not be fetched are listed in failed_guilds.",
+			Parameters: []openAPIParameter{
+				openAPIPathParam("world", "The world", openAPIString(), "Antica"),
+				{Name: "sort", In: "query", Description: "The value to rank the guilds by, level_total if not given", Schema: openAPIEnum(GuildsLeaderboardSorts...), Example: "members_online"},
+			},
+			Response: GuildsLeaderboardResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/guilds/:world/statistics", Summary: "Rank the guilds of a world by their statistics", Tag: "guilds",
+			Description: "Show the statistics of /v4/guild/:name/statistics for all active guilds on a certain world, ranked by one of them (the highest first). The guilds of a world are fetched concurrently and cached together with the leaderboard for TIBIADATA_GUILDS_LEADERBOARD_CACHE_TTL seconds, fetched is the time they were fetched. Guilds that could not be fetched are listed in failed_guilds.",
+			Parameters: []openAPIParameter{
+				openAPIPathParam("world", "The world", openAPIString(), "Antica"),
+				{Name: "sort", In: "query", Description: "The statistic to rank the guilds by, members_total if not given", Schema: openAPIEnum(GuildStatisticsSorts...), Example: "level_average"},
+			},
+			Response: GuildsStatisticsResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/guildtracker", Summary: "Tracked guilds", Tag: "guildtracker",
+			Description: "Show all guilds of the guild tracker with the time of their last poll and the error of the last poll if it failed. Needs the persistence store (TIBIADATA_STORE_PATH).",
+			Response:    GuildTrackerResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodPost, Path: "/v4/guildtracker", Summary: "Track guilds", Tag: "guildtracker",
+			Description: "Add guilds to the guild tracker. Tracked guilds are polled every TIBIADATA_GUILDS_TRACKER_INTERVAL_MINUTES and the joins, leaves and rank and title changes of their members are stored. Needs the persistence store (TIBIADATA_STORE_PATH) and the management token (TIBIADATA_MANAGEMENT_TOKEN) as bearer token.",
+			RequestBody: GuildTrackerRequest{}, Response: GuildTrackerResponse{}, V4: true, Management: true,
+		},
+		{
+			Method: http.MethodDelete, Path: "/v4/guildtracker/:name", Summary: "Stop tracking a guild", Tag: "guildtracker",
+			Description: "Remove a guild and its history from the guild tracker. Needs the persistence store (TIBIADATA_STORE_PATH) and the management token (TIBIADATA_MANAGEMENT_TOKEN) as bearer token.",
+			Parameters:  []openAPIParameter{openAPIPathParam("name", "The name of guild", openAPIString(), "Elysium")},
+			Response:    GuildTrackerResponse{}, V4: true, Management: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/guildtracker/:name/history", Summary: "Membership history of a tracked guild", Tag: "guildtracker",
+			Description: "Show the members that joined or left a tracked guild and the changes of their ranks and titles in a period, the latest first. The changes are seen by comparing two polls, so their time is the time of the later poll. Needs the persistence store (TIBIADATA_STORE_PATH).",
+			Parameters:  append([]openAPIParameter{openAPIPathParam("name", "The name of guild", openAPIString(), "Elysium")}, openAPIPeriodParams...),
+			Response:    GuildHistoryResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/highscores/:world", Summary: "Highscores of tibia", Description: "Redirects to the experience highscores of all vocations", Tag: "highscores",
+			Parameters: openAPIHighscoreParams[:1], StatusCode: http.StatusMovedPermanently,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/highscores/:world/:category", Summary: "Highscores of tibia", Description: "Redirects to the highscores of all vocations", Tag: "highscores",
+			Parameters: openAPIHighscoreParams[:2], StatusCode: http.StatusMovedPermanently,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/highscores/:world/:category/:vocation", Summary: "Highscores of tibia", Description: "Show the first page of the highscores", Tag: "highscores",
+			Parameters: append(append([]openAPIParameter{}, openAPIHighscoreParams...), openAPIHighscoreFilterParams...), Response: HighscoresResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/highscores/:world/:category/:vocation/:page", Summary: "Highscores of tibia", Description: "Show all highscores of tibia. In restriction mode, the valid vocation option is all.", Tag: "highscores",
+			Parameters: append(append(append([]openAPIParameter{}, openAPIHighscoreParams...), openAPIPathParam("page", "The current page", openAPIInteger(1), 1)), openAPIHighscoreFilterParams...),
+			Response:   HighscoresResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/highscores/:world/:category/:vocation/all", Summary: "Highscores of tibia (all pages)", Tag: "highscores",
+			Description: "Show the highscores of all pages merged in rank order. Pages that could not be fetched are listed in failed_pages. With format ndjson the entries (or the failed page) are streamed page by page. In restriction mode, the valid vocation option is all.",
+			Parameters:  append(append([]openAPIParameter{}, openAPIHighscoreParams...), openAPIHighscoreFilterParams...), Response: HighscoresAllResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/highscores/:world/:category/:vocation/deltas", Summary: "Highscore gains", Tag: "highscores",
+			Description: "Show the value gained by every character between two stored snapshots of a highscore list, sorted by the value gained. Needs the persistence store (TIBIADATA_STORE_PATH) and the list in TIBIADATA_HIGHSCORES_SNAPSHOTS.",
+			Parameters:  append(append([]openAPIParameter{}, openAPIHighscoreParams...), openAPIPeriodParams...), Response: HighscoresDeltasResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/highscores/:world/:category/:vocation/rankchanges", Summary: "Highscore rank changes", Tag: "highscores",
+			Description: "Show the rank changes of all characters between two stored snapshots of a highscore list, including characters that are new or dropped off. Needs the persistence store (TIBIADATA_STORE_PATH) and the list in TIBIADATA_HIGHSCORES_SNAPSHOTS.",
+			Parameters:  append(append([]openAPIParameter{}, openAPIHighscoreParams...), openAPIPeriodParams...), Response: HighscoresRankChangesResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/house/:world/:house_id", Summary: "House view", Description: "Show all information about one house", Tag: "houses",
+			Parameters: []openAPIParameter{
+				openAPIPathParam("world", "The world to show", openAPIString(), "Antica"),
+				openAPIPathParam("house_id", "The ID of the house", openAPIInteger(1), 35019),
+			},
+			Response: HouseResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/houses/:world", Summary: "List of houses of a world", Tag: "houses",
+			Description: "Show the houses and guildhalls of all towns of a world grouped by town, with the counts of the rented, auctioned and free houses and guildhalls listed. The towns are fetched concurrently, towns that could not be fetched are listed in failed_towns.",
+			Parameters:  append([]openAPIParameter{openAPIPathParam("world", "The world to show", openAPIString(), "Antica")}, openAPIHousesFilterParams...),
+			Response:    HousesWorldResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/houses/:world/:town", Summary: "List of houses", Description: "Show all houses filtered on world and town", Tag: "houses",
+			Parameters: []openAPIParameter{
+				openAPIPathParam("world", "The world to show", openAPIString(), "Antica"),
+				openAPIPathParam("town", "The town to show", openAPIString(), "Venore"),
+			},
+			Response: HousesOverviewResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/houses/:world/search", Summary: "Search of houses of a world", Tag: "houses",
+			Description: "Search the houses and guildhalls of a world by their size, beds, rent and auction, sorted and paginated. The house lists of the towns are filtered first, the house pages of the remaining houses are fetched concurrently for their beds and auction, their beds are cached for TIBIADATA_HOUSES_SEARCH_CACHE_TTL seconds. The current bid is taken from the house lists, the bidder and auction end are cached for TIBIADATA_HOUSES_SEARCH_AUCTION_CACHE_TTL seconds and only fetched for the returned page unless sorted by auction_end. At most TIBIADATA_HOUSES_SEARCH_MAX_FETCHES house pages are fetched per search. Towns and houses that could not be fetched are listed in failed_towns and failed_houses.",
+			Parameters:  append(append([]openAPIParameter{openAPIPathParam("world", "The world to search", openAPIString(), "Antica")}, openAPIHousesFilterParams...), openAPIHousesSearchParams...),
+			Response:    HousesSearchResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/killstatistics/:world", Summary: "The killstatistics", Description: "Show all killstatistics filtered on world", Tag: "killstatistics",
+			Parameters: []openAPIParameter{openAPIPathParam("world", "The world to show", openAPIString(), "Antica")},
+			Response:   KillStatisticsResponse{}, V4: true,
+		},
+		{Method: http.MethodGet, Path: "/v4/news/archive", Summary: "Show news archive (90 days)", Description: "Show news archive with a filtering on 90 days", Tag: "news", Response: NewsListResponse{}, V4: true},
+		{
+			Method: http.MethodGet, Path: "/v4/news/archive/:days", Summary: "Show news archive (with days filter)", Description: "Show news archive with a filtering option on days", Tag: "news",
+			Parameters: []openAPIParameter{openAPIPathParam("days", "The number of days to show", openAPIInteger(1), 30)},
+			Response:   NewsListResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/news/id/:news_id", Summary: "Show one news entry", Tag: "news",
+			Parameters: []openAPIParameter{openAPIPathParam("news_id", "The ID of news entry", openAPIInteger(1), 6512)},
+			Response:   NewsResponse{}, V4: true,
+		},
+		{Method: http.MethodGet, Path: "/v4/news/latest", Summary: "Show newslist (90 days)", Description: "Show newslist with filtering on articles and news of last 90 days", Tag: "news", Response: NewsListResponse{}, V4: true},
+		{Method: http.MethodGet, Path: "/v4/news/newsticker", Summary: "Show news tickers (90 days)", Description: "Show news of type news tickers of last 90 days", Tag: "news", Response: NewsListResponse{}, V4: true},
+		{
+			Method: http.MethodGet, Path: "/v4/spell/:spell_id", Summary: "Show one spell", Description: "Show all information about one spell", Tag: "spells",
+			Parameters: []openAPIParameter{openAPIPathParam("spell_id", "The name of spell", openAPIString(), "stronghaste")},
+			Response:   SpellInformationResponse{}, V4: true,
+		},
+		{Method: http.MethodGet, Path: "/v4/spells", Summary: "List all spells", Description: "Show all spells", Tag: "spells", Response: SpellsOverviewResponse{}, V4: true},
+		{
+			Method: http.MethodGet, Path: "/v4/wartracker", Summary: "Tracked wars", Tag: "wartracker",
+			Description: "Show all wars of the war tracker with the time of their last poll and the error of the last poll if it failed. Needs the persistence store (TIBIADATA_STORE_PATH).",
+			Response:    WarTrackerResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodPost, Path: "/v4/wartracker", Summary: "Track a war", Tag: "wartracker",
+			Description: "Add a war between two guilds of the same world to the war tracker. The deaths of the members of both guilds are polled every TIBIADATA_WAR_TRACKER_INTERVAL_MINUTES, a death counts as a kill if a killer or an assist is a member of the other guild. Deaths before the war was added are not counted. Needs the persistence store (TIBIADATA_STORE_PATH) and the management token (TIBIADATA_MANAGEMENT_TOKEN) as bearer token.",
+			RequestBody: WarTrackerRequest{}, Response: WarTrackerResponse{}, V4: true, Management: true,
+		},
+		{
+			Method: http.MethodDelete, Path: "/v4/wartracker/:id", Summary: "Stop tracking a war", Tag: "wartracker",
+			Description: "Remove a war and its kills from the war tracker. Needs the persistence store (TIBIADATA_STORE_PATH) and the management token (TIBIADATA_MANAGEMENT_TOKEN) as bearer token.",
+			Parameters:  []openAPIParameter{openAPIPathParam("id", "The ID of the war", openAPIInteger(1), 1)},
+			Response:    WarTrackerResponse{}, V4: true, Management: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/wartracker/:id/kills", Summary: "Kill feed of a tracked war", Tag: "wartracker",
+			Description: "Show the members of both guilds killed by the other guild in a period with the killers and assists of the other guild, the latest first. Needs the persistence store (TIBIADATA_STORE_PATH).",
+			Parameters:  append([]openAPIParameter{openAPIPathParam("id", "The ID of the war", openAPIInteger(1), 1)}, openAPIPeriodParams...),
+			Response:    WarKillsResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/wartracker/:id/scoreboard", Summary: "Scoreboard of a tracked war", Tag: "wartracker",
+			Description: "Show the kills and deaths of both guilds and the kills, assists and deaths of their characters since the war was added, the characters with the most kills first. Needs the persistence store (TIBIADATA_STORE_PATH).",
+			Parameters:  []openAPIParameter{openAPIPathParam("id", "The ID of the war", openAPIInteger(1), 1)},
+			Response:    WarScoreboardResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/watchlist", Summary: "Watched characters", Tag: "watchlist",
+			Description: "Show all characters on the watchlist with the time of their last poll and the error of the last poll if it failed. Needs the persistence store (TIBIADATA_STORE_PATH).",
+			Response:    WatchlistResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodPost, Path: "/v4/watchlist", Summary: "Watch characters", Tag: "watchlist",
+			Description: "Add characters to the watchlist. Watched characters are polled every TIBIADATA_WATCHLIST_INTERVAL_MINUTES and their snapshots and deaths are stored. Needs the persistence store (TIBIADATA_STORE_PATH) and the management token (TIBIADATA_MANAGEMENT_TOKEN) as bearer token.",
+			RequestBody: CharactersRequest{}, Response: WatchlistResponse{}, V4: true, Management: true,
+		},
+		{
+			Method: http.MethodDelete, Path: "/v4/watchlist/:name", Summary: "Stop watching a character", Tag: "watchlist",
+			Description: "Remove a character and its history from the watchlist. Needs the persistence store (TIBIADATA_STORE_PATH) and the management token (TIBIADATA_MANAGEMENT_TOKEN) as bearer token.",
+			Parameters:  []openAPIParameter{openAPIPathParam("name", "The character name", openAPIString(), "Trollefar")},
+			Response:    WatchlistResponse{}, V4: true, Management: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/watchlist/:name/timeline", Summary: "Timeline of a watched character", Tag: "watchlist",
+			Description: "Show the snapshots (level, world, guild, residence, former names and account status) and the level history of a watched character in a period. Snapshots are stored when something changed and at least once a day, the first snapshot is the state at the start of the period. Needs the persistence store (TIBIADATA_STORE_PATH).",
+			Parameters:  append([]openAPIParameter{openAPIPathParam("name", "The character name", openAPIString(), "Trollefar")}, openAPIPeriodParams...),
+			Response:    WatchlistTimelineResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/watchlist/:name/deaths", Summary: "Deaths of a watched character", Tag: "watchlist",
+			Description: "Show all deaths of a watched character seen since it was added, including the ones tibia.com no longer lists. Needs the persistence store (TIBIADATA_STORE_PATH).",
+			Parameters:  []openAPIParameter{openAPIPathParam("name", "The character name", openAPIString(), "Trollefar")},
+			Response:    WatchlistDeathsResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/webhooks", Summary: "Webhooks", Tag: "webhooks",
+			Description: "Show all webhooks without their secrets. Needs the persistence store (TIBIADATA_STORE_PATH) and the management token (TIBIADATA_MANAGEMENT_TOKEN) as bearer token.",
+			Response:    WebhooksResponse{}, V4: true, Management: true,
+		},
+		{
+			Method: http.MethodPost, Path: "/v4/webhooks", Summary: "Add a webhook", Tag: "webhooks",
+			Description: "Add a webhook that events are posted to: level-ups, deaths, name changes and guild changes of watched characters (" + strings.Join(WebhookEvents[:5], ", ") + "), members joining and leaving guilds (" + strings.Join(WebhookEvents[5:7], ", ") + ") and auction bids and owners of houses (" + strings.Join(WebhookEvents[7:], ", ") + "). Guilds and houses are polled every TIBIADATA_WEBHOOKS_INTERVAL_MINUTES. Every event is signed with the secret in the X-TibiaData-Signature header (sha256=<hex HMAC-SHA256 of the body>), the secret is only returned here. Failed deliveries are retried with an exponential backoff, the later events of the webhook wait for them. Urls whose host resolves to a loopback (unless TIBIADATA_WEBHOOKS_ALLOW_LOOPBACK is set), private, link-local, unspecified, 0.0.0.0/8 or carrier-grade NAT (100.64.0.0/10) address are refused. Needs the persistence store (TIBIADATA_STORE_PATH) and the management token (TIBIADATA_MANAGEMENT_TOKEN) as bearer token.",
+			RequestBody: WebhookRequest{}, Response: WebhookResponse{}, V4: true, Management: true,
+		},
+		{
+			Method: http.MethodDelete, Path: "/v4/webhooks/:id", Summary: "Remove a webhook", Tag: "webhooks",
+			Description: "Remove a webhook and its delivery log. Needs the persistence store (TIBIADATA_STORE_PATH) and the management token (TIBIADATA_MANAGEMENT_TOKEN) as bearer token.",
+			Parameters:  []openAPIParameter{openAPIPathParam("id", "The ID of the webhook", openAPIInteger(1), 1)},
+			Response:    WebhooksResponse{}, V4: true, Management: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/webhooks/:id/deliveries", Summary: "Delivery log of a webhook", Tag: "webhooks",
+			Description: "Show the deliveries of a webhook with their payload, attempts and the error of the last attempt, the newest first. Deliveries are kept for TIBIADATA_WEBHOOKS_RETENTION_DAYS. Needs the persistence store (TIBIADATA_STORE_PATH) and the management token (TIBIADATA_MANAGEMENT_TOKEN) as bearer token.",
+			Parameters: []openAPIParameter{
+				openAPIPathParam("id", "The ID of the webhook", openAPIInteger(1), 1),
+				{Name: "status", In: "query", Description: "The status of the deliveries", Schema: openAPIEnum(WebhookDeliveryPending, WebhookDeliveryDelivered, WebhookDeliveryFailed), Example: WebhookDeliveryFailed},
+			},
+			Response: WebhookDeliveriesResponse{}, V4: true, Management: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/world/:name", Summary: "Show one world", Description: "Show all information about one world", Tag: "worlds",
+			Parameters: []openAPIParameter{openAPIPathParam("name", "The name of world", openAPIString(), "Antica")},
+			Response:   WorldResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/world/:name/online/diff", Summary: "Online changes of a tracked world", Tag: "worlds",
+			Description: "Show the players who logged in, logged out and levelled between the online snapshots of a tracked world in a period, and the durations of the sessions whose login and logout were both seen. The worlds in TIBIADATA_WORLDS_TRACKED are snapshotted every TIBIADATA_WORLDS_SNAPSHOT_INTERVAL_MINUTES, the first snapshot is the online list at the start of the period. Needs the persistence store (TIBIADATA_STORE_PATH).",
+			Parameters:  append([]openAPIParameter{openAPIPathParam("name", "The name of world", openAPIString(), "Antica")}, openAPIPeriodParams...),
+			Response:    WorldOnlineDiffResponse{}, V4: true,
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/world/:name/stream", Summary: "Stream of the online players of a world", Tag: "worlds",
+			Description: "Stream the logins, logouts and level changes of the online players of one world as server-sent events with the event type as event name. The world is polled every TIBIADATA_WORLD_STREAM_INTERVAL_SECONDS once for all clients. Reconnecting clients resume with the Last-Event-ID header (or the last_event_id parameter) as long as the event is among the latest TIBIADATA_WORLD_STREAM_REPLAY_SIZE events, other clients start with a snapshot event of all online players.",
+			Parameters: []openAPIParameter{
+				openAPIPathParam("name", "The name of world", openAPIString(), "Antica"),
+				{Name: "last_event_id", In: "query", Description: "The ID of the last received event", Schema: openAPIInteger(0)},
+			},
+			Response: WorldStreamEvent{}, MediaType: "text/event-stream",
+		},
+		{
+			Method: http.MethodGet, Path: "/v4/world/:name/ws", Summary: "WebSocket of the online players of a world", Tag: "worlds",
+			Description: "Stream the logins, logouts and level changes of the online players of one world as json messages over a WebSocket, like the server-sent events of /v4/world/{name}/stream. Reconnecting clients resume with the last_event_id parameter.",
+			Parameters: []openAPIParameter{
+				openAPIPathParam("name", "The name of world", openAPIString(), "Antica"),
+				{Name: "last_event_id", In: "query", Description: "The ID of the last received event", Schema: openAPIInteger(0)},
+			},
+			Response: WorldStreamEvent{}, StatusCode: http.StatusSwitchingProtocols,
+		},
+		{Method: http.MethodGet, Path: "/v4/worlds", Summary: "List of all worlds", Description: "Show all worlds of Tibia", Tag: "worlds", Response: WorldsOverviewResponse{}, V4: true},
+	}
+
+	// openAPIErrorResponses are the names of the error responses by http code
+	openAPIErrorResponses = map[int]string{
+		http.StatusBadRequest:          "BadRequest",
+		http.StatusUnauthorized:        "Unauthorized",
+		http.StatusForbidden:           "Forbidden",
+		http.StatusNotAcceptable:       "NotAcceptable",
+		http.StatusInternalServerError: "InternalServerError",
+		http.StatusBadGateway:          "BadGateway",
+		http.StatusServiceUnavailable:  "ServiceUnavailable",
+	}
+
+	// tibiaDataOpenAPI is the OpenAPI document of the API
+	tibiaDataOpenAPI = sync.OnceValue(newOpenAPIDocument)
+)
+
+// openAPIGinParamRegex matches the parameters of gin paths
+var openAPIGinParamRegex = regexp.MustCompile(`[:*]([a-z_]+)`)
+
+// openAPIPath converts a gin path into an OpenAPI path
+func openAPIPath(path string) string {
+	return openAPIGinParamRegex.ReplaceAllString(path, "{$1}")
+}
+
+// openAPIErrorHTTPCode returns the http code the API responds with on an error
+func openAPIErrorHTTPCode(err validation.Error) int {
+	// unsupported formats are answered with 406 by TibiaDataAPIHandleResponse
+	if err == validation.ErrorFormatNotSupported {
+		return http.StatusNotAcceptable
+	}
+	// endpoints using the store answer with 503 if it is not enabled and with 401 or 403 without the management token
+	if code := tibiaDataStoreHTTPCode(err); code != 0 {
+		return code
+	}
+	return TibiaDataErrorInformation(err, 0).Status.HTTPCode
+}
+
+// openAPISchemaBuilder derives the schemas of the components from go types
+type openAPISchemaBuilder struct {
+	schemas map[string]*openAPISchema
+}
+
+// schema returns the schema of a go type, named structs are referenced
+func (b *openAPISchemaBuilder) schema(t reflect.Type) *openAPISchema {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return b.schema(t.Elem())
+	case reflect.String:
+		return &openAPISchema{Type: "string"}
+	case reflect.Bool:
+		return &openAPISchema{Type: "boolean"}
+	case reflect.Int, reflect.Int64:
+		return &openAPISchema{Type: "integer", Format: "int64"}
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return &openAPISchema{Type: "integer", Format: "int32"}
+	case reflect.Float32, reflect.Float64:
+		return &openAPISchema{Type: "number", Format: "double"}
+	case reflect.Slice, reflect.Array:
+		return &openAPISchema{Type: "array", Items: b.schema(t.Elem())}
+	case reflect.Map:
+		return &openAPISchema{Type: "object", AdditionalProperties: b.schema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return b.object(t)
+		}
+		if _, exists := b.schemas[t.Name()]; !exists {
+			// the entry is added first, so that recursive types end
+			b.schemas[t.Name()] = &openAPISchema{}
+			*b.schemas[t.Name()] = *b.object(t)
+		}
+		return &openAPISchema{Ref: "#/components/schemas/" + t.Name()}
+	}
+
+	// interfaces can be any value
+	return &openAPISchema{}
+}
+
+// object returns the schema of the json object of a struct
+func (b *openAPISchemaBuilder) object(t reflect.Type) *openAPISchema {
+	schema := &openAPISchema{Type: "object", Properties: map[string]*openAPISchema{}}
+
+	for _, field := range reflect.VisibleFields(t) {
+		name, ok := jsonFieldName(field)
+		if !ok || (field.Anonymous && field.Type.Kind() == reflect.Struct) {
+			continue
+		}
+
+		schema.Properties[name] = b.schema(field.Type)
+		if !strings.Contains(field.Tag.Get("json"), ",omitempty") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+
+	return schema
+}
+
+// newOpenAPIDocument builds the OpenAPI document from openAPIRoutes
+func newOpenAPIDocument() openAPIDocument {
+	builder := &openAPISchemaBuilder{schemas: map[string]*openAPISchema{}}
+
+	document := openAPIDocument{
+		OpenAPI: "3.0.3",
+		Info: openAPIInfo{
+			Title:       "TibiaData API",
+			Description: "This is the API documentation for the TibiaData API. The data is collected from tibia.com and converted into json.",
+			Version:     TibiaDataBuildRelease,
+			License:     openAPILicense{Name: "MIT", URL: "https://github.com/TibiaData/tibiadata-api-go/blob/main/LICENSE"},
+		},
+		Paths: map[string]map[string]*openAPIOperation{},
+		Components: openAPIComponents{
+			Parameters: map[string]openAPIParameter{
+				"fields": {
+					Name: "fields", In: "query", Schema: openAPIString(), Example: "character.character.level",
+					Description: "Reduce the response to a comma separated list of dot-paths, the information block is always included.",
+				},
+				"format": {
+					Name: "format", In: "query", Schema: openAPIEnum(TibiaDataFormatJSON, TibiaDataFormatCSV, TibiaDataFormatNDJSON, TibiaDataFormatMsgpack, TibiaDataFormatProtobuf),
+					Description: "The output format, csv and ndjson are only available for lists. The Accept header can be used instead.",
+				},
+			},
+			Responses: map[string]openAPIResponse{},
+			SecuritySchemes: map[string]openAPISecurityScheme{
+				"management": {Type: "http", Scheme: "bearer", Description: "The management token (TIBIADATA_MANAGEMENT_TOKEN) of the endpoints managing the store."},
+			},
+		},
+	}
+
+	// error codes are documented with an example each on the response of their http code
+	errorExamples := map[int]map[string]openAPIExample{}
+	errorCodes := map[int][]string{}
+	for _, err := range validation.Errors() {
+		httpCode := openAPIErrorHTTPCode(err)
+		if errorExamples[httpCode] == nil {
+			errorExamples[httpCode] = map[string]openAPIExample{}
+		}
+
+		errorExamples[httpCode][strconv.Itoa(err.Code())] = openAPIExample{
+			Summary: err.Error(),
+			Value:   OutInformation{Information: TibiaDataErrorInformation(err, httpCode)},
+		}
+		errorCodes[httpCode] = append(errorCodes[httpCode], fmt.Sprintf("- `%d`: %s", err.Code(), err.Error()))
+	}
+
+	informationSchema := builder.schema(reflect.TypeOf(OutInformation{}))
+	for httpCode, name := range openAPIErrorResponses {
+		document.Components.Responses[name] = openAPIResponse{
+			Description: http.StatusText(httpCode) + ", the error codes are:\n\n" + strings.Join(errorCodes[httpCode], "\n"),
+			Content: map[string]openAPIMediaType{
+				"application/json": {Schema: informationSchema, Examples: errorExamples[httpCode]},
+			},
+		}
+	}
+
+	tags := map[string]bool{}
+	for _, route := range openAPIRoutes {
+		operation := &openAPIOperation{
+			Summary:     route.Summary,
+			Description: route.Description,
+			OperationID: strings.ToLower(route.Method) + openAPIOperationID(route.Path),
+			Tags:        []string{route.Tag},
+			Deprecated:  route.Deprecated,
+			Parameters:  route.Parameters,
+			Responses:   map[string]openAPIResponse{},
+		}
+		tags[route.Tag] = true
+
+		statusCode := route.StatusCode
+		if statusCode == 0 {
+			statusCode = http.StatusOK
+		}
+
+		response := openAPIResponse{Description: http.StatusText(statusCode)}
+		switch {
+		case route.Response != nil:
+			mediaType := route.MediaType
+			if mediaType == "" {
+				mediaType = "application/json"
+			}
+			response.Content = map[string]openAPIMediaType{
+				mediaType: {Schema: builder.schema(reflect.TypeOf(route.Response))},
+			}
+		case route.Path == "/docs":
+			response.Content = map[string]openAPIMediaType{
+				"text/html": {Schema: openAPIString()},
+			}
+		}
+		if response.Description == "" {
+			response.Description = "Deprecated"
+		}
+		operation.Responses[strconv.Itoa(statusCode)] = response
+
+		if route.RequestBody != nil {
+			operation.RequestBody = &openAPIRequestBody{
+				Required: true,
+				Content: map[string]openAPIMediaType{
+					"application/json": {Schema: builder.schema(reflect.TypeOf(route.RequestBody))},
+				},
+			}
+		}
+
+		if route.V4 {
+			operation.Parameters = append(operation.Parameters,
+				openAPIParameter{Ref: "#/components/parameters/fields"},
+				openAPIParameter{Ref: "#/components/parameters/format"},
+			)
+			for httpCode, name := range openAPIErrorResponses {
+				// only the endpoints managing the store check the management token
+				if (httpCode == http.StatusUnauthorized || httpCode == http.StatusForbidden) && !route.Management {
+					continue
+				}
+				operation.Responses[strconv.Itoa(httpCode)] = openAPIResponse{Ref: "#/components/responses/" + name}
+			}
+		}
+		if route.Management {
+			operation.Security = []map[string][]string{{"management": {}}}
+		}
+
+		path := openAPIPath(route.Path)
+		if document.Paths[path] == nil {
+			document.Paths[path] = map[string]*openAPIOperation{}
+		}
+		document.Paths[path][strings.ToLower(route.Method)] = operation
+	}
+
+	for tag := range tags {
+		document.Tags = append(document.Tags, openAPITag{Name: tag})
+	}
+	sort.Slice(document.Tags, func(i, j int) bool {
+		return document.Tags[i].Name < document.Tags[j].Name
+	})
+
+	document.Components.Schemas = builder.schemas
+
+	return document
+}
+
+// openAPIOperationID returns the camel cased gin path, e.g. V4CharacterName for /v4/character/:name
+func openAPIOperationID(path string) string {
+	var id strings.Builder
+	for _, part := range strings.FieldsFunc(path, func(r rune) bool {
+		return r == '/' || r == ':' || r == '*' || r == '_' || r == '.'
+	}) {
+		id.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return id.String()
+}
+
+// tibiaOpenAPI serves the OpenAPI document
+func tibiaOpenAPI(c *gin.Context) {
+	c.JSON(http.StatusOK, tibiaDataOpenAPI())
+}
+
+// tibiaDocs serves the interactive documentation of the OpenAPI document
+func tibiaDocs(c *gin.Context) {
+	page, err := static.DocsFiles.ReadFile("docs/index.html")
+	if err != nil {
+		TibiaDataErrorHandler(c, err, http.StatusInternalServerError)
+		return
+	}
+
+	c.Data(http.StatusOK, "text/html; charset=utf-8", page)
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+)
+
+// TestOpenAPIRoutesDocumented makes sure that every registered route is documented and the other way around
+func TestOpenAPIRoutesDocumented(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	tibiaDataRoutes(router)
+
+	document := tibiaDataOpenAPI()
+	registered := map[string]bool{}
+
+	for _, route := range router.Routes() {
+		path := openAPIPath(route.Path)
+		registered[strings.ToLower(route.Method)+" "+path] = true
+
+		if document.Paths[path][strings.ToLower(route.Method)] == nil {
+			t.Errorf("route %s %s is not documented in openAPIRoutes", route.Method, route.Path)
+		}
+	}
+
+	for path, operations := range document.Paths {
+		for method, operation := range operations {
+			if !registered[method+" "+path] {
+				t.Errorf("documented route %s %s is not registered", method, path)
+			}
+
+			// all path parameters are documented
+			for _, match := range openAPIGinParamRegex.FindAllStringSubmatch(strings.NewReplacer("{", ":", "}", "").Replace(path), -1) {
+				found := false
+				for _, parameter := range operation.Parameters {
+					found = found || (parameter.In == "path" && parameter.Name == match[1])
+				}
+				if !found {
+					t.Errorf("path parameter %s of %s %s is not documented", match[1], method, path)
+				}
+			}
+		}
+	}
+}
+
+func TestOpenAPIErrorCodes(t *testing.T) {
+	assert := assert.New(t)
+	document := tibiaDataOpenAPI()
+
+	for _, err := range validation.Errors() {
+		response := document.Components.Responses[openAPIErrorResponses[openAPIErrorHTTPCode(err)]]
+		code := strconv.Itoa(err.Code())
+
+		if assert.Contains(response.Content["application/json"].Examples, code, "error %d is not documented", err.Code()) {
+			example := response.Content["application/json"].Examples[code]
+			assert.Equal(err.Error(), example.Summary)
+			assert.Equal(err.Code(), example.Value.(OutInformation).Information.Status.Error)
+		}
+		assert.Contains(response.Description, "`"+code+"`: "+err.Error())
+	}
+}
+
+func TestOpenAPIReferences(t *testing.T) {
+	assert := assert.New(t)
+
+	data, err := json.Marshal(tibiaDataOpenAPI())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var document map[string]interface{}
+	assert.Nil(json.Unmarshal(data, &document))
+	assert.Equal("3.0.3", document["openapi"])
+
+	// every $ref points to an existing component
+	var walk func(value interface{})
+	walk = func(value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if ref, ok := v["$ref"].(string); ok {
+				var target interface{} = document
+				for _, key := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+					target = target.(map[string]interface{})[key]
+				}
+				assert.NotNil(target, ref)
+			}
+			for _, child := range v {
+				walk(child)
+			}
+		case []interface{}:
+			for _, child := range v {
+				walk(child)
+			}
+		}
+	}
+	walk(document)
+
+	schemas := document["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+	character := schemas["CharacterInfo"].(map[string]interface{})
+	assert.Contains(character["required"], "name")
+	assert.NotContains(character["required"], "former_names")
+	assert.Equal("integer", character["properties"].(map[string]interface{})["level"].(map[string]interface{})["type"])
+}
+
+func TestOpenAPIHandlers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	assert := assert.New(t)
+
+	router := gin.New()
+	tibiaDataRoutes(router)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Contains(w.Body.String(), `"/v4/character/{name}"`)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/docs", nil))
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("text/html; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Contains(w.Body.String(), "openapi.json")
+}
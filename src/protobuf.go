@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/tibiadata/tibiadata-api-go/src/tibiadatapb"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// tibiaDataProtoMessages maps the response types to their Protocol Buffers message
+var tibiaDataProtoMessages = map[reflect.Type]func() proto.Message{
+	reflect.TypeOf(BoostableBossesOverviewResponse{}): func() proto.Message { return &tibiadatapb.BoostableBossesOverviewResponse{} },
+	reflect.TypeOf(CharacterResponse{}):               func() proto.Message { return &tibiadatapb.CharacterResponse{} },
+	reflect.TypeOf(CharacterGuildHistoryResponse{}):   func() proto.Message { return &tibiadatapb.CharacterGuildHistoryResponse{} },
+	reflect.TypeOf(CharacterRanksResponse{}):          func() proto.Message { return &tibiadatapb.CharacterRanksResponse{} },
+	reflect.TypeOf(CharactersResponse{}):              func() proto.Message { return &tibiadatapb.CharactersResponse{} },
+	reflect.TypeOf(CreatureResponse{}):                func() proto.Message { return &tibiadatapb.CreatureResponse{} },
+	reflect.TypeOf(CreaturesOverviewResponse{}):       func() proto.Message { return &tibiadatapb.CreaturesOverviewResponse{} },
+	reflect.TypeOf(ExperienceResponse{}):              func() proto.Message { return &tibiadatapb.ExperienceResponse{} },
+	reflect.TypeOf(FansitesResponse{}):                func() proto.Message { return &tibiadatapb.FansitesResponse{} },
+	reflect.TypeOf(GuildEventsResponse{}):             func() proto.Message { return &tibiadatapb.GuildEventsResponse{} },
+	reflect.TypeOf(GuildExpandedResponse{}):           func() proto.Message { return &tibiadatapb.GuildExpandedResponse{} },
+	reflect.TypeOf(GuildHistoryResponse{}):            func() proto.Message { return &tibiadatapb.GuildHistoryResponse{} },
+	reflect.TypeOf(GuildStatisticsResponse{}):         func() proto.Message { return &tibiadatapb.GuildStatisticsResponse{} },
+	reflect.TypeOf(GuildWarsResponse{}):               func() proto.Message { return &tibiadatapb.GuildWarsResponse{} },
+	reflect.TypeOf(GuildResponse{}):                   func() proto.Message { return &tibiadatapb.GuildResponse{} },
+	reflect.TypeOf(GuildsLeaderboardResponse{}):       func() proto.Message { return &tibiadatapb.GuildsLeaderboardResponse{} },
+	reflect.TypeOf(GuildsOverviewResponse{}):          func() proto.Message { return &tibiadatapb.GuildsOverviewResponse{} },
+	reflect.TypeOf(GuildsStatisticsResponse{}):        func() proto.Message { return &tibiadatapb.GuildsStatisticsResponse{} },
+	reflect.TypeOf(GuildTrackerResponse{}):            func() proto.Message { return &tibiadatapb.GuildTrackerResponse{} },
+	reflect.TypeOf(HighscoresResponse{}):              func() proto.Message { return &tibiadatapb.HighscoresResponse{} },
+	reflect.TypeOf(HighscoresAllResponse{}):           func() proto.Message { return &tibiadatapb.HighscoresAllResponse{} },
+	reflect.TypeOf(HighscoresDeltasResponse{}):        func() proto.Message { return &tibiadatapb.HighscoresDeltasResponse{} },
+	reflect.TypeOf(HighscoresRankChangesResponse{}):   func() proto.Message { return &tibiadatapb.HighscoresRankChangesResponse{} },
+	reflect.TypeOf(HouseResponse{}):                   func() proto.Message { return &tibiadatapb.HouseResponse{} },
+	reflect.TypeOf(HousesOverviewResponse{}):          func() proto.Message { return &tibiadatapb.HousesOverviewResponse{} },
+	reflect.TypeOf(HousesWorldResponse{}):             func() proto.Message { return &tibiadatapb.HousesWorldResponse{} },
+	reflect.TypeOf(HousesSearchResponse{}):            func() proto.Message { return &tibiadatapb.HousesSearchResponse{} },
+	reflect.TypeOf(KillStatisticsResponse{}):          func() proto.Message { return &tibiadatapb.KillStatisticsResponse{} },
+	reflect.TypeOf(NewsResponse{}):                    func() proto.Message { return &tibiadatapb.NewsResponse{} },
+	reflect.TypeOf(NewsListResponse{}):                func() proto.Message { return &tibiadatapb.NewsListResponse{} },
+	reflect.TypeOf(OutInformation{}):                  func() proto.Message { return &tibiadatapb.OutInformation{} },
+	reflect.TypeOf(SpellInformationResponse{}):        func() proto.Message { return &tibiadatapb.SpellInformationResponse{} },
+	reflect.TypeOf(SpellsOverviewResponse{}):          func() proto.Message { return &tibiadatapb.SpellsOverviewResponse{} },
+	reflect.TypeOf(WarTrackerResponse{}):              func() proto.Message { return &tibiadatapb.WarTrackerResponse{} },
+	reflect.TypeOf(WarKillsResponse{}):                func() proto.Message { return &tibiadatapb.WarKillsResponse{} },
+	reflect.TypeOf(WarScoreboardResponse{}):           func() proto.Message { return &tibiadatapb.WarScoreboardResponse{} },
+	reflect.TypeOf(WatchlistResponse{}):               func() proto.Message { return &tibiadatapb.WatchlistResponse{} },
+	reflect.TypeOf(WatchlistTimelineResponse{}):       func() proto.Message { return &tibiadatapb.WatchlistTimelineResponse{} },
+	reflect.TypeOf(WatchlistDeathsResponse{}):         func() proto.Message { return &tibiadatapb.WatchlistDeathsResponse{} },
+	reflect.TypeOf(WebhooksResponse{}):                func() proto.Message { return &tibiadatapb.WebhooksResponse{} },
+	reflect.TypeOf(WebhookResponse{}):                 func() proto.Message { return &tibiadatapb.WebhookResponse{} },
+	reflect.TypeOf(WebhookDeliveriesResponse{}):       func() proto.Message { return &tibiadatapb.WebhookDeliveriesResponse{} },
+	reflect.TypeOf(WorldOnlineDiffResponse{}):         func() proto.Message { return &tibiadatapb.WorldOnlineDiffResponse{} },
+	reflect.TypeOf(WorldResponse{}):                   func() proto.Message { return &tibiadatapb.WorldResponse{} },
+	reflect.TypeOf(WorldsOverviewResponse{}):          func() proto.Message { return &tibiadatapb.WorldsOverviewResponse{} },
+}
+
+// TibiaDataProtoMessage func - returns an empty Protocol Buffers message for the type of the response
+func TibiaDataProtoMessage(j interface{}) (proto.Message, error) {
+	newMessage, exists := tibiaDataProtoMessages[reflect.TypeOf(j)]
+	if !exists {
+		return nil, validation.ErrorFormatNotSupported
+	}
+
+	return newMessage(), nil
+}
+
+// TibiaDataToProto func - converts data into the given message
+// The data is passed through its json representation, as the message fields are
+// named after the json keys, and data can therefore also be a reduced json value
+func TibiaDataToProto(data interface{}, message proto.Message) error {
+	js, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(js, message)
+}
+
+// TibiaDataEncodeProtobuf func - encodes data in the wire format of message
+func TibiaDataEncodeProtobuf(data interface{}, message proto.Message) ([]byte, error) {
+	if err := TibiaDataToProto(data, message); err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(message)
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/tibiadatapb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// TestProtoMessagesInSync makes sure that every json key of the response types
+// has a field in the Protocol Buffers message, so that no data is dropped silently
+func TestProtoMessagesInSync(t *testing.T) {
+	for responseType, newMessage := range tibiaDataProtoMessages {
+		protoInSync(t, responseType.Name(), responseType, newMessage().ProtoReflect().Descriptor())
+	}
+}
+
+func protoInSync(t *testing.T, path string, goType reflect.Type, message protoreflect.MessageDescriptor) {
+	for goType.Kind() == reflect.Ptr || goType.Kind() == reflect.Slice {
+		goType = goType.Elem()
+	}
+
+	for i := 0; i < goType.NumField(); i++ {
+		field := goType.Field(i)
+		name, ok := jsonFieldName(field)
+		if !ok {
+			continue
+		}
+
+		protoField := message.Fields().ByName(protoreflect.Name(name))
+		if protoField == nil {
+			t.Errorf("%s.%s has no field in message %s", path, name, message.FullName())
+			continue
+		}
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if (fieldType.Kind() == reflect.Slice) != protoField.IsList() {
+			t.Errorf("%s.%s differs in being a list", path, name)
+		}
+		if protoField.Message() != nil {
+			protoInSync(t, path+"."+name, fieldType, protoField.Message())
+		}
+	}
+}
+
+func TestProtoEncodeWorld(t *testing.T) {
+	assert := assert.New(t)
+
+	world := WorldResponse{
+		World: World{
+			Name:              "Antica",
+			PlayersOnline:     2,
+			WorldsQuestTitles: []string{"Rise of Devovorga"},
+			BattleyeProtected: true,
+			OnlinePlayers: []OnlinePlayers{
+				{Name: "Durin", Level: 1000, Vocation: "Elite Knight"},
+				{Name: "Trollefar", Level: 500, Vocation: "Royal Paladin"},
+			},
+		},
+	}
+
+	message, err := TibiaDataProtoMessage(world)
+	assert.Nil(err)
+
+	body, err := TibiaDataEncodeProtobuf(world, message)
+	assert.Nil(err)
+
+	decoded := &tibiadatapb.WorldResponse{}
+	assert.Nil(proto.Unmarshal(body, decoded))
+	assert.Equal("Antica", decoded.GetWorld().GetName())
+	assert.Equal(int64(2), decoded.GetWorld().GetPlayersOnline())
+	assert.Equal([]string{"Rise of Devovorga"}, decoded.GetWorld().GetWorldQuestTitles())
+	assert.True(decoded.GetWorld().GetBattleyeProtected())
+	assert.Len(decoded.GetWorld().GetOnlinePlayers(), 2)
+	assert.Equal(int64(500), decoded.GetWorld().GetOnlinePlayers()[1].GetLevel())
+}
+
+func TestProtoHandleResponse(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	assert := assert.New(t)
+
+	world := WorldResponse{
+		World: World{
+			Name:          "Antica",
+			PlayersOnline: 1,
+			OnlinePlayers: []OnlinePlayers{{Name: "Durin", Level: 1000, Vocation: "Elite Knight"}},
+		},
+	}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v4/world/antica?fields=world.name", nil)
+	c.Request.Header.Set("Accept", "application/x-protobuf")
+
+	TibiaDataAPIHandleResponse(c, "", world)
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("application/x-protobuf", w.Header().Get("Content-Type"))
+
+	decoded := &tibiadatapb.WorldResponse{}
+	assert.Nil(proto.Unmarshal(w.Body.Bytes(), decoded))
+	assert.Equal("Antica", decoded.GetWorld().GetName())
+	assert.Equal(int64(0), decoded.GetWorld().GetPlayersOnline())
+	assert.Empty(decoded.GetWorld().GetOnlinePlayers())
+	assert.NotNil(decoded.GetInformation())
+}
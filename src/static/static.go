@@ -4,3 +4,6 @@ import "embed"
 
 //go:embed testdata/*
 var TestFiles embed.FS
+
+//go:embed docs/*
+var DocsFiles embed.FS
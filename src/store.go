@@ -0,0 +1,269 @@
+package main
+
+import (
+	"crypto/subtle"
+	"encoding/binary"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+	bolt "go.etcd.io/bbolt"
+)
+
+// TibiaDataStore is the embedded database of the features keeping data over time
+// It is nil if TIBIADATA_STORE_PATH is not set, endpoints using it respond with ErrorStoreNotEnabled then.
+var TibiaDataStore *bolt.DB
+
+// TibiaDataManagementToken is the bearer token of the endpoints managing the store (webhooks, watchlist and trackers)
+// It is empty if TIBIADATA_MANAGEMENT_TOKEN is not set, the endpoints respond with ErrorManagementNotEnabled then.
+var TibiaDataManagementToken string
+
+// TibiaDataStoreOpen func - opens (or creates) the embedded database at path
+func TibiaDataStoreOpen(path string) (*bolt.DB, error) {
+	return bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+}
+
+// tibiaDataStoreDB returns the store or ErrorStoreNotEnabled if it is not enabled
+func tibiaDataStoreDB() (*bolt.DB, error) {
+	if TibiaDataStore == nil {
+		return nil, validation.ErrorStoreNotEnabled
+	}
+	return TibiaDataStore, nil
+}
+
+// tibiaDataStoreHTTPCode returns the http code of an error of an endpoint using the store (0 for the default)
+func tibiaDataStoreHTTPCode(err error) int {
+	switch err {
+	case validation.ErrorStoreNotEnabled:
+		return http.StatusServiceUnavailable
+	case validation.ErrorManagementNotEnabled:
+		return http.StatusForbidden
+	case validation.ErrorManagementTokenInvalid:
+		return http.StatusUnauthorized
+	}
+	return 0
+}
+
+// tibiaDataManagementAuthorize checks the authorization (Bearer <token>) of a request to an endpoint managing the store
+func tibiaDataManagementAuthorize(authorization string) error {
+	if TibiaDataManagementToken == "" {
+		return validation.ErrorManagementNotEnabled
+	}
+
+	token, found := strings.CutPrefix(authorization, "Bearer ")
+	if !found || subtle.ConstantTimeCompare([]byte(token), []byte(TibiaDataManagementToken)) != 1 {
+		return validation.ErrorManagementTokenInvalid
+	}
+	return nil
+}
+
+// tibiaDataStorePeriod returns the period of the from, to and period parameters of an endpoint using the store
+// period is day or week and ends now, from and to are RFC 3339 times or dates (to defaults to now).
+// Without parameters the period is the last day.
+func tibiaDataStorePeriod(from, to, period string, now time.Time) (time.Time, time.Time, error) {
+	parse := func(value string) (time.Time, error) {
+		if t, err := time.Parse(time.RFC3339, value); err == nil {
+			return t, nil
+		}
+		t, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			return time.Time{}, validation.ErrorPeriodInvalid
+		}
+		return t, nil
+	}
+
+	switch {
+	case period != "" && (from != "" || to != ""):
+		return time.Time{}, time.Time{}, validation.ErrorPeriodInvalid
+	case from == "" && to == "" && (period == "" || period == "day"):
+		return now.AddDate(0, 0, -1), now, nil
+	case from == "" && to == "" && period == "week":
+		return now.AddDate(0, 0, -7), now, nil
+	case from == "" || period != "":
+		return time.Time{}, time.Time{}, validation.ErrorPeriodInvalid
+	}
+
+	fromTime, err := parse(from)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	toTime := now
+	if to != "" {
+		toTime, err = parse(to)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+
+	if !fromTime.Before(toTime) {
+		return time.Time{}, time.Time{}, validation.ErrorPeriodInvalid
+	}
+
+	return fromTime, toTime, nil
+}
+
+// tibiaDataLocalInformation returns the information of a response that is not fetched from tibia.com (e.g. data of the store)
+func tibiaDataLocalInformation() Information {
+	return Information{
+		APIDetails: TibiaDataAPIDetails,
+		Timestamp:  TibiaDataDatetime(""),
+		TibiaURLs:  []string{},
+		Status: Status{
+			HTTPCode: http.StatusOK,
+		},
+	}
+}
+
+// tibiaDataStoreTimeKey returns the key of a time, keys of times sort chronologically
+func tibiaDataStoreTimeKey(t time.Time) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(t.Unix()))
+	return key
+}
+
+// tibiaDataStoreKeyTime returns the time of a key of tibiaDataStoreTimeKey
+func tibiaDataStoreKeyTime(key []byte) time.Time {
+	return time.Unix(int64(binary.BigEndian.Uint64(key)), 0).UTC()
+}
+
+// tibiaDataStorePrune deletes the entries of a bucket with time keys that are older than retention
+// or exceed the newest maxCount entries (retention and maxCount of zero or less keep everything).
+func tibiaDataStorePrune(bucket *bolt.Bucket, now time.Time, retention time.Duration, maxCount int) error {
+	var expired [][]byte
+
+	cursor := bucket.Cursor()
+	count := 0
+	for key, _ := cursor.Last(); key != nil; key, _ = cursor.Prev() {
+		count++
+		if (maxCount > 0 && count > maxCount) || (retention > 0 && tibiaDataStoreKeyTime(key).Before(now.Add(-retention))) {
+			expired = append(expired, append([]byte{}, key...))
+		}
+	}
+
+	for _, key := range expired {
+		if err := bucket.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tibiaDataStoreRun calls poll right away and then every interval until stop is closed, failed polls are logged with name
+// poll gets half the interval as the minimum age of the entries it polls, so that entries polled shortly before
+// (e.g. before a restart) are skipped.
+func tibiaDataStoreRun(name string, interval time.Duration, poll func(minAge time.Duration, now time.Time) error, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := poll(interval/2, time.Now()); err != nil {
+			log.Printf("[error] TibiaData API %s poll failed: %s", name, err)
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// tibiaDataStoreDue reports whether an entry last polled at lastPolled (RFC 3339, empty if never) is due at now
+func tibiaDataStoreDue(lastPolled string, minAge time.Duration, now time.Time) bool {
+	polled, err := time.Parse(time.RFC3339, lastPolled)
+	return err != nil || now.Sub(polled) >= minAge
+}
+
+// tibiaDataStoreEntry is an entry of a registry, a bucket of json values polled in the background (e.g. the watchlist)
+type tibiaDataStoreEntry struct {
+	Key   []byte
+	Value interface{}
+}
+
+// tibiaDataStoreList returns the values of a registry in the order of their keys
+func tibiaDataStoreList[T any](tx *bolt.Tx, name []byte) ([]T, error) {
+	values := []T{}
+
+	bucket := tx.Bucket(name)
+	if bucket == nil {
+		return values, nil
+	}
+
+	err := bucket.ForEach(func(_, data []byte) error {
+		var value T
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		values = append(values, value)
+		return nil
+	})
+
+	return values, err
+}
+
+// tibiaDataStoreAdd adds entries to a registry, entries whose key exists already are kept as they are
+// It fails with full if the registry would have more than maxSize entries.
+func tibiaDataStoreAdd(tx *bolt.Tx, name []byte, entries []tibiaDataStoreEntry, maxSize int, full error) error {
+	bucket, err := tx.CreateBucketIfNotExists(name)
+	if err != nil {
+		return err
+	}
+
+	size := 0
+	if err := bucket.ForEach(func(_, _ []byte) error { size++; return nil }); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if bucket.Get(entry.Key) != nil {
+			continue
+		}
+		if size >= maxSize {
+			return full
+		}
+		size++
+
+		value, err := json.Marshal(entry.Value)
+		if err != nil {
+			return err
+		}
+		if err := bucket.Put(entry.Key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tibiaDataStoreRemove deletes an entry of a registry, it fails with notFound if the entry does not exist
+func tibiaDataStoreRemove(tx *bolt.Tx, name, key []byte, notFound error) error {
+	bucket := tx.Bucket(name)
+	if bucket == nil || bucket.Get(key) == nil {
+		return notFound
+	}
+	return bucket.Delete(key)
+}
+
+// tibiaDataStoreGet decodes an entry of a registry into value
+// It is false if the entry does not exist, which is the case if it was removed while it was polled.
+func tibiaDataStoreGet(tx *bolt.Tx, name, key []byte, value interface{}) (bool, error) {
+	bucket := tx.Bucket(name)
+	if bucket == nil || bucket.Get(key) == nil {
+		return false, nil
+	}
+	return true, json.Unmarshal(bucket.Get(key), value)
+}
+
+// tibiaDataStorePut stores value as the entry of key in a registry
+func tibiaDataStorePut(tx *bolt.Tx, name, key []byte, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return tx.Bucket(name).Put(key, data)
+}
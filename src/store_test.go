@@ -0,0 +1,198 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tibiadata/tibiadata-api-go/src/validation"
+	bolt "go.etcd.io/bbolt"
+)
+
+// testStore opens an empty store in a temporary directory as TibiaDataStore for the duration of the test
+func testStore(t *testing.T) *bolt.DB {
+	db, err := TibiaDataStoreOpen(filepath.Join(t.TempDir(), "tibiadata.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	TibiaDataStore = db
+	t.Cleanup(func() {
+		TibiaDataStore = nil
+		db.Close()
+	})
+
+	return db
+}
+
+func TestStorePeriod(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)
+
+	from, to, err := tibiaDataStorePeriod("", "", "", now)
+	assert.Nil(err)
+	assert.Equal(now.AddDate(0, 0, -1), from)
+	assert.Equal(now, to)
+
+	from, _, err = tibiaDataStorePeriod("", "", "week", now)
+	assert.Nil(err)
+	assert.Equal(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC), from)
+
+	from, to, err = tibiaDataStorePeriod("2025-01-01", "2025-01-02T06:00:00Z", "", now)
+	assert.Nil(err)
+	assert.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), from)
+	assert.Equal(time.Date(2025, 1, 2, 6, 0, 0, 0, time.UTC), to)
+
+	_, to, err = tibiaDataStorePeriod("2025-01-01", "", "", now)
+	assert.Nil(err)
+	assert.Equal(now, to)
+
+	for _, params := range [][3]string{
+		{"", "", "month"},
+		{"2025-01-01", "", "day"},
+		{"", "2025-01-01", ""},
+		{"yesterday", "", ""},
+		{"2025-01-02", "2025-01-01", ""},
+	} {
+		_, _, err = tibiaDataStorePeriod(params[0], params[1], params[2], now)
+		assert.Equal(validation.ErrorPeriodInvalid, err, params)
+	}
+}
+
+func TestStorePrune(t *testing.T) {
+	assert := assert.New(t)
+	db := testStore(t)
+	now := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)
+
+	keys := func(bucket *bolt.Bucket) (times []time.Time) {
+		_ = bucket.ForEach(func(key, _ []byte) error {
+			times = append(times, tibiaDataStoreKeyTime(key))
+			return nil
+		})
+		return times
+	}
+
+	err := db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucket([]byte("test"))
+		if err != nil {
+			return err
+		}
+		for day := 0; day < 5; day++ {
+			if err := bucket.Put(tibiaDataStoreTimeKey(now.AddDate(0, 0, -day)), []byte{}); err != nil {
+				return err
+			}
+		}
+
+		// everything older than 3 days
+		assert.Nil(tibiaDataStorePrune(bucket, now, 3*24*time.Hour, 0))
+		assert.Equal([]time.Time{now.AddDate(0, 0, -3), now.AddDate(0, 0, -2), now.AddDate(0, 0, -1), now}, keys(bucket))
+
+		// all but the newest 2
+		assert.Nil(tibiaDataStorePrune(bucket, now, 0, 2))
+		assert.Equal([]time.Time{now.AddDate(0, 0, -1), now}, keys(bucket))
+		return nil
+	})
+	assert.Nil(err)
+}
+
+func TestStoreRegistry(t *testing.T) {
+	assert := assert.New(t)
+	db := testStore(t)
+	now := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)
+
+	type entry struct {
+		Name       string `json:"name"`
+		LastPolled string `json:"last_polled,omitempty"`
+	}
+	name := []byte("test")
+
+	err := db.Update(func(tx *bolt.Tx) error {
+		full := validation.ErrorWatchlistFull
+
+		// existing keys are kept and do not count twice
+		assert.Nil(tibiaDataStoreAdd(tx, name, []tibiaDataStoreEntry{{[]byte("b"), entry{Name: "B"}}, {[]byte("a"), entry{Name: "A"}}}, 2, full))
+		assert.Nil(tibiaDataStoreAdd(tx, name, []tibiaDataStoreEntry{{[]byte("a"), entry{Name: "Other"}}}, 2, full))
+		assert.Equal(full, tibiaDataStoreAdd(tx, name, []tibiaDataStoreEntry{{[]byte("c"), entry{Name: "C"}}}, 2, full))
+
+		entries, err := tibiaDataStoreList[entry](tx, name)
+		assert.Nil(err)
+		assert.Equal([]entry{{Name: "A"}, {Name: "B"}}, entries)
+
+		var stored entry
+		ok, err := tibiaDataStoreGet(tx, name, []byte("a"), &stored)
+		assert.True(ok)
+		assert.Nil(err)
+		stored.LastPolled = now.Format(time.RFC3339)
+		assert.Nil(tibiaDataStorePut(tx, name, []byte("a"), stored))
+
+		// removed entries are not found anymore, e.g. by a poll that was running
+		notFound := validation.ErrorCharacterNotWatched
+		assert.Nil(tibiaDataStoreRemove(tx, name, []byte("b"), notFound))
+		assert.Equal(notFound, tibiaDataStoreRemove(tx, name, []byte("b"), notFound))
+		ok, err = tibiaDataStoreGet(tx, name, []byte("b"), &stored)
+		assert.False(ok)
+		assert.Nil(err)
+
+		entries, err = tibiaDataStoreList[entry](tx, name)
+		assert.Nil(err)
+		assert.Equal([]entry{{Name: "A", LastPolled: "2025-01-08T12:00:00Z"}}, entries)
+		return nil
+	})
+	assert.Nil(err)
+
+	// entries are due if they were never polled or polled at least minAge ago
+	assert.True(tibiaDataStoreDue("", time.Hour, now))
+	assert.True(tibiaDataStoreDue("2025-01-08T11:00:00Z", time.Hour, now))
+	assert.False(tibiaDataStoreDue("2025-01-08T11:30:00Z", time.Hour, now))
+}
+
+func TestStoreNotEnabled(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := tibiaDataStoreDB()
+	assert.Equal(validation.ErrorStoreNotEnabled, err)
+	assert.Equal(http.StatusServiceUnavailable, tibiaDataStoreHTTPCode(err))
+	assert.Equal(http.StatusServiceUnavailable, openAPIErrorHTTPCode(validation.ErrorStoreNotEnabled))
+	assert.Equal(0, tibiaDataStoreHTTPCode(validation.ErrorPeriodInvalid))
+}
+
+func TestStoreManagementToken(t *testing.T) {
+	assert := assert.New(t)
+	testStore(t)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	tibiaDataRoutes(router)
+
+	request := func(method, path, authorization string) int {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(method, path, nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	defer func(token string) { TibiaDataManagementToken = token }(TibiaDataManagementToken)
+	TibiaDataManagementToken = ""
+
+	// without a configured token the endpoints managing the store are disabled, the others are not
+	assert.Equal(validation.ErrorManagementNotEnabled, tibiaDataManagementAuthorize("Bearer "))
+	assert.Equal(http.StatusForbidden, request(http.MethodGet, "/v4/webhooks", "Bearer "))
+	assert.Equal(http.StatusForbidden, request(http.MethodDelete, "/v4/wartracker/1", ""))
+	assert.Equal(http.StatusOK, request(http.MethodGet, "/v4/guildtracker", ""))
+
+	TibiaDataManagementToken = "token"
+	assert.Nil(tibiaDataManagementAuthorize("Bearer token"))
+	for _, authorization := range []string{"", "token", "Bearer other", "Basic token"} {
+		assert.Equal(validation.ErrorManagementTokenInvalid, tibiaDataManagementAuthorize(authorization), authorization)
+	}
+	assert.Equal(http.StatusUnauthorized, request(http.MethodDelete, "/v4/watchlist/Durin", "Bearer other"))
+	assert.Equal(http.StatusOK, request(http.MethodGet, "/v4/webhooks", "Bearer token"))
+	assert.Equal(http.StatusOK, request(http.MethodGet, "/v4/watchlist", ""))
+}
@@ -0,0 +1,261 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: boostable_bosses_overview.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: BoostableBosses and Information
+type BoostableBossesOverviewResponse struct {
+	state           protoimpl.MessageState    `protogen:"open.v1"`
+	BoostableBosses *BoostableBossesContainer `protobuf:"bytes,1,opt,name=boostable_bosses,json=boostableBosses,proto3" json:"boostable_bosses,omitempty"`
+	Information     *Information              `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BoostableBossesOverviewResponse) Reset() {
+	*x = BoostableBossesOverviewResponse{}
+	mi := &file_boostable_bosses_overview_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoostableBossesOverviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoostableBossesOverviewResponse) ProtoMessage() {}
+
+func (x *BoostableBossesOverviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boostable_bosses_overview_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoostableBossesOverviewResponse.ProtoReflect.Descriptor instead.
+func (*BoostableBossesOverviewResponse) Descriptor() ([]byte, []int) {
+	return file_boostable_bosses_overview_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BoostableBossesOverviewResponse) GetBoostableBosses() *BoostableBossesContainer {
+	if x != nil {
+		return x.BoostableBosses
+	}
+	return nil
+}
+
+func (x *BoostableBossesOverviewResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type BoostableBossesContainer struct {
+	state             protoimpl.MessageState   `protogen:"open.v1"`
+	Boosted           *OverviewBoostableBoss   `protobuf:"bytes,1,opt,name=boosted,proto3" json:"boosted,omitempty"`                                                // The current boosted boss.
+	BoostableBossList []*OverviewBoostableBoss `protobuf:"bytes,2,rep,name=boostable_boss_list,json=boostableBossList,proto3" json:"boostable_boss_list,omitempty"` // The list of boostable bosses.
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BoostableBossesContainer) Reset() {
+	*x = BoostableBossesContainer{}
+	mi := &file_boostable_bosses_overview_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoostableBossesContainer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoostableBossesContainer) ProtoMessage() {}
+
+func (x *BoostableBossesContainer) ProtoReflect() protoreflect.Message {
+	mi := &file_boostable_bosses_overview_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoostableBossesContainer.ProtoReflect.Descriptor instead.
+func (*BoostableBossesContainer) Descriptor() ([]byte, []int) {
+	return file_boostable_bosses_overview_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BoostableBossesContainer) GetBoosted() *OverviewBoostableBoss {
+	if x != nil {
+		return x.Boosted
+	}
+	return nil
+}
+
+func (x *BoostableBossesContainer) GetBoostableBossList() []*OverviewBoostableBoss {
+	if x != nil {
+		return x.BoostableBossList
+	}
+	return nil
+}
+
+// Child of BoostableBoss (used for list of boostable bosses and boosted boss section)
+type OverviewBoostableBoss struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                         // The name of the boss.
+	ImageUrl      string                 `protobuf:"bytes,2,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"` // The URL to this boss's image.
+	Featured      bool                   `protobuf:"varint,3,opt,name=featured,proto3" json:"featured,omitempty"`                // Whether it is featured of not.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OverviewBoostableBoss) Reset() {
+	*x = OverviewBoostableBoss{}
+	mi := &file_boostable_bosses_overview_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverviewBoostableBoss) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverviewBoostableBoss) ProtoMessage() {}
+
+func (x *OverviewBoostableBoss) ProtoReflect() protoreflect.Message {
+	mi := &file_boostable_bosses_overview_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverviewBoostableBoss.ProtoReflect.Descriptor instead.
+func (*OverviewBoostableBoss) Descriptor() ([]byte, []int) {
+	return file_boostable_bosses_overview_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OverviewBoostableBoss) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OverviewBoostableBoss) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *OverviewBoostableBoss) GetFeatured() bool {
+	if x != nil {
+		return x.Featured
+	}
+	return false
+}
+
+var File_boostable_bosses_overview_proto protoreflect.FileDescriptor
+
+const file_boostable_bosses_overview_proto_rawDesc = "" +
+	"\n" +
+	"\x1fboostable_bosses_overview.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\xb1\x01\n" +
+	"\x1fBoostableBossesOverviewResponse\x12Q\n" +
+	"\x10boostable_bosses\x18\x01 \x01(\v2&.tibiadata.v4.BoostableBossesContainerR\x0fboostableBosses\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xae\x01\n" +
+	"\x18BoostableBossesContainer\x12=\n" +
+	"\aboosted\x18\x01 \x01(\v2#.tibiadata.v4.OverviewBoostableBossR\aboosted\x12S\n" +
+	"\x13boostable_boss_list\x18\x02 \x03(\v2#.tibiadata.v4.OverviewBoostableBossR\x11boostableBossList\"d\n" +
+	"\x15OverviewBoostableBoss\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\timage_url\x18\x02 \x01(\tR\bimageUrl\x12\x1a\n" +
+	"\bfeatured\x18\x03 \x01(\bR\bfeaturedB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_boostable_bosses_overview_proto_rawDescOnce sync.Once
+	file_boostable_bosses_overview_proto_rawDescData []byte
+)
+
+func file_boostable_bosses_overview_proto_rawDescGZIP() []byte {
+	file_boostable_bosses_overview_proto_rawDescOnce.Do(func() {
+		file_boostable_bosses_overview_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_boostable_bosses_overview_proto_rawDesc), len(file_boostable_bosses_overview_proto_rawDesc)))
+	})
+	return file_boostable_bosses_overview_proto_rawDescData
+}
+
+var file_boostable_bosses_overview_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_boostable_bosses_overview_proto_goTypes = []any{
+	(*BoostableBossesOverviewResponse)(nil), // 0: tibiadata.v4.BoostableBossesOverviewResponse
+	(*BoostableBossesContainer)(nil),        // 1: tibiadata.v4.BoostableBossesContainer
+	(*OverviewBoostableBoss)(nil),           // 2: tibiadata.v4.OverviewBoostableBoss
+	(*Information)(nil),                     // 3: tibiadata.v4.Information
+}
+var file_boostable_bosses_overview_proto_depIdxs = []int32{
+	1, // 0: tibiadata.v4.BoostableBossesOverviewResponse.boostable_bosses:type_name -> tibiadata.v4.BoostableBossesContainer
+	3, // 1: tibiadata.v4.BoostableBossesOverviewResponse.information:type_name -> tibiadata.v4.Information
+	2, // 2: tibiadata.v4.BoostableBossesContainer.boosted:type_name -> tibiadata.v4.OverviewBoostableBoss
+	2, // 3: tibiadata.v4.BoostableBossesContainer.boostable_boss_list:type_name -> tibiadata.v4.OverviewBoostableBoss
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_boostable_bosses_overview_proto_init() }
+func file_boostable_bosses_overview_proto_init() {
+	if File_boostable_bosses_overview_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_boostable_bosses_overview_proto_rawDesc), len(file_boostable_bosses_overview_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_boostable_bosses_overview_proto_goTypes,
+		DependencyIndexes: file_boostable_bosses_overview_proto_depIdxs,
+		MessageInfos:      file_boostable_bosses_overview_proto_msgTypes,
+	}.Build()
+	File_boostable_bosses_overview_proto = out.File
+	file_boostable_bosses_overview_proto_goTypes = nil
+	file_boostable_bosses_overview_proto_depIdxs = nil
+}
@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: characters_batch.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: Characters and Information
+type CharactersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Characters    []*CharactersCharacter `protobuf:"bytes,1,rep,name=characters,proto3" json:"characters,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharactersResponse) Reset() {
+	*x = CharactersResponse{}
+	mi := &file_characters_batch_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharactersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharactersResponse) ProtoMessage() {}
+
+func (x *CharactersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_characters_batch_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharactersResponse.ProtoReflect.Descriptor instead.
+func (*CharactersResponse) Descriptor() ([]byte, []int) {
+	return file_characters_batch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CharactersResponse) GetCharacters() []*CharactersCharacter {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+func (x *CharactersResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of CharactersResponse
+type CharactersCharacter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`           // The requested character name.
+	Character     *Character             `protobuf:"bytes,2,opt,name=character,proto3" json:"character,omitempty"` // The character (if it could be fetched).
+	Error         *Status                `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`         // The error of the character (if it could not be fetched).
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharactersCharacter) Reset() {
+	*x = CharactersCharacter{}
+	mi := &file_characters_batch_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharactersCharacter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharactersCharacter) ProtoMessage() {}
+
+func (x *CharactersCharacter) ProtoReflect() protoreflect.Message {
+	mi := &file_characters_batch_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharactersCharacter.ProtoReflect.Descriptor instead.
+func (*CharactersCharacter) Descriptor() ([]byte, []int) {
+	return file_characters_batch_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CharactersCharacter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CharactersCharacter) GetCharacter() *Character {
+	if x != nil {
+		return x.Character
+	}
+	return nil
+}
+
+func (x *CharactersCharacter) GetError() *Status {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+var File_characters_batch_proto protoreflect.FileDescriptor
+
+const file_characters_batch_proto_rawDesc = "" +
+	"\n" +
+	"\x16characters_batch.proto\x12\ftibiadata.v4\x1a\x1acharacters_character.proto\x1a\x11information.proto\"\x94\x01\n" +
+	"\x12CharactersResponse\x12A\n" +
+	"\n" +
+	"characters\x18\x01 \x03(\v2!.tibiadata.v4.CharactersCharacterR\n" +
+	"characters\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\x8c\x01\n" +
+	"\x13CharactersCharacter\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x125\n" +
+	"\tcharacter\x18\x02 \x01(\v2\x17.tibiadata.v4.CharacterR\tcharacter\x12*\n" +
+	"\x05error\x18\x03 \x01(\v2\x14.tibiadata.v4.StatusR\x05errorB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_characters_batch_proto_rawDescOnce sync.Once
+	file_characters_batch_proto_rawDescData []byte
+)
+
+func file_characters_batch_proto_rawDescGZIP() []byte {
+	file_characters_batch_proto_rawDescOnce.Do(func() {
+		file_characters_batch_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_characters_batch_proto_rawDesc), len(file_characters_batch_proto_rawDesc)))
+	})
+	return file_characters_batch_proto_rawDescData
+}
+
+var file_characters_batch_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_characters_batch_proto_goTypes = []any{
+	(*CharactersResponse)(nil),  // 0: tibiadata.v4.CharactersResponse
+	(*CharactersCharacter)(nil), // 1: tibiadata.v4.CharactersCharacter
+	(*Information)(nil),         // 2: tibiadata.v4.Information
+	(*Character)(nil),           // 3: tibiadata.v4.Character
+	(*Status)(nil),              // 4: tibiadata.v4.Status
+}
+var file_characters_batch_proto_depIdxs = []int32{
+	1, // 0: tibiadata.v4.CharactersResponse.characters:type_name -> tibiadata.v4.CharactersCharacter
+	2, // 1: tibiadata.v4.CharactersResponse.information:type_name -> tibiadata.v4.Information
+	3, // 2: tibiadata.v4.CharactersCharacter.character:type_name -> tibiadata.v4.Character
+	4, // 3: tibiadata.v4.CharactersCharacter.error:type_name -> tibiadata.v4.Status
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_characters_batch_proto_init() }
+func file_characters_batch_proto_init() {
+	if File_characters_batch_proto != nil {
+		return
+	}
+	file_characters_character_proto_init()
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_characters_batch_proto_rawDesc), len(file_characters_batch_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_characters_batch_proto_goTypes,
+		DependencyIndexes: file_characters_batch_proto_depIdxs,
+		MessageInfos:      file_characters_batch_proto_msgTypes,
+	}.Build()
+	File_characters_batch_proto = out.File
+	file_characters_batch_proto_goTypes = nil
+	file_characters_batch_proto_depIdxs = nil
+}
@@ -0,0 +1,1061 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: characters_character.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels, Characters and Information
+type CharacterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Character     *Character             `protobuf:"bytes,1,opt,name=character,proto3" json:"character,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharacterResponse) Reset() {
+	*x = CharacterResponse{}
+	mi := &file_characters_character_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharacterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterResponse) ProtoMessage() {}
+
+func (x *CharacterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_characters_character_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterResponse.ProtoReflect.Descriptor instead.
+func (*CharacterResponse) Descriptor() ([]byte, []int) {
+	return file_characters_character_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CharacterResponse) GetCharacter() *Character {
+	if x != nil {
+		return x.Character
+	}
+	return nil
+}
+
+func (x *CharacterResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of Character
+type AccountBadges struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                      // The name of the badge.
+	IconUrl       string                 `protobuf:"bytes,2,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"` // The URL to the badge's icon.
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`        // The description of the badge.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountBadges) Reset() {
+	*x = AccountBadges{}
+	mi := &file_characters_character_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountBadges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountBadges) ProtoMessage() {}
+
+func (x *AccountBadges) ProtoReflect() protoreflect.Message {
+	mi := &file_characters_character_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountBadges.ProtoReflect.Descriptor instead.
+func (*AccountBadges) Descriptor() ([]byte, []int) {
+	return file_characters_character_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AccountBadges) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccountBadges) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
+	}
+	return ""
+}
+
+func (x *AccountBadges) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Child of Character
+type AccountInformation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Position      string                 `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`                             // The account's special position.
+	Created       string                 `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"`                               // The account's date of creation.
+	LoyaltyTitle  string                 `protobuf:"bytes,3,opt,name=loyalty_title,json=loyaltyTitle,proto3" json:"loyalty_title,omitempty"` // The account's loyalty title.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountInformation) Reset() {
+	*x = AccountInformation{}
+	mi := &file_characters_character_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountInformation) ProtoMessage() {}
+
+func (x *AccountInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_characters_character_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountInformation.ProtoReflect.Descriptor instead.
+func (*AccountInformation) Descriptor() ([]byte, []int) {
+	return file_characters_character_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AccountInformation) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *AccountInformation) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *AccountInformation) GetLoyaltyTitle() string {
+	if x != nil {
+		return x.LoyaltyTitle
+	}
+	return ""
+}
+
+// Child of Character
+type Achievements struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`      // The name of the achievement.
+	Grade         int64                  `protobuf:"varint,2,opt,name=grade,proto3" json:"grade,omitempty"`   // The grade/stars of the achievement.
+	Secret        bool                   `protobuf:"varint,3,opt,name=secret,proto3" json:"secret,omitempty"` // Whether it is a secret achievement or not.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Achievements) Reset() {
+	*x = Achievements{}
+	mi := &file_characters_character_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Achievements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Achievements) ProtoMessage() {}
+
+func (x *Achievements) ProtoReflect() protoreflect.Message {
+	mi := &file_characters_character_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Achievements.ProtoReflect.Descriptor instead.
+func (*Achievements) Descriptor() ([]byte, []int) {
+	return file_characters_character_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Achievements) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Achievements) GetGrade() int64 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *Achievements) GetSecret() bool {
+	if x != nil {
+		return x.Secret
+	}
+	return false
+}
+
+// Child of JSONData
+type Character struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Character          *CharacterInfo         `protobuf:"bytes,1,opt,name=character,proto3" json:"character,omitempty"`                                             // The character's information.
+	AccountBadges      []*AccountBadges       `protobuf:"bytes,2,rep,name=account_badges,json=accountBadges,proto3" json:"account_badges,omitempty"`                // The account's badges.
+	Achievements       []*Achievements        `protobuf:"bytes,3,rep,name=achievements,proto3" json:"achievements,omitempty"`                                       // The character's achievements.
+	Deaths             []*Deaths              `protobuf:"bytes,4,rep,name=deaths,proto3" json:"deaths,omitempty"`                                                   // The character's deaths.
+	DeathsTruncated    bool                   `protobuf:"varint,5,opt,name=deaths_truncated,json=deathsTruncated,proto3" json:"deaths_truncated,omitempty"`         // Whether the character's deaths were truncated or not.
+	AccountInformation *AccountInformation    `protobuf:"bytes,6,opt,name=account_information,json=accountInformation,proto3" json:"account_information,omitempty"` // The account information.
+	OtherCharacters    []*OtherCharacters     `protobuf:"bytes,7,rep,name=other_characters,json=otherCharacters,proto3" json:"other_characters,omitempty"`          // The account's other characters.
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Character) Reset() {
+	*x = Character{}
+	mi := &file_characters_character_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Character) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Character) ProtoMessage() {}
+
+func (x *Character) ProtoReflect() protoreflect.Message {
+	mi := &file_characters_character_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Character.ProtoReflect.Descriptor instead.
+func (*Character) Descriptor() ([]byte, []int) {
+	return file_characters_character_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Character) GetCharacter() *CharacterInfo {
+	if x != nil {
+		return x.Character
+	}
+	return nil
+}
+
+func (x *Character) GetAccountBadges() []*AccountBadges {
+	if x != nil {
+		return x.AccountBadges
+	}
+	return nil
+}
+
+func (x *Character) GetAchievements() []*Achievements {
+	if x != nil {
+		return x.Achievements
+	}
+	return nil
+}
+
+func (x *Character) GetDeaths() []*Deaths {
+	if x != nil {
+		return x.Deaths
+	}
+	return nil
+}
+
+func (x *Character) GetDeathsTruncated() bool {
+	if x != nil {
+		return x.DeathsTruncated
+	}
+	return false
+}
+
+func (x *Character) GetAccountInformation() *AccountInformation {
+	if x != nil {
+		return x.AccountInformation
+	}
+	return nil
+}
+
+func (x *Character) GetOtherCharacters() []*OtherCharacters {
+	if x != nil {
+		return x.OtherCharacters
+	}
+	return nil
+}
+
+// Child of CharacterInfo
+type CharacterGuild struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // The name of the guild.
+	Rank          string                 `protobuf:"bytes,2,opt,name=rank,proto3" json:"rank,omitempty"` // The character's rank in the guild.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharacterGuild) Reset() {
+	*x = CharacterGuild{}
+	mi := &file_characters_character_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharacterGuild) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterGuild) ProtoMessage() {}
+
+func (x *CharacterGuild) ProtoReflect() protoreflect.Message {
+	mi := &file_characters_character_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterGuild.ProtoReflect.Descriptor instead.
+func (*CharacterGuild) Descriptor() ([]byte, []int) {
+	return file_characters_character_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CharacterGuild) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CharacterGuild) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+// Child of Character
+type CharacterInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                      // The name of the character.
+	FormerNames       []string               `protobuf:"bytes,2,rep,name=former_names,json=formerNames,proto3" json:"former_names,omitempty"`                     // List of former names of the character.
+	Traded            bool                   `protobuf:"varint,3,opt,name=traded,proto3" json:"traded,omitempty"`                                                 // Whether the character was traded. (last 6 months)
+	DeletionDate      string                 `protobuf:"bytes,4,opt,name=deletion_date,json=deletionDate,proto3" json:"deletion_date,omitempty"`                  // The date when the character will be deleted. (if scheduled for deletion)
+	Sex               string                 `protobuf:"bytes,5,opt,name=sex,proto3" json:"sex,omitempty"`                                                        // The character's sex.
+	Title             string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`                                                    // The character's selected title.
+	UnlockedTitles    int64                  `protobuf:"varint,7,opt,name=unlocked_titles,json=unlockedTitles,proto3" json:"unlocked_titles,omitempty"`           // The number of titles the character has unlocked.
+	Vocation          string                 `protobuf:"bytes,8,opt,name=vocation,proto3" json:"vocation,omitempty"`                                              // The character's vocation.
+	Level             int64                  `protobuf:"varint,9,opt,name=level,proto3" json:"level,omitempty"`                                                   // The character's level.
+	AchievementPoints int64                  `protobuf:"varint,10,opt,name=achievement_points,json=achievementPoints,proto3" json:"achievement_points,omitempty"` // The total of achievement points the character has.
+	World             string                 `protobuf:"bytes,11,opt,name=world,proto3" json:"world,omitempty"`                                                   // The character's current world.
+	FormerWorlds      []string               `protobuf:"bytes,12,rep,name=former_worlds,json=formerWorlds,proto3" json:"former_worlds,omitempty"`                 // List of former worlds the character was in. (last 6 months)
+	Residence         string                 `protobuf:"bytes,13,opt,name=residence,proto3" json:"residence,omitempty"`                                           // The character's current residence.
+	MarriedTo         string                 `protobuf:"bytes,14,opt,name=married_to,json=marriedTo,proto3" json:"married_to,omitempty"`                          // The name of the character's husband/spouse.
+	Houses            []*Houses              `protobuf:"bytes,15,rep,name=houses,proto3" json:"houses,omitempty"`                                                 // List of houses the character owns currently.
+	Guild             *CharacterGuild        `protobuf:"bytes,16,opt,name=guild,proto3" json:"guild,omitempty"`                                                   // The guild that the character is member of.
+	LastLogin         string                 `protobuf:"bytes,17,opt,name=last_login,json=lastLogin,proto3" json:"last_login,omitempty"`                          // The character's last logged in time.
+	Position          string                 `protobuf:"bytes,18,opt,name=position,proto3" json:"position,omitempty"`                                             // The character's special position.
+	AccountStatus     string                 `protobuf:"bytes,19,opt,name=account_status,json=accountStatus,proto3" json:"account_status,omitempty"`              // Whether account is Free or Premium.
+	Comment           string                 `protobuf:"bytes,20,opt,name=comment,proto3" json:"comment,omitempty"`                                               // The character's comment.
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CharacterInfo) Reset() {
+	*x = CharacterInfo{}
+	mi := &file_characters_character_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharacterInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterInfo) ProtoMessage() {}
+
+func (x *CharacterInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_characters_character_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterInfo.ProtoReflect.Descriptor instead.
+func (*CharacterInfo) Descriptor() ([]byte, []int) {
+	return file_characters_character_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CharacterInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CharacterInfo) GetFormerNames() []string {
+	if x != nil {
+		return x.FormerNames
+	}
+	return nil
+}
+
+func (x *CharacterInfo) GetTraded() bool {
+	if x != nil {
+		return x.Traded
+	}
+	return false
+}
+
+func (x *CharacterInfo) GetDeletionDate() string {
+	if x != nil {
+		return x.DeletionDate
+	}
+	return ""
+}
+
+func (x *CharacterInfo) GetSex() string {
+	if x != nil {
+		return x.Sex
+	}
+	return ""
+}
+
+func (x *CharacterInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CharacterInfo) GetUnlockedTitles() int64 {
+	if x != nil {
+		return x.UnlockedTitles
+	}
+	return 0
+}
+
+func (x *CharacterInfo) GetVocation() string {
+	if x != nil {
+		return x.Vocation
+	}
+	return ""
+}
+
+func (x *CharacterInfo) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *CharacterInfo) GetAchievementPoints() int64 {
+	if x != nil {
+		return x.AchievementPoints
+	}
+	return 0
+}
+
+func (x *CharacterInfo) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *CharacterInfo) GetFormerWorlds() []string {
+	if x != nil {
+		return x.FormerWorlds
+	}
+	return nil
+}
+
+func (x *CharacterInfo) GetResidence() string {
+	if x != nil {
+		return x.Residence
+	}
+	return ""
+}
+
+func (x *CharacterInfo) GetMarriedTo() string {
+	if x != nil {
+		return x.MarriedTo
+	}
+	return ""
+}
+
+func (x *CharacterInfo) GetHouses() []*Houses {
+	if x != nil {
+		return x.Houses
+	}
+	return nil
+}
+
+func (x *CharacterInfo) GetGuild() *CharacterGuild {
+	if x != nil {
+		return x.Guild
+	}
+	return nil
+}
+
+func (x *CharacterInfo) GetLastLogin() string {
+	if x != nil {
+		return x.LastLogin
+	}
+	return ""
+}
+
+func (x *CharacterInfo) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *CharacterInfo) GetAccountStatus() string {
+	if x != nil {
+		return x.AccountStatus
+	}
+	return ""
+}
+
+func (x *CharacterInfo) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// Child of Character
+type Deaths struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`       // The timestamp when the death occurred.
+	Level         int64                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`    // The level when the death occurred.
+	Killers       []*Killers             `protobuf:"bytes,3,rep,name=killers,proto3" json:"killers,omitempty"` // List of killers involved.
+	Assists       []*Killers             `protobuf:"bytes,4,rep,name=assists,proto3" json:"assists,omitempty"` // List of assists involved.
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`   // The plain text reason of death.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Deaths) Reset() {
+	*x = Deaths{}
+	mi := &file_characters_character_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deaths) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deaths) ProtoMessage() {}
+
+func (x *Deaths) ProtoReflect() protoreflect.Message {
+	mi := &file_characters_character_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deaths.ProtoReflect.Descriptor instead.
+func (*Deaths) Descriptor() ([]byte, []int) {
+	return file_characters_character_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Deaths) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *Deaths) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Deaths) GetKillers() []*Killers {
+	if x != nil {
+		return x.Killers
+	}
+	return nil
+}
+
+func (x *Deaths) GetAssists() []*Killers {
+	if x != nil {
+		return x.Assists
+	}
+	return nil
+}
+
+func (x *Deaths) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Child of CharacterInfo
+type Houses struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`        // The name of the house.
+	Town          string                 `protobuf:"bytes,2,opt,name=town,proto3" json:"town,omitempty"`        // The town where the house is located in.
+	Paid          string                 `protobuf:"bytes,3,opt,name=paid,proto3" json:"paid,omitempty"`        // The date the last paid rent is due.
+	Houseid       int64                  `protobuf:"varint,4,opt,name=houseid,proto3" json:"houseid,omitempty"` // The internal ID of the house.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Houses) Reset() {
+	*x = Houses{}
+	mi := &file_characters_character_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Houses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Houses) ProtoMessage() {}
+
+func (x *Houses) ProtoReflect() protoreflect.Message {
+	mi := &file_characters_character_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Houses.ProtoReflect.Descriptor instead.
+func (*Houses) Descriptor() ([]byte, []int) {
+	return file_characters_character_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Houses) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Houses) GetTown() string {
+	if x != nil {
+		return x.Town
+	}
+	return ""
+}
+
+func (x *Houses) GetPaid() string {
+	if x != nil {
+		return x.Paid
+	}
+	return ""
+}
+
+func (x *Houses) GetHouseid() int64 {
+	if x != nil {
+		return x.Houseid
+	}
+	return 0
+}
+
+// Child of Deaths
+type Killers struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`      // The name of the killer/assist.
+	Player        bool                   `protobuf:"varint,2,opt,name=player,proto3" json:"player,omitempty"` // Whether it is a player or not.
+	Traded        bool                   `protobuf:"varint,3,opt,name=traded,proto3" json:"traded,omitempty"` // If the killer/assist was traded after the death.
+	Summon        string                 `protobuf:"bytes,4,opt,name=summon,proto3" json:"summon,omitempty"`  // The name of the summoned creature.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Killers) Reset() {
+	*x = Killers{}
+	mi := &file_characters_character_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Killers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Killers) ProtoMessage() {}
+
+func (x *Killers) ProtoReflect() protoreflect.Message {
+	mi := &file_characters_character_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Killers.ProtoReflect.Descriptor instead.
+func (*Killers) Descriptor() ([]byte, []int) {
+	return file_characters_character_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Killers) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Killers) GetPlayer() bool {
+	if x != nil {
+		return x.Player
+	}
+	return false
+}
+
+func (x *Killers) GetTraded() bool {
+	if x != nil {
+		return x.Traded
+	}
+	return false
+}
+
+func (x *Killers) GetSummon() string {
+	if x != nil {
+		return x.Summon
+	}
+	return ""
+}
+
+// Child of Character
+type OtherCharacters struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`         // The name of the character.
+	World         string                 `protobuf:"bytes,2,opt,name=world,proto3" json:"world,omitempty"`       // The name of the world.
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`     // The status of the character being online or offline.
+	Deleted       bool                   `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`  // Whether the character is scheduled for deletion or not.
+	Main          bool                   `protobuf:"varint,5,opt,name=main,proto3" json:"main,omitempty"`        // Whether this is the main character or not.
+	Traded        bool                   `protobuf:"varint,6,opt,name=traded,proto3" json:"traded,omitempty"`    // Whether the character has been traded last 6 months or not.
+	Position      string                 `protobuf:"bytes,7,opt,name=position,proto3" json:"position,omitempty"` // The character's special position.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OtherCharacters) Reset() {
+	*x = OtherCharacters{}
+	mi := &file_characters_character_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OtherCharacters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OtherCharacters) ProtoMessage() {}
+
+func (x *OtherCharacters) ProtoReflect() protoreflect.Message {
+	mi := &file_characters_character_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OtherCharacters.ProtoReflect.Descriptor instead.
+func (*OtherCharacters) Descriptor() ([]byte, []int) {
+	return file_characters_character_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *OtherCharacters) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OtherCharacters) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *OtherCharacters) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OtherCharacters) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *OtherCharacters) GetMain() bool {
+	if x != nil {
+		return x.Main
+	}
+	return false
+}
+
+func (x *OtherCharacters) GetTraded() bool {
+	if x != nil {
+		return x.Traded
+	}
+	return false
+}
+
+func (x *OtherCharacters) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+var File_characters_character_proto protoreflect.FileDescriptor
+
+const file_characters_character_proto_rawDesc = "" +
+	"\n" +
+	"\x1acharacters_character.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\x87\x01\n" +
+	"\x11CharacterResponse\x125\n" +
+	"\tcharacter\x18\x01 \x01(\v2\x17.tibiadata.v4.CharacterR\tcharacter\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"`\n" +
+	"\rAccountBadges\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\bicon_url\x18\x02 \x01(\tR\aiconUrl\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"o\n" +
+	"\x12AccountInformation\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\tR\bposition\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\tR\acreated\x12#\n" +
+	"\rloyalty_title\x18\x03 \x01(\tR\floyaltyTitle\"P\n" +
+	"\fAchievements\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05grade\x18\x02 \x01(\x03R\x05grade\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\bR\x06secret\"\xc0\x03\n" +
+	"\tCharacter\x129\n" +
+	"\tcharacter\x18\x01 \x01(\v2\x1b.tibiadata.v4.CharacterInfoR\tcharacter\x12B\n" +
+	"\x0eaccount_badges\x18\x02 \x03(\v2\x1b.tibiadata.v4.AccountBadgesR\raccountBadges\x12>\n" +
+	"\fachievements\x18\x03 \x03(\v2\x1a.tibiadata.v4.AchievementsR\fachievements\x12,\n" +
+	"\x06deaths\x18\x04 \x03(\v2\x14.tibiadata.v4.DeathsR\x06deaths\x12)\n" +
+	"\x10deaths_truncated\x18\x05 \x01(\bR\x0fdeathsTruncated\x12Q\n" +
+	"\x13account_information\x18\x06 \x01(\v2 .tibiadata.v4.AccountInformationR\x12accountInformation\x12H\n" +
+	"\x10other_characters\x18\a \x03(\v2\x1d.tibiadata.v4.OtherCharactersR\x0fotherCharacters\"8\n" +
+	"\x0eCharacterGuild\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\tR\x04rank\"\x8b\x05\n" +
+	"\rCharacterInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fformer_names\x18\x02 \x03(\tR\vformerNames\x12\x16\n" +
+	"\x06traded\x18\x03 \x01(\bR\x06traded\x12#\n" +
+	"\rdeletion_date\x18\x04 \x01(\tR\fdeletionDate\x12\x10\n" +
+	"\x03sex\x18\x05 \x01(\tR\x03sex\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12'\n" +
+	"\x0funlocked_titles\x18\a \x01(\x03R\x0eunlockedTitles\x12\x1a\n" +
+	"\bvocation\x18\b \x01(\tR\bvocation\x12\x14\n" +
+	"\x05level\x18\t \x01(\x03R\x05level\x12-\n" +
+	"\x12achievement_points\x18\n" +
+	" \x01(\x03R\x11achievementPoints\x12\x14\n" +
+	"\x05world\x18\v \x01(\tR\x05world\x12#\n" +
+	"\rformer_worlds\x18\f \x03(\tR\fformerWorlds\x12\x1c\n" +
+	"\tresidence\x18\r \x01(\tR\tresidence\x12\x1d\n" +
+	"\n" +
+	"married_to\x18\x0e \x01(\tR\tmarriedTo\x12,\n" +
+	"\x06houses\x18\x0f \x03(\v2\x14.tibiadata.v4.HousesR\x06houses\x122\n" +
+	"\x05guild\x18\x10 \x01(\v2\x1c.tibiadata.v4.CharacterGuildR\x05guild\x12\x1d\n" +
+	"\n" +
+	"last_login\x18\x11 \x01(\tR\tlastLogin\x12\x1a\n" +
+	"\bposition\x18\x12 \x01(\tR\bposition\x12%\n" +
+	"\x0eaccount_status\x18\x13 \x01(\tR\raccountStatus\x12\x18\n" +
+	"\acomment\x18\x14 \x01(\tR\acomment\"\xac\x01\n" +
+	"\x06Deaths\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\tR\x04time\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x03R\x05level\x12/\n" +
+	"\akillers\x18\x03 \x03(\v2\x15.tibiadata.v4.KillersR\akillers\x12/\n" +
+	"\aassists\x18\x04 \x03(\v2\x15.tibiadata.v4.KillersR\aassists\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"^\n" +
+	"\x06Houses\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04town\x18\x02 \x01(\tR\x04town\x12\x12\n" +
+	"\x04paid\x18\x03 \x01(\tR\x04paid\x12\x18\n" +
+	"\ahouseid\x18\x04 \x01(\x03R\ahouseid\"e\n" +
+	"\aKillers\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06player\x18\x02 \x01(\bR\x06player\x12\x16\n" +
+	"\x06traded\x18\x03 \x01(\bR\x06traded\x12\x16\n" +
+	"\x06summon\x18\x04 \x01(\tR\x06summon\"\xb5\x01\n" +
+	"\x0fOtherCharacters\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05world\x18\x02 \x01(\tR\x05world\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x18\n" +
+	"\adeleted\x18\x04 \x01(\bR\adeleted\x12\x12\n" +
+	"\x04main\x18\x05 \x01(\bR\x04main\x12\x16\n" +
+	"\x06traded\x18\x06 \x01(\bR\x06traded\x12\x1a\n" +
+	"\bposition\x18\a \x01(\tR\bpositionB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_characters_character_proto_rawDescOnce sync.Once
+	file_characters_character_proto_rawDescData []byte
+)
+
+func file_characters_character_proto_rawDescGZIP() []byte {
+	file_characters_character_proto_rawDescOnce.Do(func() {
+		file_characters_character_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_characters_character_proto_rawDesc), len(file_characters_character_proto_rawDesc)))
+	})
+	return file_characters_character_proto_rawDescData
+}
+
+var file_characters_character_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_characters_character_proto_goTypes = []any{
+	(*CharacterResponse)(nil),  // 0: tibiadata.v4.CharacterResponse
+	(*AccountBadges)(nil),      // 1: tibiadata.v4.AccountBadges
+	(*AccountInformation)(nil), // 2: tibiadata.v4.AccountInformation
+	(*Achievements)(nil),       // 3: tibiadata.v4.Achievements
+	(*Character)(nil),          // 4: tibiadata.v4.Character
+	(*CharacterGuild)(nil),     // 5: tibiadata.v4.CharacterGuild
+	(*CharacterInfo)(nil),      // 6: tibiadata.v4.CharacterInfo
+	(*Deaths)(nil),             // 7: tibiadata.v4.Deaths
+	(*Houses)(nil),             // 8: tibiadata.v4.Houses
+	(*Killers)(nil),            // 9: tibiadata.v4.Killers
+	(*OtherCharacters)(nil),    // 10: tibiadata.v4.OtherCharacters
+	(*Information)(nil),        // 11: tibiadata.v4.Information
+}
+var file_characters_character_proto_depIdxs = []int32{
+	4,  // 0: tibiadata.v4.CharacterResponse.character:type_name -> tibiadata.v4.Character
+	11, // 1: tibiadata.v4.CharacterResponse.information:type_name -> tibiadata.v4.Information
+	6,  // 2: tibiadata.v4.Character.character:type_name -> tibiadata.v4.CharacterInfo
+	1,  // 3: tibiadata.v4.Character.account_badges:type_name -> tibiadata.v4.AccountBadges
+	3,  // 4: tibiadata.v4.Character.achievements:type_name -> tibiadata.v4.Achievements
+	7,  // 5: tibiadata.v4.Character.deaths:type_name -> tibiadata.v4.Deaths
+	2,  // 6: tibiadata.v4.Character.account_information:type_name -> tibiadata.v4.AccountInformation
+	10, // 7: tibiadata.v4.Character.other_characters:type_name -> tibiadata.v4.OtherCharacters
+	8,  // 8: tibiadata.v4.CharacterInfo.houses:type_name -> tibiadata.v4.Houses
+	5,  // 9: tibiadata.v4.CharacterInfo.guild:type_name -> tibiadata.v4.CharacterGuild
+	9,  // 10: tibiadata.v4.Deaths.killers:type_name -> tibiadata.v4.Killers
+	9,  // 11: tibiadata.v4.Deaths.assists:type_name -> tibiadata.v4.Killers
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_characters_character_proto_init() }
+func file_characters_character_proto_init() {
+	if File_characters_character_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_characters_character_proto_rawDesc), len(file_characters_character_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_characters_character_proto_goTypes,
+		DependencyIndexes: file_characters_character_proto_depIdxs,
+		MessageInfos:      file_characters_character_proto_msgTypes,
+	}.Build()
+	File_characters_character_proto = out.File
+	file_characters_character_proto_goTypes = nil
+	file_characters_character_proto_depIdxs = nil
+}
@@ -0,0 +1,309 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: characters_ranks.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: CharacterRanks and Information
+type CharacterRanksResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CharacterRanks *CharacterRanks        `protobuf:"bytes,1,opt,name=character_ranks,json=characterRanks,proto3" json:"character_ranks,omitempty"`
+	Information    *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CharacterRanksResponse) Reset() {
+	*x = CharacterRanksResponse{}
+	mi := &file_characters_ranks_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharacterRanksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterRanksResponse) ProtoMessage() {}
+
+func (x *CharacterRanksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_characters_ranks_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterRanksResponse.ProtoReflect.Descriptor instead.
+func (*CharacterRanksResponse) Descriptor() ([]byte, []int) {
+	return file_characters_ranks_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CharacterRanksResponse) GetCharacterRanks() *CharacterRanks {
+	if x != nil {
+		return x.CharacterRanks
+	}
+	return nil
+}
+
+func (x *CharacterRanksResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type CharacterRanks struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`         // The name of the character.
+	World         string                 `protobuf:"bytes,2,opt,name=world,proto3" json:"world,omitempty"`       // The world the highscores belong to.
+	Vocation      string                 `protobuf:"bytes,3,opt,name=vocation,proto3" json:"vocation,omitempty"` // The vocation the highscores are filtered on.
+	Ranks         []*CharacterRank       `protobuf:"bytes,4,rep,name=ranks,proto3" json:"ranks,omitempty"`       // The character's rank in every highscore category.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharacterRanks) Reset() {
+	*x = CharacterRanks{}
+	mi := &file_characters_ranks_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharacterRanks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterRanks) ProtoMessage() {}
+
+func (x *CharacterRanks) ProtoReflect() protoreflect.Message {
+	mi := &file_characters_ranks_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterRanks.ProtoReflect.Descriptor instead.
+func (*CharacterRanks) Descriptor() ([]byte, []int) {
+	return file_characters_ranks_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CharacterRanks) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CharacterRanks) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *CharacterRanks) GetVocation() string {
+	if x != nil {
+		return x.Vocation
+	}
+	return ""
+}
+
+func (x *CharacterRanks) GetRanks() []*CharacterRank {
+	if x != nil {
+		return x.Ranks
+	}
+	return nil
+}
+
+// Child of CharacterRanks
+type CharacterRank struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`    // The highscore category.
+	Ranked        *bool                  `protobuf:"varint,2,opt,name=ranked,proto3,oneof" json:"ranked,omitempty"` // Whether the character is on the highscores of the category, unset if unknown (page limit reached or error).
+	Rank          int64                  `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`           // The character's rank. (if ranked)
+	Value         int64                  `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`         // The character's value for the highscores. (if ranked)
+	Page          int64                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`           // The highscore page the character is on. (if ranked)
+	Error         *Status                `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`          // The error of the category (if its pages could not be fetched).
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharacterRank) Reset() {
+	*x = CharacterRank{}
+	mi := &file_characters_ranks_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharacterRank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterRank) ProtoMessage() {}
+
+func (x *CharacterRank) ProtoReflect() protoreflect.Message {
+	mi := &file_characters_ranks_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterRank.ProtoReflect.Descriptor instead.
+func (*CharacterRank) Descriptor() ([]byte, []int) {
+	return file_characters_ranks_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CharacterRank) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CharacterRank) GetRanked() bool {
+	if x != nil && x.Ranked != nil {
+		return *x.Ranked
+	}
+	return false
+}
+
+func (x *CharacterRank) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *CharacterRank) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *CharacterRank) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *CharacterRank) GetError() *Status {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+var File_characters_ranks_proto protoreflect.FileDescriptor
+
+const file_characters_ranks_proto_rawDesc = "" +
+	"\n" +
+	"\x16characters_ranks.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\x9c\x01\n" +
+	"\x16CharacterRanksResponse\x12E\n" +
+	"\x0fcharacter_ranks\x18\x01 \x01(\v2\x1c.tibiadata.v4.CharacterRanksR\x0echaracterRanks\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\x89\x01\n" +
+	"\x0eCharacterRanks\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05world\x18\x02 \x01(\tR\x05world\x12\x1a\n" +
+	"\bvocation\x18\x03 \x01(\tR\bvocation\x121\n" +
+	"\x05ranks\x18\x04 \x03(\v2\x1b.tibiadata.v4.CharacterRankR\x05ranks\"\xbd\x01\n" +
+	"\rCharacterRank\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x1b\n" +
+	"\x06ranked\x18\x02 \x01(\bH\x00R\x06ranked\x88\x01\x01\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x03R\x04rank\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\x03R\x05value\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x03R\x04page\x12*\n" +
+	"\x05error\x18\x06 \x01(\v2\x14.tibiadata.v4.StatusR\x05errorB\t\n" +
+	"\a_rankedB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_characters_ranks_proto_rawDescOnce sync.Once
+	file_characters_ranks_proto_rawDescData []byte
+)
+
+func file_characters_ranks_proto_rawDescGZIP() []byte {
+	file_characters_ranks_proto_rawDescOnce.Do(func() {
+		file_characters_ranks_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_characters_ranks_proto_rawDesc), len(file_characters_ranks_proto_rawDesc)))
+	})
+	return file_characters_ranks_proto_rawDescData
+}
+
+var file_characters_ranks_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_characters_ranks_proto_goTypes = []any{
+	(*CharacterRanksResponse)(nil), // 0: tibiadata.v4.CharacterRanksResponse
+	(*CharacterRanks)(nil),         // 1: tibiadata.v4.CharacterRanks
+	(*CharacterRank)(nil),          // 2: tibiadata.v4.CharacterRank
+	(*Information)(nil),            // 3: tibiadata.v4.Information
+	(*Status)(nil),                 // 4: tibiadata.v4.Status
+}
+var file_characters_ranks_proto_depIdxs = []int32{
+	1, // 0: tibiadata.v4.CharacterRanksResponse.character_ranks:type_name -> tibiadata.v4.CharacterRanks
+	3, // 1: tibiadata.v4.CharacterRanksResponse.information:type_name -> tibiadata.v4.Information
+	2, // 2: tibiadata.v4.CharacterRanks.ranks:type_name -> tibiadata.v4.CharacterRank
+	4, // 3: tibiadata.v4.CharacterRank.error:type_name -> tibiadata.v4.Status
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_characters_ranks_proto_init() }
+func file_characters_ranks_proto_init() {
+	if File_characters_ranks_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	file_characters_ranks_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_characters_ranks_proto_rawDesc), len(file_characters_ranks_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_characters_ranks_proto_goTypes,
+		DependencyIndexes: file_characters_ranks_proto_depIdxs,
+		MessageInfos:      file_characters_ranks_proto_msgTypes,
+	}.Build()
+	File_characters_ranks_proto = out.File
+	file_characters_ranks_proto_goTypes = nil
+	file_characters_ranks_proto_depIdxs = nil
+}
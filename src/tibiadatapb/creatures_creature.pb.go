@@ -0,0 +1,358 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: creatures_creature.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: Creature and Information
+type CreatureResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Creature      *Creature              `protobuf:"bytes,1,opt,name=creature,proto3" json:"creature,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatureResponse) Reset() {
+	*x = CreatureResponse{}
+	mi := &file_creatures_creature_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatureResponse) ProtoMessage() {}
+
+func (x *CreatureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_creatures_creature_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatureResponse.ProtoReflect.Descriptor instead.
+func (*CreatureResponse) Descriptor() ([]byte, []int) {
+	return file_creatures_creature_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreatureResponse) GetCreature() *Creature {
+	if x != nil {
+		return x.Creature
+	}
+	return nil
+}
+
+func (x *CreatureResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type Creature struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                   // The name of the creature.
+	Race             string                 `protobuf:"bytes,2,opt,name=race,proto3" json:"race,omitempty"`                                                   // The creature's internal name.
+	ImageUrl         string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`                           // The URL to this creature's image.
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                                     // A description of the creature.
+	Behaviour        string                 `protobuf:"bytes,5,opt,name=behaviour,proto3" json:"behaviour,omitempty"`                                         // The plain description of behaviour of the creature.
+	Hitpoints        int64                  `protobuf:"varint,6,opt,name=hitpoints,proto3" json:"hitpoints,omitempty"`                                        // The number of hitpoints the creature has.
+	Immune           []string               `protobuf:"bytes,7,rep,name=immune,proto3" json:"immune,omitempty"`                                               // The elements it is immune to.
+	Strong           []string               `protobuf:"bytes,8,rep,name=strong,proto3" json:"strong,omitempty"`                                               // The elements it is strong against.
+	Weakness         []string               `protobuf:"bytes,9,rep,name=weakness,proto3" json:"weakness,omitempty"`                                           // The elements it is weak against.
+	Healed           []string               `protobuf:"bytes,10,rep,name=healed,proto3" json:"healed,omitempty"`                                              // The elements it is healed when being damaged.
+	BeParalysed      bool                   `protobuf:"varint,11,opt,name=be_paralysed,json=beParalysed,proto3" json:"be_paralysed,omitempty"`                // Whether it can be paralysed or not.
+	BeSummoned       bool                   `protobuf:"varint,12,opt,name=be_summoned,json=beSummoned,proto3" json:"be_summoned,omitempty"`                   // Whether it can be summoned or not.
+	SummonedMana     int64                  `protobuf:"varint,13,opt,name=summoned_mana,json=summonedMana,proto3" json:"summoned_mana,omitempty"`             // The mana neccessary to summon it.
+	BeConvinced      bool                   `protobuf:"varint,14,opt,name=be_convinced,json=beConvinced,proto3" json:"be_convinced,omitempty"`                // Whether it can be convinced or not.
+	ConvincedMana    int64                  `protobuf:"varint,15,opt,name=convinced_mana,json=convincedMana,proto3" json:"convinced_mana,omitempty"`          // The mana neccessary to convince it.
+	SeeInvisible     bool                   `protobuf:"varint,16,opt,name=see_invisible,json=seeInvisible,proto3" json:"see_invisible,omitempty"`             // Whether it can see even when being invisible or not.
+	ExperiencePoints int64                  `protobuf:"varint,17,opt,name=experience_points,json=experiencePoints,proto3" json:"experience_points,omitempty"` // The number of experience points given for killing it.
+	IsLootable       bool                   `protobuf:"varint,18,opt,name=is_lootable,json=isLootable,proto3" json:"is_lootable,omitempty"`                   // Whether it can be looted or not.
+	LootList         []string               `protobuf:"bytes,19,rep,name=loot_list,json=lootList,proto3" json:"loot_list,omitempty"`                          // Some of the items it drops.
+	Featured         bool                   `protobuf:"varint,20,opt,name=featured,proto3" json:"featured,omitempty"`                                         // Whether it is featured of not.
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Creature) Reset() {
+	*x = Creature{}
+	mi := &file_creatures_creature_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Creature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Creature) ProtoMessage() {}
+
+func (x *Creature) ProtoReflect() protoreflect.Message {
+	mi := &file_creatures_creature_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Creature.ProtoReflect.Descriptor instead.
+func (*Creature) Descriptor() ([]byte, []int) {
+	return file_creatures_creature_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Creature) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Creature) GetRace() string {
+	if x != nil {
+		return x.Race
+	}
+	return ""
+}
+
+func (x *Creature) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Creature) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Creature) GetBehaviour() string {
+	if x != nil {
+		return x.Behaviour
+	}
+	return ""
+}
+
+func (x *Creature) GetHitpoints() int64 {
+	if x != nil {
+		return x.Hitpoints
+	}
+	return 0
+}
+
+func (x *Creature) GetImmune() []string {
+	if x != nil {
+		return x.Immune
+	}
+	return nil
+}
+
+func (x *Creature) GetStrong() []string {
+	if x != nil {
+		return x.Strong
+	}
+	return nil
+}
+
+func (x *Creature) GetWeakness() []string {
+	if x != nil {
+		return x.Weakness
+	}
+	return nil
+}
+
+func (x *Creature) GetHealed() []string {
+	if x != nil {
+		return x.Healed
+	}
+	return nil
+}
+
+func (x *Creature) GetBeParalysed() bool {
+	if x != nil {
+		return x.BeParalysed
+	}
+	return false
+}
+
+func (x *Creature) GetBeSummoned() bool {
+	if x != nil {
+		return x.BeSummoned
+	}
+	return false
+}
+
+func (x *Creature) GetSummonedMana() int64 {
+	if x != nil {
+		return x.SummonedMana
+	}
+	return 0
+}
+
+func (x *Creature) GetBeConvinced() bool {
+	if x != nil {
+		return x.BeConvinced
+	}
+	return false
+}
+
+func (x *Creature) GetConvincedMana() int64 {
+	if x != nil {
+		return x.ConvincedMana
+	}
+	return 0
+}
+
+func (x *Creature) GetSeeInvisible() bool {
+	if x != nil {
+		return x.SeeInvisible
+	}
+	return false
+}
+
+func (x *Creature) GetExperiencePoints() int64 {
+	if x != nil {
+		return x.ExperiencePoints
+	}
+	return 0
+}
+
+func (x *Creature) GetIsLootable() bool {
+	if x != nil {
+		return x.IsLootable
+	}
+	return false
+}
+
+func (x *Creature) GetLootList() []string {
+	if x != nil {
+		return x.LootList
+	}
+	return nil
+}
+
+func (x *Creature) GetFeatured() bool {
+	if x != nil {
+		return x.Featured
+	}
+	return false
+}
+
+var File_creatures_creature_proto protoreflect.FileDescriptor
+
+const file_creatures_creature_proto_rawDesc = "" +
+	"\n" +
+	"\x18creatures_creature.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\x83\x01\n" +
+	"\x10CreatureResponse\x122\n" +
+	"\bcreature\x18\x01 \x01(\v2\x16.tibiadata.v4.CreatureR\bcreature\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xf0\x04\n" +
+	"\bCreature\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04race\x18\x02 \x01(\tR\x04race\x12\x1b\n" +
+	"\timage_url\x18\x03 \x01(\tR\bimageUrl\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1c\n" +
+	"\tbehaviour\x18\x05 \x01(\tR\tbehaviour\x12\x1c\n" +
+	"\thitpoints\x18\x06 \x01(\x03R\thitpoints\x12\x16\n" +
+	"\x06immune\x18\a \x03(\tR\x06immune\x12\x16\n" +
+	"\x06strong\x18\b \x03(\tR\x06strong\x12\x1a\n" +
+	"\bweakness\x18\t \x03(\tR\bweakness\x12\x16\n" +
+	"\x06healed\x18\n" +
+	" \x03(\tR\x06healed\x12!\n" +
+	"\fbe_paralysed\x18\v \x01(\bR\vbeParalysed\x12\x1f\n" +
+	"\vbe_summoned\x18\f \x01(\bR\n" +
+	"beSummoned\x12#\n" +
+	"\rsummoned_mana\x18\r \x01(\x03R\fsummonedMana\x12!\n" +
+	"\fbe_convinced\x18\x0e \x01(\bR\vbeConvinced\x12%\n" +
+	"\x0econvinced_mana\x18\x0f \x01(\x03R\rconvincedMana\x12#\n" +
+	"\rsee_invisible\x18\x10 \x01(\bR\fseeInvisible\x12+\n" +
+	"\x11experience_points\x18\x11 \x01(\x03R\x10experiencePoints\x12\x1f\n" +
+	"\vis_lootable\x18\x12 \x01(\bR\n" +
+	"isLootable\x12\x1b\n" +
+	"\tloot_list\x18\x13 \x03(\tR\blootList\x12\x1a\n" +
+	"\bfeatured\x18\x14 \x01(\bR\bfeaturedB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_creatures_creature_proto_rawDescOnce sync.Once
+	file_creatures_creature_proto_rawDescData []byte
+)
+
+func file_creatures_creature_proto_rawDescGZIP() []byte {
+	file_creatures_creature_proto_rawDescOnce.Do(func() {
+		file_creatures_creature_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_creatures_creature_proto_rawDesc), len(file_creatures_creature_proto_rawDesc)))
+	})
+	return file_creatures_creature_proto_rawDescData
+}
+
+var file_creatures_creature_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_creatures_creature_proto_goTypes = []any{
+	(*CreatureResponse)(nil), // 0: tibiadata.v4.CreatureResponse
+	(*Creature)(nil),         // 1: tibiadata.v4.Creature
+	(*Information)(nil),      // 2: tibiadata.v4.Information
+}
+var file_creatures_creature_proto_depIdxs = []int32{
+	1, // 0: tibiadata.v4.CreatureResponse.creature:type_name -> tibiadata.v4.Creature
+	2, // 1: tibiadata.v4.CreatureResponse.information:type_name -> tibiadata.v4.Information
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_creatures_creature_proto_init() }
+func file_creatures_creature_proto_init() {
+	if File_creatures_creature_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_creatures_creature_proto_rawDesc), len(file_creatures_creature_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_creatures_creature_proto_goTypes,
+		DependencyIndexes: file_creatures_creature_proto_depIdxs,
+		MessageInfos:      file_creatures_creature_proto_msgTypes,
+	}.Build()
+	File_creatures_creature_proto = out.File
+	file_creatures_creature_proto_goTypes = nil
+	file_creatures_creature_proto_depIdxs = nil
+}
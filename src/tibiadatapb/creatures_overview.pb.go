@@ -0,0 +1,270 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: creatures_overview.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: Creatures and Information
+type CreaturesOverviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Creatures     *CreaturesContainer    `protobuf:"bytes,1,opt,name=creatures,proto3" json:"creatures,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreaturesOverviewResponse) Reset() {
+	*x = CreaturesOverviewResponse{}
+	mi := &file_creatures_overview_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreaturesOverviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreaturesOverviewResponse) ProtoMessage() {}
+
+func (x *CreaturesOverviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_creatures_overview_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreaturesOverviewResponse.ProtoReflect.Descriptor instead.
+func (*CreaturesOverviewResponse) Descriptor() ([]byte, []int) {
+	return file_creatures_overview_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreaturesOverviewResponse) GetCreatures() *CreaturesContainer {
+	if x != nil {
+		return x.Creatures
+	}
+	return nil
+}
+
+func (x *CreaturesOverviewResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type CreaturesContainer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Boosted       *OverviewCreature      `protobuf:"bytes,1,opt,name=boosted,proto3" json:"boosted,omitempty"`                               // The current boosted creature.
+	CreatureList  []*OverviewCreature    `protobuf:"bytes,2,rep,name=creature_list,json=creatureList,proto3" json:"creature_list,omitempty"` // The list of creatures.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreaturesContainer) Reset() {
+	*x = CreaturesContainer{}
+	mi := &file_creatures_overview_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreaturesContainer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreaturesContainer) ProtoMessage() {}
+
+func (x *CreaturesContainer) ProtoReflect() protoreflect.Message {
+	mi := &file_creatures_overview_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreaturesContainer.ProtoReflect.Descriptor instead.
+func (*CreaturesContainer) Descriptor() ([]byte, []int) {
+	return file_creatures_overview_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreaturesContainer) GetBoosted() *OverviewCreature {
+	if x != nil {
+		return x.Boosted
+	}
+	return nil
+}
+
+func (x *CreaturesContainer) GetCreatureList() []*OverviewCreature {
+	if x != nil {
+		return x.CreatureList
+	}
+	return nil
+}
+
+// Child of Creatures (used for list of creatures and boosted section)
+type OverviewCreature struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                         // The name of the creature (usually in plural).
+	Race          string                 `protobuf:"bytes,2,opt,name=race,proto3" json:"race,omitempty"`                         // The creature's internal name.
+	ImageUrl      string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"` // The URL to this creature's image.
+	Featured      bool                   `protobuf:"varint,4,opt,name=featured,proto3" json:"featured,omitempty"`                // Whether it is featured of not.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OverviewCreature) Reset() {
+	*x = OverviewCreature{}
+	mi := &file_creatures_overview_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverviewCreature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverviewCreature) ProtoMessage() {}
+
+func (x *OverviewCreature) ProtoReflect() protoreflect.Message {
+	mi := &file_creatures_overview_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverviewCreature.ProtoReflect.Descriptor instead.
+func (*OverviewCreature) Descriptor() ([]byte, []int) {
+	return file_creatures_overview_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OverviewCreature) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OverviewCreature) GetRace() string {
+	if x != nil {
+		return x.Race
+	}
+	return ""
+}
+
+func (x *OverviewCreature) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *OverviewCreature) GetFeatured() bool {
+	if x != nil {
+		return x.Featured
+	}
+	return false
+}
+
+var File_creatures_overview_proto protoreflect.FileDescriptor
+
+const file_creatures_overview_proto_rawDesc = "" +
+	"\n" +
+	"\x18creatures_overview.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\x98\x01\n" +
+	"\x19CreaturesOverviewResponse\x12>\n" +
+	"\tcreatures\x18\x01 \x01(\v2 .tibiadata.v4.CreaturesContainerR\tcreatures\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\x93\x01\n" +
+	"\x12CreaturesContainer\x128\n" +
+	"\aboosted\x18\x01 \x01(\v2\x1e.tibiadata.v4.OverviewCreatureR\aboosted\x12C\n" +
+	"\rcreature_list\x18\x02 \x03(\v2\x1e.tibiadata.v4.OverviewCreatureR\fcreatureList\"s\n" +
+	"\x10OverviewCreature\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04race\x18\x02 \x01(\tR\x04race\x12\x1b\n" +
+	"\timage_url\x18\x03 \x01(\tR\bimageUrl\x12\x1a\n" +
+	"\bfeatured\x18\x04 \x01(\bR\bfeaturedB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_creatures_overview_proto_rawDescOnce sync.Once
+	file_creatures_overview_proto_rawDescData []byte
+)
+
+func file_creatures_overview_proto_rawDescGZIP() []byte {
+	file_creatures_overview_proto_rawDescOnce.Do(func() {
+		file_creatures_overview_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_creatures_overview_proto_rawDesc), len(file_creatures_overview_proto_rawDesc)))
+	})
+	return file_creatures_overview_proto_rawDescData
+}
+
+var file_creatures_overview_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_creatures_overview_proto_goTypes = []any{
+	(*CreaturesOverviewResponse)(nil), // 0: tibiadata.v4.CreaturesOverviewResponse
+	(*CreaturesContainer)(nil),        // 1: tibiadata.v4.CreaturesContainer
+	(*OverviewCreature)(nil),          // 2: tibiadata.v4.OverviewCreature
+	(*Information)(nil),               // 3: tibiadata.v4.Information
+}
+var file_creatures_overview_proto_depIdxs = []int32{
+	1, // 0: tibiadata.v4.CreaturesOverviewResponse.creatures:type_name -> tibiadata.v4.CreaturesContainer
+	3, // 1: tibiadata.v4.CreaturesOverviewResponse.information:type_name -> tibiadata.v4.Information
+	2, // 2: tibiadata.v4.CreaturesContainer.boosted:type_name -> tibiadata.v4.OverviewCreature
+	2, // 3: tibiadata.v4.CreaturesContainer.creature_list:type_name -> tibiadata.v4.OverviewCreature
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_creatures_overview_proto_init() }
+func file_creatures_overview_proto_init() {
+	if File_creatures_overview_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_creatures_overview_proto_rawDesc), len(file_creatures_overview_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_creatures_overview_proto_goTypes,
+		DependencyIndexes: file_creatures_overview_proto_depIdxs,
+		MessageInfos:      file_creatures_overview_proto_msgTypes,
+	}.Build()
+	File_creatures_overview_proto = out.File
+	file_creatures_overview_proto_goTypes = nil
+	file_creatures_overview_proto_depIdxs = nil
+}
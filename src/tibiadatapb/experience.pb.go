@@ -0,0 +1,283 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: experience.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: Experience and Information
+type ExperienceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Experience    *Experience            `protobuf:"bytes,1,opt,name=experience,proto3" json:"experience,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExperienceResponse) Reset() {
+	*x = ExperienceResponse{}
+	mi := &file_experience_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExperienceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperienceResponse) ProtoMessage() {}
+
+func (x *ExperienceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_experience_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperienceResponse.ProtoReflect.Descriptor instead.
+func (*ExperienceResponse) Descriptor() ([]byte, []int) {
+	return file_experience_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExperienceResponse) GetExperience() *Experience {
+	if x != nil {
+		return x.Experience
+	}
+	return nil
+}
+
+func (x *ExperienceResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type Experience struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         int64                  `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`                                     // The level.
+	Experience    int64                  `protobuf:"varint,2,opt,name=experience,proto3" json:"experience,omitempty"`                           // The experience points.
+	LevelProgress *LevelProgress         `protobuf:"bytes,3,opt,name=level_progress,json=levelProgress,proto3" json:"level_progress,omitempty"` // The progress of the experience towards the next level.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Experience) Reset() {
+	*x = Experience{}
+	mi := &file_experience_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Experience) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Experience) ProtoMessage() {}
+
+func (x *Experience) ProtoReflect() protoreflect.Message {
+	mi := &file_experience_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Experience.ProtoReflect.Descriptor instead.
+func (*Experience) Descriptor() ([]byte, []int) {
+	return file_experience_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Experience) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Experience) GetExperience() int64 {
+	if x != nil {
+		return x.Experience
+	}
+	return 0
+}
+
+func (x *Experience) GetLevelProgress() *LevelProgress {
+	if x != nil {
+		return x.LevelProgress
+	}
+	return nil
+}
+
+// Child of Highscore and Experience
+type LevelProgress struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	ExperienceCurrentLevel int64                  `protobuf:"varint,1,opt,name=experience_current_level,json=experienceCurrentLevel,proto3" json:"experience_current_level,omitempty"` // The experience needed for the current level.
+	ExperienceNextLevel    int64                  `protobuf:"varint,2,opt,name=experience_next_level,json=experienceNextLevel,proto3" json:"experience_next_level,omitempty"`          // The experience needed for the next level.
+	ExperienceRemaining    int64                  `protobuf:"varint,3,opt,name=experience_remaining,json=experienceRemaining,proto3" json:"experience_remaining,omitempty"`            // The experience remaining to the next level.
+	Progress               float64                `protobuf:"fixed64,4,opt,name=progress,proto3" json:"progress,omitempty"`                                                            // The progress towards the next level in percent.
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *LevelProgress) Reset() {
+	*x = LevelProgress{}
+	mi := &file_experience_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LevelProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LevelProgress) ProtoMessage() {}
+
+func (x *LevelProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_experience_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LevelProgress.ProtoReflect.Descriptor instead.
+func (*LevelProgress) Descriptor() ([]byte, []int) {
+	return file_experience_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LevelProgress) GetExperienceCurrentLevel() int64 {
+	if x != nil {
+		return x.ExperienceCurrentLevel
+	}
+	return 0
+}
+
+func (x *LevelProgress) GetExperienceNextLevel() int64 {
+	if x != nil {
+		return x.ExperienceNextLevel
+	}
+	return 0
+}
+
+func (x *LevelProgress) GetExperienceRemaining() int64 {
+	if x != nil {
+		return x.ExperienceRemaining
+	}
+	return 0
+}
+
+func (x *LevelProgress) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+var File_experience_proto protoreflect.FileDescriptor
+
+const file_experience_proto_rawDesc = "" +
+	"\n" +
+	"\x10experience.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\x8b\x01\n" +
+	"\x12ExperienceResponse\x128\n" +
+	"\n" +
+	"experience\x18\x01 \x01(\v2\x18.tibiadata.v4.ExperienceR\n" +
+	"experience\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\x86\x01\n" +
+	"\n" +
+	"Experience\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\x03R\x05level\x12\x1e\n" +
+	"\n" +
+	"experience\x18\x02 \x01(\x03R\n" +
+	"experience\x12B\n" +
+	"\x0elevel_progress\x18\x03 \x01(\v2\x1b.tibiadata.v4.LevelProgressR\rlevelProgress\"\xcc\x01\n" +
+	"\rLevelProgress\x128\n" +
+	"\x18experience_current_level\x18\x01 \x01(\x03R\x16experienceCurrentLevel\x122\n" +
+	"\x15experience_next_level\x18\x02 \x01(\x03R\x13experienceNextLevel\x121\n" +
+	"\x14experience_remaining\x18\x03 \x01(\x03R\x13experienceRemaining\x12\x1a\n" +
+	"\bprogress\x18\x04 \x01(\x01R\bprogressB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_experience_proto_rawDescOnce sync.Once
+	file_experience_proto_rawDescData []byte
+)
+
+func file_experience_proto_rawDescGZIP() []byte {
+	file_experience_proto_rawDescOnce.Do(func() {
+		file_experience_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_experience_proto_rawDesc), len(file_experience_proto_rawDesc)))
+	})
+	return file_experience_proto_rawDescData
+}
+
+var file_experience_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_experience_proto_goTypes = []any{
+	(*ExperienceResponse)(nil), // 0: tibiadata.v4.ExperienceResponse
+	(*Experience)(nil),         // 1: tibiadata.v4.Experience
+	(*LevelProgress)(nil),      // 2: tibiadata.v4.LevelProgress
+	(*Information)(nil),        // 3: tibiadata.v4.Information
+}
+var file_experience_proto_depIdxs = []int32{
+	1, // 0: tibiadata.v4.ExperienceResponse.experience:type_name -> tibiadata.v4.Experience
+	3, // 1: tibiadata.v4.ExperienceResponse.information:type_name -> tibiadata.v4.Information
+	2, // 2: tibiadata.v4.Experience.level_progress:type_name -> tibiadata.v4.LevelProgress
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_experience_proto_init() }
+func file_experience_proto_init() {
+	if File_experience_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_experience_proto_rawDesc), len(file_experience_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_experience_proto_goTypes,
+		DependencyIndexes: file_experience_proto_depIdxs,
+		MessageInfos:      file_experience_proto_msgTypes,
+	}.Build()
+	File_experience_proto = out.File
+	file_experience_proto_goTypes = nil
+	file_experience_proto_depIdxs = nil
+}
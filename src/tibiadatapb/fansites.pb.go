@@ -0,0 +1,506 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: fansites.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: Fansites and Information
+type FansitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fansites      *Fansites              `protobuf:"bytes,1,opt,name=fansites,proto3" json:"fansites,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FansitesResponse) Reset() {
+	*x = FansitesResponse{}
+	mi := &file_fansites_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FansitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FansitesResponse) ProtoMessage() {}
+
+func (x *FansitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fansites_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FansitesResponse.ProtoReflect.Descriptor instead.
+func (*FansitesResponse) Descriptor() ([]byte, []int) {
+	return file_fansites_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FansitesResponse) GetFansites() *Fansites {
+	if x != nil {
+		return x.Fansites
+	}
+	return nil
+}
+
+func (x *FansitesResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of Fansite
+type ContentType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statistics    bool                   `protobuf:"varint,1,opt,name=statistics,proto3" json:"statistics,omitempty"` // Whether the fansite content is statistics.
+	Texts         bool                   `protobuf:"varint,2,opt,name=texts,proto3" json:"texts,omitempty"`           // Whether the fansite content is texts.
+	Tools         bool                   `protobuf:"varint,3,opt,name=tools,proto3" json:"tools,omitempty"`           // Whether the fansite content is tools.
+	Wiki          bool                   `protobuf:"varint,4,opt,name=wiki,proto3" json:"wiki,omitempty"`             // Whether the fansite content is wiki.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentType) Reset() {
+	*x = ContentType{}
+	mi := &file_fansites_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentType) ProtoMessage() {}
+
+func (x *ContentType) ProtoReflect() protoreflect.Message {
+	mi := &file_fansites_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentType.ProtoReflect.Descriptor instead.
+func (*ContentType) Descriptor() ([]byte, []int) {
+	return file_fansites_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ContentType) GetStatistics() bool {
+	if x != nil {
+		return x.Statistics
+	}
+	return false
+}
+
+func (x *ContentType) GetTexts() bool {
+	if x != nil {
+		return x.Texts
+	}
+	return false
+}
+
+func (x *ContentType) GetTools() bool {
+	if x != nil {
+		return x.Tools
+	}
+	return false
+}
+
+func (x *ContentType) GetWiki() bool {
+	if x != nil {
+		return x.Wiki
+	}
+	return false
+}
+
+// Child of Fansites
+type Fansite struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                              // The name of the fansite.
+	LogoUrl        string                 `protobuf:"bytes,2,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`                         // The URL to the fansite's logo.
+	Homepage       string                 `protobuf:"bytes,3,opt,name=homepage,proto3" json:"homepage,omitempty"`                                      // The fansite's homepage.
+	Contact        string                 `protobuf:"bytes,4,opt,name=contact,proto3" json:"contact,omitempty"`                                        // The fansite contact person.
+	ContentType    *ContentType           `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`             // The content type of the fansite.
+	SocialMedia    *SocialMedia           `protobuf:"bytes,6,opt,name=social_media,json=socialMedia,proto3" json:"social_media,omitempty"`             // The social media presence of the fansite.
+	Languages      []string               `protobuf:"bytes,7,rep,name=languages,proto3" json:"languages,omitempty"`                                    // The fansite's languages.
+	Specials       []string               `protobuf:"bytes,8,rep,name=specials,proto3" json:"specials,omitempty"`                                      // The fansite's specials.
+	FansiteItem    bool                   `protobuf:"varint,9,opt,name=fansite_item,json=fansiteItem,proto3" json:"fansite_item,omitempty"`            // The fansite's ingame item.
+	FansiteItemUrl string                 `protobuf:"bytes,10,opt,name=fansite_item_url,json=fansiteItemUrl,proto3" json:"fansite_item_url,omitempty"` // The URL to the fansite's ingame item.
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Fansite) Reset() {
+	*x = Fansite{}
+	mi := &file_fansites_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fansite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fansite) ProtoMessage() {}
+
+func (x *Fansite) ProtoReflect() protoreflect.Message {
+	mi := &file_fansites_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fansite.ProtoReflect.Descriptor instead.
+func (*Fansite) Descriptor() ([]byte, []int) {
+	return file_fansites_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Fansite) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Fansite) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *Fansite) GetHomepage() string {
+	if x != nil {
+		return x.Homepage
+	}
+	return ""
+}
+
+func (x *Fansite) GetContact() string {
+	if x != nil {
+		return x.Contact
+	}
+	return ""
+}
+
+func (x *Fansite) GetContentType() *ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return nil
+}
+
+func (x *Fansite) GetSocialMedia() *SocialMedia {
+	if x != nil {
+		return x.SocialMedia
+	}
+	return nil
+}
+
+func (x *Fansite) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *Fansite) GetSpecials() []string {
+	if x != nil {
+		return x.Specials
+	}
+	return nil
+}
+
+func (x *Fansite) GetFansiteItem() bool {
+	if x != nil {
+		return x.FansiteItem
+	}
+	return false
+}
+
+func (x *Fansite) GetFansiteItemUrl() string {
+	if x != nil {
+		return x.FansiteItemUrl
+	}
+	return ""
+}
+
+// Child of JSONData
+type Fansites struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promoted      []*Fansite             `protobuf:"bytes,1,rep,name=promoted,proto3" json:"promoted,omitempty"`   // List of promoted fansites.
+	Supported     []*Fansite             `protobuf:"bytes,2,rep,name=supported,proto3" json:"supported,omitempty"` // List of supported fansites.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Fansites) Reset() {
+	*x = Fansites{}
+	mi := &file_fansites_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fansites) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fansites) ProtoMessage() {}
+
+func (x *Fansites) ProtoReflect() protoreflect.Message {
+	mi := &file_fansites_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fansites.ProtoReflect.Descriptor instead.
+func (*Fansites) Descriptor() ([]byte, []int) {
+	return file_fansites_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Fansites) GetPromoted() []*Fansite {
+	if x != nil {
+		return x.Promoted
+	}
+	return nil
+}
+
+func (x *Fansites) GetSupported() []*Fansite {
+	if x != nil {
+		return x.Supported
+	}
+	return nil
+}
+
+// Child of Fansite
+type SocialMedia struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discord       bool                   `protobuf:"varint,1,opt,name=discord,proto3" json:"discord,omitempty"`     // Whether the fansite has Discord or not.
+	Facebook      bool                   `protobuf:"varint,2,opt,name=facebook,proto3" json:"facebook,omitempty"`   // Whether the fansite has Facebook or not.
+	Instagram     bool                   `protobuf:"varint,3,opt,name=instagram,proto3" json:"instagram,omitempty"` // Whether the fansite has Instagram or not.
+	Reddit        bool                   `protobuf:"varint,4,opt,name=reddit,proto3" json:"reddit,omitempty"`       // Whether the fansite has Reddit or not.
+	Twitch        bool                   `protobuf:"varint,5,opt,name=twitch,proto3" json:"twitch,omitempty"`       // Whether the fansite has Twitch or not.
+	Twitter       bool                   `protobuf:"varint,6,opt,name=twitter,proto3" json:"twitter,omitempty"`     // Whether the fansite has Twitter or not.
+	Youtube       bool                   `protobuf:"varint,7,opt,name=youtube,proto3" json:"youtube,omitempty"`     // Whether the fansite has Youtube or not.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SocialMedia) Reset() {
+	*x = SocialMedia{}
+	mi := &file_fansites_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SocialMedia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SocialMedia) ProtoMessage() {}
+
+func (x *SocialMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_fansites_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SocialMedia.ProtoReflect.Descriptor instead.
+func (*SocialMedia) Descriptor() ([]byte, []int) {
+	return file_fansites_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SocialMedia) GetDiscord() bool {
+	if x != nil {
+		return x.Discord
+	}
+	return false
+}
+
+func (x *SocialMedia) GetFacebook() bool {
+	if x != nil {
+		return x.Facebook
+	}
+	return false
+}
+
+func (x *SocialMedia) GetInstagram() bool {
+	if x != nil {
+		return x.Instagram
+	}
+	return false
+}
+
+func (x *SocialMedia) GetReddit() bool {
+	if x != nil {
+		return x.Reddit
+	}
+	return false
+}
+
+func (x *SocialMedia) GetTwitch() bool {
+	if x != nil {
+		return x.Twitch
+	}
+	return false
+}
+
+func (x *SocialMedia) GetTwitter() bool {
+	if x != nil {
+		return x.Twitter
+	}
+	return false
+}
+
+func (x *SocialMedia) GetYoutube() bool {
+	if x != nil {
+		return x.Youtube
+	}
+	return false
+}
+
+var File_fansites_proto protoreflect.FileDescriptor
+
+const file_fansites_proto_rawDesc = "" +
+	"\n" +
+	"\x0efansites.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\x83\x01\n" +
+	"\x10FansitesResponse\x122\n" +
+	"\bfansites\x18\x01 \x01(\v2\x16.tibiadata.v4.FansitesR\bfansites\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"m\n" +
+	"\vContentType\x12\x1e\n" +
+	"\n" +
+	"statistics\x18\x01 \x01(\bR\n" +
+	"statistics\x12\x14\n" +
+	"\x05texts\x18\x02 \x01(\bR\x05texts\x12\x14\n" +
+	"\x05tools\x18\x03 \x01(\bR\x05tools\x12\x12\n" +
+	"\x04wiki\x18\x04 \x01(\bR\x04wiki\"\xf1\x02\n" +
+	"\aFansite\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\blogo_url\x18\x02 \x01(\tR\alogoUrl\x12\x1a\n" +
+	"\bhomepage\x18\x03 \x01(\tR\bhomepage\x12\x18\n" +
+	"\acontact\x18\x04 \x01(\tR\acontact\x12<\n" +
+	"\fcontent_type\x18\x05 \x01(\v2\x19.tibiadata.v4.ContentTypeR\vcontentType\x12<\n" +
+	"\fsocial_media\x18\x06 \x01(\v2\x19.tibiadata.v4.SocialMediaR\vsocialMedia\x12\x1c\n" +
+	"\tlanguages\x18\a \x03(\tR\tlanguages\x12\x1a\n" +
+	"\bspecials\x18\b \x03(\tR\bspecials\x12!\n" +
+	"\ffansite_item\x18\t \x01(\bR\vfansiteItem\x12(\n" +
+	"\x10fansite_item_url\x18\n" +
+	" \x01(\tR\x0efansiteItemUrl\"r\n" +
+	"\bFansites\x121\n" +
+	"\bpromoted\x18\x01 \x03(\v2\x15.tibiadata.v4.FansiteR\bpromoted\x123\n" +
+	"\tsupported\x18\x02 \x03(\v2\x15.tibiadata.v4.FansiteR\tsupported\"\xc5\x01\n" +
+	"\vSocialMedia\x12\x18\n" +
+	"\adiscord\x18\x01 \x01(\bR\adiscord\x12\x1a\n" +
+	"\bfacebook\x18\x02 \x01(\bR\bfacebook\x12\x1c\n" +
+	"\tinstagram\x18\x03 \x01(\bR\tinstagram\x12\x16\n" +
+	"\x06reddit\x18\x04 \x01(\bR\x06reddit\x12\x16\n" +
+	"\x06twitch\x18\x05 \x01(\bR\x06twitch\x12\x18\n" +
+	"\atwitter\x18\x06 \x01(\bR\atwitter\x12\x18\n" +
+	"\ayoutube\x18\a \x01(\bR\ayoutubeB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_fansites_proto_rawDescOnce sync.Once
+	file_fansites_proto_rawDescData []byte
+)
+
+func file_fansites_proto_rawDescGZIP() []byte {
+	file_fansites_proto_rawDescOnce.Do(func() {
+		file_fansites_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_fansites_proto_rawDesc), len(file_fansites_proto_rawDesc)))
+	})
+	return file_fansites_proto_rawDescData
+}
+
+var file_fansites_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_fansites_proto_goTypes = []any{
+	(*FansitesResponse)(nil), // 0: tibiadata.v4.FansitesResponse
+	(*ContentType)(nil),      // 1: tibiadata.v4.ContentType
+	(*Fansite)(nil),          // 2: tibiadata.v4.Fansite
+	(*Fansites)(nil),         // 3: tibiadata.v4.Fansites
+	(*SocialMedia)(nil),      // 4: tibiadata.v4.SocialMedia
+	(*Information)(nil),      // 5: tibiadata.v4.Information
+}
+var file_fansites_proto_depIdxs = []int32{
+	3, // 0: tibiadata.v4.FansitesResponse.fansites:type_name -> tibiadata.v4.Fansites
+	5, // 1: tibiadata.v4.FansitesResponse.information:type_name -> tibiadata.v4.Information
+	1, // 2: tibiadata.v4.Fansite.content_type:type_name -> tibiadata.v4.ContentType
+	4, // 3: tibiadata.v4.Fansite.social_media:type_name -> tibiadata.v4.SocialMedia
+	2, // 4: tibiadata.v4.Fansites.promoted:type_name -> tibiadata.v4.Fansite
+	2, // 5: tibiadata.v4.Fansites.supported:type_name -> tibiadata.v4.Fansite
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_fansites_proto_init() }
+func file_fansites_proto_init() {
+	if File_fansites_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_fansites_proto_rawDesc), len(file_fansites_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_fansites_proto_goTypes,
+		DependencyIndexes: file_fansites_proto_depIdxs,
+		MessageInfos:      file_fansites_proto_msgTypes,
+	}.Build()
+	File_fansites_proto = out.File
+	file_fansites_proto_goTypes = nil
+	file_fansites_proto_depIdxs = nil
+}
@@ -0,0 +1,8 @@
+// Package tibiadatapb contains the Protocol Buffers representation of the v4 response types
+// and the TibiaData gRPC service.
+//
+// The messages mirror the json output of the API, so the field names of the
+// .proto files are the same as the json keys of the response structs.
+package tibiadatapb
+
+//go:generate buf generate
@@ -0,0 +1,614 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: guilds_guild.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: Guild and Information
+type GuildResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guild         *Guild                 `protobuf:"bytes,1,opt,name=guild,proto3" json:"guild,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildResponse) Reset() {
+	*x = GuildResponse{}
+	mi := &file_guilds_guild_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildResponse) ProtoMessage() {}
+
+func (x *GuildResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_guild_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildResponse.ProtoReflect.Descriptor instead.
+func (*GuildResponse) Descriptor() ([]byte, []int) {
+	return file_guilds_guild_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GuildResponse) GetGuild() *Guild {
+	if x != nil {
+		return x.Guild
+	}
+	return nil
+}
+
+func (x *GuildResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type Guild struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                  // The name of the guild.
+	World            string                 `protobuf:"bytes,2,opt,name=world,proto3" json:"world,omitempty"`                                                // The world the guild belongs to.
+	LogoUrl          string                 `protobuf:"bytes,3,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`                             // The URL to the guild's logo.
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                                    // The description of the guild.
+	Guildhalls       []*Guildhall           `protobuf:"bytes,5,rep,name=guildhalls,proto3" json:"guildhalls,omitempty"`                                      // The guildhall the guild has as their home.
+	Active           bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`                                             // Whether the guild is active or in formation.
+	Founded          string                 `protobuf:"bytes,7,opt,name=founded,proto3" json:"founded,omitempty"`                                            // The day it was founded.
+	OpenApplications bool                   `protobuf:"varint,8,opt,name=open_applications,json=openApplications,proto3" json:"open_applications,omitempty"` // Whether applications are open or not.
+	Homepage         string                 `protobuf:"bytes,9,opt,name=homepage,proto3" json:"homepage,omitempty"`                                          // The guild's homepage.
+	InWar            bool                   `protobuf:"varint,10,opt,name=in_war,json=inWar,proto3" json:"in_war,omitempty"`                                 // Whether it is currently in war or not.
+	DisbandDate      string                 `protobuf:"bytes,11,opt,name=disband_date,json=disbandDate,proto3" json:"disband_date,omitempty"`                // The date when the guild will be disbanded, if the condition aren't meet.
+	DisbandCondition string                 `protobuf:"bytes,12,opt,name=disband_condition,json=disbandCondition,proto3" json:"disband_condition,omitempty"` // The reason why the guild will get disbanded.
+	PlayersOnline    int64                  `protobuf:"varint,13,opt,name=players_online,json=playersOnline,proto3" json:"players_online,omitempty"`         // The number of online members in the guild.
+	PlayersOffline   int64                  `protobuf:"varint,14,opt,name=players_offline,json=playersOffline,proto3" json:"players_offline,omitempty"`      // The number of offline members in the guild.
+	MembersTotal     int64                  `protobuf:"varint,15,opt,name=members_total,json=membersTotal,proto3" json:"members_total,omitempty"`            // The number of total members in the guild.
+	MembersInvited   int64                  `protobuf:"varint,16,opt,name=members_invited,json=membersInvited,proto3" json:"members_invited,omitempty"`      // The number of invited members in the guild.
+	Members          []*GuildMember         `protobuf:"bytes,17,rep,name=members,proto3" json:"members,omitempty"`                                           // List of all members in the guild.
+	Invites          []*InvitedGuildMember  `protobuf:"bytes,18,rep,name=invites,proto3" json:"invites,omitempty"`                                           // List of invited members.
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Guild) Reset() {
+	*x = Guild{}
+	mi := &file_guilds_guild_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Guild) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Guild) ProtoMessage() {}
+
+func (x *Guild) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_guild_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Guild.ProtoReflect.Descriptor instead.
+func (*Guild) Descriptor() ([]byte, []int) {
+	return file_guilds_guild_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Guild) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Guild) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *Guild) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *Guild) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Guild) GetGuildhalls() []*Guildhall {
+	if x != nil {
+		return x.Guildhalls
+	}
+	return nil
+}
+
+func (x *Guild) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Guild) GetFounded() string {
+	if x != nil {
+		return x.Founded
+	}
+	return ""
+}
+
+func (x *Guild) GetOpenApplications() bool {
+	if x != nil {
+		return x.OpenApplications
+	}
+	return false
+}
+
+func (x *Guild) GetHomepage() string {
+	if x != nil {
+		return x.Homepage
+	}
+	return ""
+}
+
+func (x *Guild) GetInWar() bool {
+	if x != nil {
+		return x.InWar
+	}
+	return false
+}
+
+func (x *Guild) GetDisbandDate() string {
+	if x != nil {
+		return x.DisbandDate
+	}
+	return ""
+}
+
+func (x *Guild) GetDisbandCondition() string {
+	if x != nil {
+		return x.DisbandCondition
+	}
+	return ""
+}
+
+func (x *Guild) GetPlayersOnline() int64 {
+	if x != nil {
+		return x.PlayersOnline
+	}
+	return 0
+}
+
+func (x *Guild) GetPlayersOffline() int64 {
+	if x != nil {
+		return x.PlayersOffline
+	}
+	return 0
+}
+
+func (x *Guild) GetMembersTotal() int64 {
+	if x != nil {
+		return x.MembersTotal
+	}
+	return 0
+}
+
+func (x *Guild) GetMembersInvited() int64 {
+	if x != nil {
+		return x.MembersInvited
+	}
+	return 0
+}
+
+func (x *Guild) GetMembers() []*GuildMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Guild) GetInvites() []*InvitedGuildMember {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+// Child of Guild
+type GuildMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`         // The name of the guild's member.
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`       // The member's title.
+	Rank          string                 `protobuf:"bytes,3,opt,name=rank,proto3" json:"rank,omitempty"`         // The rank the member does belong to.
+	Vocation      string                 `protobuf:"bytes,4,opt,name=vocation,proto3" json:"vocation,omitempty"` // The member's vocation.
+	Level         int64                  `protobuf:"varint,5,opt,name=level,proto3" json:"level,omitempty"`      // The member's level.
+	Joined        string                 `protobuf:"bytes,6,opt,name=joined,proto3" json:"joined,omitempty"`     // The day when the member joined.
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`     // Whether the member is online or offline.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildMember) Reset() {
+	*x = GuildMember{}
+	mi := &file_guilds_guild_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildMember) ProtoMessage() {}
+
+func (x *GuildMember) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_guild_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildMember.ProtoReflect.Descriptor instead.
+func (*GuildMember) Descriptor() ([]byte, []int) {
+	return file_guilds_guild_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GuildMember) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildMember) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GuildMember) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *GuildMember) GetVocation() string {
+	if x != nil {
+		return x.Vocation
+	}
+	return ""
+}
+
+func (x *GuildMember) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *GuildMember) GetJoined() string {
+	if x != nil {
+		return x.Joined
+	}
+	return ""
+}
+
+func (x *GuildMember) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// Child of Guild
+type Guildhall struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                            // The name of the house.
+	World         string                 `protobuf:"bytes,2,opt,name=world,proto3" json:"world,omitempty"`                          // The world the guildhall belongs to.
+	PaidUntil     string                 `protobuf:"bytes,3,opt,name=paid_until,json=paidUntil,proto3" json:"paid_until,omitempty"` // The date the last paid rent is due.
+	Town          string                 `protobuf:"bytes,4,opt,name=town,proto3" json:"town,omitempty"`                            // The town where the guildhall is located (only with guildhall details).
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                        // The status of the guildhall: rented, or with guildhall details also moving, transferring or auctioned.
+	Owner         string                 `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`                          // The character who rented the guildhall (only with guildhall details).
+	Houseid       int64                  `protobuf:"varint,7,opt,name=houseid,proto3" json:"houseid,omitempty"`                     // The internal ID of the guildhall (only with guildhall details).
+	Rent          int64                  `protobuf:"varint,8,opt,name=rent,proto3" json:"rent,omitempty"`                           // The monthly cost in gold coins for the guildhall (only with guildhall details).
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Guildhall) Reset() {
+	*x = Guildhall{}
+	mi := &file_guilds_guild_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Guildhall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Guildhall) ProtoMessage() {}
+
+func (x *Guildhall) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_guild_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Guildhall.ProtoReflect.Descriptor instead.
+func (*Guildhall) Descriptor() ([]byte, []int) {
+	return file_guilds_guild_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Guildhall) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Guildhall) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *Guildhall) GetPaidUntil() string {
+	if x != nil {
+		return x.PaidUntil
+	}
+	return ""
+}
+
+func (x *Guildhall) GetTown() string {
+	if x != nil {
+		return x.Town
+	}
+	return ""
+}
+
+func (x *Guildhall) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Guildhall) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Guildhall) GetHouseid() int64 {
+	if x != nil {
+		return x.Houseid
+	}
+	return 0
+}
+
+func (x *Guildhall) GetRent() int64 {
+	if x != nil {
+		return x.Rent
+	}
+	return 0
+}
+
+// Child of Guild
+type InvitedGuildMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // The name of the character.
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // The date the character was invited.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvitedGuildMember) Reset() {
+	*x = InvitedGuildMember{}
+	mi := &file_guilds_guild_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvitedGuildMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitedGuildMember) ProtoMessage() {}
+
+func (x *InvitedGuildMember) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_guild_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitedGuildMember.ProtoReflect.Descriptor instead.
+func (*InvitedGuildMember) Descriptor() ([]byte, []int) {
+	return file_guilds_guild_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *InvitedGuildMember) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InvitedGuildMember) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+var File_guilds_guild_proto protoreflect.FileDescriptor
+
+const file_guilds_guild_proto_rawDesc = "" +
+	"\n" +
+	"\x12guilds_guild.proto\x12\ftibiadata.v4\x1a\x11information.proto\"w\n" +
+	"\rGuildResponse\x12)\n" +
+	"\x05guild\x18\x01 \x01(\v2\x13.tibiadata.v4.GuildR\x05guild\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\x98\x05\n" +
+	"\x05Guild\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05world\x18\x02 \x01(\tR\x05world\x12\x19\n" +
+	"\blogo_url\x18\x03 \x01(\tR\alogoUrl\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x127\n" +
+	"\n" +
+	"guildhalls\x18\x05 \x03(\v2\x17.tibiadata.v4.GuildhallR\n" +
+	"guildhalls\x12\x16\n" +
+	"\x06active\x18\x06 \x01(\bR\x06active\x12\x18\n" +
+	"\afounded\x18\a \x01(\tR\afounded\x12+\n" +
+	"\x11open_applications\x18\b \x01(\bR\x10openApplications\x12\x1a\n" +
+	"\bhomepage\x18\t \x01(\tR\bhomepage\x12\x15\n" +
+	"\x06in_war\x18\n" +
+	" \x01(\bR\x05inWar\x12!\n" +
+	"\fdisband_date\x18\v \x01(\tR\vdisbandDate\x12+\n" +
+	"\x11disband_condition\x18\f \x01(\tR\x10disbandCondition\x12%\n" +
+	"\x0eplayers_online\x18\r \x01(\x03R\rplayersOnline\x12'\n" +
+	"\x0fplayers_offline\x18\x0e \x01(\x03R\x0eplayersOffline\x12#\n" +
+	"\rmembers_total\x18\x0f \x01(\x03R\fmembersTotal\x12'\n" +
+	"\x0fmembers_invited\x18\x10 \x01(\x03R\x0emembersInvited\x123\n" +
+	"\amembers\x18\x11 \x03(\v2\x19.tibiadata.v4.GuildMemberR\amembers\x12:\n" +
+	"\ainvites\x18\x12 \x03(\v2 .tibiadata.v4.InvitedGuildMemberR\ainvites\"\xad\x01\n" +
+	"\vGuildMember\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\tR\x04rank\x12\x1a\n" +
+	"\bvocation\x18\x04 \x01(\tR\bvocation\x12\x14\n" +
+	"\x05level\x18\x05 \x01(\x03R\x05level\x12\x16\n" +
+	"\x06joined\x18\x06 \x01(\tR\x06joined\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\"\xc4\x01\n" +
+	"\tGuildhall\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05world\x18\x02 \x01(\tR\x05world\x12\x1d\n" +
+	"\n" +
+	"paid_until\x18\x03 \x01(\tR\tpaidUntil\x12\x12\n" +
+	"\x04town\x18\x04 \x01(\tR\x04town\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x14\n" +
+	"\x05owner\x18\x06 \x01(\tR\x05owner\x12\x18\n" +
+	"\ahouseid\x18\a \x01(\x03R\ahouseid\x12\x12\n" +
+	"\x04rent\x18\b \x01(\x03R\x04rent\"<\n" +
+	"\x12InvitedGuildMember\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04dateB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_guilds_guild_proto_rawDescOnce sync.Once
+	file_guilds_guild_proto_rawDescData []byte
+)
+
+func file_guilds_guild_proto_rawDescGZIP() []byte {
+	file_guilds_guild_proto_rawDescOnce.Do(func() {
+		file_guilds_guild_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_guilds_guild_proto_rawDesc), len(file_guilds_guild_proto_rawDesc)))
+	})
+	return file_guilds_guild_proto_rawDescData
+}
+
+var file_guilds_guild_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_guilds_guild_proto_goTypes = []any{
+	(*GuildResponse)(nil),      // 0: tibiadata.v4.GuildResponse
+	(*Guild)(nil),              // 1: tibiadata.v4.Guild
+	(*GuildMember)(nil),        // 2: tibiadata.v4.GuildMember
+	(*Guildhall)(nil),          // 3: tibiadata.v4.Guildhall
+	(*InvitedGuildMember)(nil), // 4: tibiadata.v4.InvitedGuildMember
+	(*Information)(nil),        // 5: tibiadata.v4.Information
+}
+var file_guilds_guild_proto_depIdxs = []int32{
+	1, // 0: tibiadata.v4.GuildResponse.guild:type_name -> tibiadata.v4.Guild
+	5, // 1: tibiadata.v4.GuildResponse.information:type_name -> tibiadata.v4.Information
+	3, // 2: tibiadata.v4.Guild.guildhalls:type_name -> tibiadata.v4.Guildhall
+	2, // 3: tibiadata.v4.Guild.members:type_name -> tibiadata.v4.GuildMember
+	4, // 4: tibiadata.v4.Guild.invites:type_name -> tibiadata.v4.InvitedGuildMember
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_guilds_guild_proto_init() }
+func file_guilds_guild_proto_init() {
+	if File_guilds_guild_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guilds_guild_proto_rawDesc), len(file_guilds_guild_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_guilds_guild_proto_goTypes,
+		DependencyIndexes: file_guilds_guild_proto_depIdxs,
+		MessageInfos:      file_guilds_guild_proto_msgTypes,
+	}.Build()
+	File_guilds_guild_proto = out.File
+	file_guilds_guild_proto_goTypes = nil
+	file_guilds_guild_proto_depIdxs = nil
+}
@@ -0,0 +1,297 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: guilds_guild_events.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: GuildEvents and Information
+type GuildEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildEvents   *GuildEvents           `protobuf:"bytes,1,opt,name=guild_events,json=guildEvents,proto3" json:"guild_events,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildEventsResponse) Reset() {
+	*x = GuildEventsResponse{}
+	mi := &file_guilds_guild_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildEventsResponse) ProtoMessage() {}
+
+func (x *GuildEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_guild_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildEventsResponse.ProtoReflect.Descriptor instead.
+func (*GuildEventsResponse) Descriptor() ([]byte, []int) {
+	return file_guilds_guild_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GuildEventsResponse) GetGuildEvents() *GuildEvents {
+	if x != nil {
+		return x.GuildEvents
+	}
+	return nil
+}
+
+func (x *GuildEventsResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type GuildEvents struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`     // The name of the guild.
+	Events        []*GuildEvent          `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"` // List of the events of the guild, the latest first.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildEvents) Reset() {
+	*x = GuildEvents{}
+	mi := &file_guilds_guild_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildEvents) ProtoMessage() {}
+
+func (x *GuildEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_guild_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildEvents.ProtoReflect.Descriptor instead.
+func (*GuildEvents) Descriptor() ([]byte, []int) {
+	return file_guilds_guild_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GuildEvents) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildEvents) GetEvents() []*GuildEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Child of GuildEvents
+type GuildEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`               // The time of the event.
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`               // The type of the event (join, leave, kick, invite, invitation_revoked, rank_change, title_change or other).
+	Character     string                 `protobuf:"bytes,3,opt,name=character,proto3" json:"character,omitempty"`     // The character the event is about.
+	By            string                 `protobuf:"bytes,4,opt,name=by,proto3" json:"by,omitempty"`                   // The character who caused the event (kick, invite, invitation_revoked, rank_change and title_change).
+	Rank          string                 `protobuf:"bytes,5,opt,name=rank,proto3" json:"rank,omitempty"`               // The rank the character was set to (rank_change).
+	Title         string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`             // The title the character was set to, empty if it was removed (title_change).
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"` // The text of the event.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildEvent) Reset() {
+	*x = GuildEvent{}
+	mi := &file_guilds_guild_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildEvent) ProtoMessage() {}
+
+func (x *GuildEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_guild_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildEvent.ProtoReflect.Descriptor instead.
+func (*GuildEvent) Descriptor() ([]byte, []int) {
+	return file_guilds_guild_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GuildEvent) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GuildEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GuildEvent) GetCharacter() string {
+	if x != nil {
+		return x.Character
+	}
+	return ""
+}
+
+func (x *GuildEvent) GetBy() string {
+	if x != nil {
+		return x.By
+	}
+	return ""
+}
+
+func (x *GuildEvent) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *GuildEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GuildEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+var File_guilds_guild_events_proto protoreflect.FileDescriptor
+
+const file_guilds_guild_events_proto_rawDesc = "" +
+	"\n" +
+	"\x19guilds_guild_events.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\x90\x01\n" +
+	"\x13GuildEventsResponse\x12<\n" +
+	"\fguild_events\x18\x01 \x01(\v2\x19.tibiadata.v4.GuildEventsR\vguildEvents\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"S\n" +
+	"\vGuildEvents\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x120\n" +
+	"\x06events\x18\x02 \x03(\v2\x18.tibiadata.v4.GuildEventR\x06events\"\xae\x01\n" +
+	"\n" +
+	"GuildEvent\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1c\n" +
+	"\tcharacter\x18\x03 \x01(\tR\tcharacter\x12\x0e\n" +
+	"\x02by\x18\x04 \x01(\tR\x02by\x12\x12\n" +
+	"\x04rank\x18\x05 \x01(\tR\x04rank\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescriptionB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_guilds_guild_events_proto_rawDescOnce sync.Once
+	file_guilds_guild_events_proto_rawDescData []byte
+)
+
+func file_guilds_guild_events_proto_rawDescGZIP() []byte {
+	file_guilds_guild_events_proto_rawDescOnce.Do(func() {
+		file_guilds_guild_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_guilds_guild_events_proto_rawDesc), len(file_guilds_guild_events_proto_rawDesc)))
+	})
+	return file_guilds_guild_events_proto_rawDescData
+}
+
+var file_guilds_guild_events_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_guilds_guild_events_proto_goTypes = []any{
+	(*GuildEventsResponse)(nil), // 0: tibiadata.v4.GuildEventsResponse
+	(*GuildEvents)(nil),         // 1: tibiadata.v4.GuildEvents
+	(*GuildEvent)(nil),          // 2: tibiadata.v4.GuildEvent
+	(*Information)(nil),         // 3: tibiadata.v4.Information
+}
+var file_guilds_guild_events_proto_depIdxs = []int32{
+	1, // 0: tibiadata.v4.GuildEventsResponse.guild_events:type_name -> tibiadata.v4.GuildEvents
+	3, // 1: tibiadata.v4.GuildEventsResponse.information:type_name -> tibiadata.v4.Information
+	2, // 2: tibiadata.v4.GuildEvents.events:type_name -> tibiadata.v4.GuildEvent
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_guilds_guild_events_proto_init() }
+func file_guilds_guild_events_proto_init() {
+	if File_guilds_guild_events_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guilds_guild_events_proto_rawDesc), len(file_guilds_guild_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_guilds_guild_events_proto_goTypes,
+		DependencyIndexes: file_guilds_guild_events_proto_depIdxs,
+		MessageInfos:      file_guilds_guild_events_proto_msgTypes,
+	}.Build()
+	File_guilds_guild_events_proto = out.File
+	file_guilds_guild_events_proto_goTypes = nil
+	file_guilds_guild_events_proto_depIdxs = nil
+}
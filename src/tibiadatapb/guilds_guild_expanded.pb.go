@@ -0,0 +1,461 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: guilds_guild_expanded.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: GuildExpanded and Information
+type GuildExpandedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildExpanded *GuildExpanded         `protobuf:"bytes,1,opt,name=guild_expanded,json=guildExpanded,proto3" json:"guild_expanded,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildExpandedResponse) Reset() {
+	*x = GuildExpandedResponse{}
+	mi := &file_guilds_guild_expanded_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildExpandedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildExpandedResponse) ProtoMessage() {}
+
+func (x *GuildExpandedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_guild_expanded_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildExpandedResponse.ProtoReflect.Descriptor instead.
+func (*GuildExpandedResponse) Descriptor() ([]byte, []int) {
+	return file_guilds_guild_expanded_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GuildExpandedResponse) GetGuildExpanded() *GuildExpanded {
+	if x != nil {
+		return x.GuildExpanded
+	}
+	return nil
+}
+
+func (x *GuildExpandedResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type GuildExpanded struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                        // The name of the guild.
+	World         string                 `protobuf:"bytes,2,opt,name=world,proto3" json:"world,omitempty"`                                      // The world the guild belongs to.
+	Fields        []string               `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`                                    // The character fields included in the members.
+	MembersTotal  int64                  `protobuf:"varint,4,opt,name=members_total,json=membersTotal,proto3" json:"members_total,omitempty"`   // The number of total members in the guild.
+	Members       []*GuildExpandedMember `protobuf:"bytes,5,rep,name=members,proto3" json:"members,omitempty"`                                  // List of all members in the guild with their character fields.
+	FailedMembers []string               `protobuf:"bytes,6,rep,name=failed_members,json=failedMembers,proto3" json:"failed_members,omitempty"` // The names of the members whose characters could not be fetched.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildExpanded) Reset() {
+	*x = GuildExpanded{}
+	mi := &file_guilds_guild_expanded_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildExpanded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildExpanded) ProtoMessage() {}
+
+func (x *GuildExpanded) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_guild_expanded_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildExpanded.ProtoReflect.Descriptor instead.
+func (*GuildExpanded) Descriptor() ([]byte, []int) {
+	return file_guilds_guild_expanded_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GuildExpanded) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildExpanded) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *GuildExpanded) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *GuildExpanded) GetMembersTotal() int64 {
+	if x != nil {
+		return x.MembersTotal
+	}
+	return 0
+}
+
+func (x *GuildExpanded) GetMembers() []*GuildExpandedMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *GuildExpanded) GetFailedMembers() []string {
+	if x != nil {
+		return x.FailedMembers
+	}
+	return nil
+}
+
+// Child of GuildExpanded
+type GuildExpandedMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`           // The name of the guild's member.
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`         // The member's title.
+	Rank          string                 `protobuf:"bytes,3,opt,name=rank,proto3" json:"rank,omitempty"`           // The rank the member does belong to.
+	Vocation      string                 `protobuf:"bytes,4,opt,name=vocation,proto3" json:"vocation,omitempty"`   // The member's vocation.
+	Level         int64                  `protobuf:"varint,5,opt,name=level,proto3" json:"level,omitempty"`        // The member's level.
+	Joined        string                 `protobuf:"bytes,6,opt,name=joined,proto3" json:"joined,omitempty"`       // The day when the member joined.
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`       // Whether the member is online or offline.
+	Character     *GuildMemberCharacter  `protobuf:"bytes,8,opt,name=character,proto3" json:"character,omitempty"` // The included fields of the member's character (if it could be fetched).
+	Error         *Status                `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`         // The error of the member's character (if it could not be fetched).
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildExpandedMember) Reset() {
+	*x = GuildExpandedMember{}
+	mi := &file_guilds_guild_expanded_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildExpandedMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildExpandedMember) ProtoMessage() {}
+
+func (x *GuildExpandedMember) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_guild_expanded_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildExpandedMember.ProtoReflect.Descriptor instead.
+func (*GuildExpandedMember) Descriptor() ([]byte, []int) {
+	return file_guilds_guild_expanded_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GuildExpandedMember) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildExpandedMember) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GuildExpandedMember) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *GuildExpandedMember) GetVocation() string {
+	if x != nil {
+		return x.Vocation
+	}
+	return ""
+}
+
+func (x *GuildExpandedMember) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *GuildExpandedMember) GetJoined() string {
+	if x != nil {
+		return x.Joined
+	}
+	return ""
+}
+
+func (x *GuildExpandedMember) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GuildExpandedMember) GetCharacter() *GuildMemberCharacter {
+	if x != nil {
+		return x.Character
+	}
+	return nil
+}
+
+func (x *GuildExpandedMember) GetError() *Status {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// Child of GuildExpandedMember
+type GuildMemberCharacter struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AccountStatus     string                 `protobuf:"bytes,1,opt,name=account_status,json=accountStatus,proto3" json:"account_status,omitempty"`              // Whether account is Free or Premium.
+	AchievementPoints int64                  `protobuf:"varint,2,opt,name=achievement_points,json=achievementPoints,proto3" json:"achievement_points,omitempty"` // The total of achievement points the character has.
+	Deaths            []*Deaths              `protobuf:"bytes,3,rep,name=deaths,proto3" json:"deaths,omitempty"`                                                 // The character's deaths.
+	FormerNames       []string               `protobuf:"bytes,4,rep,name=former_names,json=formerNames,proto3" json:"former_names,omitempty"`                    // List of former names of the character.
+	Houses            []*Houses              `protobuf:"bytes,5,rep,name=houses,proto3" json:"houses,omitempty"`                                                 // List of houses the character owns currently.
+	LastLogin         string                 `protobuf:"bytes,6,opt,name=last_login,json=lastLogin,proto3" json:"last_login,omitempty"`                          // The character's last logged in time.
+	Residence         string                 `protobuf:"bytes,7,opt,name=residence,proto3" json:"residence,omitempty"`                                           // The character's current residence.
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GuildMemberCharacter) Reset() {
+	*x = GuildMemberCharacter{}
+	mi := &file_guilds_guild_expanded_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildMemberCharacter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildMemberCharacter) ProtoMessage() {}
+
+func (x *GuildMemberCharacter) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_guild_expanded_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildMemberCharacter.ProtoReflect.Descriptor instead.
+func (*GuildMemberCharacter) Descriptor() ([]byte, []int) {
+	return file_guilds_guild_expanded_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GuildMemberCharacter) GetAccountStatus() string {
+	if x != nil {
+		return x.AccountStatus
+	}
+	return ""
+}
+
+func (x *GuildMemberCharacter) GetAchievementPoints() int64 {
+	if x != nil {
+		return x.AchievementPoints
+	}
+	return 0
+}
+
+func (x *GuildMemberCharacter) GetDeaths() []*Deaths {
+	if x != nil {
+		return x.Deaths
+	}
+	return nil
+}
+
+func (x *GuildMemberCharacter) GetFormerNames() []string {
+	if x != nil {
+		return x.FormerNames
+	}
+	return nil
+}
+
+func (x *GuildMemberCharacter) GetHouses() []*Houses {
+	if x != nil {
+		return x.Houses
+	}
+	return nil
+}
+
+func (x *GuildMemberCharacter) GetLastLogin() string {
+	if x != nil {
+		return x.LastLogin
+	}
+	return ""
+}
+
+func (x *GuildMemberCharacter) GetResidence() string {
+	if x != nil {
+		return x.Residence
+	}
+	return ""
+}
+
+var File_guilds_guild_expanded_proto protoreflect.FileDescriptor
+
+const file_guilds_guild_expanded_proto_rawDesc = "" +
+	"\n" +
+	"\x1bguilds_guild_expanded.proto\x12\ftibiadata.v4\x1a\x1acharacters_character.proto\x1a\x11information.proto\"\x98\x01\n" +
+	"\x15GuildExpandedResponse\x12B\n" +
+	"\x0eguild_expanded\x18\x01 \x01(\v2\x1b.tibiadata.v4.GuildExpandedR\rguildExpanded\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xda\x01\n" +
+	"\rGuildExpanded\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05world\x18\x02 \x01(\tR\x05world\x12\x16\n" +
+	"\x06fields\x18\x03 \x03(\tR\x06fields\x12#\n" +
+	"\rmembers_total\x18\x04 \x01(\x03R\fmembersTotal\x12;\n" +
+	"\amembers\x18\x05 \x03(\v2!.tibiadata.v4.GuildExpandedMemberR\amembers\x12%\n" +
+	"\x0efailed_members\x18\x06 \x03(\tR\rfailedMembers\"\xa3\x02\n" +
+	"\x13GuildExpandedMember\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\tR\x04rank\x12\x1a\n" +
+	"\bvocation\x18\x04 \x01(\tR\bvocation\x12\x14\n" +
+	"\x05level\x18\x05 \x01(\x03R\x05level\x12\x16\n" +
+	"\x06joined\x18\x06 \x01(\tR\x06joined\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12@\n" +
+	"\tcharacter\x18\b \x01(\v2\".tibiadata.v4.GuildMemberCharacterR\tcharacter\x12*\n" +
+	"\x05error\x18\t \x01(\v2\x14.tibiadata.v4.StatusR\x05error\"\xa8\x02\n" +
+	"\x14GuildMemberCharacter\x12%\n" +
+	"\x0eaccount_status\x18\x01 \x01(\tR\raccountStatus\x12-\n" +
+	"\x12achievement_points\x18\x02 \x01(\x03R\x11achievementPoints\x12,\n" +
+	"\x06deaths\x18\x03 \x03(\v2\x14.tibiadata.v4.DeathsR\x06deaths\x12!\n" +
+	"\fformer_names\x18\x04 \x03(\tR\vformerNames\x12,\n" +
+	"\x06houses\x18\x05 \x03(\v2\x14.tibiadata.v4.HousesR\x06houses\x12\x1d\n" +
+	"\n" +
+	"last_login\x18\x06 \x01(\tR\tlastLogin\x12\x1c\n" +
+	"\tresidence\x18\a \x01(\tR\tresidenceB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_guilds_guild_expanded_proto_rawDescOnce sync.Once
+	file_guilds_guild_expanded_proto_rawDescData []byte
+)
+
+func file_guilds_guild_expanded_proto_rawDescGZIP() []byte {
+	file_guilds_guild_expanded_proto_rawDescOnce.Do(func() {
+		file_guilds_guild_expanded_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_guilds_guild_expanded_proto_rawDesc), len(file_guilds_guild_expanded_proto_rawDesc)))
+	})
+	return file_guilds_guild_expanded_proto_rawDescData
+}
+
+var file_guilds_guild_expanded_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_guilds_guild_expanded_proto_goTypes = []any{
+	(*GuildExpandedResponse)(nil), // 0: tibiadata.v4.GuildExpandedResponse
+	(*GuildExpanded)(nil),         // 1: tibiadata.v4.GuildExpanded
+	(*GuildExpandedMember)(nil),   // 2: tibiadata.v4.GuildExpandedMember
+	(*GuildMemberCharacter)(nil),  // 3: tibiadata.v4.GuildMemberCharacter
+	(*Information)(nil),           // 4: tibiadata.v4.Information
+	(*Status)(nil),                // 5: tibiadata.v4.Status
+	(*Deaths)(nil),                // 6: tibiadata.v4.Deaths
+	(*Houses)(nil),                // 7: tibiadata.v4.Houses
+}
+var file_guilds_guild_expanded_proto_depIdxs = []int32{
+	1, // 0: tibiadata.v4.GuildExpandedResponse.guild_expanded:type_name -> tibiadata.v4.GuildExpanded
+	4, // 1: tibiadata.v4.GuildExpandedResponse.information:type_name -> tibiadata.v4.Information
+	2, // 2: tibiadata.v4.GuildExpanded.members:type_name -> tibiadata.v4.GuildExpandedMember
+	3, // 3: tibiadata.v4.GuildExpandedMember.character:type_name -> tibiadata.v4.GuildMemberCharacter
+	5, // 4: tibiadata.v4.GuildExpandedMember.error:type_name -> tibiadata.v4.Status
+	6, // 5: tibiadata.v4.GuildMemberCharacter.deaths:type_name -> tibiadata.v4.Deaths
+	7, // 6: tibiadata.v4.GuildMemberCharacter.houses:type_name -> tibiadata.v4.Houses
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_guilds_guild_expanded_proto_init() }
+func file_guilds_guild_expanded_proto_init() {
+	if File_guilds_guild_expanded_proto != nil {
+		return
+	}
+	file_characters_character_proto_init()
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guilds_guild_expanded_proto_rawDesc), len(file_guilds_guild_expanded_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_guilds_guild_expanded_proto_goTypes,
+		DependencyIndexes: file_guilds_guild_expanded_proto_depIdxs,
+		MessageInfos:      file_guilds_guild_expanded_proto_msgTypes,
+	}.Build()
+	File_guilds_guild_expanded_proto = out.File
+	file_guilds_guild_expanded_proto_goTypes = nil
+	file_guilds_guild_expanded_proto_depIdxs = nil
+}
@@ -0,0 +1,364 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: guilds_guild_wars.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: GuildWars and Information
+type GuildWarsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildWars     *GuildWars             `protobuf:"bytes,1,opt,name=guild_wars,json=guildWars,proto3" json:"guild_wars,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildWarsResponse) Reset() {
+	*x = GuildWarsResponse{}
+	mi := &file_guilds_guild_wars_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildWarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildWarsResponse) ProtoMessage() {}
+
+func (x *GuildWarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_guild_wars_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildWarsResponse.ProtoReflect.Descriptor instead.
+func (*GuildWarsResponse) Descriptor() ([]byte, []int) {
+	return file_guilds_guild_wars_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GuildWarsResponse) GetGuildWars() *GuildWars {
+	if x != nil {
+		return x.GuildWars
+	}
+	return nil
+}
+
+func (x *GuildWarsResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type GuildWars struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`       // The name of the guild.
+	Current       []*GuildWar            `protobuf:"bytes,2,rep,name=current,proto3" json:"current,omitempty"` // List of the active wars of the guild.
+	History       []*GuildWar            `protobuf:"bytes,3,rep,name=history,proto3" json:"history,omitempty"` // List of the past wars and rejected declarations of the guild, the latest first.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildWars) Reset() {
+	*x = GuildWars{}
+	mi := &file_guilds_guild_wars_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildWars) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildWars) ProtoMessage() {}
+
+func (x *GuildWars) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_guild_wars_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildWars.ProtoReflect.Descriptor instead.
+func (*GuildWars) Descriptor() ([]byte, []int) {
+	return file_guilds_guild_wars_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GuildWars) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildWars) GetCurrent() []*GuildWar {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *GuildWars) GetHistory() []*GuildWar {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// Child of GuildWars
+type GuildWar struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`                                     // The status of the war (active, ended or rejected).
+	Opponent      string                 `protobuf:"bytes,2,opt,name=opponent,proto3" json:"opponent,omitempty"`                                 // The name of the opposing guild.
+	DeclaredBy    string                 `protobuf:"bytes,3,opt,name=declared_by,json=declaredBy,proto3" json:"declared_by,omitempty"`           // The name of the guild that declared the war (only past wars).
+	Declared      string                 `protobuf:"bytes,4,opt,name=declared,proto3" json:"declared,omitempty"`                                 // The day the war was declared (only past wars).
+	Started       string                 `protobuf:"bytes,5,opt,name=started,proto3" json:"started,omitempty"`                                   // The day the war started.
+	EndDate       string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                    // The day the war ended, or will end at the latest for active wars.
+	Kills         int64                  `protobuf:"varint,7,opt,name=kills,proto3" json:"kills,omitempty"`                                      // The kills of the guild.
+	OpponentKills int64                  `protobuf:"varint,8,opt,name=opponent_kills,json=opponentKills,proto3" json:"opponent_kills,omitempty"` // The kills of the opposing guild.
+	ScoreLimit    int64                  `protobuf:"varint,9,opt,name=score_limit,json=scoreLimit,proto3" json:"score_limit,omitempty"`          // The score a guild has to reach to win the war.
+	Duration      int64                  `protobuf:"varint,10,opt,name=duration,proto3" json:"duration,omitempty"`                               // The duration of the war in days (only past wars).
+	Fee           int64                  `protobuf:"varint,11,opt,name=fee,proto3" json:"fee,omitempty"`                                         // The fee in gold coins the guild pays if it loses.
+	OpponentFee   int64                  `protobuf:"varint,12,opt,name=opponent_fee,json=opponentFee,proto3" json:"opponent_fee,omitempty"`      // The fee in gold coins the opposing guild pays if it loses.
+	Winner        string                 `protobuf:"bytes,13,opt,name=winner,proto3" json:"winner,omitempty"`                                    // The name of the winning guild, empty for active wars and draws.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildWar) Reset() {
+	*x = GuildWar{}
+	mi := &file_guilds_guild_wars_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildWar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildWar) ProtoMessage() {}
+
+func (x *GuildWar) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_guild_wars_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildWar.ProtoReflect.Descriptor instead.
+func (*GuildWar) Descriptor() ([]byte, []int) {
+	return file_guilds_guild_wars_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GuildWar) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GuildWar) GetOpponent() string {
+	if x != nil {
+		return x.Opponent
+	}
+	return ""
+}
+
+func (x *GuildWar) GetDeclaredBy() string {
+	if x != nil {
+		return x.DeclaredBy
+	}
+	return ""
+}
+
+func (x *GuildWar) GetDeclared() string {
+	if x != nil {
+		return x.Declared
+	}
+	return ""
+}
+
+func (x *GuildWar) GetStarted() string {
+	if x != nil {
+		return x.Started
+	}
+	return ""
+}
+
+func (x *GuildWar) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GuildWar) GetKills() int64 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *GuildWar) GetOpponentKills() int64 {
+	if x != nil {
+		return x.OpponentKills
+	}
+	return 0
+}
+
+func (x *GuildWar) GetScoreLimit() int64 {
+	if x != nil {
+		return x.ScoreLimit
+	}
+	return 0
+}
+
+func (x *GuildWar) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *GuildWar) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *GuildWar) GetOpponentFee() int64 {
+	if x != nil {
+		return x.OpponentFee
+	}
+	return 0
+}
+
+func (x *GuildWar) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+var File_guilds_guild_wars_proto protoreflect.FileDescriptor
+
+const file_guilds_guild_wars_proto_rawDesc = "" +
+	"\n" +
+	"\x17guilds_guild_wars.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\x88\x01\n" +
+	"\x11GuildWarsResponse\x126\n" +
+	"\n" +
+	"guild_wars\x18\x01 \x01(\v2\x17.tibiadata.v4.GuildWarsR\tguildWars\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\x83\x01\n" +
+	"\tGuildWars\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x120\n" +
+	"\acurrent\x18\x02 \x03(\v2\x16.tibiadata.v4.GuildWarR\acurrent\x120\n" +
+	"\ahistory\x18\x03 \x03(\v2\x16.tibiadata.v4.GuildWarR\ahistory\"\xf7\x02\n" +
+	"\bGuildWar\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1a\n" +
+	"\bopponent\x18\x02 \x01(\tR\bopponent\x12\x1f\n" +
+	"\vdeclared_by\x18\x03 \x01(\tR\n" +
+	"declaredBy\x12\x1a\n" +
+	"\bdeclared\x18\x04 \x01(\tR\bdeclared\x12\x18\n" +
+	"\astarted\x18\x05 \x01(\tR\astarted\x12\x19\n" +
+	"\bend_date\x18\x06 \x01(\tR\aendDate\x12\x14\n" +
+	"\x05kills\x18\a \x01(\x03R\x05kills\x12%\n" +
+	"\x0eopponent_kills\x18\b \x01(\x03R\ropponentKills\x12\x1f\n" +
+	"\vscore_limit\x18\t \x01(\x03R\n" +
+	"scoreLimit\x12\x1a\n" +
+	"\bduration\x18\n" +
+	" \x01(\x03R\bduration\x12\x10\n" +
+	"\x03fee\x18\v \x01(\x03R\x03fee\x12!\n" +
+	"\fopponent_fee\x18\f \x01(\x03R\vopponentFee\x12\x16\n" +
+	"\x06winner\x18\r \x01(\tR\x06winnerB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_guilds_guild_wars_proto_rawDescOnce sync.Once
+	file_guilds_guild_wars_proto_rawDescData []byte
+)
+
+func file_guilds_guild_wars_proto_rawDescGZIP() []byte {
+	file_guilds_guild_wars_proto_rawDescOnce.Do(func() {
+		file_guilds_guild_wars_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_guilds_guild_wars_proto_rawDesc), len(file_guilds_guild_wars_proto_rawDesc)))
+	})
+	return file_guilds_guild_wars_proto_rawDescData
+}
+
+var file_guilds_guild_wars_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_guilds_guild_wars_proto_goTypes = []any{
+	(*GuildWarsResponse)(nil), // 0: tibiadata.v4.GuildWarsResponse
+	(*GuildWars)(nil),         // 1: tibiadata.v4.GuildWars
+	(*GuildWar)(nil),          // 2: tibiadata.v4.GuildWar
+	(*Information)(nil),       // 3: tibiadata.v4.Information
+}
+var file_guilds_guild_wars_proto_depIdxs = []int32{
+	1, // 0: tibiadata.v4.GuildWarsResponse.guild_wars:type_name -> tibiadata.v4.GuildWars
+	3, // 1: tibiadata.v4.GuildWarsResponse.information:type_name -> tibiadata.v4.Information
+	2, // 2: tibiadata.v4.GuildWars.current:type_name -> tibiadata.v4.GuildWar
+	2, // 3: tibiadata.v4.GuildWars.history:type_name -> tibiadata.v4.GuildWar
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_guilds_guild_wars_proto_init() }
+func file_guilds_guild_wars_proto_init() {
+	if File_guilds_guild_wars_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guilds_guild_wars_proto_rawDesc), len(file_guilds_guild_wars_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_guilds_guild_wars_proto_goTypes,
+		DependencyIndexes: file_guilds_guild_wars_proto_depIdxs,
+		MessageInfos:      file_guilds_guild_wars_proto_msgTypes,
+	}.Build()
+	File_guilds_guild_wars_proto = out.File
+	file_guilds_guild_wars_proto_goTypes = nil
+	file_guilds_guild_wars_proto_depIdxs = nil
+}
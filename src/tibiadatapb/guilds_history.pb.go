@@ -0,0 +1,587 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: guilds_history.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: GuildTracker and Information
+type GuildTrackerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildTracker  []*TrackedGuild        `protobuf:"bytes,1,rep,name=guild_tracker,json=guildTracker,proto3" json:"guild_tracker,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildTrackerResponse) Reset() {
+	*x = GuildTrackerResponse{}
+	mi := &file_guilds_history_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildTrackerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildTrackerResponse) ProtoMessage() {}
+
+func (x *GuildTrackerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_history_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildTrackerResponse.ProtoReflect.Descriptor instead.
+func (*GuildTrackerResponse) Descriptor() ([]byte, []int) {
+	return file_guilds_history_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GuildTrackerResponse) GetGuildTracker() []*TrackedGuild {
+	if x != nil {
+		return x.GuildTracker
+	}
+	return nil
+}
+
+func (x *GuildTrackerResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of GuildTrackerResponse
+type TrackedGuild struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                               // The name the guild is tracked with.
+	Added         string                 `protobuf:"bytes,2,opt,name=added,proto3" json:"added,omitempty"`                             // The time the guild was added to the tracker.
+	LastPolled    string                 `protobuf:"bytes,3,opt,name=last_polled,json=lastPolled,proto3" json:"last_polled,omitempty"` // The time the guild was last polled successfully.
+	Error         *Status                `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`                             // The error of the last poll (if it failed).
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackedGuild) Reset() {
+	*x = TrackedGuild{}
+	mi := &file_guilds_history_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackedGuild) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackedGuild) ProtoMessage() {}
+
+func (x *TrackedGuild) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_history_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackedGuild.ProtoReflect.Descriptor instead.
+func (*TrackedGuild) Descriptor() ([]byte, []int) {
+	return file_guilds_history_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TrackedGuild) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrackedGuild) GetAdded() string {
+	if x != nil {
+		return x.Added
+	}
+	return ""
+}
+
+func (x *TrackedGuild) GetLastPolled() string {
+	if x != nil {
+		return x.LastPolled
+	}
+	return ""
+}
+
+func (x *TrackedGuild) GetError() *Status {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// Child of GuildHistory and CharacterGuildHistory
+type GuildHistoryEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`                                        // The time of the poll the change was seen in.
+	Guild         string                 `protobuf:"bytes,2,opt,name=guild,proto3" json:"guild,omitempty"`                                      // The name of the guild.
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                        // The type of the change (join, leave, rank_change or title_change).
+	Character     string                 `protobuf:"bytes,4,opt,name=character,proto3" json:"character,omitempty"`                              // The name of the member.
+	Rank          string                 `protobuf:"bytes,5,opt,name=rank,proto3" json:"rank,omitempty"`                                        // The rank of the member after the change (the last rank on leave).
+	PreviousRank  string                 `protobuf:"bytes,6,opt,name=previous_rank,json=previousRank,proto3" json:"previous_rank,omitempty"`    // The rank of the member before the change (rank_change).
+	Title         string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`                                      // The title of the member after the change (the last title on leave).
+	PreviousTitle string                 `protobuf:"bytes,8,opt,name=previous_title,json=previousTitle,proto3" json:"previous_title,omitempty"` // The title of the member before the change (title_change).
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildHistoryEvent) Reset() {
+	*x = GuildHistoryEvent{}
+	mi := &file_guilds_history_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildHistoryEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildHistoryEvent) ProtoMessage() {}
+
+func (x *GuildHistoryEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_history_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildHistoryEvent.ProtoReflect.Descriptor instead.
+func (*GuildHistoryEvent) Descriptor() ([]byte, []int) {
+	return file_guilds_history_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GuildHistoryEvent) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *GuildHistoryEvent) GetGuild() string {
+	if x != nil {
+		return x.Guild
+	}
+	return ""
+}
+
+func (x *GuildHistoryEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GuildHistoryEvent) GetCharacter() string {
+	if x != nil {
+		return x.Character
+	}
+	return ""
+}
+
+func (x *GuildHistoryEvent) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *GuildHistoryEvent) GetPreviousRank() string {
+	if x != nil {
+		return x.PreviousRank
+	}
+	return ""
+}
+
+func (x *GuildHistoryEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GuildHistoryEvent) GetPreviousTitle() string {
+	if x != nil {
+		return x.PreviousTitle
+	}
+	return ""
+}
+
+// The base includes two levels: GuildHistory and Information
+type GuildHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildHistory  *GuildHistory          `protobuf:"bytes,1,opt,name=guild_history,json=guildHistory,proto3" json:"guild_history,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildHistoryResponse) Reset() {
+	*x = GuildHistoryResponse{}
+	mi := &file_guilds_history_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildHistoryResponse) ProtoMessage() {}
+
+func (x *GuildHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_history_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GuildHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_guilds_history_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GuildHistoryResponse) GetGuildHistory() *GuildHistory {
+	if x != nil {
+		return x.GuildHistory
+	}
+	return nil
+}
+
+func (x *GuildHistoryResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type GuildHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                               // The name the guild is tracked with.
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`                               // The start of the period.
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`                                   // The end of the period.
+	LastPolled    string                 `protobuf:"bytes,4,opt,name=last_polled,json=lastPolled,proto3" json:"last_polled,omitempty"` // The time the guild was last polled successfully.
+	Events        []*GuildHistoryEvent   `protobuf:"bytes,5,rep,name=events,proto3" json:"events,omitempty"`                           // The changes of the members in the period, the latest first.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildHistory) Reset() {
+	*x = GuildHistory{}
+	mi := &file_guilds_history_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildHistory) ProtoMessage() {}
+
+func (x *GuildHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_history_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildHistory.ProtoReflect.Descriptor instead.
+func (*GuildHistory) Descriptor() ([]byte, []int) {
+	return file_guilds_history_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GuildHistory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildHistory) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GuildHistory) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GuildHistory) GetLastPolled() string {
+	if x != nil {
+		return x.LastPolled
+	}
+	return ""
+}
+
+func (x *GuildHistory) GetEvents() []*GuildHistoryEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// The base includes two levels: CharacterGuildHistory and Information
+type CharacterGuildHistoryResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	CharacterGuildHistory *CharacterGuildHistory `protobuf:"bytes,1,opt,name=character_guild_history,json=characterGuildHistory,proto3" json:"character_guild_history,omitempty"`
+	Information           *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CharacterGuildHistoryResponse) Reset() {
+	*x = CharacterGuildHistoryResponse{}
+	mi := &file_guilds_history_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharacterGuildHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterGuildHistoryResponse) ProtoMessage() {}
+
+func (x *CharacterGuildHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_history_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterGuildHistoryResponse.ProtoReflect.Descriptor instead.
+func (*CharacterGuildHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_guilds_history_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CharacterGuildHistoryResponse) GetCharacterGuildHistory() *CharacterGuildHistory {
+	if x != nil {
+		return x.CharacterGuildHistory
+	}
+	return nil
+}
+
+func (x *CharacterGuildHistoryResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type CharacterGuildHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`     // The name of the character.
+	Events        []*GuildHistoryEvent   `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"` // The changes of the character in all tracked guilds, the latest first.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharacterGuildHistory) Reset() {
+	*x = CharacterGuildHistory{}
+	mi := &file_guilds_history_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharacterGuildHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterGuildHistory) ProtoMessage() {}
+
+func (x *CharacterGuildHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_history_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterGuildHistory.ProtoReflect.Descriptor instead.
+func (*CharacterGuildHistory) Descriptor() ([]byte, []int) {
+	return file_guilds_history_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CharacterGuildHistory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CharacterGuildHistory) GetEvents() []*GuildHistoryEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+var File_guilds_history_proto protoreflect.FileDescriptor
+
+const file_guilds_history_proto_rawDesc = "" +
+	"\n" +
+	"\x14guilds_history.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\x94\x01\n" +
+	"\x14GuildTrackerResponse\x12?\n" +
+	"\rguild_tracker\x18\x01 \x03(\v2\x1a.tibiadata.v4.TrackedGuildR\fguildTracker\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\x85\x01\n" +
+	"\fTrackedGuild\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05added\x18\x02 \x01(\tR\x05added\x12\x1f\n" +
+	"\vlast_polled\x18\x03 \x01(\tR\n" +
+	"lastPolled\x12*\n" +
+	"\x05error\x18\x04 \x01(\v2\x14.tibiadata.v4.StatusR\x05error\"\xe5\x01\n" +
+	"\x11GuildHistoryEvent\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\tR\x04time\x12\x14\n" +
+	"\x05guild\x18\x02 \x01(\tR\x05guild\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1c\n" +
+	"\tcharacter\x18\x04 \x01(\tR\tcharacter\x12\x12\n" +
+	"\x04rank\x18\x05 \x01(\tR\x04rank\x12#\n" +
+	"\rprevious_rank\x18\x06 \x01(\tR\fpreviousRank\x12\x14\n" +
+	"\x05title\x18\a \x01(\tR\x05title\x12%\n" +
+	"\x0eprevious_title\x18\b \x01(\tR\rpreviousTitle\"\x94\x01\n" +
+	"\x14GuildHistoryResponse\x12?\n" +
+	"\rguild_history\x18\x01 \x01(\v2\x1a.tibiadata.v4.GuildHistoryR\fguildHistory\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xa0\x01\n" +
+	"\fGuildHistory\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12\x1f\n" +
+	"\vlast_polled\x18\x04 \x01(\tR\n" +
+	"lastPolled\x127\n" +
+	"\x06events\x18\x05 \x03(\v2\x1f.tibiadata.v4.GuildHistoryEventR\x06events\"\xb9\x01\n" +
+	"\x1dCharacterGuildHistoryResponse\x12[\n" +
+	"\x17character_guild_history\x18\x01 \x01(\v2#.tibiadata.v4.CharacterGuildHistoryR\x15characterGuildHistory\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"d\n" +
+	"\x15CharacterGuildHistory\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x127\n" +
+	"\x06events\x18\x02 \x03(\v2\x1f.tibiadata.v4.GuildHistoryEventR\x06eventsB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_guilds_history_proto_rawDescOnce sync.Once
+	file_guilds_history_proto_rawDescData []byte
+)
+
+func file_guilds_history_proto_rawDescGZIP() []byte {
+	file_guilds_history_proto_rawDescOnce.Do(func() {
+		file_guilds_history_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_guilds_history_proto_rawDesc), len(file_guilds_history_proto_rawDesc)))
+	})
+	return file_guilds_history_proto_rawDescData
+}
+
+var file_guilds_history_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_guilds_history_proto_goTypes = []any{
+	(*GuildTrackerResponse)(nil),          // 0: tibiadata.v4.GuildTrackerResponse
+	(*TrackedGuild)(nil),                  // 1: tibiadata.v4.TrackedGuild
+	(*GuildHistoryEvent)(nil),             // 2: tibiadata.v4.GuildHistoryEvent
+	(*GuildHistoryResponse)(nil),          // 3: tibiadata.v4.GuildHistoryResponse
+	(*GuildHistory)(nil),                  // 4: tibiadata.v4.GuildHistory
+	(*CharacterGuildHistoryResponse)(nil), // 5: tibiadata.v4.CharacterGuildHistoryResponse
+	(*CharacterGuildHistory)(nil),         // 6: tibiadata.v4.CharacterGuildHistory
+	(*Information)(nil),                   // 7: tibiadata.v4.Information
+	(*Status)(nil),                        // 8: tibiadata.v4.Status
+}
+var file_guilds_history_proto_depIdxs = []int32{
+	1, // 0: tibiadata.v4.GuildTrackerResponse.guild_tracker:type_name -> tibiadata.v4.TrackedGuild
+	7, // 1: tibiadata.v4.GuildTrackerResponse.information:type_name -> tibiadata.v4.Information
+	8, // 2: tibiadata.v4.TrackedGuild.error:type_name -> tibiadata.v4.Status
+	4, // 3: tibiadata.v4.GuildHistoryResponse.guild_history:type_name -> tibiadata.v4.GuildHistory
+	7, // 4: tibiadata.v4.GuildHistoryResponse.information:type_name -> tibiadata.v4.Information
+	2, // 5: tibiadata.v4.GuildHistory.events:type_name -> tibiadata.v4.GuildHistoryEvent
+	6, // 6: tibiadata.v4.CharacterGuildHistoryResponse.character_guild_history:type_name -> tibiadata.v4.CharacterGuildHistory
+	7, // 7: tibiadata.v4.CharacterGuildHistoryResponse.information:type_name -> tibiadata.v4.Information
+	2, // 8: tibiadata.v4.CharacterGuildHistory.events:type_name -> tibiadata.v4.GuildHistoryEvent
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_guilds_history_proto_init() }
+func file_guilds_history_proto_init() {
+	if File_guilds_history_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guilds_history_proto_rawDesc), len(file_guilds_history_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_guilds_history_proto_goTypes,
+		DependencyIndexes: file_guilds_history_proto_depIdxs,
+		MessageInfos:      file_guilds_history_proto_msgTypes,
+	}.Build()
+	File_guilds_history_proto = out.File
+	file_guilds_history_proto_goTypes = nil
+	file_guilds_history_proto_depIdxs = nil
+}
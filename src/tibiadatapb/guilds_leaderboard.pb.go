@@ -0,0 +1,324 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: guilds_leaderboard.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: GuildsLeaderboard and Information
+type GuildsLeaderboardResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	GuildsLeaderboard *GuildsLeaderboard     `protobuf:"bytes,1,opt,name=guilds_leaderboard,json=guildsLeaderboard,proto3" json:"guilds_leaderboard,omitempty"`
+	Information       *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GuildsLeaderboardResponse) Reset() {
+	*x = GuildsLeaderboardResponse{}
+	mi := &file_guilds_leaderboard_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildsLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildsLeaderboardResponse) ProtoMessage() {}
+
+func (x *GuildsLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_leaderboard_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildsLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GuildsLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_guilds_leaderboard_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GuildsLeaderboardResponse) GetGuildsLeaderboard() *GuildsLeaderboard {
+	if x != nil {
+		return x.GuildsLeaderboard
+	}
+	return nil
+}
+
+func (x *GuildsLeaderboardResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type GuildsLeaderboard struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	World         string                    `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`                                   // The world of the guilds.
+	Sort          string                    `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"`                                     // The value the guilds are ranked by.
+	Fetched       string                    `protobuf:"bytes,3,opt,name=fetched,proto3" json:"fetched,omitempty"`                               // The time the guilds were fetched from tibia.com.
+	Guilds        []*GuildsLeaderboardEntry `protobuf:"bytes,4,rep,name=guilds,proto3" json:"guilds,omitempty"`                                 // The active guilds of the world, ranked by sort.
+	FailedGuilds  []string                  `protobuf:"bytes,5,rep,name=failed_guilds,json=failedGuilds,proto3" json:"failed_guilds,omitempty"` // The names of the guilds that could not be fetched.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildsLeaderboard) Reset() {
+	*x = GuildsLeaderboard{}
+	mi := &file_guilds_leaderboard_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildsLeaderboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildsLeaderboard) ProtoMessage() {}
+
+func (x *GuildsLeaderboard) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_leaderboard_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildsLeaderboard.ProtoReflect.Descriptor instead.
+func (*GuildsLeaderboard) Descriptor() ([]byte, []int) {
+	return file_guilds_leaderboard_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GuildsLeaderboard) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *GuildsLeaderboard) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GuildsLeaderboard) GetFetched() string {
+	if x != nil {
+		return x.Fetched
+	}
+	return ""
+}
+
+func (x *GuildsLeaderboard) GetGuilds() []*GuildsLeaderboardEntry {
+	if x != nil {
+		return x.Guilds
+	}
+	return nil
+}
+
+func (x *GuildsLeaderboard) GetFailedGuilds() []string {
+	if x != nil {
+		return x.FailedGuilds
+	}
+	return nil
+}
+
+// Child of GuildsLeaderboard
+type GuildsLeaderboardEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int64                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`                                        // The rank of the guild on the leaderboard.
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                         // The name of the guild.
+	LogoUrl       string                 `protobuf:"bytes,3,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`                    // The URL to the guild's logo.
+	MembersTotal  int64                  `protobuf:"varint,4,opt,name=members_total,json=membersTotal,proto3" json:"members_total,omitempty"`    // The number of total members in the guild.
+	MembersOnline int64                  `protobuf:"varint,5,opt,name=members_online,json=membersOnline,proto3" json:"members_online,omitempty"` // The number of members that were online when the guild was fetched.
+	LevelTotal    int64                  `protobuf:"varint,6,opt,name=level_total,json=levelTotal,proto3" json:"level_total,omitempty"`          // The sum of the levels of the members.
+	LevelAverage  float64                `protobuf:"fixed64,7,opt,name=level_average,json=levelAverage,proto3" json:"level_average,omitempty"`   // The average level of the members.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildsLeaderboardEntry) Reset() {
+	*x = GuildsLeaderboardEntry{}
+	mi := &file_guilds_leaderboard_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildsLeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildsLeaderboardEntry) ProtoMessage() {}
+
+func (x *GuildsLeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_leaderboard_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildsLeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*GuildsLeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_guilds_leaderboard_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GuildsLeaderboardEntry) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *GuildsLeaderboardEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildsLeaderboardEntry) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *GuildsLeaderboardEntry) GetMembersTotal() int64 {
+	if x != nil {
+		return x.MembersTotal
+	}
+	return 0
+}
+
+func (x *GuildsLeaderboardEntry) GetMembersOnline() int64 {
+	if x != nil {
+		return x.MembersOnline
+	}
+	return 0
+}
+
+func (x *GuildsLeaderboardEntry) GetLevelTotal() int64 {
+	if x != nil {
+		return x.LevelTotal
+	}
+	return 0
+}
+
+func (x *GuildsLeaderboardEntry) GetLevelAverage() float64 {
+	if x != nil {
+		return x.LevelAverage
+	}
+	return 0
+}
+
+var File_guilds_leaderboard_proto protoreflect.FileDescriptor
+
+const file_guilds_leaderboard_proto_rawDesc = "" +
+	"\n" +
+	"\x18guilds_leaderboard.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\xa8\x01\n" +
+	"\x19GuildsLeaderboardResponse\x12N\n" +
+	"\x12guilds_leaderboard\x18\x01 \x01(\v2\x1f.tibiadata.v4.GuildsLeaderboardR\x11guildsLeaderboard\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xba\x01\n" +
+	"\x11GuildsLeaderboard\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\x12\x12\n" +
+	"\x04sort\x18\x02 \x01(\tR\x04sort\x12\x18\n" +
+	"\afetched\x18\x03 \x01(\tR\afetched\x12<\n" +
+	"\x06guilds\x18\x04 \x03(\v2$.tibiadata.v4.GuildsLeaderboardEntryR\x06guilds\x12#\n" +
+	"\rfailed_guilds\x18\x05 \x03(\tR\ffailedGuilds\"\xed\x01\n" +
+	"\x16GuildsLeaderboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x03R\x04rank\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\blogo_url\x18\x03 \x01(\tR\alogoUrl\x12#\n" +
+	"\rmembers_total\x18\x04 \x01(\x03R\fmembersTotal\x12%\n" +
+	"\x0emembers_online\x18\x05 \x01(\x03R\rmembersOnline\x12\x1f\n" +
+	"\vlevel_total\x18\x06 \x01(\x03R\n" +
+	"levelTotal\x12#\n" +
+	"\rlevel_average\x18\a \x01(\x01R\flevelAverageB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_guilds_leaderboard_proto_rawDescOnce sync.Once
+	file_guilds_leaderboard_proto_rawDescData []byte
+)
+
+func file_guilds_leaderboard_proto_rawDescGZIP() []byte {
+	file_guilds_leaderboard_proto_rawDescOnce.Do(func() {
+		file_guilds_leaderboard_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_guilds_leaderboard_proto_rawDesc), len(file_guilds_leaderboard_proto_rawDesc)))
+	})
+	return file_guilds_leaderboard_proto_rawDescData
+}
+
+var file_guilds_leaderboard_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_guilds_leaderboard_proto_goTypes = []any{
+	(*GuildsLeaderboardResponse)(nil), // 0: tibiadata.v4.GuildsLeaderboardResponse
+	(*GuildsLeaderboard)(nil),         // 1: tibiadata.v4.GuildsLeaderboard
+	(*GuildsLeaderboardEntry)(nil),    // 2: tibiadata.v4.GuildsLeaderboardEntry
+	(*Information)(nil),               // 3: tibiadata.v4.Information
+}
+var file_guilds_leaderboard_proto_depIdxs = []int32{
+	1, // 0: tibiadata.v4.GuildsLeaderboardResponse.guilds_leaderboard:type_name -> tibiadata.v4.GuildsLeaderboard
+	3, // 1: tibiadata.v4.GuildsLeaderboardResponse.information:type_name -> tibiadata.v4.Information
+	2, // 2: tibiadata.v4.GuildsLeaderboard.guilds:type_name -> tibiadata.v4.GuildsLeaderboardEntry
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_guilds_leaderboard_proto_init() }
+func file_guilds_leaderboard_proto_init() {
+	if File_guilds_leaderboard_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guilds_leaderboard_proto_rawDesc), len(file_guilds_leaderboard_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_guilds_leaderboard_proto_goTypes,
+		DependencyIndexes: file_guilds_leaderboard_proto_depIdxs,
+		MessageInfos:      file_guilds_leaderboard_proto_msgTypes,
+	}.Build()
+	File_guilds_leaderboard_proto = out.File
+	file_guilds_leaderboard_proto_goTypes = nil
+	file_guilds_leaderboard_proto_depIdxs = nil
+}
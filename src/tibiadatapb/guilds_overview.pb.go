@@ -0,0 +1,270 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: guilds_overview.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: Guilds and Information
+type GuildsOverviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guilds        *OverviewGuilds        `protobuf:"bytes,1,opt,name=guilds,proto3" json:"guilds,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildsOverviewResponse) Reset() {
+	*x = GuildsOverviewResponse{}
+	mi := &file_guilds_overview_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildsOverviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildsOverviewResponse) ProtoMessage() {}
+
+func (x *GuildsOverviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_overview_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildsOverviewResponse.ProtoReflect.Descriptor instead.
+func (*GuildsOverviewResponse) Descriptor() ([]byte, []int) {
+	return file_guilds_overview_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GuildsOverviewResponse) GetGuilds() *OverviewGuilds {
+	if x != nil {
+		return x.Guilds
+	}
+	return nil
+}
+
+func (x *GuildsOverviewResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of Guilds
+type OverviewGuild struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                      // The name of the guild.
+	LogoUrl       string                 `protobuf:"bytes,2,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"` // The URL to the guild's logo.
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`        // The description of the guild.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OverviewGuild) Reset() {
+	*x = OverviewGuild{}
+	mi := &file_guilds_overview_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverviewGuild) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverviewGuild) ProtoMessage() {}
+
+func (x *OverviewGuild) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_overview_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverviewGuild.ProtoReflect.Descriptor instead.
+func (*OverviewGuild) Descriptor() ([]byte, []int) {
+	return file_guilds_overview_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OverviewGuild) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OverviewGuild) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *OverviewGuild) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Child of JSONData
+type OverviewGuilds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         string                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`         // The world the guilds belongs to.
+	Active        []*OverviewGuild       `protobuf:"bytes,2,rep,name=active,proto3" json:"active,omitempty"`       // List of active guilds.
+	Formation     []*OverviewGuild       `protobuf:"bytes,3,rep,name=formation,proto3" json:"formation,omitempty"` // List of guilds under formation.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OverviewGuilds) Reset() {
+	*x = OverviewGuilds{}
+	mi := &file_guilds_overview_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverviewGuilds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverviewGuilds) ProtoMessage() {}
+
+func (x *OverviewGuilds) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_overview_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverviewGuilds.ProtoReflect.Descriptor instead.
+func (*OverviewGuilds) Descriptor() ([]byte, []int) {
+	return file_guilds_overview_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OverviewGuilds) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *OverviewGuilds) GetActive() []*OverviewGuild {
+	if x != nil {
+		return x.Active
+	}
+	return nil
+}
+
+func (x *OverviewGuilds) GetFormation() []*OverviewGuild {
+	if x != nil {
+		return x.Formation
+	}
+	return nil
+}
+
+var File_guilds_overview_proto protoreflect.FileDescriptor
+
+const file_guilds_overview_proto_rawDesc = "" +
+	"\n" +
+	"\x15guilds_overview.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\x8b\x01\n" +
+	"\x16GuildsOverviewResponse\x124\n" +
+	"\x06guilds\x18\x01 \x01(\v2\x1c.tibiadata.v4.OverviewGuildsR\x06guilds\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"`\n" +
+	"\rOverviewGuild\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\blogo_url\x18\x02 \x01(\tR\alogoUrl\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"\x96\x01\n" +
+	"\x0eOverviewGuilds\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\x123\n" +
+	"\x06active\x18\x02 \x03(\v2\x1b.tibiadata.v4.OverviewGuildR\x06active\x129\n" +
+	"\tformation\x18\x03 \x03(\v2\x1b.tibiadata.v4.OverviewGuildR\tformationB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_guilds_overview_proto_rawDescOnce sync.Once
+	file_guilds_overview_proto_rawDescData []byte
+)
+
+func file_guilds_overview_proto_rawDescGZIP() []byte {
+	file_guilds_overview_proto_rawDescOnce.Do(func() {
+		file_guilds_overview_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_guilds_overview_proto_rawDesc), len(file_guilds_overview_proto_rawDesc)))
+	})
+	return file_guilds_overview_proto_rawDescData
+}
+
+var file_guilds_overview_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_guilds_overview_proto_goTypes = []any{
+	(*GuildsOverviewResponse)(nil), // 0: tibiadata.v4.GuildsOverviewResponse
+	(*OverviewGuild)(nil),          // 1: tibiadata.v4.OverviewGuild
+	(*OverviewGuilds)(nil),         // 2: tibiadata.v4.OverviewGuilds
+	(*Information)(nil),            // 3: tibiadata.v4.Information
+}
+var file_guilds_overview_proto_depIdxs = []int32{
+	2, // 0: tibiadata.v4.GuildsOverviewResponse.guilds:type_name -> tibiadata.v4.OverviewGuilds
+	3, // 1: tibiadata.v4.GuildsOverviewResponse.information:type_name -> tibiadata.v4.Information
+	1, // 2: tibiadata.v4.OverviewGuilds.active:type_name -> tibiadata.v4.OverviewGuild
+	1, // 3: tibiadata.v4.OverviewGuilds.formation:type_name -> tibiadata.v4.OverviewGuild
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_guilds_overview_proto_init() }
+func file_guilds_overview_proto_init() {
+	if File_guilds_overview_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guilds_overview_proto_rawDesc), len(file_guilds_overview_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_guilds_overview_proto_goTypes,
+		DependencyIndexes: file_guilds_overview_proto_depIdxs,
+		MessageInfos:      file_guilds_overview_proto_msgTypes,
+	}.Build()
+	File_guilds_overview_proto = out.File
+	file_guilds_overview_proto_goTypes = nil
+	file_guilds_overview_proto_depIdxs = nil
+}
@@ -0,0 +1,669 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: guilds_statistics.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: GuildStatistics and Information
+type GuildStatisticsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GuildStatistics *GuildStatistics       `protobuf:"bytes,1,opt,name=guild_statistics,json=guildStatistics,proto3" json:"guild_statistics,omitempty"`
+	Information     *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GuildStatisticsResponse) Reset() {
+	*x = GuildStatisticsResponse{}
+	mi := &file_guilds_statistics_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildStatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildStatisticsResponse) ProtoMessage() {}
+
+func (x *GuildStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_statistics_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*GuildStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_guilds_statistics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GuildStatisticsResponse) GetGuildStatistics() *GuildStatistics {
+	if x != nil {
+		return x.GuildStatistics
+	}
+	return nil
+}
+
+func (x *GuildStatisticsResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// The base includes two levels: GuildsStatistics and Information
+type GuildsStatisticsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	GuildsStatistics *GuildsStatistics      `protobuf:"bytes,1,opt,name=guilds_statistics,json=guildsStatistics,proto3" json:"guilds_statistics,omitempty"`
+	Information      *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GuildsStatisticsResponse) Reset() {
+	*x = GuildsStatisticsResponse{}
+	mi := &file_guilds_statistics_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildsStatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildsStatisticsResponse) ProtoMessage() {}
+
+func (x *GuildsStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_statistics_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildsStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*GuildsStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_guilds_statistics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GuildsStatisticsResponse) GetGuildsStatistics() *GuildsStatistics {
+	if x != nil {
+		return x.GuildsStatistics
+	}
+	return nil
+}
+
+func (x *GuildsStatisticsResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type GuildsStatistics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         string                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`                                   // The world of the guilds.
+	Sort          string                 `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"`                                     // The statistic the guilds are ranked by.
+	Guilds        []*GuildStatistics     `protobuf:"bytes,3,rep,name=guilds,proto3" json:"guilds,omitempty"`                                 // The statistics of the active guilds of the world, ranked by sort.
+	FailedGuilds  []string               `protobuf:"bytes,4,rep,name=failed_guilds,json=failedGuilds,proto3" json:"failed_guilds,omitempty"` // The names of the guilds that could not be fetched.
+	Fetched       string                 `protobuf:"bytes,5,opt,name=fetched,proto3" json:"fetched,omitempty"`                               // The time the guilds were fetched from tibia.com.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildsStatistics) Reset() {
+	*x = GuildsStatistics{}
+	mi := &file_guilds_statistics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildsStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildsStatistics) ProtoMessage() {}
+
+func (x *GuildsStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_statistics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildsStatistics.ProtoReflect.Descriptor instead.
+func (*GuildsStatistics) Descriptor() ([]byte, []int) {
+	return file_guilds_statistics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GuildsStatistics) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *GuildsStatistics) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GuildsStatistics) GetGuilds() []*GuildStatistics {
+	if x != nil {
+		return x.Guilds
+	}
+	return nil
+}
+
+func (x *GuildsStatistics) GetFailedGuilds() []string {
+	if x != nil {
+		return x.FailedGuilds
+	}
+	return nil
+}
+
+func (x *GuildsStatistics) GetFetched() string {
+	if x != nil {
+		return x.Fetched
+	}
+	return ""
+}
+
+// Child of JSONData and GuildsStatistics
+type GuildStatistics struct {
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	Rank           int64                     `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`                                           // The rank of the guild on its world (only world statistics).
+	Name           string                    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                            // The name of the guild.
+	World          string                    `protobuf:"bytes,3,opt,name=world,proto3" json:"world,omitempty"`                                          // The world the guild belongs to.
+	MembersTotal   int64                     `protobuf:"varint,4,opt,name=members_total,json=membersTotal,proto3" json:"members_total,omitempty"`       // The number of total members in the guild.
+	MembersOnline  int64                     `protobuf:"varint,5,opt,name=members_online,json=membersOnline,proto3" json:"members_online,omitempty"`    // The number of online members in the guild.
+	MembersInvited int64                     `protobuf:"varint,6,opt,name=members_invited,json=membersInvited,proto3" json:"members_invited,omitempty"` // The number of invited characters.
+	OnlineRatio    float64                   `protobuf:"fixed64,7,opt,name=online_ratio,json=onlineRatio,proto3" json:"online_ratio,omitempty"`         // The share of the members that are online (0 to 1).
+	Vocations      []*GuildStatisticsCount   `protobuf:"bytes,8,rep,name=vocations,proto3" json:"vocations,omitempty"`                                  // The members per vocation (promotions count to their vocation), the most first.
+	Ranks          []*GuildStatisticsCount   `protobuf:"bytes,9,rep,name=ranks,proto3" json:"ranks,omitempty"`                                          // The members per rank, in the order of the guild's ranks.
+	Levels         *GuildStatisticsLevels    `protobuf:"bytes,10,opt,name=levels,proto3" json:"levels,omitempty"`                                       // The distribution of the levels of the members.
+	Invites        []*GuildStatisticsInvites `protobuf:"bytes,11,rep,name=invites,proto3" json:"invites,omitempty"`                                     // The invited characters per day of their invitation, the oldest first.
+	LongestServing []*GuildMember            `protobuf:"bytes,12,rep,name=longest_serving,json=longestServing,proto3" json:"longest_serving,omitempty"` // The members who joined the guild first.
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GuildStatistics) Reset() {
+	*x = GuildStatistics{}
+	mi := &file_guilds_statistics_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildStatistics) ProtoMessage() {}
+
+func (x *GuildStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_statistics_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildStatistics.ProtoReflect.Descriptor instead.
+func (*GuildStatistics) Descriptor() ([]byte, []int) {
+	return file_guilds_statistics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GuildStatistics) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *GuildStatistics) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildStatistics) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *GuildStatistics) GetMembersTotal() int64 {
+	if x != nil {
+		return x.MembersTotal
+	}
+	return 0
+}
+
+func (x *GuildStatistics) GetMembersOnline() int64 {
+	if x != nil {
+		return x.MembersOnline
+	}
+	return 0
+}
+
+func (x *GuildStatistics) GetMembersInvited() int64 {
+	if x != nil {
+		return x.MembersInvited
+	}
+	return 0
+}
+
+func (x *GuildStatistics) GetOnlineRatio() float64 {
+	if x != nil {
+		return x.OnlineRatio
+	}
+	return 0
+}
+
+func (x *GuildStatistics) GetVocations() []*GuildStatisticsCount {
+	if x != nil {
+		return x.Vocations
+	}
+	return nil
+}
+
+func (x *GuildStatistics) GetRanks() []*GuildStatisticsCount {
+	if x != nil {
+		return x.Ranks
+	}
+	return nil
+}
+
+func (x *GuildStatistics) GetLevels() *GuildStatisticsLevels {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+func (x *GuildStatistics) GetInvites() []*GuildStatisticsInvites {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+func (x *GuildStatistics) GetLongestServing() []*GuildMember {
+	if x != nil {
+		return x.LongestServing
+	}
+	return nil
+}
+
+// Child of GuildStatistics
+type GuildStatisticsCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`    // The name of the vocation or rank.
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // The number of members.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildStatisticsCount) Reset() {
+	*x = GuildStatisticsCount{}
+	mi := &file_guilds_statistics_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildStatisticsCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildStatisticsCount) ProtoMessage() {}
+
+func (x *GuildStatisticsCount) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_statistics_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildStatisticsCount.ProtoReflect.Descriptor instead.
+func (*GuildStatisticsCount) Descriptor() ([]byte, []int) {
+	return file_guilds_statistics_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GuildStatisticsCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildStatisticsCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Child of GuildStatistics
+type GuildStatisticsInvites struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`    // The date the characters were invited.
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // The number of characters invited on the date.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildStatisticsInvites) Reset() {
+	*x = GuildStatisticsInvites{}
+	mi := &file_guilds_statistics_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildStatisticsInvites) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildStatisticsInvites) ProtoMessage() {}
+
+func (x *GuildStatisticsInvites) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_statistics_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildStatisticsInvites.ProtoReflect.Descriptor instead.
+func (*GuildStatisticsInvites) Descriptor() ([]byte, []int) {
+	return file_guilds_statistics_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GuildStatisticsInvites) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GuildStatisticsInvites) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Child of GuildStatistics
+type GuildStatisticsLevels struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           int64                  `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`          // The lowest level of the members.
+	Max           int64                  `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`          // The highest level of the members.
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`      // The sum of the levels of the members.
+	Average       float64                `protobuf:"fixed64,4,opt,name=average,proto3" json:"average,omitempty"` // The average level of the members.
+	P10           int64                  `protobuf:"varint,5,opt,name=p10,proto3" json:"p10,omitempty"`          // The 10th percentile of the levels of the members.
+	P25           int64                  `protobuf:"varint,6,opt,name=p25,proto3" json:"p25,omitempty"`          // The 25th percentile of the levels of the members.
+	Median        int64                  `protobuf:"varint,7,opt,name=median,proto3" json:"median,omitempty"`    // The median level of the members.
+	P75           int64                  `protobuf:"varint,8,opt,name=p75,proto3" json:"p75,omitempty"`          // The 75th percentile of the levels of the members.
+	P90           int64                  `protobuf:"varint,9,opt,name=p90,proto3" json:"p90,omitempty"`          // The 90th percentile of the levels of the members.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildStatisticsLevels) Reset() {
+	*x = GuildStatisticsLevels{}
+	mi := &file_guilds_statistics_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildStatisticsLevels) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildStatisticsLevels) ProtoMessage() {}
+
+func (x *GuildStatisticsLevels) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_statistics_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildStatisticsLevels.ProtoReflect.Descriptor instead.
+func (*GuildStatisticsLevels) Descriptor() ([]byte, []int) {
+	return file_guilds_statistics_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GuildStatisticsLevels) GetMin() int64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *GuildStatisticsLevels) GetMax() int64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *GuildStatisticsLevels) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GuildStatisticsLevels) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *GuildStatisticsLevels) GetP10() int64 {
+	if x != nil {
+		return x.P10
+	}
+	return 0
+}
+
+func (x *GuildStatisticsLevels) GetP25() int64 {
+	if x != nil {
+		return x.P25
+	}
+	return 0
+}
+
+func (x *GuildStatisticsLevels) GetMedian() int64 {
+	if x != nil {
+		return x.Median
+	}
+	return 0
+}
+
+func (x *GuildStatisticsLevels) GetP75() int64 {
+	if x != nil {
+		return x.P75
+	}
+	return 0
+}
+
+func (x *GuildStatisticsLevels) GetP90() int64 {
+	if x != nil {
+		return x.P90
+	}
+	return 0
+}
+
+var File_guilds_statistics_proto protoreflect.FileDescriptor
+
+const file_guilds_statistics_proto_rawDesc = "" +
+	"\n" +
+	"\x17guilds_statistics.proto\x12\ftibiadata.v4\x1a\x12guilds_guild.proto\x1a\x11information.proto\"\xa0\x01\n" +
+	"\x17GuildStatisticsResponse\x12H\n" +
+	"\x10guild_statistics\x18\x01 \x01(\v2\x1d.tibiadata.v4.GuildStatisticsR\x0fguildStatistics\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xa4\x01\n" +
+	"\x18GuildsStatisticsResponse\x12K\n" +
+	"\x11guilds_statistics\x18\x01 \x01(\v2\x1e.tibiadata.v4.GuildsStatisticsR\x10guildsStatistics\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xb2\x01\n" +
+	"\x10GuildsStatistics\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\x12\x12\n" +
+	"\x04sort\x18\x02 \x01(\tR\x04sort\x125\n" +
+	"\x06guilds\x18\x03 \x03(\v2\x1d.tibiadata.v4.GuildStatisticsR\x06guilds\x12#\n" +
+	"\rfailed_guilds\x18\x04 \x03(\tR\ffailedGuilds\x12\x18\n" +
+	"\afetched\x18\x05 \x01(\tR\afetched\"\xa4\x04\n" +
+	"\x0fGuildStatistics\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x03R\x04rank\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05world\x18\x03 \x01(\tR\x05world\x12#\n" +
+	"\rmembers_total\x18\x04 \x01(\x03R\fmembersTotal\x12%\n" +
+	"\x0emembers_online\x18\x05 \x01(\x03R\rmembersOnline\x12'\n" +
+	"\x0fmembers_invited\x18\x06 \x01(\x03R\x0emembersInvited\x12!\n" +
+	"\fonline_ratio\x18\a \x01(\x01R\vonlineRatio\x12@\n" +
+	"\tvocations\x18\b \x03(\v2\".tibiadata.v4.GuildStatisticsCountR\tvocations\x128\n" +
+	"\x05ranks\x18\t \x03(\v2\".tibiadata.v4.GuildStatisticsCountR\x05ranks\x12;\n" +
+	"\x06levels\x18\n" +
+	" \x01(\v2#.tibiadata.v4.GuildStatisticsLevelsR\x06levels\x12>\n" +
+	"\ainvites\x18\v \x03(\v2$.tibiadata.v4.GuildStatisticsInvitesR\ainvites\x12B\n" +
+	"\x0flongest_serving\x18\f \x03(\v2\x19.tibiadata.v4.GuildMemberR\x0elongestServing\"@\n" +
+	"\x14GuildStatisticsCount\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"B\n" +
+	"\x16GuildStatisticsInvites\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\xcb\x01\n" +
+	"\x15GuildStatisticsLevels\x12\x10\n" +
+	"\x03min\x18\x01 \x01(\x03R\x03min\x12\x10\n" +
+	"\x03max\x18\x02 \x01(\x03R\x03max\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x18\n" +
+	"\aaverage\x18\x04 \x01(\x01R\aaverage\x12\x10\n" +
+	"\x03p10\x18\x05 \x01(\x03R\x03p10\x12\x10\n" +
+	"\x03p25\x18\x06 \x01(\x03R\x03p25\x12\x16\n" +
+	"\x06median\x18\a \x01(\x03R\x06median\x12\x10\n" +
+	"\x03p75\x18\b \x01(\x03R\x03p75\x12\x10\n" +
+	"\x03p90\x18\t \x01(\x03R\x03p90B7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_guilds_statistics_proto_rawDescOnce sync.Once
+	file_guilds_statistics_proto_rawDescData []byte
+)
+
+func file_guilds_statistics_proto_rawDescGZIP() []byte {
+	file_guilds_statistics_proto_rawDescOnce.Do(func() {
+		file_guilds_statistics_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_guilds_statistics_proto_rawDesc), len(file_guilds_statistics_proto_rawDesc)))
+	})
+	return file_guilds_statistics_proto_rawDescData
+}
+
+var file_guilds_statistics_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_guilds_statistics_proto_goTypes = []any{
+	(*GuildStatisticsResponse)(nil),  // 0: tibiadata.v4.GuildStatisticsResponse
+	(*GuildsStatisticsResponse)(nil), // 1: tibiadata.v4.GuildsStatisticsResponse
+	(*GuildsStatistics)(nil),         // 2: tibiadata.v4.GuildsStatistics
+	(*GuildStatistics)(nil),          // 3: tibiadata.v4.GuildStatistics
+	(*GuildStatisticsCount)(nil),     // 4: tibiadata.v4.GuildStatisticsCount
+	(*GuildStatisticsInvites)(nil),   // 5: tibiadata.v4.GuildStatisticsInvites
+	(*GuildStatisticsLevels)(nil),    // 6: tibiadata.v4.GuildStatisticsLevels
+	(*Information)(nil),              // 7: tibiadata.v4.Information
+	(*GuildMember)(nil),              // 8: tibiadata.v4.GuildMember
+}
+var file_guilds_statistics_proto_depIdxs = []int32{
+	3,  // 0: tibiadata.v4.GuildStatisticsResponse.guild_statistics:type_name -> tibiadata.v4.GuildStatistics
+	7,  // 1: tibiadata.v4.GuildStatisticsResponse.information:type_name -> tibiadata.v4.Information
+	2,  // 2: tibiadata.v4.GuildsStatisticsResponse.guilds_statistics:type_name -> tibiadata.v4.GuildsStatistics
+	7,  // 3: tibiadata.v4.GuildsStatisticsResponse.information:type_name -> tibiadata.v4.Information
+	3,  // 4: tibiadata.v4.GuildsStatistics.guilds:type_name -> tibiadata.v4.GuildStatistics
+	4,  // 5: tibiadata.v4.GuildStatistics.vocations:type_name -> tibiadata.v4.GuildStatisticsCount
+	4,  // 6: tibiadata.v4.GuildStatistics.ranks:type_name -> tibiadata.v4.GuildStatisticsCount
+	6,  // 7: tibiadata.v4.GuildStatistics.levels:type_name -> tibiadata.v4.GuildStatisticsLevels
+	5,  // 8: tibiadata.v4.GuildStatistics.invites:type_name -> tibiadata.v4.GuildStatisticsInvites
+	8,  // 9: tibiadata.v4.GuildStatistics.longest_serving:type_name -> tibiadata.v4.GuildMember
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_guilds_statistics_proto_init() }
+func file_guilds_statistics_proto_init() {
+	if File_guilds_statistics_proto != nil {
+		return
+	}
+	file_guilds_guild_proto_init()
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guilds_statistics_proto_rawDesc), len(file_guilds_statistics_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_guilds_statistics_proto_goTypes,
+		DependencyIndexes: file_guilds_statistics_proto_depIdxs,
+		MessageInfos:      file_guilds_statistics_proto_msgTypes,
+	}.Build()
+	File_guilds_statistics_proto = out.File
+	file_guilds_statistics_proto_goTypes = nil
+	file_guilds_statistics_proto_depIdxs = nil
+}
@@ -0,0 +1,815 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: guilds_war_tracker.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: WarTracker and Information
+type WarTrackerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarTracker    []*TrackedWar          `protobuf:"bytes,1,rep,name=war_tracker,json=warTracker,proto3" json:"war_tracker,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarTrackerResponse) Reset() {
+	*x = WarTrackerResponse{}
+	mi := &file_guilds_war_tracker_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarTrackerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarTrackerResponse) ProtoMessage() {}
+
+func (x *WarTrackerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_war_tracker_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarTrackerResponse.ProtoReflect.Descriptor instead.
+func (*WarTrackerResponse) Descriptor() ([]byte, []int) {
+	return file_guilds_war_tracker_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WarTrackerResponse) GetWarTracker() []*TrackedWar {
+	if x != nil {
+		return x.WarTracker
+	}
+	return nil
+}
+
+func (x *WarTrackerResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of WarTrackerResponse
+type TrackedWar struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                  // The id of the war.
+	Guild         string                 `protobuf:"bytes,2,opt,name=guild,proto3" json:"guild,omitempty"`                             // The name of the first guild.
+	Opponent      string                 `protobuf:"bytes,3,opt,name=opponent,proto3" json:"opponent,omitempty"`                       // The name of the second guild.
+	World         string                 `protobuf:"bytes,4,opt,name=world,proto3" json:"world,omitempty"`                             // The world of the guilds.
+	Added         string                 `protobuf:"bytes,5,opt,name=added,proto3" json:"added,omitempty"`                             // The time the war was added to the tracker, earlier deaths are not counted.
+	LastPolled    string                 `protobuf:"bytes,6,opt,name=last_polled,json=lastPolled,proto3" json:"last_polled,omitempty"` // The time the war was last polled successfully.
+	Error         *Status                `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`                             // The error of the last poll (if it failed).
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackedWar) Reset() {
+	*x = TrackedWar{}
+	mi := &file_guilds_war_tracker_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackedWar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackedWar) ProtoMessage() {}
+
+func (x *TrackedWar) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_war_tracker_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackedWar.ProtoReflect.Descriptor instead.
+func (*TrackedWar) Descriptor() ([]byte, []int) {
+	return file_guilds_war_tracker_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TrackedWar) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TrackedWar) GetGuild() string {
+	if x != nil {
+		return x.Guild
+	}
+	return ""
+}
+
+func (x *TrackedWar) GetOpponent() string {
+	if x != nil {
+		return x.Opponent
+	}
+	return ""
+}
+
+func (x *TrackedWar) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *TrackedWar) GetAdded() string {
+	if x != nil {
+		return x.Added
+	}
+	return ""
+}
+
+func (x *TrackedWar) GetLastPolled() string {
+	if x != nil {
+		return x.LastPolled
+	}
+	return ""
+}
+
+func (x *TrackedWar) GetError() *Status {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// Child of WarKills
+type WarKill struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`                                  // The time of the death.
+	Guild         string                 `protobuf:"bytes,2,opt,name=guild,proto3" json:"guild,omitempty"`                                // The guild that scored the kill.
+	Victim        string                 `protobuf:"bytes,3,opt,name=victim,proto3" json:"victim,omitempty"`                              // The name of the member that died.
+	VictimGuild   string                 `protobuf:"bytes,4,opt,name=victim_guild,json=victimGuild,proto3" json:"victim_guild,omitempty"` // The guild of the member that died.
+	Level         int64                  `protobuf:"varint,5,opt,name=level,proto3" json:"level,omitempty"`                               // The level of the member when it died.
+	Killers       []string               `protobuf:"bytes,6,rep,name=killers,proto3" json:"killers,omitempty"`                            // The members of the scoring guild that killed the member.
+	Assists       []string               `protobuf:"bytes,7,rep,name=assists,proto3" json:"assists,omitempty"`                            // The members of the scoring guild that assisted.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarKill) Reset() {
+	*x = WarKill{}
+	mi := &file_guilds_war_tracker_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarKill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarKill) ProtoMessage() {}
+
+func (x *WarKill) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_war_tracker_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarKill.ProtoReflect.Descriptor instead.
+func (*WarKill) Descriptor() ([]byte, []int) {
+	return file_guilds_war_tracker_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WarKill) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *WarKill) GetGuild() string {
+	if x != nil {
+		return x.Guild
+	}
+	return ""
+}
+
+func (x *WarKill) GetVictim() string {
+	if x != nil {
+		return x.Victim
+	}
+	return ""
+}
+
+func (x *WarKill) GetVictimGuild() string {
+	if x != nil {
+		return x.VictimGuild
+	}
+	return ""
+}
+
+func (x *WarKill) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *WarKill) GetKillers() []string {
+	if x != nil {
+		return x.Killers
+	}
+	return nil
+}
+
+func (x *WarKill) GetAssists() []string {
+	if x != nil {
+		return x.Assists
+	}
+	return nil
+}
+
+// The base includes two levels: WarKills and Information
+type WarKillsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarKills      *WarKills              `protobuf:"bytes,1,opt,name=war_kills,json=warKills,proto3" json:"war_kills,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarKillsResponse) Reset() {
+	*x = WarKillsResponse{}
+	mi := &file_guilds_war_tracker_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarKillsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarKillsResponse) ProtoMessage() {}
+
+func (x *WarKillsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_war_tracker_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarKillsResponse.ProtoReflect.Descriptor instead.
+func (*WarKillsResponse) Descriptor() ([]byte, []int) {
+	return file_guilds_war_tracker_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WarKillsResponse) GetWarKills() *WarKills {
+	if x != nil {
+		return x.WarKills
+	}
+	return nil
+}
+
+func (x *WarKillsResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type WarKills struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                  // The id of the war.
+	Guild         string                 `protobuf:"bytes,2,opt,name=guild,proto3" json:"guild,omitempty"`                             // The name of the first guild.
+	Opponent      string                 `protobuf:"bytes,3,opt,name=opponent,proto3" json:"opponent,omitempty"`                       // The name of the second guild.
+	From          string                 `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`                               // The start of the period.
+	To            string                 `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`                                   // The end of the period.
+	LastPolled    string                 `protobuf:"bytes,6,opt,name=last_polled,json=lastPolled,proto3" json:"last_polled,omitempty"` // The time the war was last polled successfully.
+	Kills         []*WarKill             `protobuf:"bytes,7,rep,name=kills,proto3" json:"kills,omitempty"`                             // The kills of the period, the latest first.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarKills) Reset() {
+	*x = WarKills{}
+	mi := &file_guilds_war_tracker_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarKills) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarKills) ProtoMessage() {}
+
+func (x *WarKills) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_war_tracker_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarKills.ProtoReflect.Descriptor instead.
+func (*WarKills) Descriptor() ([]byte, []int) {
+	return file_guilds_war_tracker_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WarKills) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WarKills) GetGuild() string {
+	if x != nil {
+		return x.Guild
+	}
+	return ""
+}
+
+func (x *WarKills) GetOpponent() string {
+	if x != nil {
+		return x.Opponent
+	}
+	return ""
+}
+
+func (x *WarKills) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *WarKills) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *WarKills) GetLastPolled() string {
+	if x != nil {
+		return x.LastPolled
+	}
+	return ""
+}
+
+func (x *WarKills) GetKills() []*WarKill {
+	if x != nil {
+		return x.Kills
+	}
+	return nil
+}
+
+// Child of WarScoreboard
+type WarScoreboardGuild struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`      // The name of the guild.
+	Kills         int64                  `protobuf:"varint,2,opt,name=kills,proto3" json:"kills,omitempty"`   // The number of members of the other guild killed by the guild.
+	Deaths        int64                  `protobuf:"varint,3,opt,name=deaths,proto3" json:"deaths,omitempty"` // The number of members of the guild killed by the other guild.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarScoreboardGuild) Reset() {
+	*x = WarScoreboardGuild{}
+	mi := &file_guilds_war_tracker_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarScoreboardGuild) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarScoreboardGuild) ProtoMessage() {}
+
+func (x *WarScoreboardGuild) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_war_tracker_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarScoreboardGuild.ProtoReflect.Descriptor instead.
+func (*WarScoreboardGuild) Descriptor() ([]byte, []int) {
+	return file_guilds_war_tracker_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WarScoreboardGuild) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WarScoreboardGuild) GetKills() int64 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *WarScoreboardGuild) GetDeaths() int64 {
+	if x != nil {
+		return x.Deaths
+	}
+	return 0
+}
+
+// Child of WarScoreboard
+type WarScoreboardCharacter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`        // The name of the character.
+	Guild         string                 `protobuf:"bytes,2,opt,name=guild,proto3" json:"guild,omitempty"`      // The guild of the character.
+	Kills         int64                  `protobuf:"varint,3,opt,name=kills,proto3" json:"kills,omitempty"`     // The number of kills the character was a killer of.
+	Assists       int64                  `protobuf:"varint,4,opt,name=assists,proto3" json:"assists,omitempty"` // The number of kills the character assisted.
+	Deaths        int64                  `protobuf:"varint,5,opt,name=deaths,proto3" json:"deaths,omitempty"`   // The number of times the character was killed.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarScoreboardCharacter) Reset() {
+	*x = WarScoreboardCharacter{}
+	mi := &file_guilds_war_tracker_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarScoreboardCharacter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarScoreboardCharacter) ProtoMessage() {}
+
+func (x *WarScoreboardCharacter) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_war_tracker_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarScoreboardCharacter.ProtoReflect.Descriptor instead.
+func (*WarScoreboardCharacter) Descriptor() ([]byte, []int) {
+	return file_guilds_war_tracker_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WarScoreboardCharacter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WarScoreboardCharacter) GetGuild() string {
+	if x != nil {
+		return x.Guild
+	}
+	return ""
+}
+
+func (x *WarScoreboardCharacter) GetKills() int64 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *WarScoreboardCharacter) GetAssists() int64 {
+	if x != nil {
+		return x.Assists
+	}
+	return 0
+}
+
+func (x *WarScoreboardCharacter) GetDeaths() int64 {
+	if x != nil {
+		return x.Deaths
+	}
+	return 0
+}
+
+// The base includes two levels: WarScoreboard and Information
+type WarScoreboardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarScoreboard *WarScoreboard         `protobuf:"bytes,1,opt,name=war_scoreboard,json=warScoreboard,proto3" json:"war_scoreboard,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarScoreboardResponse) Reset() {
+	*x = WarScoreboardResponse{}
+	mi := &file_guilds_war_tracker_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarScoreboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarScoreboardResponse) ProtoMessage() {}
+
+func (x *WarScoreboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_war_tracker_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarScoreboardResponse.ProtoReflect.Descriptor instead.
+func (*WarScoreboardResponse) Descriptor() ([]byte, []int) {
+	return file_guilds_war_tracker_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WarScoreboardResponse) GetWarScoreboard() *WarScoreboard {
+	if x != nil {
+		return x.WarScoreboard
+	}
+	return nil
+}
+
+func (x *WarScoreboardResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type WarScoreboard struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Id            int64                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                  // The id of the war.
+	World         string                    `protobuf:"bytes,2,opt,name=world,proto3" json:"world,omitempty"`                             // The world of the guilds.
+	Added         string                    `protobuf:"bytes,3,opt,name=added,proto3" json:"added,omitempty"`                             // The time the war was added to the tracker.
+	LastPolled    string                    `protobuf:"bytes,4,opt,name=last_polled,json=lastPolled,proto3" json:"last_polled,omitempty"` // The time the war was last polled successfully.
+	Guilds        []*WarScoreboardGuild     `protobuf:"bytes,5,rep,name=guilds,proto3" json:"guilds,omitempty"`                           // The kills and deaths of both guilds.
+	Characters    []*WarScoreboardCharacter `protobuf:"bytes,6,rep,name=characters,proto3" json:"characters,omitempty"`                   // The characters with kills, assists or deaths, the most kills first.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarScoreboard) Reset() {
+	*x = WarScoreboard{}
+	mi := &file_guilds_war_tracker_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarScoreboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarScoreboard) ProtoMessage() {}
+
+func (x *WarScoreboard) ProtoReflect() protoreflect.Message {
+	mi := &file_guilds_war_tracker_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarScoreboard.ProtoReflect.Descriptor instead.
+func (*WarScoreboard) Descriptor() ([]byte, []int) {
+	return file_guilds_war_tracker_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WarScoreboard) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WarScoreboard) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *WarScoreboard) GetAdded() string {
+	if x != nil {
+		return x.Added
+	}
+	return ""
+}
+
+func (x *WarScoreboard) GetLastPolled() string {
+	if x != nil {
+		return x.LastPolled
+	}
+	return ""
+}
+
+func (x *WarScoreboard) GetGuilds() []*WarScoreboardGuild {
+	if x != nil {
+		return x.Guilds
+	}
+	return nil
+}
+
+func (x *WarScoreboard) GetCharacters() []*WarScoreboardCharacter {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+var File_guilds_war_tracker_proto protoreflect.FileDescriptor
+
+const file_guilds_war_tracker_proto_rawDesc = "" +
+	"\n" +
+	"\x18guilds_war_tracker.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\x8c\x01\n" +
+	"\x12WarTrackerResponse\x129\n" +
+	"\vwar_tracker\x18\x01 \x03(\v2\x18.tibiadata.v4.TrackedWarR\n" +
+	"warTracker\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xc7\x01\n" +
+	"\n" +
+	"TrackedWar\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05guild\x18\x02 \x01(\tR\x05guild\x12\x1a\n" +
+	"\bopponent\x18\x03 \x01(\tR\bopponent\x12\x14\n" +
+	"\x05world\x18\x04 \x01(\tR\x05world\x12\x14\n" +
+	"\x05added\x18\x05 \x01(\tR\x05added\x12\x1f\n" +
+	"\vlast_polled\x18\x06 \x01(\tR\n" +
+	"lastPolled\x12*\n" +
+	"\x05error\x18\a \x01(\v2\x14.tibiadata.v4.StatusR\x05error\"\xb8\x01\n" +
+	"\aWarKill\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\tR\x04time\x12\x14\n" +
+	"\x05guild\x18\x02 \x01(\tR\x05guild\x12\x16\n" +
+	"\x06victim\x18\x03 \x01(\tR\x06victim\x12!\n" +
+	"\fvictim_guild\x18\x04 \x01(\tR\vvictimGuild\x12\x14\n" +
+	"\x05level\x18\x05 \x01(\x03R\x05level\x12\x18\n" +
+	"\akillers\x18\x06 \x03(\tR\akillers\x12\x18\n" +
+	"\aassists\x18\a \x03(\tR\aassists\"\x84\x01\n" +
+	"\x10WarKillsResponse\x123\n" +
+	"\twar_kills\x18\x01 \x01(\v2\x16.tibiadata.v4.WarKillsR\bwarKills\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xbe\x01\n" +
+	"\bWarKills\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05guild\x18\x02 \x01(\tR\x05guild\x12\x1a\n" +
+	"\bopponent\x18\x03 \x01(\tR\bopponent\x12\x12\n" +
+	"\x04from\x18\x04 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x05 \x01(\tR\x02to\x12\x1f\n" +
+	"\vlast_polled\x18\x06 \x01(\tR\n" +
+	"lastPolled\x12+\n" +
+	"\x05kills\x18\a \x03(\v2\x15.tibiadata.v4.WarKillR\x05kills\"V\n" +
+	"\x12WarScoreboardGuild\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05kills\x18\x02 \x01(\x03R\x05kills\x12\x16\n" +
+	"\x06deaths\x18\x03 \x01(\x03R\x06deaths\"\x8a\x01\n" +
+	"\x16WarScoreboardCharacter\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05guild\x18\x02 \x01(\tR\x05guild\x12\x14\n" +
+	"\x05kills\x18\x03 \x01(\x03R\x05kills\x12\x18\n" +
+	"\aassists\x18\x04 \x01(\x03R\aassists\x12\x16\n" +
+	"\x06deaths\x18\x05 \x01(\x03R\x06deaths\"\x98\x01\n" +
+	"\x15WarScoreboardResponse\x12B\n" +
+	"\x0ewar_scoreboard\x18\x01 \x01(\v2\x1b.tibiadata.v4.WarScoreboardR\rwarScoreboard\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xec\x01\n" +
+	"\rWarScoreboard\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05world\x18\x02 \x01(\tR\x05world\x12\x14\n" +
+	"\x05added\x18\x03 \x01(\tR\x05added\x12\x1f\n" +
+	"\vlast_polled\x18\x04 \x01(\tR\n" +
+	"lastPolled\x128\n" +
+	"\x06guilds\x18\x05 \x03(\v2 .tibiadata.v4.WarScoreboardGuildR\x06guilds\x12D\n" +
+	"\n" +
+	"characters\x18\x06 \x03(\v2$.tibiadata.v4.WarScoreboardCharacterR\n" +
+	"charactersB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_guilds_war_tracker_proto_rawDescOnce sync.Once
+	file_guilds_war_tracker_proto_rawDescData []byte
+)
+
+func file_guilds_war_tracker_proto_rawDescGZIP() []byte {
+	file_guilds_war_tracker_proto_rawDescOnce.Do(func() {
+		file_guilds_war_tracker_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_guilds_war_tracker_proto_rawDesc), len(file_guilds_war_tracker_proto_rawDesc)))
+	})
+	return file_guilds_war_tracker_proto_rawDescData
+}
+
+var file_guilds_war_tracker_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_guilds_war_tracker_proto_goTypes = []any{
+	(*WarTrackerResponse)(nil),     // 0: tibiadata.v4.WarTrackerResponse
+	(*TrackedWar)(nil),             // 1: tibiadata.v4.TrackedWar
+	(*WarKill)(nil),                // 2: tibiadata.v4.WarKill
+	(*WarKillsResponse)(nil),       // 3: tibiadata.v4.WarKillsResponse
+	(*WarKills)(nil),               // 4: tibiadata.v4.WarKills
+	(*WarScoreboardGuild)(nil),     // 5: tibiadata.v4.WarScoreboardGuild
+	(*WarScoreboardCharacter)(nil), // 6: tibiadata.v4.WarScoreboardCharacter
+	(*WarScoreboardResponse)(nil),  // 7: tibiadata.v4.WarScoreboardResponse
+	(*WarScoreboard)(nil),          // 8: tibiadata.v4.WarScoreboard
+	(*Information)(nil),            // 9: tibiadata.v4.Information
+	(*Status)(nil),                 // 10: tibiadata.v4.Status
+}
+var file_guilds_war_tracker_proto_depIdxs = []int32{
+	1,  // 0: tibiadata.v4.WarTrackerResponse.war_tracker:type_name -> tibiadata.v4.TrackedWar
+	9,  // 1: tibiadata.v4.WarTrackerResponse.information:type_name -> tibiadata.v4.Information
+	10, // 2: tibiadata.v4.TrackedWar.error:type_name -> tibiadata.v4.Status
+	4,  // 3: tibiadata.v4.WarKillsResponse.war_kills:type_name -> tibiadata.v4.WarKills
+	9,  // 4: tibiadata.v4.WarKillsResponse.information:type_name -> tibiadata.v4.Information
+	2,  // 5: tibiadata.v4.WarKills.kills:type_name -> tibiadata.v4.WarKill
+	8,  // 6: tibiadata.v4.WarScoreboardResponse.war_scoreboard:type_name -> tibiadata.v4.WarScoreboard
+	9,  // 7: tibiadata.v4.WarScoreboardResponse.information:type_name -> tibiadata.v4.Information
+	5,  // 8: tibiadata.v4.WarScoreboard.guilds:type_name -> tibiadata.v4.WarScoreboardGuild
+	6,  // 9: tibiadata.v4.WarScoreboard.characters:type_name -> tibiadata.v4.WarScoreboardCharacter
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_guilds_war_tracker_proto_init() }
+func file_guilds_war_tracker_proto_init() {
+	if File_guilds_war_tracker_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guilds_war_tracker_proto_rawDesc), len(file_guilds_war_tracker_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_guilds_war_tracker_proto_goTypes,
+		DependencyIndexes: file_guilds_war_tracker_proto_depIdxs,
+		MessageInfos:      file_guilds_war_tracker_proto_msgTypes,
+	}.Build()
+	File_guilds_war_tracker_proto = out.File
+	file_guilds_war_tracker_proto_goTypes = nil
+	file_guilds_war_tracker_proto_depIdxs = nil
+}
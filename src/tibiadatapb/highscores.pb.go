@@ -0,0 +1,564 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: highscores.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: Highscores and Information
+type HighscoresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Highscores    *Highscores            `protobuf:"bytes,1,opt,name=highscores,proto3" json:"highscores,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HighscoresResponse) Reset() {
+	*x = HighscoresResponse{}
+	mi := &file_highscores_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighscoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighscoresResponse) ProtoMessage() {}
+
+func (x *HighscoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_highscores_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighscoresResponse.ProtoReflect.Descriptor instead.
+func (*HighscoresResponse) Descriptor() ([]byte, []int) {
+	return file_highscores_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HighscoresResponse) GetHighscores() *Highscores {
+	if x != nil {
+		return x.Highscores
+	}
+	return nil
+}
+
+func (x *HighscoresResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// The base includes three levels: Highscores, FailedPages and Information
+type HighscoresAllResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Highscores    *Highscores             `protobuf:"bytes,1,opt,name=highscores,proto3" json:"highscores,omitempty"`                      // The highscores of all pages.
+	FailedPages   []*HighscoresFailedPage `protobuf:"bytes,2,rep,name=failed_pages,json=failedPages,proto3" json:"failed_pages,omitempty"` // The pages that could not be fetched.
+	Information   *Information            `protobuf:"bytes,3,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HighscoresAllResponse) Reset() {
+	*x = HighscoresAllResponse{}
+	mi := &file_highscores_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighscoresAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighscoresAllResponse) ProtoMessage() {}
+
+func (x *HighscoresAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_highscores_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighscoresAllResponse.ProtoReflect.Descriptor instead.
+func (*HighscoresAllResponse) Descriptor() ([]byte, []int) {
+	return file_highscores_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HighscoresAllResponse) GetHighscores() *Highscores {
+	if x != nil {
+		return x.Highscores
+	}
+	return nil
+}
+
+func (x *HighscoresAllResponse) GetFailedPages() []*HighscoresFailedPage {
+	if x != nil {
+		return x.FailedPages
+	}
+	return nil
+}
+
+func (x *HighscoresAllResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of HighscoresAllResponse
+type HighscoresFailedPage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`  // The page that could not be fetched.
+	Error         *Status                `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"` // The error of the page.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HighscoresFailedPage) Reset() {
+	*x = HighscoresFailedPage{}
+	mi := &file_highscores_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighscoresFailedPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighscoresFailedPage) ProtoMessage() {}
+
+func (x *HighscoresFailedPage) ProtoReflect() protoreflect.Message {
+	mi := &file_highscores_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighscoresFailedPage.ProtoReflect.Descriptor instead.
+func (*HighscoresFailedPage) Descriptor() ([]byte, []int) {
+	return file_highscores_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HighscoresFailedPage) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *HighscoresFailedPage) GetError() *Status {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// Child of Highscores
+type Highscore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int64                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`                                       // The character's rank/postition.
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                        // The name of the character.
+	Vocation      string                 `protobuf:"bytes,3,opt,name=vocation,proto3" json:"vocation,omitempty"`                                // The character's vocation.
+	World         string                 `protobuf:"bytes,4,opt,name=world,proto3" json:"world,omitempty"`                                      // The character's world.
+	Level         int64                  `protobuf:"varint,5,opt,name=level,proto3" json:"level,omitempty"`                                     // The character's level.
+	Value         int64                  `protobuf:"varint,6,opt,name=value,proto3" json:"value,omitempty"`                                     // The character's value for the highscores or loyalty points.
+	Title         string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`                                      // The character's loyalty title. (when category: loyalty)
+	LevelProgress *LevelProgress         `protobuf:"bytes,8,opt,name=level_progress,json=levelProgress,proto3" json:"level_progress,omitempty"` // The progress towards the next level. (when category: experience)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Highscore) Reset() {
+	*x = Highscore{}
+	mi := &file_highscores_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Highscore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Highscore) ProtoMessage() {}
+
+func (x *Highscore) ProtoReflect() protoreflect.Message {
+	mi := &file_highscores_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Highscore.ProtoReflect.Descriptor instead.
+func (*Highscore) Descriptor() ([]byte, []int) {
+	return file_highscores_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Highscore) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *Highscore) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Highscore) GetVocation() string {
+	if x != nil {
+		return x.Vocation
+	}
+	return ""
+}
+
+func (x *Highscore) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *Highscore) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Highscore) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Highscore) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Highscore) GetLevelProgress() *LevelProgress {
+	if x != nil {
+		return x.LevelProgress
+	}
+	return nil
+}
+
+// Child of Highscore
+type HighscorePage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPage   int64                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`    // The current page being displayed.
+	TotalPages    int64                  `protobuf:"varint,2,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`       // The total number of pages.
+	TotalRecords  int64                  `protobuf:"varint,3,opt,name=total_records,json=totalRecords,proto3" json:"total_records,omitempty"` // The total amount of highscore records.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HighscorePage) Reset() {
+	*x = HighscorePage{}
+	mi := &file_highscores_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighscorePage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighscorePage) ProtoMessage() {}
+
+func (x *HighscorePage) ProtoReflect() protoreflect.Message {
+	mi := &file_highscores_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighscorePage.ProtoReflect.Descriptor instead.
+func (*HighscorePage) Descriptor() ([]byte, []int) {
+	return file_highscores_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HighscorePage) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *HighscorePage) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *HighscorePage) GetTotalRecords() int64 {
+	if x != nil {
+		return x.TotalRecords
+	}
+	return 0
+}
+
+// Child of JSONData
+type Highscores struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         string                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`                                      // The world the highscores belong to.
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`                                // The selected category being displayed.
+	Vocation      string                 `protobuf:"bytes,3,opt,name=vocation,proto3" json:"vocation,omitempty"`                                // The selected vocation filtered on.
+	HighscoreAge  int64                  `protobuf:"varint,4,opt,name=highscore_age,json=highscoreAge,proto3" json:"highscore_age,omitempty"`   // The age of the highscore page in minutes.
+	HighscoreList []*Highscore           `protobuf:"bytes,5,rep,name=highscore_list,json=highscoreList,proto3" json:"highscore_list,omitempty"` // List of highscore records.
+	HighscorePage *HighscorePage         `protobuf:"bytes,6,opt,name=highscore_page,json=highscorePage,proto3" json:"highscore_page,omitempty"` // Information of highscore pages.
+	WorldTypes    []string               `protobuf:"bytes,7,rep,name=world_types,json=worldTypes,proto3" json:"world_types,omitempty"`          // The world types filtered on (only on all worlds).
+	Battleye      string                 `protobuf:"bytes,8,opt,name=battleye,proto3" json:"battleye,omitempty"`                                // The battleye protection filtered on (only on all worlds).
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Highscores) Reset() {
+	*x = Highscores{}
+	mi := &file_highscores_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Highscores) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Highscores) ProtoMessage() {}
+
+func (x *Highscores) ProtoReflect() protoreflect.Message {
+	mi := &file_highscores_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Highscores.ProtoReflect.Descriptor instead.
+func (*Highscores) Descriptor() ([]byte, []int) {
+	return file_highscores_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Highscores) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *Highscores) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Highscores) GetVocation() string {
+	if x != nil {
+		return x.Vocation
+	}
+	return ""
+}
+
+func (x *Highscores) GetHighscoreAge() int64 {
+	if x != nil {
+		return x.HighscoreAge
+	}
+	return 0
+}
+
+func (x *Highscores) GetHighscoreList() []*Highscore {
+	if x != nil {
+		return x.HighscoreList
+	}
+	return nil
+}
+
+func (x *Highscores) GetHighscorePage() *HighscorePage {
+	if x != nil {
+		return x.HighscorePage
+	}
+	return nil
+}
+
+func (x *Highscores) GetWorldTypes() []string {
+	if x != nil {
+		return x.WorldTypes
+	}
+	return nil
+}
+
+func (x *Highscores) GetBattleye() string {
+	if x != nil {
+		return x.Battleye
+	}
+	return ""
+}
+
+var File_highscores_proto protoreflect.FileDescriptor
+
+const file_highscores_proto_rawDesc = "" +
+	"\n" +
+	"\x10highscores.proto\x12\ftibiadata.v4\x1a\x10experience.proto\x1a\x11information.proto\"\x8b\x01\n" +
+	"\x12HighscoresResponse\x128\n" +
+	"\n" +
+	"highscores\x18\x01 \x01(\v2\x18.tibiadata.v4.HighscoresR\n" +
+	"highscores\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xd5\x01\n" +
+	"\x15HighscoresAllResponse\x128\n" +
+	"\n" +
+	"highscores\x18\x01 \x01(\v2\x18.tibiadata.v4.HighscoresR\n" +
+	"highscores\x12E\n" +
+	"\ffailed_pages\x18\x02 \x03(\v2\".tibiadata.v4.HighscoresFailedPageR\vfailedPages\x12;\n" +
+	"\vinformation\x18\x03 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"V\n" +
+	"\x14HighscoresFailedPage\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x03R\x04page\x12*\n" +
+	"\x05error\x18\x02 \x01(\v2\x14.tibiadata.v4.StatusR\x05error\"\xeb\x01\n" +
+	"\tHighscore\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x03R\x04rank\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bvocation\x18\x03 \x01(\tR\bvocation\x12\x14\n" +
+	"\x05world\x18\x04 \x01(\tR\x05world\x12\x14\n" +
+	"\x05level\x18\x05 \x01(\x03R\x05level\x12\x14\n" +
+	"\x05value\x18\x06 \x01(\x03R\x05value\x12\x14\n" +
+	"\x05title\x18\a \x01(\tR\x05title\x12B\n" +
+	"\x0elevel_progress\x18\b \x01(\v2\x1b.tibiadata.v4.LevelProgressR\rlevelProgress\"x\n" +
+	"\rHighscorePage\x12!\n" +
+	"\fcurrent_page\x18\x01 \x01(\x03R\vcurrentPage\x12\x1f\n" +
+	"\vtotal_pages\x18\x02 \x01(\x03R\n" +
+	"totalPages\x12#\n" +
+	"\rtotal_records\x18\x03 \x01(\x03R\ftotalRecords\"\xc0\x02\n" +
+	"\n" +
+	"Highscores\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x1a\n" +
+	"\bvocation\x18\x03 \x01(\tR\bvocation\x12#\n" +
+	"\rhighscore_age\x18\x04 \x01(\x03R\fhighscoreAge\x12>\n" +
+	"\x0ehighscore_list\x18\x05 \x03(\v2\x17.tibiadata.v4.HighscoreR\rhighscoreList\x12B\n" +
+	"\x0ehighscore_page\x18\x06 \x01(\v2\x1b.tibiadata.v4.HighscorePageR\rhighscorePage\x12\x1f\n" +
+	"\vworld_types\x18\a \x03(\tR\n" +
+	"worldTypes\x12\x1a\n" +
+	"\bbattleye\x18\b \x01(\tR\bbattleyeB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_highscores_proto_rawDescOnce sync.Once
+	file_highscores_proto_rawDescData []byte
+)
+
+func file_highscores_proto_rawDescGZIP() []byte {
+	file_highscores_proto_rawDescOnce.Do(func() {
+		file_highscores_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_highscores_proto_rawDesc), len(file_highscores_proto_rawDesc)))
+	})
+	return file_highscores_proto_rawDescData
+}
+
+var file_highscores_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_highscores_proto_goTypes = []any{
+	(*HighscoresResponse)(nil),    // 0: tibiadata.v4.HighscoresResponse
+	(*HighscoresAllResponse)(nil), // 1: tibiadata.v4.HighscoresAllResponse
+	(*HighscoresFailedPage)(nil),  // 2: tibiadata.v4.HighscoresFailedPage
+	(*Highscore)(nil),             // 3: tibiadata.v4.Highscore
+	(*HighscorePage)(nil),         // 4: tibiadata.v4.HighscorePage
+	(*Highscores)(nil),            // 5: tibiadata.v4.Highscores
+	(*Information)(nil),           // 6: tibiadata.v4.Information
+	(*Status)(nil),                // 7: tibiadata.v4.Status
+	(*LevelProgress)(nil),         // 8: tibiadata.v4.LevelProgress
+}
+var file_highscores_proto_depIdxs = []int32{
+	5, // 0: tibiadata.v4.HighscoresResponse.highscores:type_name -> tibiadata.v4.Highscores
+	6, // 1: tibiadata.v4.HighscoresResponse.information:type_name -> tibiadata.v4.Information
+	5, // 2: tibiadata.v4.HighscoresAllResponse.highscores:type_name -> tibiadata.v4.Highscores
+	2, // 3: tibiadata.v4.HighscoresAllResponse.failed_pages:type_name -> tibiadata.v4.HighscoresFailedPage
+	6, // 4: tibiadata.v4.HighscoresAllResponse.information:type_name -> tibiadata.v4.Information
+	7, // 5: tibiadata.v4.HighscoresFailedPage.error:type_name -> tibiadata.v4.Status
+	8, // 6: tibiadata.v4.Highscore.level_progress:type_name -> tibiadata.v4.LevelProgress
+	3, // 7: tibiadata.v4.Highscores.highscore_list:type_name -> tibiadata.v4.Highscore
+	4, // 8: tibiadata.v4.Highscores.highscore_page:type_name -> tibiadata.v4.HighscorePage
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_highscores_proto_init() }
+func file_highscores_proto_init() {
+	if File_highscores_proto != nil {
+		return
+	}
+	file_experience_proto_init()
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_highscores_proto_rawDesc), len(file_highscores_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_highscores_proto_goTypes,
+		DependencyIndexes: file_highscores_proto_depIdxs,
+		MessageInfos:      file_highscores_proto_msgTypes,
+	}.Build()
+	File_highscores_proto = out.File
+	file_highscores_proto_goTypes = nil
+	file_highscores_proto_depIdxs = nil
+}
@@ -0,0 +1,608 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: highscores_snapshots.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: HighscoresDeltas and Information
+type HighscoresDeltasResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	HighscoresDeltas *HighscoresDeltas      `protobuf:"bytes,1,opt,name=highscores_deltas,json=highscoresDeltas,proto3" json:"highscores_deltas,omitempty"`
+	Information      *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *HighscoresDeltasResponse) Reset() {
+	*x = HighscoresDeltasResponse{}
+	mi := &file_highscores_snapshots_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighscoresDeltasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighscoresDeltasResponse) ProtoMessage() {}
+
+func (x *HighscoresDeltasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_highscores_snapshots_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighscoresDeltasResponse.ProtoReflect.Descriptor instead.
+func (*HighscoresDeltasResponse) Descriptor() ([]byte, []int) {
+	return file_highscores_snapshots_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HighscoresDeltasResponse) GetHighscoresDeltas() *HighscoresDeltas {
+	if x != nil {
+		return x.HighscoresDeltas
+	}
+	return nil
+}
+
+func (x *HighscoresDeltasResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type HighscoresDeltas struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         string                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`       // The world the highscores belong to.
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"` // The highscore category.
+	Vocation      string                 `protobuf:"bytes,3,opt,name=vocation,proto3" json:"vocation,omitempty"` // The vocation filtered on.
+	From          string                 `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`         // The time of the snapshot the period starts with.
+	To            string                 `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`             // The time of the snapshot the period ends with.
+	Deltas        []*HighscoreDelta      `protobuf:"bytes,6,rep,name=deltas,proto3" json:"deltas,omitempty"`     // The characters on both snapshots, sorted by the value gained.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HighscoresDeltas) Reset() {
+	*x = HighscoresDeltas{}
+	mi := &file_highscores_snapshots_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighscoresDeltas) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighscoresDeltas) ProtoMessage() {}
+
+func (x *HighscoresDeltas) ProtoReflect() protoreflect.Message {
+	mi := &file_highscores_snapshots_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighscoresDeltas.ProtoReflect.Descriptor instead.
+func (*HighscoresDeltas) Descriptor() ([]byte, []int) {
+	return file_highscores_snapshots_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HighscoresDeltas) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *HighscoresDeltas) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *HighscoresDeltas) GetVocation() string {
+	if x != nil {
+		return x.Vocation
+	}
+	return ""
+}
+
+func (x *HighscoresDeltas) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *HighscoresDeltas) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *HighscoresDeltas) GetDeltas() []*HighscoreDelta {
+	if x != nil {
+		return x.Deltas
+	}
+	return nil
+}
+
+// Child of HighscoresDeltas
+type HighscoreDelta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int64                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`                               // The character's rank at the end of the period.
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                // The name of the character.
+	Vocation      string                 `protobuf:"bytes,3,opt,name=vocation,proto3" json:"vocation,omitempty"`                        // The character's vocation.
+	World         string                 `protobuf:"bytes,4,opt,name=world,proto3" json:"world,omitempty"`                              // The character's world.
+	Level         int64                  `protobuf:"varint,5,opt,name=level,proto3" json:"level,omitempty"`                             // The character's level at the end of the period.
+	Value         int64                  `protobuf:"varint,6,opt,name=value,proto3" json:"value,omitempty"`                             // The character's value at the end of the period.
+	LevelDelta    int64                  `protobuf:"varint,7,opt,name=level_delta,json=levelDelta,proto3" json:"level_delta,omitempty"` // The levels gained in the period.
+	ValueDelta    int64                  `protobuf:"varint,8,opt,name=value_delta,json=valueDelta,proto3" json:"value_delta,omitempty"` // The value gained in the period.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HighscoreDelta) Reset() {
+	*x = HighscoreDelta{}
+	mi := &file_highscores_snapshots_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighscoreDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighscoreDelta) ProtoMessage() {}
+
+func (x *HighscoreDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_highscores_snapshots_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighscoreDelta.ProtoReflect.Descriptor instead.
+func (*HighscoreDelta) Descriptor() ([]byte, []int) {
+	return file_highscores_snapshots_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HighscoreDelta) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *HighscoreDelta) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HighscoreDelta) GetVocation() string {
+	if x != nil {
+		return x.Vocation
+	}
+	return ""
+}
+
+func (x *HighscoreDelta) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *HighscoreDelta) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *HighscoreDelta) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *HighscoreDelta) GetLevelDelta() int64 {
+	if x != nil {
+		return x.LevelDelta
+	}
+	return 0
+}
+
+func (x *HighscoreDelta) GetValueDelta() int64 {
+	if x != nil {
+		return x.ValueDelta
+	}
+	return 0
+}
+
+// The base includes two levels: HighscoresRankChanges and Information
+type HighscoresRankChangesResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	HighscoresRankChanges *HighscoresRankChanges `protobuf:"bytes,1,opt,name=highscores_rank_changes,json=highscoresRankChanges,proto3" json:"highscores_rank_changes,omitempty"`
+	Information           *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *HighscoresRankChangesResponse) Reset() {
+	*x = HighscoresRankChangesResponse{}
+	mi := &file_highscores_snapshots_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighscoresRankChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighscoresRankChangesResponse) ProtoMessage() {}
+
+func (x *HighscoresRankChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_highscores_snapshots_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighscoresRankChangesResponse.ProtoReflect.Descriptor instead.
+func (*HighscoresRankChangesResponse) Descriptor() ([]byte, []int) {
+	return file_highscores_snapshots_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HighscoresRankChangesResponse) GetHighscoresRankChanges() *HighscoresRankChanges {
+	if x != nil {
+		return x.HighscoresRankChanges
+	}
+	return nil
+}
+
+func (x *HighscoresRankChangesResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type HighscoresRankChanges struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         string                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`                                // The world the highscores belong to.
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`                          // The highscore category.
+	Vocation      string                 `protobuf:"bytes,3,opt,name=vocation,proto3" json:"vocation,omitempty"`                          // The vocation filtered on.
+	From          string                 `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`                                  // The time of the snapshot the period starts with.
+	To            string                 `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`                                      // The time of the snapshot the period ends with.
+	RankChanges   []*HighscoreRankChange `protobuf:"bytes,6,rep,name=rank_changes,json=rankChanges,proto3" json:"rank_changes,omitempty"` // The characters of both snapshots, sorted by rank (dropped characters last).
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HighscoresRankChanges) Reset() {
+	*x = HighscoresRankChanges{}
+	mi := &file_highscores_snapshots_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighscoresRankChanges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighscoresRankChanges) ProtoMessage() {}
+
+func (x *HighscoresRankChanges) ProtoReflect() protoreflect.Message {
+	mi := &file_highscores_snapshots_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighscoresRankChanges.ProtoReflect.Descriptor instead.
+func (*HighscoresRankChanges) Descriptor() ([]byte, []int) {
+	return file_highscores_snapshots_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HighscoresRankChanges) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *HighscoresRankChanges) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *HighscoresRankChanges) GetVocation() string {
+	if x != nil {
+		return x.Vocation
+	}
+	return ""
+}
+
+func (x *HighscoresRankChanges) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *HighscoresRankChanges) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *HighscoresRankChanges) GetRankChanges() []*HighscoreRankChange {
+	if x != nil {
+		return x.RankChanges
+	}
+	return nil
+}
+
+// Child of HighscoresRankChanges
+type HighscoreRankChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int64                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`                                     // The character's rank at the end of the period (0 if it dropped off).
+	PreviousRank  int64                  `protobuf:"varint,2,opt,name=previous_rank,json=previousRank,proto3" json:"previous_rank,omitempty"` // The character's rank at the start of the period (0 if it is new).
+	RankChange    int64                  `protobuf:"varint,3,opt,name=rank_change,json=rankChange,proto3" json:"rank_change,omitempty"`       // The ranks moved up, negative if the character moved down.
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                                      // The name of the character.
+	Vocation      string                 `protobuf:"bytes,5,opt,name=vocation,proto3" json:"vocation,omitempty"`                              // The character's vocation.
+	World         string                 `protobuf:"bytes,6,opt,name=world,proto3" json:"world,omitempty"`                                    // The character's world.
+	New           bool                   `protobuf:"varint,7,opt,name=new,proto3" json:"new,omitempty"`                                       // Whether the character is only on the snapshot at the end of the period.
+	Dropped       bool                   `protobuf:"varint,8,opt,name=dropped,proto3" json:"dropped,omitempty"`                               // Whether the character is only on the snapshot at the start of the period.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HighscoreRankChange) Reset() {
+	*x = HighscoreRankChange{}
+	mi := &file_highscores_snapshots_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighscoreRankChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighscoreRankChange) ProtoMessage() {}
+
+func (x *HighscoreRankChange) ProtoReflect() protoreflect.Message {
+	mi := &file_highscores_snapshots_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighscoreRankChange.ProtoReflect.Descriptor instead.
+func (*HighscoreRankChange) Descriptor() ([]byte, []int) {
+	return file_highscores_snapshots_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HighscoreRankChange) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *HighscoreRankChange) GetPreviousRank() int64 {
+	if x != nil {
+		return x.PreviousRank
+	}
+	return 0
+}
+
+func (x *HighscoreRankChange) GetRankChange() int64 {
+	if x != nil {
+		return x.RankChange
+	}
+	return 0
+}
+
+func (x *HighscoreRankChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HighscoreRankChange) GetVocation() string {
+	if x != nil {
+		return x.Vocation
+	}
+	return ""
+}
+
+func (x *HighscoreRankChange) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *HighscoreRankChange) GetNew() bool {
+	if x != nil {
+		return x.New
+	}
+	return false
+}
+
+func (x *HighscoreRankChange) GetDropped() bool {
+	if x != nil {
+		return x.Dropped
+	}
+	return false
+}
+
+var File_highscores_snapshots_proto protoreflect.FileDescriptor
+
+const file_highscores_snapshots_proto_rawDesc = "" +
+	"\n" +
+	"\x1ahighscores_snapshots.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\xa4\x01\n" +
+	"\x18HighscoresDeltasResponse\x12K\n" +
+	"\x11highscores_deltas\x18\x01 \x01(\v2\x1e.tibiadata.v4.HighscoresDeltasR\x10highscoresDeltas\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xba\x01\n" +
+	"\x10HighscoresDeltas\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x1a\n" +
+	"\bvocation\x18\x03 \x01(\tR\bvocation\x12\x12\n" +
+	"\x04from\x18\x04 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x05 \x01(\tR\x02to\x124\n" +
+	"\x06deltas\x18\x06 \x03(\v2\x1c.tibiadata.v4.HighscoreDeltaR\x06deltas\"\xd8\x01\n" +
+	"\x0eHighscoreDelta\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x03R\x04rank\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bvocation\x18\x03 \x01(\tR\bvocation\x12\x14\n" +
+	"\x05world\x18\x04 \x01(\tR\x05world\x12\x14\n" +
+	"\x05level\x18\x05 \x01(\x03R\x05level\x12\x14\n" +
+	"\x05value\x18\x06 \x01(\x03R\x05value\x12\x1f\n" +
+	"\vlevel_delta\x18\a \x01(\x03R\n" +
+	"levelDelta\x12\x1f\n" +
+	"\vvalue_delta\x18\b \x01(\x03R\n" +
+	"valueDelta\"\xb9\x01\n" +
+	"\x1dHighscoresRankChangesResponse\x12[\n" +
+	"\x17highscores_rank_changes\x18\x01 \x01(\v2#.tibiadata.v4.HighscoresRankChangesR\x15highscoresRankChanges\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xcf\x01\n" +
+	"\x15HighscoresRankChanges\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x1a\n" +
+	"\bvocation\x18\x03 \x01(\tR\bvocation\x12\x12\n" +
+	"\x04from\x18\x04 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x05 \x01(\tR\x02to\x12D\n" +
+	"\frank_changes\x18\x06 \x03(\v2!.tibiadata.v4.HighscoreRankChangeR\vrankChanges\"\xe1\x01\n" +
+	"\x13HighscoreRankChange\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x03R\x04rank\x12#\n" +
+	"\rprevious_rank\x18\x02 \x01(\x03R\fpreviousRank\x12\x1f\n" +
+	"\vrank_change\x18\x03 \x01(\x03R\n" +
+	"rankChange\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1a\n" +
+	"\bvocation\x18\x05 \x01(\tR\bvocation\x12\x14\n" +
+	"\x05world\x18\x06 \x01(\tR\x05world\x12\x10\n" +
+	"\x03new\x18\a \x01(\bR\x03new\x12\x18\n" +
+	"\adropped\x18\b \x01(\bR\adroppedB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_highscores_snapshots_proto_rawDescOnce sync.Once
+	file_highscores_snapshots_proto_rawDescData []byte
+)
+
+func file_highscores_snapshots_proto_rawDescGZIP() []byte {
+	file_highscores_snapshots_proto_rawDescOnce.Do(func() {
+		file_highscores_snapshots_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_highscores_snapshots_proto_rawDesc), len(file_highscores_snapshots_proto_rawDesc)))
+	})
+	return file_highscores_snapshots_proto_rawDescData
+}
+
+var file_highscores_snapshots_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_highscores_snapshots_proto_goTypes = []any{
+	(*HighscoresDeltasResponse)(nil),      // 0: tibiadata.v4.HighscoresDeltasResponse
+	(*HighscoresDeltas)(nil),              // 1: tibiadata.v4.HighscoresDeltas
+	(*HighscoreDelta)(nil),                // 2: tibiadata.v4.HighscoreDelta
+	(*HighscoresRankChangesResponse)(nil), // 3: tibiadata.v4.HighscoresRankChangesResponse
+	(*HighscoresRankChanges)(nil),         // 4: tibiadata.v4.HighscoresRankChanges
+	(*HighscoreRankChange)(nil),           // 5: tibiadata.v4.HighscoreRankChange
+	(*Information)(nil),                   // 6: tibiadata.v4.Information
+}
+var file_highscores_snapshots_proto_depIdxs = []int32{
+	1, // 0: tibiadata.v4.HighscoresDeltasResponse.highscores_deltas:type_name -> tibiadata.v4.HighscoresDeltas
+	6, // 1: tibiadata.v4.HighscoresDeltasResponse.information:type_name -> tibiadata.v4.Information
+	2, // 2: tibiadata.v4.HighscoresDeltas.deltas:type_name -> tibiadata.v4.HighscoreDelta
+	4, // 3: tibiadata.v4.HighscoresRankChangesResponse.highscores_rank_changes:type_name -> tibiadata.v4.HighscoresRankChanges
+	6, // 4: tibiadata.v4.HighscoresRankChangesResponse.information:type_name -> tibiadata.v4.Information
+	5, // 5: tibiadata.v4.HighscoresRankChanges.rank_changes:type_name -> tibiadata.v4.HighscoreRankChange
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_highscores_snapshots_proto_init() }
+func file_highscores_snapshots_proto_init() {
+	if File_highscores_snapshots_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_highscores_snapshots_proto_rawDesc), len(file_highscores_snapshots_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_highscores_snapshots_proto_goTypes,
+		DependencyIndexes: file_highscores_snapshots_proto_depIdxs,
+		MessageInfos:      file_highscores_snapshots_proto_msgTypes,
+	}.Build()
+	File_highscores_snapshots_proto = out.File
+	file_highscores_snapshots_proto_goTypes = nil
+	file_highscores_snapshots_proto_depIdxs = nil
+}
@@ -0,0 +1,552 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: houses_house.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: Houses and Information
+type HouseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	House         *House                 `protobuf:"bytes,1,opt,name=house,proto3" json:"house,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HouseResponse) Reset() {
+	*x = HouseResponse{}
+	mi := &file_houses_house_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseResponse) ProtoMessage() {}
+
+func (x *HouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_houses_house_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseResponse.ProtoReflect.Descriptor instead.
+func (*HouseResponse) Descriptor() ([]byte, []int) {
+	return file_houses_house_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HouseResponse) GetHouse() *House {
+	if x != nil {
+		return x.House
+	}
+	return nil
+}
+
+func (x *HouseResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type House struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Houseid       int64                  `protobuf:"varint,1,opt,name=houseid,proto3" json:"houseid,omitempty"` // The internal ID of the house/guildhall.
+	World         string                 `protobuf:"bytes,2,opt,name=world,proto3" json:"world,omitempty"`      // The name of the world the house/guildhall belongs to.
+	Town          string                 `protobuf:"bytes,3,opt,name=town,proto3" json:"town,omitempty"`        // The town where the house/guildhall is located.
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`        // The name of the house/guildhall.
+	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`        // The type of home. (house or guildhall)
+	Beds          int64                  `protobuf:"varint,6,opt,name=beds,proto3" json:"beds,omitempty"`       // The number of beds it has.
+	Size          int64                  `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`       // The number of SQM it has.
+	Rent          int64                  `protobuf:"varint,8,opt,name=rent,proto3" json:"rent,omitempty"`       // The monthly cost in gold coins for the house.
+	Img           string                 `protobuf:"bytes,9,opt,name=img,proto3" json:"img,omitempty"`          // The URL to the house's minimap image.
+	Status        *HouseStatus           `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`   // The current status of the house/guildhall.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *House) Reset() {
+	*x = House{}
+	mi := &file_houses_house_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *House) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*House) ProtoMessage() {}
+
+func (x *House) ProtoReflect() protoreflect.Message {
+	mi := &file_houses_house_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use House.ProtoReflect.Descriptor instead.
+func (*House) Descriptor() ([]byte, []int) {
+	return file_houses_house_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *House) GetHouseid() int64 {
+	if x != nil {
+		return x.Houseid
+	}
+	return 0
+}
+
+func (x *House) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *House) GetTown() string {
+	if x != nil {
+		return x.Town
+	}
+	return ""
+}
+
+func (x *House) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *House) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *House) GetBeds() int64 {
+	if x != nil {
+		return x.Beds
+	}
+	return 0
+}
+
+func (x *House) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *House) GetRent() int64 {
+	if x != nil {
+		return x.Rent
+	}
+	return 0
+}
+
+func (x *House) GetImg() string {
+	if x != nil {
+		return x.Img
+	}
+	return ""
+}
+
+func (x *House) GetStatus() *HouseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Child of Status
+type HouseAuction struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CurrentBid     int64                  `protobuf:"varint,1,opt,name=current_bid,json=currentBid,proto3" json:"current_bid,omitempty"`             // The currently highest bid on the house/guildhall.
+	CurrentBidder  string                 `protobuf:"bytes,2,opt,name=current_bidder,json=currentBidder,proto3" json:"current_bidder,omitempty"`     // The character that holds the current highest bid.
+	AuctionOngoing bool                   `protobuf:"varint,3,opt,name=auction_ongoing,json=auctionOngoing,proto3" json:"auction_ongoing,omitempty"` // Whether the auction is still ongoing or not.
+	AuctionEnd     string                 `protobuf:"bytes,4,opt,name=auction_end,json=auctionEnd,proto3" json:"auction_end,omitempty"`              // The date when the auction will finish.
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *HouseAuction) Reset() {
+	*x = HouseAuction{}
+	mi := &file_houses_house_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HouseAuction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseAuction) ProtoMessage() {}
+
+func (x *HouseAuction) ProtoReflect() protoreflect.Message {
+	mi := &file_houses_house_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseAuction.ProtoReflect.Descriptor instead.
+func (*HouseAuction) Descriptor() ([]byte, []int) {
+	return file_houses_house_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HouseAuction) GetCurrentBid() int64 {
+	if x != nil {
+		return x.CurrentBid
+	}
+	return 0
+}
+
+func (x *HouseAuction) GetCurrentBidder() string {
+	if x != nil {
+		return x.CurrentBidder
+	}
+	return ""
+}
+
+func (x *HouseAuction) GetAuctionOngoing() bool {
+	if x != nil {
+		return x.AuctionOngoing
+	}
+	return false
+}
+
+func (x *HouseAuction) GetAuctionEnd() string {
+	if x != nil {
+		return x.AuctionEnd
+	}
+	return ""
+}
+
+// Child of Status
+type HouseRental struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Owner            string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`                                               // The current owner of the house/guildhall.
+	OwnerSex         string                 `protobuf:"bytes,2,opt,name=owner_sex,json=ownerSex,proto3" json:"owner_sex,omitempty"`                         // The owner's sex.
+	PaidUntil        string                 `protobuf:"bytes,3,opt,name=paid_until,json=paidUntil,proto3" json:"paid_until,omitempty"`                      // The date the last paid rent is due.
+	MovingDate       string                 `protobuf:"bytes,4,opt,name=moving_date,json=movingDate,proto3" json:"moving_date,omitempty"`                   // The date when the owner will move out.
+	TransferReceiver string                 `protobuf:"bytes,5,opt,name=transfer_receiver,json=transferReceiver,proto3" json:"transfer_receiver,omitempty"` // The character who will receive the house.
+	TransferPrice    int64                  `protobuf:"varint,6,opt,name=transfer_price,json=transferPrice,proto3" json:"transfer_price,omitempty"`         // The price that will be paid from the current owner to the new owner for the transfer.
+	TransferAccept   bool                   `protobuf:"varint,7,opt,name=transfer_accept,json=transferAccept,proto3" json:"transfer_accept,omitempty"`      // Whether the transfer is accepted or not.
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *HouseRental) Reset() {
+	*x = HouseRental{}
+	mi := &file_houses_house_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HouseRental) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseRental) ProtoMessage() {}
+
+func (x *HouseRental) ProtoReflect() protoreflect.Message {
+	mi := &file_houses_house_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseRental.ProtoReflect.Descriptor instead.
+func (*HouseRental) Descriptor() ([]byte, []int) {
+	return file_houses_house_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HouseRental) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *HouseRental) GetOwnerSex() string {
+	if x != nil {
+		return x.OwnerSex
+	}
+	return ""
+}
+
+func (x *HouseRental) GetPaidUntil() string {
+	if x != nil {
+		return x.PaidUntil
+	}
+	return ""
+}
+
+func (x *HouseRental) GetMovingDate() string {
+	if x != nil {
+		return x.MovingDate
+	}
+	return ""
+}
+
+func (x *HouseRental) GetTransferReceiver() string {
+	if x != nil {
+		return x.TransferReceiver
+	}
+	return ""
+}
+
+func (x *HouseRental) GetTransferPrice() int64 {
+	if x != nil {
+		return x.TransferPrice
+	}
+	return 0
+}
+
+func (x *HouseRental) GetTransferAccept() bool {
+	if x != nil {
+		return x.TransferAccept
+	}
+	return false
+}
+
+// Child of House
+type HouseStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsAuctioned   bool                   `protobuf:"varint,1,opt,name=is_auctioned,json=isAuctioned,proto3" json:"is_auctioned,omitempty"`       // Whether the house/guildhall is being auctioned.
+	IsRented      bool                   `protobuf:"varint,2,opt,name=is_rented,json=isRented,proto3" json:"is_rented,omitempty"`                // Wether the house/guildhall is being rented.
+	IsMoving      bool                   `protobuf:"varint,3,opt,name=is_moving,json=isMoving,proto3" json:"is_moving,omitempty"`                // Wether the owner is moving out.
+	IsTransfering bool                   `protobuf:"varint,4,opt,name=is_transfering,json=isTransfering,proto3" json:"is_transfering,omitempty"` // Wether the house/guildhall is being transfered.
+	Auction       *HouseAuction          `protobuf:"bytes,5,opt,name=auction,proto3" json:"auction,omitempty"`                                   // Details about the auction.
+	Rental        *HouseRental           `protobuf:"bytes,6,opt,name=rental,proto3" json:"rental,omitempty"`                                     // Details about the transfer.
+	Original      string                 `protobuf:"bytes,7,opt,name=original,proto3" json:"original,omitempty"`                                 // Original plain text information.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HouseStatus) Reset() {
+	*x = HouseStatus{}
+	mi := &file_houses_house_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HouseStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseStatus) ProtoMessage() {}
+
+func (x *HouseStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_houses_house_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseStatus.ProtoReflect.Descriptor instead.
+func (*HouseStatus) Descriptor() ([]byte, []int) {
+	return file_houses_house_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HouseStatus) GetIsAuctioned() bool {
+	if x != nil {
+		return x.IsAuctioned
+	}
+	return false
+}
+
+func (x *HouseStatus) GetIsRented() bool {
+	if x != nil {
+		return x.IsRented
+	}
+	return false
+}
+
+func (x *HouseStatus) GetIsMoving() bool {
+	if x != nil {
+		return x.IsMoving
+	}
+	return false
+}
+
+func (x *HouseStatus) GetIsTransfering() bool {
+	if x != nil {
+		return x.IsTransfering
+	}
+	return false
+}
+
+func (x *HouseStatus) GetAuction() *HouseAuction {
+	if x != nil {
+		return x.Auction
+	}
+	return nil
+}
+
+func (x *HouseStatus) GetRental() *HouseRental {
+	if x != nil {
+		return x.Rental
+	}
+	return nil
+}
+
+func (x *HouseStatus) GetOriginal() string {
+	if x != nil {
+		return x.Original
+	}
+	return ""
+}
+
+var File_houses_house_proto protoreflect.FileDescriptor
+
+const file_houses_house_proto_rawDesc = "" +
+	"\n" +
+	"\x12houses_house.proto\x12\ftibiadata.v4\x1a\x11information.proto\"w\n" +
+	"\rHouseResponse\x12)\n" +
+	"\x05house\x18\x01 \x01(\v2\x13.tibiadata.v4.HouseR\x05house\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xf4\x01\n" +
+	"\x05House\x12\x18\n" +
+	"\ahouseid\x18\x01 \x01(\x03R\ahouseid\x12\x14\n" +
+	"\x05world\x18\x02 \x01(\tR\x05world\x12\x12\n" +
+	"\x04town\x18\x03 \x01(\tR\x04town\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x12\n" +
+	"\x04beds\x18\x06 \x01(\x03R\x04beds\x12\x12\n" +
+	"\x04size\x18\a \x01(\x03R\x04size\x12\x12\n" +
+	"\x04rent\x18\b \x01(\x03R\x04rent\x12\x10\n" +
+	"\x03img\x18\t \x01(\tR\x03img\x121\n" +
+	"\x06status\x18\n" +
+	" \x01(\v2\x19.tibiadata.v4.HouseStatusR\x06status\"\xa0\x01\n" +
+	"\fHouseAuction\x12\x1f\n" +
+	"\vcurrent_bid\x18\x01 \x01(\x03R\n" +
+	"currentBid\x12%\n" +
+	"\x0ecurrent_bidder\x18\x02 \x01(\tR\rcurrentBidder\x12'\n" +
+	"\x0fauction_ongoing\x18\x03 \x01(\bR\x0eauctionOngoing\x12\x1f\n" +
+	"\vauction_end\x18\x04 \x01(\tR\n" +
+	"auctionEnd\"\xfd\x01\n" +
+	"\vHouseRental\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x1b\n" +
+	"\towner_sex\x18\x02 \x01(\tR\bownerSex\x12\x1d\n" +
+	"\n" +
+	"paid_until\x18\x03 \x01(\tR\tpaidUntil\x12\x1f\n" +
+	"\vmoving_date\x18\x04 \x01(\tR\n" +
+	"movingDate\x12+\n" +
+	"\x11transfer_receiver\x18\x05 \x01(\tR\x10transferReceiver\x12%\n" +
+	"\x0etransfer_price\x18\x06 \x01(\x03R\rtransferPrice\x12'\n" +
+	"\x0ftransfer_accept\x18\a \x01(\bR\x0etransferAccept\"\x96\x02\n" +
+	"\vHouseStatus\x12!\n" +
+	"\fis_auctioned\x18\x01 \x01(\bR\visAuctioned\x12\x1b\n" +
+	"\tis_rented\x18\x02 \x01(\bR\bisRented\x12\x1b\n" +
+	"\tis_moving\x18\x03 \x01(\bR\bisMoving\x12%\n" +
+	"\x0eis_transfering\x18\x04 \x01(\bR\risTransfering\x124\n" +
+	"\aauction\x18\x05 \x01(\v2\x1a.tibiadata.v4.HouseAuctionR\aauction\x121\n" +
+	"\x06rental\x18\x06 \x01(\v2\x19.tibiadata.v4.HouseRentalR\x06rental\x12\x1a\n" +
+	"\boriginal\x18\a \x01(\tR\boriginalB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_houses_house_proto_rawDescOnce sync.Once
+	file_houses_house_proto_rawDescData []byte
+)
+
+func file_houses_house_proto_rawDescGZIP() []byte {
+	file_houses_house_proto_rawDescOnce.Do(func() {
+		file_houses_house_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_houses_house_proto_rawDesc), len(file_houses_house_proto_rawDesc)))
+	})
+	return file_houses_house_proto_rawDescData
+}
+
+var file_houses_house_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_houses_house_proto_goTypes = []any{
+	(*HouseResponse)(nil), // 0: tibiadata.v4.HouseResponse
+	(*House)(nil),         // 1: tibiadata.v4.House
+	(*HouseAuction)(nil),  // 2: tibiadata.v4.HouseAuction
+	(*HouseRental)(nil),   // 3: tibiadata.v4.HouseRental
+	(*HouseStatus)(nil),   // 4: tibiadata.v4.HouseStatus
+	(*Information)(nil),   // 5: tibiadata.v4.Information
+}
+var file_houses_house_proto_depIdxs = []int32{
+	1, // 0: tibiadata.v4.HouseResponse.house:type_name -> tibiadata.v4.House
+	5, // 1: tibiadata.v4.HouseResponse.information:type_name -> tibiadata.v4.Information
+	4, // 2: tibiadata.v4.House.status:type_name -> tibiadata.v4.HouseStatus
+	2, // 3: tibiadata.v4.HouseStatus.auction:type_name -> tibiadata.v4.HouseAuction
+	3, // 4: tibiadata.v4.HouseStatus.rental:type_name -> tibiadata.v4.HouseRental
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_houses_house_proto_init() }
+func file_houses_house_proto_init() {
+	if File_houses_house_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_houses_house_proto_rawDesc), len(file_houses_house_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_houses_house_proto_goTypes,
+		DependencyIndexes: file_houses_house_proto_depIdxs,
+		MessageInfos:      file_houses_house_proto_msgTypes,
+	}.Build()
+	File_houses_house_proto = out.File
+	file_houses_house_proto_goTypes = nil
+	file_houses_house_proto_depIdxs = nil
+}
@@ -0,0 +1,384 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: houses_overview.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: HousesHouses and Information
+type HousesOverviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Houses        *HousesHouses          `protobuf:"bytes,1,opt,name=houses,proto3" json:"houses,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HousesOverviewResponse) Reset() {
+	*x = HousesOverviewResponse{}
+	mi := &file_houses_overview_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HousesOverviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HousesOverviewResponse) ProtoMessage() {}
+
+func (x *HousesOverviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_houses_overview_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HousesOverviewResponse.ProtoReflect.Descriptor instead.
+func (*HousesOverviewResponse) Descriptor() ([]byte, []int) {
+	return file_houses_overview_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HousesOverviewResponse) GetHouses() *HousesHouses {
+	if x != nil {
+		return x.Houses
+	}
+	return nil
+}
+
+func (x *HousesOverviewResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of House
+type HousesAuction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrentBid    int64                  `protobuf:"varint,1,opt,name=current_bid,json=currentBid,proto3" json:"current_bid,omitempty"` // The highest bid so far.
+	TimeLeft      string                 `protobuf:"bytes,2,opt,name=time_left,json=timeLeft,proto3" json:"time_left,omitempty"`        // The number of days or hours left until the bid ends.
+	Finished      bool                   `protobuf:"varint,3,opt,name=finished,proto3" json:"finished,omitempty"`                       // Whether the auction is finished or not.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HousesAuction) Reset() {
+	*x = HousesAuction{}
+	mi := &file_houses_overview_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HousesAuction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HousesAuction) ProtoMessage() {}
+
+func (x *HousesAuction) ProtoReflect() protoreflect.Message {
+	mi := &file_houses_overview_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HousesAuction.ProtoReflect.Descriptor instead.
+func (*HousesAuction) Descriptor() ([]byte, []int) {
+	return file_houses_overview_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HousesAuction) GetCurrentBid() int64 {
+	if x != nil {
+		return x.CurrentBid
+	}
+	return 0
+}
+
+func (x *HousesAuction) GetTimeLeft() string {
+	if x != nil {
+		return x.TimeLeft
+	}
+	return ""
+}
+
+func (x *HousesAuction) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+// Child of HousesHouses
+type HousesHouse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                       // The name of the house/guildhall.
+	HouseId       int64                  `protobuf:"varint,2,opt,name=house_id,json=houseId,proto3" json:"house_id,omitempty"` // The internal ID of the house/guildhall.
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`                      // The size in SQM.
+	Rent          int64                  `protobuf:"varint,4,opt,name=rent,proto3" json:"rent,omitempty"`                      // The monthly cost in gold coins for the house/guildhall.
+	Rented        bool                   `protobuf:"varint,5,opt,name=rented,proto3" json:"rented,omitempty"`                  // Whether the auction is rented or not.
+	Auctioned     bool                   `protobuf:"varint,6,opt,name=auctioned,proto3" json:"auctioned,omitempty"`            // Whether the auction is auctioned or not.
+	Auction       *HousesAuction         `protobuf:"bytes,7,opt,name=auction,proto3" json:"auction,omitempty"`                 // Details about the auction.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HousesHouse) Reset() {
+	*x = HousesHouse{}
+	mi := &file_houses_overview_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HousesHouse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HousesHouse) ProtoMessage() {}
+
+func (x *HousesHouse) ProtoReflect() protoreflect.Message {
+	mi := &file_houses_overview_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HousesHouse.ProtoReflect.Descriptor instead.
+func (*HousesHouse) Descriptor() ([]byte, []int) {
+	return file_houses_overview_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HousesHouse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HousesHouse) GetHouseId() int64 {
+	if x != nil {
+		return x.HouseId
+	}
+	return 0
+}
+
+func (x *HousesHouse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *HousesHouse) GetRent() int64 {
+	if x != nil {
+		return x.Rent
+	}
+	return 0
+}
+
+func (x *HousesHouse) GetRented() bool {
+	if x != nil {
+		return x.Rented
+	}
+	return false
+}
+
+func (x *HousesHouse) GetAuctioned() bool {
+	if x != nil {
+		return x.Auctioned
+	}
+	return false
+}
+
+func (x *HousesHouse) GetAuction() *HousesAuction {
+	if x != nil {
+		return x.Auction
+	}
+	return nil
+}
+
+// Child of JSONData
+type HousesHouses struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         string                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`                                      // The name of the world the house/guildhall belongs to.
+	Town          string                 `protobuf:"bytes,2,opt,name=town,proto3" json:"town,omitempty"`                                        // The town where the house/guildhall is located.
+	HouseList     []*HousesHouse         `protobuf:"bytes,3,rep,name=house_list,json=houseList,proto3" json:"house_list,omitempty"`             // List of all houses.
+	GuildhallList []*HousesHouse         `protobuf:"bytes,4,rep,name=guildhall_list,json=guildhallList,proto3" json:"guildhall_list,omitempty"` // List of all guildhalls.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HousesHouses) Reset() {
+	*x = HousesHouses{}
+	mi := &file_houses_overview_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HousesHouses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HousesHouses) ProtoMessage() {}
+
+func (x *HousesHouses) ProtoReflect() protoreflect.Message {
+	mi := &file_houses_overview_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HousesHouses.ProtoReflect.Descriptor instead.
+func (*HousesHouses) Descriptor() ([]byte, []int) {
+	return file_houses_overview_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HousesHouses) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *HousesHouses) GetTown() string {
+	if x != nil {
+		return x.Town
+	}
+	return ""
+}
+
+func (x *HousesHouses) GetHouseList() []*HousesHouse {
+	if x != nil {
+		return x.HouseList
+	}
+	return nil
+}
+
+func (x *HousesHouses) GetGuildhallList() []*HousesHouse {
+	if x != nil {
+		return x.GuildhallList
+	}
+	return nil
+}
+
+var File_houses_overview_proto protoreflect.FileDescriptor
+
+const file_houses_overview_proto_rawDesc = "" +
+	"\n" +
+	"\x15houses_overview.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\x89\x01\n" +
+	"\x16HousesOverviewResponse\x122\n" +
+	"\x06houses\x18\x01 \x01(\v2\x1a.tibiadata.v4.HousesHousesR\x06houses\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"i\n" +
+	"\rHousesAuction\x12\x1f\n" +
+	"\vcurrent_bid\x18\x01 \x01(\x03R\n" +
+	"currentBid\x12\x1b\n" +
+	"\ttime_left\x18\x02 \x01(\tR\btimeLeft\x12\x1a\n" +
+	"\bfinished\x18\x03 \x01(\bR\bfinished\"\xd1\x01\n" +
+	"\vHousesHouse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\bhouse_id\x18\x02 \x01(\x03R\ahouseId\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12\x12\n" +
+	"\x04rent\x18\x04 \x01(\x03R\x04rent\x12\x16\n" +
+	"\x06rented\x18\x05 \x01(\bR\x06rented\x12\x1c\n" +
+	"\tauctioned\x18\x06 \x01(\bR\tauctioned\x125\n" +
+	"\aauction\x18\a \x01(\v2\x1b.tibiadata.v4.HousesAuctionR\aauction\"\xb4\x01\n" +
+	"\fHousesHouses\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\x12\x12\n" +
+	"\x04town\x18\x02 \x01(\tR\x04town\x128\n" +
+	"\n" +
+	"house_list\x18\x03 \x03(\v2\x19.tibiadata.v4.HousesHouseR\thouseList\x12@\n" +
+	"\x0eguildhall_list\x18\x04 \x03(\v2\x19.tibiadata.v4.HousesHouseR\rguildhallListB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_houses_overview_proto_rawDescOnce sync.Once
+	file_houses_overview_proto_rawDescData []byte
+)
+
+func file_houses_overview_proto_rawDescGZIP() []byte {
+	file_houses_overview_proto_rawDescOnce.Do(func() {
+		file_houses_overview_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_houses_overview_proto_rawDesc), len(file_houses_overview_proto_rawDesc)))
+	})
+	return file_houses_overview_proto_rawDescData
+}
+
+var file_houses_overview_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_houses_overview_proto_goTypes = []any{
+	(*HousesOverviewResponse)(nil), // 0: tibiadata.v4.HousesOverviewResponse
+	(*HousesAuction)(nil),          // 1: tibiadata.v4.HousesAuction
+	(*HousesHouse)(nil),            // 2: tibiadata.v4.HousesHouse
+	(*HousesHouses)(nil),           // 3: tibiadata.v4.HousesHouses
+	(*Information)(nil),            // 4: tibiadata.v4.Information
+}
+var file_houses_overview_proto_depIdxs = []int32{
+	3, // 0: tibiadata.v4.HousesOverviewResponse.houses:type_name -> tibiadata.v4.HousesHouses
+	4, // 1: tibiadata.v4.HousesOverviewResponse.information:type_name -> tibiadata.v4.Information
+	1, // 2: tibiadata.v4.HousesHouse.auction:type_name -> tibiadata.v4.HousesAuction
+	2, // 3: tibiadata.v4.HousesHouses.house_list:type_name -> tibiadata.v4.HousesHouse
+	2, // 4: tibiadata.v4.HousesHouses.guildhall_list:type_name -> tibiadata.v4.HousesHouse
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_houses_overview_proto_init() }
+func file_houses_overview_proto_init() {
+	if File_houses_overview_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_houses_overview_proto_rawDesc), len(file_houses_overview_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_houses_overview_proto_goTypes,
+		DependencyIndexes: file_houses_overview_proto_depIdxs,
+		MessageInfos:      file_houses_overview_proto_msgTypes,
+	}.Build()
+	File_houses_overview_proto = out.File
+	file_houses_overview_proto_goTypes = nil
+	file_houses_overview_proto_depIdxs = nil
+}
@@ -0,0 +1,458 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: houses_search.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: HousesSearch and Information
+type HousesSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HousesSearch  *HousesSearch          `protobuf:"bytes,1,opt,name=houses_search,json=housesSearch,proto3" json:"houses_search,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HousesSearchResponse) Reset() {
+	*x = HousesSearchResponse{}
+	mi := &file_houses_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HousesSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HousesSearchResponse) ProtoMessage() {}
+
+func (x *HousesSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_houses_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HousesSearchResponse.ProtoReflect.Descriptor instead.
+func (*HousesSearchResponse) Descriptor() ([]byte, []int) {
+	return file_houses_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HousesSearchResponse) GetHousesSearch() *HousesSearch {
+	if x != nil {
+		return x.HousesSearch
+	}
+	return nil
+}
+
+func (x *HousesSearchResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of HousesSearch
+type HousesSearchHouse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseId       int64                  `protobuf:"varint,1,opt,name=house_id,json=houseId,proto3" json:"house_id,omitempty"` // The internal ID of the house/guildhall.
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                       // The name of the house/guildhall.
+	Town          string                 `protobuf:"bytes,3,opt,name=town,proto3" json:"town,omitempty"`                       // The town where the house/guildhall is located.
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                       // The type of home. (house or guildhall)
+	Beds          int64                  `protobuf:"varint,5,opt,name=beds,proto3" json:"beds,omitempty"`                      // The number of beds it has.
+	Size          int64                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`                      // The number of SQM it has.
+	Rent          int64                  `protobuf:"varint,7,opt,name=rent,proto3" json:"rent,omitempty"`                      // The monthly cost in gold coins for the house/guildhall.
+	Rented        bool                   `protobuf:"varint,8,opt,name=rented,proto3" json:"rented,omitempty"`                  // Whether the house/guildhall is rented or not.
+	Auctioned     bool                   `protobuf:"varint,9,opt,name=auctioned,proto3" json:"auctioned,omitempty"`            // Whether the house/guildhall is auctioned or not.
+	Auction       *HouseAuction          `protobuf:"bytes,10,opt,name=auction,proto3" json:"auction,omitempty"`                // Details about the auction.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HousesSearchHouse) Reset() {
+	*x = HousesSearchHouse{}
+	mi := &file_houses_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HousesSearchHouse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HousesSearchHouse) ProtoMessage() {}
+
+func (x *HousesSearchHouse) ProtoReflect() protoreflect.Message {
+	mi := &file_houses_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HousesSearchHouse.ProtoReflect.Descriptor instead.
+func (*HousesSearchHouse) Descriptor() ([]byte, []int) {
+	return file_houses_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HousesSearchHouse) GetHouseId() int64 {
+	if x != nil {
+		return x.HouseId
+	}
+	return 0
+}
+
+func (x *HousesSearchHouse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HousesSearchHouse) GetTown() string {
+	if x != nil {
+		return x.Town
+	}
+	return ""
+}
+
+func (x *HousesSearchHouse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *HousesSearchHouse) GetBeds() int64 {
+	if x != nil {
+		return x.Beds
+	}
+	return 0
+}
+
+func (x *HousesSearchHouse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *HousesSearchHouse) GetRent() int64 {
+	if x != nil {
+		return x.Rent
+	}
+	return 0
+}
+
+func (x *HousesSearchHouse) GetRented() bool {
+	if x != nil {
+		return x.Rented
+	}
+	return false
+}
+
+func (x *HousesSearchHouse) GetAuctioned() bool {
+	if x != nil {
+		return x.Auctioned
+	}
+	return false
+}
+
+func (x *HousesSearchHouse) GetAuction() *HouseAuction {
+	if x != nil {
+		return x.Auction
+	}
+	return nil
+}
+
+// Child of HousesSearch
+type HousesSearchPage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPage   int64                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`    // The current page being displayed.
+	TotalPages    int64                  `protobuf:"varint,2,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`       // The total number of pages.
+	TotalResults  int64                  `protobuf:"varint,3,opt,name=total_results,json=totalResults,proto3" json:"total_results,omitempty"` // The total number of houses and guildhalls found.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HousesSearchPage) Reset() {
+	*x = HousesSearchPage{}
+	mi := &file_houses_search_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HousesSearchPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HousesSearchPage) ProtoMessage() {}
+
+func (x *HousesSearchPage) ProtoReflect() protoreflect.Message {
+	mi := &file_houses_search_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HousesSearchPage.ProtoReflect.Descriptor instead.
+func (*HousesSearchPage) Descriptor() ([]byte, []int) {
+	return file_houses_search_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HousesSearchPage) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *HousesSearchPage) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *HousesSearchPage) GetTotalResults() int64 {
+	if x != nil {
+		return x.TotalResults
+	}
+	return 0
+}
+
+// Child of JSONData
+type HousesSearch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         string                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`                                           // The name of the world.
+	Town          string                 `protobuf:"bytes,2,opt,name=town,proto3" json:"town,omitempty"`                                             // The town that was searched, empty if all towns were searched.
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                             // The type of home that was searched, empty if both were searched.
+	Sort          string                 `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`                                             // The value the houses are sorted by.
+	Order         string                 `protobuf:"bytes,5,opt,name=order,proto3" json:"order,omitempty"`                                           // The order the houses are sorted in.
+	Page          *HousesSearchPage      `protobuf:"bytes,6,opt,name=page,proto3" json:"page,omitempty"`                                             // The pagination of the houses.
+	Houses        []*HousesSearchHouse   `protobuf:"bytes,7,rep,name=houses,proto3" json:"houses,omitempty"`                                         // The houses and guildhalls of the page.
+	FailedTowns   []string               `protobuf:"bytes,8,rep,name=failed_towns,json=failedTowns,proto3" json:"failed_towns,omitempty"`            // The names of the towns that could not be fetched.
+	FailedHouses  []int64                `protobuf:"varint,9,rep,packed,name=failed_houses,json=failedHouses,proto3" json:"failed_houses,omitempty"` // The IDs of the houses and guildhalls whose house page could not be fetched.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HousesSearch) Reset() {
+	*x = HousesSearch{}
+	mi := &file_houses_search_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HousesSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HousesSearch) ProtoMessage() {}
+
+func (x *HousesSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_houses_search_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HousesSearch.ProtoReflect.Descriptor instead.
+func (*HousesSearch) Descriptor() ([]byte, []int) {
+	return file_houses_search_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HousesSearch) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *HousesSearch) GetTown() string {
+	if x != nil {
+		return x.Town
+	}
+	return ""
+}
+
+func (x *HousesSearch) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *HousesSearch) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *HousesSearch) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *HousesSearch) GetPage() *HousesSearchPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *HousesSearch) GetHouses() []*HousesSearchHouse {
+	if x != nil {
+		return x.Houses
+	}
+	return nil
+}
+
+func (x *HousesSearch) GetFailedTowns() []string {
+	if x != nil {
+		return x.FailedTowns
+	}
+	return nil
+}
+
+func (x *HousesSearch) GetFailedHouses() []int64 {
+	if x != nil {
+		return x.FailedHouses
+	}
+	return nil
+}
+
+var File_houses_search_proto protoreflect.FileDescriptor
+
+const file_houses_search_proto_rawDesc = "" +
+	"\n" +
+	"\x13houses_search.proto\x12\ftibiadata.v4\x1a\x12houses_house.proto\x1a\x11information.proto\"\x94\x01\n" +
+	"\x14HousesSearchResponse\x12?\n" +
+	"\rhouses_search\x18\x01 \x01(\v2\x1a.tibiadata.v4.HousesSearchR\fhousesSearch\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\x92\x02\n" +
+	"\x11HousesSearchHouse\x12\x19\n" +
+	"\bhouse_id\x18\x01 \x01(\x03R\ahouseId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04town\x18\x03 \x01(\tR\x04town\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x12\n" +
+	"\x04beds\x18\x05 \x01(\x03R\x04beds\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\x12\x12\n" +
+	"\x04rent\x18\a \x01(\x03R\x04rent\x12\x16\n" +
+	"\x06rented\x18\b \x01(\bR\x06rented\x12\x1c\n" +
+	"\tauctioned\x18\t \x01(\bR\tauctioned\x124\n" +
+	"\aauction\x18\n" +
+	" \x01(\v2\x1a.tibiadata.v4.HouseAuctionR\aauction\"{\n" +
+	"\x10HousesSearchPage\x12!\n" +
+	"\fcurrent_page\x18\x01 \x01(\x03R\vcurrentPage\x12\x1f\n" +
+	"\vtotal_pages\x18\x02 \x01(\x03R\n" +
+	"totalPages\x12#\n" +
+	"\rtotal_results\x18\x03 \x01(\x03R\ftotalResults\"\xab\x02\n" +
+	"\fHousesSearch\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\x12\x12\n" +
+	"\x04town\x18\x02 \x01(\tR\x04town\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x14\n" +
+	"\x05order\x18\x05 \x01(\tR\x05order\x122\n" +
+	"\x04page\x18\x06 \x01(\v2\x1e.tibiadata.v4.HousesSearchPageR\x04page\x127\n" +
+	"\x06houses\x18\a \x03(\v2\x1f.tibiadata.v4.HousesSearchHouseR\x06houses\x12!\n" +
+	"\ffailed_towns\x18\b \x03(\tR\vfailedTowns\x12#\n" +
+	"\rfailed_houses\x18\t \x03(\x03R\ffailedHousesB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_houses_search_proto_rawDescOnce sync.Once
+	file_houses_search_proto_rawDescData []byte
+)
+
+func file_houses_search_proto_rawDescGZIP() []byte {
+	file_houses_search_proto_rawDescOnce.Do(func() {
+		file_houses_search_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_houses_search_proto_rawDesc), len(file_houses_search_proto_rawDesc)))
+	})
+	return file_houses_search_proto_rawDescData
+}
+
+var file_houses_search_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_houses_search_proto_goTypes = []any{
+	(*HousesSearchResponse)(nil), // 0: tibiadata.v4.HousesSearchResponse
+	(*HousesSearchHouse)(nil),    // 1: tibiadata.v4.HousesSearchHouse
+	(*HousesSearchPage)(nil),     // 2: tibiadata.v4.HousesSearchPage
+	(*HousesSearch)(nil),         // 3: tibiadata.v4.HousesSearch
+	(*Information)(nil),          // 4: tibiadata.v4.Information
+	(*HouseAuction)(nil),         // 5: tibiadata.v4.HouseAuction
+}
+var file_houses_search_proto_depIdxs = []int32{
+	3, // 0: tibiadata.v4.HousesSearchResponse.houses_search:type_name -> tibiadata.v4.HousesSearch
+	4, // 1: tibiadata.v4.HousesSearchResponse.information:type_name -> tibiadata.v4.Information
+	5, // 2: tibiadata.v4.HousesSearchHouse.auction:type_name -> tibiadata.v4.HouseAuction
+	2, // 3: tibiadata.v4.HousesSearch.page:type_name -> tibiadata.v4.HousesSearchPage
+	1, // 4: tibiadata.v4.HousesSearch.houses:type_name -> tibiadata.v4.HousesSearchHouse
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_houses_search_proto_init() }
+func file_houses_search_proto_init() {
+	if File_houses_search_proto != nil {
+		return
+	}
+	file_houses_house_proto_init()
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_houses_search_proto_rawDesc), len(file_houses_search_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_houses_search_proto_goTypes,
+		DependencyIndexes: file_houses_search_proto_depIdxs,
+		MessageInfos:      file_houses_search_proto_msgTypes,
+	}.Build()
+	File_houses_search_proto = out.File
+	file_houses_search_proto_goTypes = nil
+	file_houses_search_proto_depIdxs = nil
+}
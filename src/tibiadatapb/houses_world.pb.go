@@ -0,0 +1,359 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: houses_world.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: HousesWorld and Information
+type HousesWorldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HousesWorld   *HousesWorld           `protobuf:"bytes,1,opt,name=houses_world,json=housesWorld,proto3" json:"houses_world,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HousesWorldResponse) Reset() {
+	*x = HousesWorldResponse{}
+	mi := &file_houses_world_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HousesWorldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HousesWorldResponse) ProtoMessage() {}
+
+func (x *HousesWorldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_houses_world_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HousesWorldResponse.ProtoReflect.Descriptor instead.
+func (*HousesWorldResponse) Descriptor() ([]byte, []int) {
+	return file_houses_world_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HousesWorldResponse) GetHousesWorld() *HousesWorld {
+	if x != nil {
+		return x.HousesWorld
+	}
+	return nil
+}
+
+func (x *HousesWorldResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of HousesWorld
+type HousesWorldTown struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Town          string                 `protobuf:"bytes,1,opt,name=town,proto3" json:"town,omitempty"`                                        // The name of the town.
+	HouseList     []*HousesHouse         `protobuf:"bytes,2,rep,name=house_list,json=houseList,proto3" json:"house_list,omitempty"`             // List of the houses of the town.
+	GuildhallList []*HousesHouse         `protobuf:"bytes,3,rep,name=guildhall_list,json=guildhallList,proto3" json:"guildhall_list,omitempty"` // List of the guildhalls of the town.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HousesWorldTown) Reset() {
+	*x = HousesWorldTown{}
+	mi := &file_houses_world_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HousesWorldTown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HousesWorldTown) ProtoMessage() {}
+
+func (x *HousesWorldTown) ProtoReflect() protoreflect.Message {
+	mi := &file_houses_world_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HousesWorldTown.ProtoReflect.Descriptor instead.
+func (*HousesWorldTown) Descriptor() ([]byte, []int) {
+	return file_houses_world_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HousesWorldTown) GetTown() string {
+	if x != nil {
+		return x.Town
+	}
+	return ""
+}
+
+func (x *HousesWorldTown) GetHouseList() []*HousesHouse {
+	if x != nil {
+		return x.HouseList
+	}
+	return nil
+}
+
+func (x *HousesWorldTown) GetGuildhallList() []*HousesHouse {
+	if x != nil {
+		return x.GuildhallList
+	}
+	return nil
+}
+
+// Child of HousesWorld
+type HousesWorldCounts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`         // The number of houses and guildhalls.
+	Rented        int64                  `protobuf:"varint,2,opt,name=rented,proto3" json:"rented,omitempty"`       // The number of rented houses and guildhalls.
+	Auctioned     int64                  `protobuf:"varint,3,opt,name=auctioned,proto3" json:"auctioned,omitempty"` // The number of auctioned houses and guildhalls.
+	Free          int64                  `protobuf:"varint,4,opt,name=free,proto3" json:"free,omitempty"`           // The number of houses and guildhalls that are neither rented nor auctioned.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HousesWorldCounts) Reset() {
+	*x = HousesWorldCounts{}
+	mi := &file_houses_world_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HousesWorldCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HousesWorldCounts) ProtoMessage() {}
+
+func (x *HousesWorldCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_houses_world_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HousesWorldCounts.ProtoReflect.Descriptor instead.
+func (*HousesWorldCounts) Descriptor() ([]byte, []int) {
+	return file_houses_world_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HousesWorldCounts) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *HousesWorldCounts) GetRented() int64 {
+	if x != nil {
+		return x.Rented
+	}
+	return 0
+}
+
+func (x *HousesWorldCounts) GetAuctioned() int64 {
+	if x != nil {
+		return x.Auctioned
+	}
+	return 0
+}
+
+func (x *HousesWorldCounts) GetFree() int64 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+// Child of JSONData
+type HousesWorld struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         string                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`                                // The name of the world.
+	Towns         []*HousesWorldTown     `protobuf:"bytes,2,rep,name=towns,proto3" json:"towns,omitempty"`                                // The houses and guildhalls grouped by town.
+	Counts        *HousesWorldCounts     `protobuf:"bytes,3,opt,name=counts,proto3" json:"counts,omitempty"`                              // The counts of the listed houses and guildhalls of all towns.
+	FailedTowns   []string               `protobuf:"bytes,4,rep,name=failed_towns,json=failedTowns,proto3" json:"failed_towns,omitempty"` // The names of the towns that could not be fetched.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HousesWorld) Reset() {
+	*x = HousesWorld{}
+	mi := &file_houses_world_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HousesWorld) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HousesWorld) ProtoMessage() {}
+
+func (x *HousesWorld) ProtoReflect() protoreflect.Message {
+	mi := &file_houses_world_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HousesWorld.ProtoReflect.Descriptor instead.
+func (*HousesWorld) Descriptor() ([]byte, []int) {
+	return file_houses_world_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HousesWorld) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *HousesWorld) GetTowns() []*HousesWorldTown {
+	if x != nil {
+		return x.Towns
+	}
+	return nil
+}
+
+func (x *HousesWorld) GetCounts() *HousesWorldCounts {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *HousesWorld) GetFailedTowns() []string {
+	if x != nil {
+		return x.FailedTowns
+	}
+	return nil
+}
+
+var File_houses_world_proto protoreflect.FileDescriptor
+
+const file_houses_world_proto_rawDesc = "" +
+	"\n" +
+	"\x12houses_world.proto\x12\ftibiadata.v4\x1a\x15houses_overview.proto\x1a\x11information.proto\"\x90\x01\n" +
+	"\x13HousesWorldResponse\x12<\n" +
+	"\fhouses_world\x18\x01 \x01(\v2\x19.tibiadata.v4.HousesWorldR\vhousesWorld\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xa1\x01\n" +
+	"\x0fHousesWorldTown\x12\x12\n" +
+	"\x04town\x18\x01 \x01(\tR\x04town\x128\n" +
+	"\n" +
+	"house_list\x18\x02 \x03(\v2\x19.tibiadata.v4.HousesHouseR\thouseList\x12@\n" +
+	"\x0eguildhall_list\x18\x03 \x03(\v2\x19.tibiadata.v4.HousesHouseR\rguildhallList\"s\n" +
+	"\x11HousesWorldCounts\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x16\n" +
+	"\x06rented\x18\x02 \x01(\x03R\x06rented\x12\x1c\n" +
+	"\tauctioned\x18\x03 \x01(\x03R\tauctioned\x12\x12\n" +
+	"\x04free\x18\x04 \x01(\x03R\x04free\"\xb4\x01\n" +
+	"\vHousesWorld\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\x123\n" +
+	"\x05towns\x18\x02 \x03(\v2\x1d.tibiadata.v4.HousesWorldTownR\x05towns\x127\n" +
+	"\x06counts\x18\x03 \x01(\v2\x1f.tibiadata.v4.HousesWorldCountsR\x06counts\x12!\n" +
+	"\ffailed_towns\x18\x04 \x03(\tR\vfailedTownsB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_houses_world_proto_rawDescOnce sync.Once
+	file_houses_world_proto_rawDescData []byte
+)
+
+func file_houses_world_proto_rawDescGZIP() []byte {
+	file_houses_world_proto_rawDescOnce.Do(func() {
+		file_houses_world_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_houses_world_proto_rawDesc), len(file_houses_world_proto_rawDesc)))
+	})
+	return file_houses_world_proto_rawDescData
+}
+
+var file_houses_world_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_houses_world_proto_goTypes = []any{
+	(*HousesWorldResponse)(nil), // 0: tibiadata.v4.HousesWorldResponse
+	(*HousesWorldTown)(nil),     // 1: tibiadata.v4.HousesWorldTown
+	(*HousesWorldCounts)(nil),   // 2: tibiadata.v4.HousesWorldCounts
+	(*HousesWorld)(nil),         // 3: tibiadata.v4.HousesWorld
+	(*Information)(nil),         // 4: tibiadata.v4.Information
+	(*HousesHouse)(nil),         // 5: tibiadata.v4.HousesHouse
+}
+var file_houses_world_proto_depIdxs = []int32{
+	3, // 0: tibiadata.v4.HousesWorldResponse.houses_world:type_name -> tibiadata.v4.HousesWorld
+	4, // 1: tibiadata.v4.HousesWorldResponse.information:type_name -> tibiadata.v4.Information
+	5, // 2: tibiadata.v4.HousesWorldTown.house_list:type_name -> tibiadata.v4.HousesHouse
+	5, // 3: tibiadata.v4.HousesWorldTown.guildhall_list:type_name -> tibiadata.v4.HousesHouse
+	1, // 4: tibiadata.v4.HousesWorld.towns:type_name -> tibiadata.v4.HousesWorldTown
+	2, // 5: tibiadata.v4.HousesWorld.counts:type_name -> tibiadata.v4.HousesWorldCounts
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_houses_world_proto_init() }
+func file_houses_world_proto_init() {
+	if File_houses_world_proto != nil {
+		return
+	}
+	file_houses_overview_proto_init()
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_houses_world_proto_rawDesc), len(file_houses_world_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_houses_world_proto_goTypes,
+		DependencyIndexes: file_houses_world_proto_depIdxs,
+		MessageInfos:      file_houses_world_proto_msgTypes,
+	}.Build()
+	File_houses_world_proto = out.File
+	file_houses_world_proto_goTypes = nil
+	file_houses_world_proto_depIdxs = nil
+}
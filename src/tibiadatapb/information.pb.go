@@ -0,0 +1,335 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: information.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// API details store information about this API
+type APIDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int64                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // The API major version currently running.
+	Release       string                 `protobuf:"bytes,2,opt,name=release,proto3" json:"release,omitempty"`  // The API release currently running.
+	Commit        string                 `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`    // The API GitHub commit sha.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIDetails) Reset() {
+	*x = APIDetails{}
+	mi := &file_information_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIDetails) ProtoMessage() {}
+
+func (x *APIDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_information_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIDetails.ProtoReflect.Descriptor instead.
+func (*APIDetails) Descriptor() ([]byte, []int) {
+	return file_information_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *APIDetails) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *APIDetails) GetRelease() string {
+	if x != nil {
+		return x.Release
+	}
+	return ""
+}
+
+func (x *APIDetails) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+// Information stores some API related data
+type Information struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Api           *APIDetails            `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`                              // The API details.
+	Timestamp     string                 `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                  // The timestamp from when the data was processed.
+	TibiaUrls     []string               `protobuf:"bytes,3,rep,name=tibia_urls,json=tibiaUrls,proto3" json:"tibia_urls,omitempty"` // The links to the sources of the data on tibia.com
+	Status        *Status                `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                        // The response status information.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Information) Reset() {
+	*x = Information{}
+	mi := &file_information_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Information) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Information) ProtoMessage() {}
+
+func (x *Information) ProtoReflect() protoreflect.Message {
+	mi := &file_information_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Information.ProtoReflect.Descriptor instead.
+func (*Information) Descriptor() ([]byte, []int) {
+	return file_information_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Information) GetApi() *APIDetails {
+	if x != nil {
+		return x.Api
+	}
+	return nil
+}
+
+func (x *Information) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *Information) GetTibiaUrls() []string {
+	if x != nil {
+		return x.TibiaUrls
+	}
+	return nil
+}
+
+func (x *Information) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// OutInformation wraps Information in other for all json outputs be consistent
+type OutInformation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Information   *Information           `protobuf:"bytes,1,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutInformation) Reset() {
+	*x = OutInformation{}
+	mi := &file_information_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutInformation) ProtoMessage() {}
+
+func (x *OutInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_information_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutInformation.ProtoReflect.Descriptor instead.
+func (*OutInformation) Descriptor() ([]byte, []int) {
+	return file_information_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OutInformation) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Status stores information about the response
+type Status struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HttpCode      int64                  `protobuf:"varint,1,opt,name=http_code,json=httpCode,proto3" json:"http_code,omitempty"` // The HTTP response code from the API.
+	Error         int64                  `protobuf:"varint,2,opt,name=error,proto3" json:"error,omitempty"`                       // The error code thrown by TibiaData API for identification of issue.
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`                    // The error message thrown by TibiaData API for human readability.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Status) Reset() {
+	*x = Status{}
+	mi := &file_information_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status) ProtoMessage() {}
+
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_information_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_information_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Status) GetHttpCode() int64 {
+	if x != nil {
+		return x.HttpCode
+	}
+	return 0
+}
+
+func (x *Status) GetError() int64 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *Status) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_information_proto protoreflect.FileDescriptor
+
+const file_information_proto_rawDesc = "" +
+	"\n" +
+	"\x11information.proto\x12\ftibiadata.v4\"X\n" +
+	"\n" +
+	"APIDetails\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\x12\x18\n" +
+	"\arelease\x18\x02 \x01(\tR\arelease\x12\x16\n" +
+	"\x06commit\x18\x03 \x01(\tR\x06commit\"\xa4\x01\n" +
+	"\vInformation\x12*\n" +
+	"\x03api\x18\x01 \x01(\v2\x18.tibiadata.v4.APIDetailsR\x03api\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\tR\ttimestamp\x12\x1d\n" +
+	"\n" +
+	"tibia_urls\x18\x03 \x03(\tR\ttibiaUrls\x12,\n" +
+	"\x06status\x18\x04 \x01(\v2\x14.tibiadata.v4.StatusR\x06status\"M\n" +
+	"\x0eOutInformation\x12;\n" +
+	"\vinformation\x18\x01 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"U\n" +
+	"\x06Status\x12\x1b\n" +
+	"\thttp_code\x18\x01 \x01(\x03R\bhttpCode\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\x03R\x05error\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessageB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_information_proto_rawDescOnce sync.Once
+	file_information_proto_rawDescData []byte
+)
+
+func file_information_proto_rawDescGZIP() []byte {
+	file_information_proto_rawDescOnce.Do(func() {
+		file_information_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_information_proto_rawDesc), len(file_information_proto_rawDesc)))
+	})
+	return file_information_proto_rawDescData
+}
+
+var file_information_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_information_proto_goTypes = []any{
+	(*APIDetails)(nil),     // 0: tibiadata.v4.APIDetails
+	(*Information)(nil),    // 1: tibiadata.v4.Information
+	(*OutInformation)(nil), // 2: tibiadata.v4.OutInformation
+	(*Status)(nil),         // 3: tibiadata.v4.Status
+}
+var file_information_proto_depIdxs = []int32{
+	0, // 0: tibiadata.v4.Information.api:type_name -> tibiadata.v4.APIDetails
+	3, // 1: tibiadata.v4.Information.status:type_name -> tibiadata.v4.Status
+	1, // 2: tibiadata.v4.OutInformation.information:type_name -> tibiadata.v4.Information
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_information_proto_init() }
+func file_information_proto_init() {
+	if File_information_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_information_proto_rawDesc), len(file_information_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_information_proto_goTypes,
+		DependencyIndexes: file_information_proto_depIdxs,
+		MessageInfos:      file_information_proto_msgTypes,
+	}.Build()
+	File_information_proto = out.File
+	file_information_proto_goTypes = nil
+	file_information_proto_depIdxs = nil
+}
@@ -0,0 +1,363 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: killstatistics.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: KillStatistics and Information
+type KillStatisticsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Killstatistics *KillStatistics        `protobuf:"bytes,1,opt,name=killstatistics,proto3" json:"killstatistics,omitempty"`
+	Information    *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *KillStatisticsResponse) Reset() {
+	*x = KillStatisticsResponse{}
+	mi := &file_killstatistics_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KillStatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillStatisticsResponse) ProtoMessage() {}
+
+func (x *KillStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_killstatistics_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*KillStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_killstatistics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *KillStatisticsResponse) GetKillstatistics() *KillStatistics {
+	if x != nil {
+		return x.Killstatistics
+	}
+	return nil
+}
+
+func (x *KillStatisticsResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of KillStatistics
+type Entry struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Race                  string                 `protobuf:"bytes,1,opt,name=race,proto3" json:"race,omitempty"`                                                                     // The name of the creature/race.
+	LastDayPlayersKilled  int64                  `protobuf:"varint,2,opt,name=last_day_players_killed,json=lastDayPlayersKilled,proto3" json:"last_day_players_killed,omitempty"`    // Number of players killed by this race in the last day.
+	LastDayKilled         int64                  `protobuf:"varint,3,opt,name=last_day_killed,json=lastDayKilled,proto3" json:"last_day_killed,omitempty"`                           // Number of creatures of this race killed in the last day.
+	LastWeekPlayersKilled int64                  `protobuf:"varint,4,opt,name=last_week_players_killed,json=lastWeekPlayersKilled,proto3" json:"last_week_players_killed,omitempty"` // Number of players killed by this race in the last week.
+	LastWeekKilled        int64                  `protobuf:"varint,5,opt,name=last_week_killed,json=lastWeekKilled,proto3" json:"last_week_killed,omitempty"`                        // Number of creatures of this race killed in the last week.
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Entry) Reset() {
+	*x = Entry{}
+	mi := &file_killstatistics_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entry) ProtoMessage() {}
+
+func (x *Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_killstatistics_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entry.ProtoReflect.Descriptor instead.
+func (*Entry) Descriptor() ([]byte, []int) {
+	return file_killstatistics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Entry) GetRace() string {
+	if x != nil {
+		return x.Race
+	}
+	return ""
+}
+
+func (x *Entry) GetLastDayPlayersKilled() int64 {
+	if x != nil {
+		return x.LastDayPlayersKilled
+	}
+	return 0
+}
+
+func (x *Entry) GetLastDayKilled() int64 {
+	if x != nil {
+		return x.LastDayKilled
+	}
+	return 0
+}
+
+func (x *Entry) GetLastWeekPlayersKilled() int64 {
+	if x != nil {
+		return x.LastWeekPlayersKilled
+	}
+	return 0
+}
+
+func (x *Entry) GetLastWeekKilled() int64 {
+	if x != nil {
+		return x.LastWeekKilled
+	}
+	return 0
+}
+
+// Child of JSONData
+type KillStatistics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         string                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`     // The world the statistics belong to.
+	Entries       []*Entry               `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"` // List of killstatistic.
+	Total         *Total                 `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`     // List of total kills.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KillStatistics) Reset() {
+	*x = KillStatistics{}
+	mi := &file_killstatistics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KillStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillStatistics) ProtoMessage() {}
+
+func (x *KillStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_killstatistics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillStatistics.ProtoReflect.Descriptor instead.
+func (*KillStatistics) Descriptor() ([]byte, []int) {
+	return file_killstatistics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *KillStatistics) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *KillStatistics) GetEntries() []*Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *KillStatistics) GetTotal() *Total {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+// Child of KillStatistics
+type Total struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	LastDayPlayersKilled  int64                  `protobuf:"varint,1,opt,name=last_day_players_killed,json=lastDayPlayersKilled,proto3" json:"last_day_players_killed,omitempty"`    // Total number of players killed in total in the last day.
+	LastDayKilled         int64                  `protobuf:"varint,2,opt,name=last_day_killed,json=lastDayKilled,proto3" json:"last_day_killed,omitempty"`                           // Total number of creatures in total killed in the last day.
+	LastWeekPlayersKilled int64                  `protobuf:"varint,3,opt,name=last_week_players_killed,json=lastWeekPlayersKilled,proto3" json:"last_week_players_killed,omitempty"` // Total number of players killed in total in the last week.
+	LastWeekKilled        int64                  `protobuf:"varint,4,opt,name=last_week_killed,json=lastWeekKilled,proto3" json:"last_week_killed,omitempty"`                        // Total number of creatures in total killed in the last week.
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Total) Reset() {
+	*x = Total{}
+	mi := &file_killstatistics_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Total) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Total) ProtoMessage() {}
+
+func (x *Total) ProtoReflect() protoreflect.Message {
+	mi := &file_killstatistics_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Total.ProtoReflect.Descriptor instead.
+func (*Total) Descriptor() ([]byte, []int) {
+	return file_killstatistics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Total) GetLastDayPlayersKilled() int64 {
+	if x != nil {
+		return x.LastDayPlayersKilled
+	}
+	return 0
+}
+
+func (x *Total) GetLastDayKilled() int64 {
+	if x != nil {
+		return x.LastDayKilled
+	}
+	return 0
+}
+
+func (x *Total) GetLastWeekPlayersKilled() int64 {
+	if x != nil {
+		return x.LastWeekPlayersKilled
+	}
+	return 0
+}
+
+func (x *Total) GetLastWeekKilled() int64 {
+	if x != nil {
+		return x.LastWeekKilled
+	}
+	return 0
+}
+
+var File_killstatistics_proto protoreflect.FileDescriptor
+
+const file_killstatistics_proto_rawDesc = "" +
+	"\n" +
+	"\x14killstatistics.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\x9b\x01\n" +
+	"\x16KillStatisticsResponse\x12D\n" +
+	"\x0ekillstatistics\x18\x01 \x01(\v2\x1c.tibiadata.v4.KillStatisticsR\x0ekillstatistics\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xdd\x01\n" +
+	"\x05Entry\x12\x12\n" +
+	"\x04race\x18\x01 \x01(\tR\x04race\x125\n" +
+	"\x17last_day_players_killed\x18\x02 \x01(\x03R\x14lastDayPlayersKilled\x12&\n" +
+	"\x0flast_day_killed\x18\x03 \x01(\x03R\rlastDayKilled\x127\n" +
+	"\x18last_week_players_killed\x18\x04 \x01(\x03R\x15lastWeekPlayersKilled\x12(\n" +
+	"\x10last_week_killed\x18\x05 \x01(\x03R\x0elastWeekKilled\"\x80\x01\n" +
+	"\x0eKillStatistics\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\x12-\n" +
+	"\aentries\x18\x02 \x03(\v2\x13.tibiadata.v4.EntryR\aentries\x12)\n" +
+	"\x05total\x18\x03 \x01(\v2\x13.tibiadata.v4.TotalR\x05total\"\xc9\x01\n" +
+	"\x05Total\x125\n" +
+	"\x17last_day_players_killed\x18\x01 \x01(\x03R\x14lastDayPlayersKilled\x12&\n" +
+	"\x0flast_day_killed\x18\x02 \x01(\x03R\rlastDayKilled\x127\n" +
+	"\x18last_week_players_killed\x18\x03 \x01(\x03R\x15lastWeekPlayersKilled\x12(\n" +
+	"\x10last_week_killed\x18\x04 \x01(\x03R\x0elastWeekKilledB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_killstatistics_proto_rawDescOnce sync.Once
+	file_killstatistics_proto_rawDescData []byte
+)
+
+func file_killstatistics_proto_rawDescGZIP() []byte {
+	file_killstatistics_proto_rawDescOnce.Do(func() {
+		file_killstatistics_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_killstatistics_proto_rawDesc), len(file_killstatistics_proto_rawDesc)))
+	})
+	return file_killstatistics_proto_rawDescData
+}
+
+var file_killstatistics_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_killstatistics_proto_goTypes = []any{
+	(*KillStatisticsResponse)(nil), // 0: tibiadata.v4.KillStatisticsResponse
+	(*Entry)(nil),                  // 1: tibiadata.v4.Entry
+	(*KillStatistics)(nil),         // 2: tibiadata.v4.KillStatistics
+	(*Total)(nil),                  // 3: tibiadata.v4.Total
+	(*Information)(nil),            // 4: tibiadata.v4.Information
+}
+var file_killstatistics_proto_depIdxs = []int32{
+	2, // 0: tibiadata.v4.KillStatisticsResponse.killstatistics:type_name -> tibiadata.v4.KillStatistics
+	4, // 1: tibiadata.v4.KillStatisticsResponse.information:type_name -> tibiadata.v4.Information
+	1, // 2: tibiadata.v4.KillStatistics.entries:type_name -> tibiadata.v4.Entry
+	3, // 3: tibiadata.v4.KillStatistics.total:type_name -> tibiadata.v4.Total
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_killstatistics_proto_init() }
+func file_killstatistics_proto_init() {
+	if File_killstatistics_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_killstatistics_proto_rawDesc), len(file_killstatistics_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_killstatistics_proto_goTypes,
+		DependencyIndexes: file_killstatistics_proto_depIdxs,
+		MessageInfos:      file_killstatistics_proto_msgTypes,
+	}.Build()
+	File_killstatistics_proto = out.File
+	file_killstatistics_proto_goTypes = nil
+	file_killstatistics_proto_depIdxs = nil
+}
@@ -0,0 +1,238 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: newslist.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base
+type NewsListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	News          []*NewsItem            `protobuf:"bytes,1,rep,name=news,proto3" json:"news,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewsListResponse) Reset() {
+	*x = NewsListResponse{}
+	mi := &file_newslist_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewsListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewsListResponse) ProtoMessage() {}
+
+func (x *NewsListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newslist_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewsListResponse.ProtoReflect.Descriptor instead.
+func (*NewsListResponse) Descriptor() ([]byte, []int) {
+	return file_newslist_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NewsListResponse) GetNews() []*NewsItem {
+	if x != nil {
+		return x.News
+	}
+	return nil
+}
+
+func (x *NewsListResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type NewsItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                      // The internal ID of the news.
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`                   // The date when the news was published.
+	News          string                 `protobuf:"bytes,3,opt,name=news,proto3" json:"news,omitempty"`                   // The news in plain text.
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`           // The category of the news.
+	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`                   // The type of news.
+	Url           string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`                     // The URL for the news with id.
+	UrlApi        string                 `protobuf:"bytes,7,opt,name=url_api,json=urlApi,proto3" json:"url_api,omitempty"` // The URL for the news in this API.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewsItem) Reset() {
+	*x = NewsItem{}
+	mi := &file_newslist_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewsItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewsItem) ProtoMessage() {}
+
+func (x *NewsItem) ProtoReflect() protoreflect.Message {
+	mi := &file_newslist_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewsItem.ProtoReflect.Descriptor instead.
+func (*NewsItem) Descriptor() ([]byte, []int) {
+	return file_newslist_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NewsItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NewsItem) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *NewsItem) GetNews() string {
+	if x != nil {
+		return x.News
+	}
+	return ""
+}
+
+func (x *NewsItem) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *NewsItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NewsItem) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *NewsItem) GetUrlApi() string {
+	if x != nil {
+		return x.UrlApi
+	}
+	return ""
+}
+
+var File_newslist_proto protoreflect.FileDescriptor
+
+const file_newslist_proto_rawDesc = "" +
+	"\n" +
+	"\x0enewslist.proto\x12\ftibiadata.v4\x1a\x11information.proto\"{\n" +
+	"\x10NewsListResponse\x12*\n" +
+	"\x04news\x18\x01 \x03(\v2\x16.tibiadata.v4.NewsItemR\x04news\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\x9d\x01\n" +
+	"\bNewsItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x12\n" +
+	"\x04news\x18\x03 \x01(\tR\x04news\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x12\x17\n" +
+	"\aurl_api\x18\a \x01(\tR\x06urlApiB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_newslist_proto_rawDescOnce sync.Once
+	file_newslist_proto_rawDescData []byte
+)
+
+func file_newslist_proto_rawDescGZIP() []byte {
+	file_newslist_proto_rawDescOnce.Do(func() {
+		file_newslist_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_newslist_proto_rawDesc), len(file_newslist_proto_rawDesc)))
+	})
+	return file_newslist_proto_rawDescData
+}
+
+var file_newslist_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_newslist_proto_goTypes = []any{
+	(*NewsListResponse)(nil), // 0: tibiadata.v4.NewsListResponse
+	(*NewsItem)(nil),         // 1: tibiadata.v4.NewsItem
+	(*Information)(nil),      // 2: tibiadata.v4.Information
+}
+var file_newslist_proto_depIdxs = []int32{
+	1, // 0: tibiadata.v4.NewsListResponse.news:type_name -> tibiadata.v4.NewsItem
+	2, // 1: tibiadata.v4.NewsListResponse.information:type_name -> tibiadata.v4.Information
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_newslist_proto_init() }
+func file_newslist_proto_init() {
+	if File_newslist_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_newslist_proto_rawDesc), len(file_newslist_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_newslist_proto_goTypes,
+		DependencyIndexes: file_newslist_proto_depIdxs,
+		MessageInfos:      file_newslist_proto_msgTypes,
+	}.Build()
+	File_newslist_proto = out.File
+	file_newslist_proto_goTypes = nil
+	file_newslist_proto_depIdxs = nil
+}
@@ -0,0 +1,343 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: spells_overview.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: Spells and Information
+type SpellsOverviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Spells        *Spells                `protobuf:"bytes,1,opt,name=spells,proto3" json:"spells,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpellsOverviewResponse) Reset() {
+	*x = SpellsOverviewResponse{}
+	mi := &file_spells_overview_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpellsOverviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpellsOverviewResponse) ProtoMessage() {}
+
+func (x *SpellsOverviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spells_overview_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpellsOverviewResponse.ProtoReflect.Descriptor instead.
+func (*SpellsOverviewResponse) Descriptor() ([]byte, []int) {
+	return file_spells_overview_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SpellsOverviewResponse) GetSpells() *Spells {
+	if x != nil {
+		return x.Spells
+	}
+	return nil
+}
+
+func (x *SpellsOverviewResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of Spells
+type Spell struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                      // The name of the spell.
+	SpellId       string                 `protobuf:"bytes,2,opt,name=spell_id,json=spellId,proto3" json:"spell_id,omitempty"`                 // The internal identifier of the spell.
+	Formula       string                 `protobuf:"bytes,3,opt,name=formula,proto3" json:"formula,omitempty"`                                // The formula to cast the spell.
+	Level         int64                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`                                   // The required level for casting.
+	Mana          int64                  `protobuf:"varint,5,opt,name=mana,proto3" json:"mana,omitempty"`                                     // The required mana for using.
+	Price         int64                  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`                                   // The price in gold coins to learn it.
+	GroupAttack   bool                   `protobuf:"varint,7,opt,name=group_attack,json=groupAttack,proto3" json:"group_attack,omitempty"`    // Whether the group is attack.
+	GroupHealing  bool                   `protobuf:"varint,8,opt,name=group_healing,json=groupHealing,proto3" json:"group_healing,omitempty"` // Whether the group is healing.
+	GroupSupport  bool                   `protobuf:"varint,9,opt,name=group_support,json=groupSupport,proto3" json:"group_support,omitempty"` // Whether the group is support.
+	TypeInstant   bool                   `protobuf:"varint,10,opt,name=type_instant,json=typeInstant,proto3" json:"type_instant,omitempty"`   // Whether the type is instant.
+	TypeRune      bool                   `protobuf:"varint,11,opt,name=type_rune,json=typeRune,proto3" json:"type_rune,omitempty"`            // Whether the type is rune.
+	PremiumOnly   bool                   `protobuf:"varint,12,opt,name=premium_only,json=premiumOnly,proto3" json:"premium_only,omitempty"`   // Whether it requires to have premium account to learn and use it.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Spell) Reset() {
+	*x = Spell{}
+	mi := &file_spells_overview_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Spell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Spell) ProtoMessage() {}
+
+func (x *Spell) ProtoReflect() protoreflect.Message {
+	mi := &file_spells_overview_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Spell.ProtoReflect.Descriptor instead.
+func (*Spell) Descriptor() ([]byte, []int) {
+	return file_spells_overview_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Spell) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Spell) GetSpellId() string {
+	if x != nil {
+		return x.SpellId
+	}
+	return ""
+}
+
+func (x *Spell) GetFormula() string {
+	if x != nil {
+		return x.Formula
+	}
+	return ""
+}
+
+func (x *Spell) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Spell) GetMana() int64 {
+	if x != nil {
+		return x.Mana
+	}
+	return 0
+}
+
+func (x *Spell) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Spell) GetGroupAttack() bool {
+	if x != nil {
+		return x.GroupAttack
+	}
+	return false
+}
+
+func (x *Spell) GetGroupHealing() bool {
+	if x != nil {
+		return x.GroupHealing
+	}
+	return false
+}
+
+func (x *Spell) GetGroupSupport() bool {
+	if x != nil {
+		return x.GroupSupport
+	}
+	return false
+}
+
+func (x *Spell) GetTypeInstant() bool {
+	if x != nil {
+		return x.TypeInstant
+	}
+	return false
+}
+
+func (x *Spell) GetTypeRune() bool {
+	if x != nil {
+		return x.TypeRune
+	}
+	return false
+}
+
+func (x *Spell) GetPremiumOnly() bool {
+	if x != nil {
+		return x.PremiumOnly
+	}
+	return false
+}
+
+// Child of JSONData
+type Spells struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SpellsFilter  string                 `protobuf:"bytes,1,opt,name=spells_filter,json=spellsFilter,proto3" json:"spells_filter,omitempty"` // The applied filters on the list
+	SpellList     []*Spell               `protobuf:"bytes,2,rep,name=spell_list,json=spellList,proto3" json:"spell_list,omitempty"`          // List of spells
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Spells) Reset() {
+	*x = Spells{}
+	mi := &file_spells_overview_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Spells) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Spells) ProtoMessage() {}
+
+func (x *Spells) ProtoReflect() protoreflect.Message {
+	mi := &file_spells_overview_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Spells.ProtoReflect.Descriptor instead.
+func (*Spells) Descriptor() ([]byte, []int) {
+	return file_spells_overview_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Spells) GetSpellsFilter() string {
+	if x != nil {
+		return x.SpellsFilter
+	}
+	return ""
+}
+
+func (x *Spells) GetSpellList() []*Spell {
+	if x != nil {
+		return x.SpellList
+	}
+	return nil
+}
+
+var File_spells_overview_proto protoreflect.FileDescriptor
+
+const file_spells_overview_proto_rawDesc = "" +
+	"\n" +
+	"\x15spells_overview.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\x83\x01\n" +
+	"\x16SpellsOverviewResponse\x12,\n" +
+	"\x06spells\x18\x01 \x01(\v2\x14.tibiadata.v4.SpellsR\x06spells\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xe0\x02\n" +
+	"\x05Spell\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\bspell_id\x18\x02 \x01(\tR\aspellId\x12\x18\n" +
+	"\aformula\x18\x03 \x01(\tR\aformula\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\x03R\x05level\x12\x12\n" +
+	"\x04mana\x18\x05 \x01(\x03R\x04mana\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x03R\x05price\x12!\n" +
+	"\fgroup_attack\x18\a \x01(\bR\vgroupAttack\x12#\n" +
+	"\rgroup_healing\x18\b \x01(\bR\fgroupHealing\x12#\n" +
+	"\rgroup_support\x18\t \x01(\bR\fgroupSupport\x12!\n" +
+	"\ftype_instant\x18\n" +
+	" \x01(\bR\vtypeInstant\x12\x1b\n" +
+	"\ttype_rune\x18\v \x01(\bR\btypeRune\x12!\n" +
+	"\fpremium_only\x18\f \x01(\bR\vpremiumOnly\"a\n" +
+	"\x06Spells\x12#\n" +
+	"\rspells_filter\x18\x01 \x01(\tR\fspellsFilter\x122\n" +
+	"\n" +
+	"spell_list\x18\x02 \x03(\v2\x13.tibiadata.v4.SpellR\tspellListB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_spells_overview_proto_rawDescOnce sync.Once
+	file_spells_overview_proto_rawDescData []byte
+)
+
+func file_spells_overview_proto_rawDescGZIP() []byte {
+	file_spells_overview_proto_rawDescOnce.Do(func() {
+		file_spells_overview_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_spells_overview_proto_rawDesc), len(file_spells_overview_proto_rawDesc)))
+	})
+	return file_spells_overview_proto_rawDescData
+}
+
+var file_spells_overview_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_spells_overview_proto_goTypes = []any{
+	(*SpellsOverviewResponse)(nil), // 0: tibiadata.v4.SpellsOverviewResponse
+	(*Spell)(nil),                  // 1: tibiadata.v4.Spell
+	(*Spells)(nil),                 // 2: tibiadata.v4.Spells
+	(*Information)(nil),            // 3: tibiadata.v4.Information
+}
+var file_spells_overview_proto_depIdxs = []int32{
+	2, // 0: tibiadata.v4.SpellsOverviewResponse.spells:type_name -> tibiadata.v4.Spells
+	3, // 1: tibiadata.v4.SpellsOverviewResponse.information:type_name -> tibiadata.v4.Information
+	1, // 2: tibiadata.v4.Spells.spell_list:type_name -> tibiadata.v4.Spell
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_spells_overview_proto_init() }
+func file_spells_overview_proto_init() {
+	if File_spells_overview_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_spells_overview_proto_rawDesc), len(file_spells_overview_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_spells_overview_proto_goTypes,
+		DependencyIndexes: file_spells_overview_proto_depIdxs,
+		MessageInfos:      file_spells_overview_proto_msgTypes,
+	}.Build()
+	File_spells_overview_proto = out.File
+	file_spells_overview_proto_goTypes = nil
+	file_spells_overview_proto_depIdxs = nil
+}
@@ -0,0 +1,548 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: spells_spell.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: Spell and Information
+type SpellInformationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Spell         *SpellData             `protobuf:"bytes,1,opt,name=spell,proto3" json:"spell,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpellInformationResponse) Reset() {
+	*x = SpellInformationResponse{}
+	mi := &file_spells_spell_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpellInformationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpellInformationResponse) ProtoMessage() {}
+
+func (x *SpellInformationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spells_spell_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpellInformationResponse.ProtoReflect.Descriptor instead.
+func (*SpellInformationResponse) Descriptor() ([]byte, []int) {
+	return file_spells_spell_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SpellInformationResponse) GetSpell() *SpellData {
+	if x != nil {
+		return x.Spell
+	}
+	return nil
+}
+
+func (x *SpellInformationResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of RuneInformation
+type RuneInformation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vocation      []string               `protobuf:"bytes,1,rep,name=vocation,proto3" json:"vocation,omitempty"`                              // List of vocations that can use the rune.
+	GroupAttack   bool                   `protobuf:"varint,2,opt,name=group_attack,json=groupAttack,proto3" json:"group_attack,omitempty"`    // Whether the group is attack.
+	GroupHealing  bool                   `protobuf:"varint,3,opt,name=group_healing,json=groupHealing,proto3" json:"group_healing,omitempty"` // Whether the group is healing.
+	GroupSupport  bool                   `protobuf:"varint,4,opt,name=group_support,json=groupSupport,proto3" json:"group_support,omitempty"` // Whether the group is support.
+	DamageType    string                 `protobuf:"bytes,5,opt,name=damage_type,json=damageType,proto3" json:"damage_type,omitempty"`        // The type of damage caused by it.
+	Level         int64                  `protobuf:"varint,6,opt,name=level,proto3" json:"level,omitempty"`                                   // The required level for using.
+	MagicLevel    int64                  `protobuf:"varint,7,opt,name=magic_level,json=magicLevel,proto3" json:"magic_level,omitempty"`       // The required magic level for using.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuneInformation) Reset() {
+	*x = RuneInformation{}
+	mi := &file_spells_spell_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuneInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuneInformation) ProtoMessage() {}
+
+func (x *RuneInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_spells_spell_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuneInformation.ProtoReflect.Descriptor instead.
+func (*RuneInformation) Descriptor() ([]byte, []int) {
+	return file_spells_spell_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RuneInformation) GetVocation() []string {
+	if x != nil {
+		return x.Vocation
+	}
+	return nil
+}
+
+func (x *RuneInformation) GetGroupAttack() bool {
+	if x != nil {
+		return x.GroupAttack
+	}
+	return false
+}
+
+func (x *RuneInformation) GetGroupHealing() bool {
+	if x != nil {
+		return x.GroupHealing
+	}
+	return false
+}
+
+func (x *RuneInformation) GetGroupSupport() bool {
+	if x != nil {
+		return x.GroupSupport
+	}
+	return false
+}
+
+func (x *RuneInformation) GetDamageType() string {
+	if x != nil {
+		return x.DamageType
+	}
+	return ""
+}
+
+func (x *RuneInformation) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *RuneInformation) GetMagicLevel() int64 {
+	if x != nil {
+		return x.MagicLevel
+	}
+	return 0
+}
+
+// Child of JSONData
+type SpellData struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                             // The name of the spell.
+	SpellId             string                 `protobuf:"bytes,2,opt,name=spell_id,json=spellId,proto3" json:"spell_id,omitempty"`                                        // The internal identifier of the spell.
+	ImageUrl            string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`                                     // The URL to this spell's image.
+	Description         string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                                               // A description of it's effect and history.
+	HasSpellInformation bool                   `protobuf:"varint,5,opt,name=has_spell_information,json=hasSpellInformation,proto3" json:"has_spell_information,omitempty"` // Whether the spell has information.
+	SpellInformation    *SpellInformation      `protobuf:"bytes,6,opt,name=spell_information,json=spellInformation,proto3" json:"spell_information,omitempty"`             // Information about the spell.
+	HasRuneInformation  bool                   `protobuf:"varint,7,opt,name=has_rune_information,json=hasRuneInformation,proto3" json:"has_rune_information,omitempty"`    // Whether the spell has rune information.
+	RuneInformation     *RuneInformation       `protobuf:"bytes,8,opt,name=rune_information,json=runeInformation,proto3" json:"rune_information,omitempty"`                // Information about the spell's rune.
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SpellData) Reset() {
+	*x = SpellData{}
+	mi := &file_spells_spell_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpellData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpellData) ProtoMessage() {}
+
+func (x *SpellData) ProtoReflect() protoreflect.Message {
+	mi := &file_spells_spell_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpellData.ProtoReflect.Descriptor instead.
+func (*SpellData) Descriptor() ([]byte, []int) {
+	return file_spells_spell_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SpellData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SpellData) GetSpellId() string {
+	if x != nil {
+		return x.SpellId
+	}
+	return ""
+}
+
+func (x *SpellData) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *SpellData) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SpellData) GetHasSpellInformation() bool {
+	if x != nil {
+		return x.HasSpellInformation
+	}
+	return false
+}
+
+func (x *SpellData) GetSpellInformation() *SpellInformation {
+	if x != nil {
+		return x.SpellInformation
+	}
+	return nil
+}
+
+func (x *SpellData) GetHasRuneInformation() bool {
+	if x != nil {
+		return x.HasRuneInformation
+	}
+	return false
+}
+
+func (x *SpellData) GetRuneInformation() *RuneInformation {
+	if x != nil {
+		return x.RuneInformation
+	}
+	return nil
+}
+
+// Child of SpellInformation
+type SpellInformation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Formula       string                 `protobuf:"bytes,1,opt,name=formula,proto3" json:"formula,omitempty"`                                    // The formula to cast the spell.
+	Vocation      []string               `protobuf:"bytes,2,rep,name=vocation,proto3" json:"vocation,omitempty"`                                  // The vocations that can use this spell.
+	GroupAttack   bool                   `protobuf:"varint,3,opt,name=group_attack,json=groupAttack,proto3" json:"group_attack,omitempty"`        // Whether the group is attack.
+	GroupHealing  bool                   `protobuf:"varint,4,opt,name=group_healing,json=groupHealing,proto3" json:"group_healing,omitempty"`     // Whether the group is healing.
+	GroupSupport  bool                   `protobuf:"varint,5,opt,name=group_support,json=groupSupport,proto3" json:"group_support,omitempty"`     // Whether the group is support.
+	TypeInstant   bool                   `protobuf:"varint,6,opt,name=type_instant,json=typeInstant,proto3" json:"type_instant,omitempty"`        // Whether the type is instant.
+	TypeRune      bool                   `protobuf:"varint,7,opt,name=type_rune,json=typeRune,proto3" json:"type_rune,omitempty"`                 // Whether the type is rune.
+	DamageType    string                 `protobuf:"bytes,8,opt,name=damage_type,json=damageType,proto3" json:"damage_type,omitempty"`            // The type of damage caused by it.
+	CooldownAlone int64                  `protobuf:"varint,9,opt,name=cooldown_alone,json=cooldownAlone,proto3" json:"cooldown_alone,omitempty"`  // The individual cooldown of this spell in seconds.
+	CooldownGroup int64                  `protobuf:"varint,10,opt,name=cooldown_group,json=cooldownGroup,proto3" json:"cooldown_group,omitempty"` // The group cooldown of this spell in seconds.
+	SoulPoints    int64                  `protobuf:"varint,11,opt,name=soul_points,json=soulPoints,proto3" json:"soul_points,omitempty"`          // The number of soul points consumed when casting.
+	Amount        int64                  `protobuf:"varint,12,opt,name=amount,proto3" json:"amount,omitempty"`                                    // The amount of objects created when casting.
+	Level         int64                  `protobuf:"varint,13,opt,name=level,proto3" json:"level,omitempty"`                                      // The required level for casting.
+	Mana          int64                  `protobuf:"varint,14,opt,name=mana,proto3" json:"mana,omitempty"`                                        // The required mana for using.
+	Price         int64                  `protobuf:"varint,15,opt,name=price,proto3" json:"price,omitempty"`                                      // The price in gold coins to learn it.
+	City          []string               `protobuf:"bytes,16,rep,name=city,proto3" json:"city,omitempty"`                                         // The cities where to learn it.
+	PremiumOnly   bool                   `protobuf:"varint,17,opt,name=premium_only,json=premiumOnly,proto3" json:"premium_only,omitempty"`       // Whether it requires a premium account to learn and use it.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpellInformation) Reset() {
+	*x = SpellInformation{}
+	mi := &file_spells_spell_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpellInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpellInformation) ProtoMessage() {}
+
+func (x *SpellInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_spells_spell_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpellInformation.ProtoReflect.Descriptor instead.
+func (*SpellInformation) Descriptor() ([]byte, []int) {
+	return file_spells_spell_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SpellInformation) GetFormula() string {
+	if x != nil {
+		return x.Formula
+	}
+	return ""
+}
+
+func (x *SpellInformation) GetVocation() []string {
+	if x != nil {
+		return x.Vocation
+	}
+	return nil
+}
+
+func (x *SpellInformation) GetGroupAttack() bool {
+	if x != nil {
+		return x.GroupAttack
+	}
+	return false
+}
+
+func (x *SpellInformation) GetGroupHealing() bool {
+	if x != nil {
+		return x.GroupHealing
+	}
+	return false
+}
+
+func (x *SpellInformation) GetGroupSupport() bool {
+	if x != nil {
+		return x.GroupSupport
+	}
+	return false
+}
+
+func (x *SpellInformation) GetTypeInstant() bool {
+	if x != nil {
+		return x.TypeInstant
+	}
+	return false
+}
+
+func (x *SpellInformation) GetTypeRune() bool {
+	if x != nil {
+		return x.TypeRune
+	}
+	return false
+}
+
+func (x *SpellInformation) GetDamageType() string {
+	if x != nil {
+		return x.DamageType
+	}
+	return ""
+}
+
+func (x *SpellInformation) GetCooldownAlone() int64 {
+	if x != nil {
+		return x.CooldownAlone
+	}
+	return 0
+}
+
+func (x *SpellInformation) GetCooldownGroup() int64 {
+	if x != nil {
+		return x.CooldownGroup
+	}
+	return 0
+}
+
+func (x *SpellInformation) GetSoulPoints() int64 {
+	if x != nil {
+		return x.SoulPoints
+	}
+	return 0
+}
+
+func (x *SpellInformation) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SpellInformation) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *SpellInformation) GetMana() int64 {
+	if x != nil {
+		return x.Mana
+	}
+	return 0
+}
+
+func (x *SpellInformation) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SpellInformation) GetCity() []string {
+	if x != nil {
+		return x.City
+	}
+	return nil
+}
+
+func (x *SpellInformation) GetPremiumOnly() bool {
+	if x != nil {
+		return x.PremiumOnly
+	}
+	return false
+}
+
+var File_spells_spell_proto protoreflect.FileDescriptor
+
+const file_spells_spell_proto_rawDesc = "" +
+	"\n" +
+	"\x12spells_spell.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\x86\x01\n" +
+	"\x18SpellInformationResponse\x12-\n" +
+	"\x05spell\x18\x01 \x01(\v2\x17.tibiadata.v4.SpellDataR\x05spell\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xf2\x01\n" +
+	"\x0fRuneInformation\x12\x1a\n" +
+	"\bvocation\x18\x01 \x03(\tR\bvocation\x12!\n" +
+	"\fgroup_attack\x18\x02 \x01(\bR\vgroupAttack\x12#\n" +
+	"\rgroup_healing\x18\x03 \x01(\bR\fgroupHealing\x12#\n" +
+	"\rgroup_support\x18\x04 \x01(\bR\fgroupSupport\x12\x1f\n" +
+	"\vdamage_type\x18\x05 \x01(\tR\n" +
+	"damageType\x12\x14\n" +
+	"\x05level\x18\x06 \x01(\x03R\x05level\x12\x1f\n" +
+	"\vmagic_level\x18\a \x01(\x03R\n" +
+	"magicLevel\"\xf6\x02\n" +
+	"\tSpellData\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\bspell_id\x18\x02 \x01(\tR\aspellId\x12\x1b\n" +
+	"\timage_url\x18\x03 \x01(\tR\bimageUrl\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x122\n" +
+	"\x15has_spell_information\x18\x05 \x01(\bR\x13hasSpellInformation\x12K\n" +
+	"\x11spell_information\x18\x06 \x01(\v2\x1e.tibiadata.v4.SpellInformationR\x10spellInformation\x120\n" +
+	"\x14has_rune_information\x18\a \x01(\bR\x12hasRuneInformation\x12H\n" +
+	"\x10rune_information\x18\b \x01(\v2\x1d.tibiadata.v4.RuneInformationR\x0fruneInformation\"\x94\x04\n" +
+	"\x10SpellInformation\x12\x18\n" +
+	"\aformula\x18\x01 \x01(\tR\aformula\x12\x1a\n" +
+	"\bvocation\x18\x02 \x03(\tR\bvocation\x12!\n" +
+	"\fgroup_attack\x18\x03 \x01(\bR\vgroupAttack\x12#\n" +
+	"\rgroup_healing\x18\x04 \x01(\bR\fgroupHealing\x12#\n" +
+	"\rgroup_support\x18\x05 \x01(\bR\fgroupSupport\x12!\n" +
+	"\ftype_instant\x18\x06 \x01(\bR\vtypeInstant\x12\x1b\n" +
+	"\ttype_rune\x18\a \x01(\bR\btypeRune\x12\x1f\n" +
+	"\vdamage_type\x18\b \x01(\tR\n" +
+	"damageType\x12%\n" +
+	"\x0ecooldown_alone\x18\t \x01(\x03R\rcooldownAlone\x12%\n" +
+	"\x0ecooldown_group\x18\n" +
+	" \x01(\x03R\rcooldownGroup\x12\x1f\n" +
+	"\vsoul_points\x18\v \x01(\x03R\n" +
+	"soulPoints\x12\x16\n" +
+	"\x06amount\x18\f \x01(\x03R\x06amount\x12\x14\n" +
+	"\x05level\x18\r \x01(\x03R\x05level\x12\x12\n" +
+	"\x04mana\x18\x0e \x01(\x03R\x04mana\x12\x14\n" +
+	"\x05price\x18\x0f \x01(\x03R\x05price\x12\x12\n" +
+	"\x04city\x18\x10 \x03(\tR\x04city\x12!\n" +
+	"\fpremium_only\x18\x11 \x01(\bR\vpremiumOnlyB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_spells_spell_proto_rawDescOnce sync.Once
+	file_spells_spell_proto_rawDescData []byte
+)
+
+func file_spells_spell_proto_rawDescGZIP() []byte {
+	file_spells_spell_proto_rawDescOnce.Do(func() {
+		file_spells_spell_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_spells_spell_proto_rawDesc), len(file_spells_spell_proto_rawDesc)))
+	})
+	return file_spells_spell_proto_rawDescData
+}
+
+var file_spells_spell_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_spells_spell_proto_goTypes = []any{
+	(*SpellInformationResponse)(nil), // 0: tibiadata.v4.SpellInformationResponse
+	(*RuneInformation)(nil),          // 1: tibiadata.v4.RuneInformation
+	(*SpellData)(nil),                // 2: tibiadata.v4.SpellData
+	(*SpellInformation)(nil),         // 3: tibiadata.v4.SpellInformation
+	(*Information)(nil),              // 4: tibiadata.v4.Information
+}
+var file_spells_spell_proto_depIdxs = []int32{
+	2, // 0: tibiadata.v4.SpellInformationResponse.spell:type_name -> tibiadata.v4.SpellData
+	4, // 1: tibiadata.v4.SpellInformationResponse.information:type_name -> tibiadata.v4.Information
+	3, // 2: tibiadata.v4.SpellData.spell_information:type_name -> tibiadata.v4.SpellInformation
+	1, // 3: tibiadata.v4.SpellData.rune_information:type_name -> tibiadata.v4.RuneInformation
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_spells_spell_proto_init() }
+func file_spells_spell_proto_init() {
+	if File_spells_spell_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_spells_spell_proto_rawDesc), len(file_spells_spell_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_spells_spell_proto_goTypes,
+		DependencyIndexes: file_spells_spell_proto_depIdxs,
+		MessageInfos:      file_spells_spell_proto_msgTypes,
+	}.Build()
+	File_spells_spell_proto = out.File
+	file_spells_spell_proto_goTypes = nil
+	file_spells_spell_proto_depIdxs = nil
+}
@@ -0,0 +1,2636 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: tibiadata.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The types of news lists
+type NewsListType int32
+
+const (
+	NewsListType_NEWS_LIST_TYPE_ARCHIVE    NewsListType = 0 // All categories.
+	NewsListType_NEWS_LIST_TYPE_LATEST     NewsListType = 1 // Only news and articles.
+	NewsListType_NEWS_LIST_TYPE_NEWSTICKER NewsListType = 2 // Only news tickers.
+)
+
+// Enum value maps for NewsListType.
+var (
+	NewsListType_name = map[int32]string{
+		0: "NEWS_LIST_TYPE_ARCHIVE",
+		1: "NEWS_LIST_TYPE_LATEST",
+		2: "NEWS_LIST_TYPE_NEWSTICKER",
+	}
+	NewsListType_value = map[string]int32{
+		"NEWS_LIST_TYPE_ARCHIVE":    0,
+		"NEWS_LIST_TYPE_LATEST":     1,
+		"NEWS_LIST_TYPE_NEWSTICKER": 2,
+	}
+)
+
+func (x NewsListType) Enum() *NewsListType {
+	p := new(NewsListType)
+	*p = x
+	return p
+}
+
+func (x NewsListType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NewsListType) Descriptor() protoreflect.EnumDescriptor {
+	return file_tibiadata_proto_enumTypes[0].Descriptor()
+}
+
+func (NewsListType) Type() protoreflect.EnumType {
+	return &file_tibiadata_proto_enumTypes[0]
+}
+
+func (x NewsListType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NewsListType.Descriptor instead.
+func (NewsListType) EnumDescriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{0}
+}
+
+type BoostableBossesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoostableBossesRequest) Reset() {
+	*x = BoostableBossesRequest{}
+	mi := &file_tibiadata_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoostableBossesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoostableBossesRequest) ProtoMessage() {}
+
+func (x *BoostableBossesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoostableBossesRequest.ProtoReflect.Descriptor instead.
+func (*BoostableBossesRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{0}
+}
+
+type CharacterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // The character name.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharacterRequest) Reset() {
+	*x = CharacterRequest{}
+	mi := &file_tibiadata_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharacterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterRequest) ProtoMessage() {}
+
+func (x *CharacterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterRequest.ProtoReflect.Descriptor instead.
+func (*CharacterRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CharacterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CharactersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Names         []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"` // The character names.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharactersRequest) Reset() {
+	*x = CharactersRequest{}
+	mi := &file_tibiadata_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharactersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharactersRequest) ProtoMessage() {}
+
+func (x *CharactersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharactersRequest.ProtoReflect.Descriptor instead.
+func (*CharactersRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CharactersRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type CreatureRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Race          string                 `protobuf:"bytes,1,opt,name=race,proto3" json:"race,omitempty"` // The race of creature.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatureRequest) Reset() {
+	*x = CreatureRequest{}
+	mi := &file_tibiadata_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatureRequest) ProtoMessage() {}
+
+func (x *CreatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatureRequest.ProtoReflect.Descriptor instead.
+func (*CreatureRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreatureRequest) GetRace() string {
+	if x != nil {
+		return x.Race
+	}
+	return ""
+}
+
+type CreaturesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreaturesRequest) Reset() {
+	*x = CreaturesRequest{}
+	mi := &file_tibiadata_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreaturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreaturesRequest) ProtoMessage() {}
+
+func (x *CreaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreaturesRequest.ProtoReflect.Descriptor instead.
+func (*CreaturesRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{4}
+}
+
+type ExperienceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         *int64                 `protobuf:"varint,1,opt,name=level,proto3,oneof" json:"level,omitempty"`           // The level to calculate the experience of.
+	Experience    *int64                 `protobuf:"varint,2,opt,name=experience,proto3,oneof" json:"experience,omitempty"` // The experience to calculate the level of.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExperienceRequest) Reset() {
+	*x = ExperienceRequest{}
+	mi := &file_tibiadata_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExperienceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperienceRequest) ProtoMessage() {}
+
+func (x *ExperienceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperienceRequest.ProtoReflect.Descriptor instead.
+func (*ExperienceRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExperienceRequest) GetLevel() int64 {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return 0
+}
+
+func (x *ExperienceRequest) GetExperience() int64 {
+	if x != nil && x.Experience != nil {
+		return *x.Experience
+	}
+	return 0
+}
+
+type FansitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FansitesRequest) Reset() {
+	*x = FansitesRequest{}
+	mi := &file_tibiadata_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FansitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FansitesRequest) ProtoMessage() {}
+
+func (x *FansitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FansitesRequest.ProtoReflect.Descriptor instead.
+func (*FansitesRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{6}
+}
+
+type GuildExpandedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`       // The name of guild.
+	Include       []string               `protobuf:"bytes,2,rep,name=include,proto3" json:"include,omitempty"` // The character fields to include.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildExpandedRequest) Reset() {
+	*x = GuildExpandedRequest{}
+	mi := &file_tibiadata_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildExpandedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildExpandedRequest) ProtoMessage() {}
+
+func (x *GuildExpandedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildExpandedRequest.ProtoReflect.Descriptor instead.
+func (*GuildExpandedRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GuildExpandedRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildExpandedRequest) GetInclude() []string {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+type GuildHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`     // The name of guild.
+	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"` // The period ending now: day or week. (default: day)
+	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`     // The start of the period, as RFC 3339 time or date.
+	To            string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`         // The end of the period, as RFC 3339 time or date. (default: now)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildHistoryRequest) Reset() {
+	*x = GuildHistoryRequest{}
+	mi := &file_tibiadata_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildHistoryRequest) ProtoMessage() {}
+
+func (x *GuildHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GuildHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GuildHistoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildHistoryRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GuildHistoryRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GuildHistoryRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type GuildRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                  // The name of guild.
+	GuildhallDetails bool                   `protobuf:"varint,2,opt,name=guildhall_details,json=guildhallDetails,proto3" json:"guildhall_details,omitempty"` // Whether to fetch the house pages of the guildhalls (only GetGuild).
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GuildRequest) Reset() {
+	*x = GuildRequest{}
+	mi := &file_tibiadata_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildRequest) ProtoMessage() {}
+
+func (x *GuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildRequest.ProtoReflect.Descriptor instead.
+func (*GuildRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GuildRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildRequest) GetGuildhallDetails() bool {
+	if x != nil {
+		return x.GuildhallDetails
+	}
+	return false
+}
+
+type GuildsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         string                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"` // The world.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildsRequest) Reset() {
+	*x = GuildsRequest{}
+	mi := &file_tibiadata_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildsRequest) ProtoMessage() {}
+
+func (x *GuildsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildsRequest.ProtoReflect.Descriptor instead.
+func (*GuildsRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GuildsRequest) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+type GuildsLeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         string                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"` // The world.
+	Sort          string                 `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"`   // The value to rank the guilds by. (default: level_total)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildsLeaderboardRequest) Reset() {
+	*x = GuildsLeaderboardRequest{}
+	mi := &file_tibiadata_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildsLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildsLeaderboardRequest) ProtoMessage() {}
+
+func (x *GuildsLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildsLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GuildsLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GuildsLeaderboardRequest) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *GuildsLeaderboardRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type GuildsStatisticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         string                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"` // The world.
+	Sort          string                 `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"`   // The statistic to rank the guilds by. (default: members_total)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildsStatisticsRequest) Reset() {
+	*x = GuildsStatisticsRequest{}
+	mi := &file_tibiadata_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildsStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildsStatisticsRequest) ProtoMessage() {}
+
+func (x *GuildsStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildsStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*GuildsStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GuildsStatisticsRequest) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *GuildsStatisticsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type GuildTrackerListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildTrackerListRequest) Reset() {
+	*x = GuildTrackerListRequest{}
+	mi := &file_tibiadata_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildTrackerListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildTrackerListRequest) ProtoMessage() {}
+
+func (x *GuildTrackerListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildTrackerListRequest.ProtoReflect.Descriptor instead.
+func (*GuildTrackerListRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{13}
+}
+
+type GuildTrackerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Names         []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"` // The guild names.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildTrackerRequest) Reset() {
+	*x = GuildTrackerRequest{}
+	mi := &file_tibiadata_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildTrackerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildTrackerRequest) ProtoMessage() {}
+
+func (x *GuildTrackerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildTrackerRequest.ProtoReflect.Descriptor instead.
+func (*GuildTrackerRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GuildTrackerRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type HighscoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         string                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`                             // The world. (default: all)
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`                       // The category. (default: experience)
+	Vocation      string                 `protobuf:"bytes,3,opt,name=vocation,proto3" json:"vocation,omitempty"`                       // The vocation. (default: all)
+	Page          int64                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`                              // The current page. (default: 1)
+	WorldTypes    []string               `protobuf:"bytes,5,rep,name=world_types,json=worldTypes,proto3" json:"world_types,omitempty"` // The world types to filter on. (only on all worlds)
+	Battleye      string                 `protobuf:"bytes,6,opt,name=battleye,proto3" json:"battleye,omitempty"`                       // The battleye protection to filter on. (only on all worlds)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HighscoresRequest) Reset() {
+	*x = HighscoresRequest{}
+	mi := &file_tibiadata_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighscoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighscoresRequest) ProtoMessage() {}
+
+func (x *HighscoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighscoresRequest.ProtoReflect.Descriptor instead.
+func (*HighscoresRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *HighscoresRequest) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *HighscoresRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *HighscoresRequest) GetVocation() string {
+	if x != nil {
+		return x.Vocation
+	}
+	return ""
+}
+
+func (x *HighscoresRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *HighscoresRequest) GetWorldTypes() []string {
+	if x != nil {
+		return x.WorldTypes
+	}
+	return nil
+}
+
+func (x *HighscoresRequest) GetBattleye() string {
+	if x != nil {
+		return x.Battleye
+	}
+	return ""
+}
+
+type HighscoresSnapshotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         string                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`       // The world. (default: all)
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"` // The category. (default: experience)
+	Vocation      string                 `protobuf:"bytes,3,opt,name=vocation,proto3" json:"vocation,omitempty"` // The vocation. (default: all)
+	Period        string                 `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`     // The period ending now: day or week. (default: day)
+	From          string                 `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`         // The start of the period, as RFC 3339 time or date.
+	To            string                 `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`             // The end of the period, as RFC 3339 time or date. (default: now)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HighscoresSnapshotsRequest) Reset() {
+	*x = HighscoresSnapshotsRequest{}
+	mi := &file_tibiadata_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighscoresSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighscoresSnapshotsRequest) ProtoMessage() {}
+
+func (x *HighscoresSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighscoresSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*HighscoresSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *HighscoresSnapshotsRequest) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *HighscoresSnapshotsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *HighscoresSnapshotsRequest) GetVocation() string {
+	if x != nil {
+		return x.Vocation
+	}
+	return ""
+}
+
+func (x *HighscoresSnapshotsRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *HighscoresSnapshotsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *HighscoresSnapshotsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type HouseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         string                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`                     // The world to show.
+	HouseId       int64                  `protobuf:"varint,2,opt,name=house_id,json=houseId,proto3" json:"house_id,omitempty"` // The ID of the house.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HouseRequest) Reset() {
+	*x = HouseRequest{}
+	mi := &file_tibiadata_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseRequest) ProtoMessage() {}
+
+func (x *HouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseRequest.ProtoReflect.Descriptor instead.
+func (*HouseRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HouseRequest) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *HouseRequest) GetHouseId() int64 {
+	if x != nil {
+		return x.HouseId
+	}
+	return 0
+}
+
+type HousesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         string                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"` // The world to show.
+	Town          string                 `protobuf:"bytes,2,opt,name=town,proto3" json:"town,omitempty"`   // The town to show.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HousesRequest) Reset() {
+	*x = HousesRequest{}
+	mi := &file_tibiadata_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HousesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HousesRequest) ProtoMessage() {}
+
+func (x *HousesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HousesRequest.ProtoReflect.Descriptor instead.
+func (*HousesRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *HousesRequest) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *HousesRequest) GetTown() string {
+	if x != nil {
+		return x.Town
+	}
+	return ""
+}
+
+type HousesSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         string                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`                         // The world to search.
+	Town          string                 `protobuf:"bytes,2,opt,name=town,proto3" json:"town,omitempty"`                           // The town to search, all towns if empty.
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                           // The type of home to search (house or guildhall), both if empty.
+	MinSize       int64                  `protobuf:"varint,4,opt,name=min_size,json=minSize,proto3" json:"min_size,omitempty"`     // The minimum size in SQM.
+	MaxSize       int64                  `protobuf:"varint,5,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`     // The maximum size in SQM.
+	MinBeds       int64                  `protobuf:"varint,6,opt,name=min_beds,json=minBeds,proto3" json:"min_beds,omitempty"`     // The minimum number of beds.
+	MaxBeds       int64                  `protobuf:"varint,7,opt,name=max_beds,json=maxBeds,proto3" json:"max_beds,omitempty"`     // The maximum number of beds.
+	MinRent       int64                  `protobuf:"varint,8,opt,name=min_rent,json=minRent,proto3" json:"min_rent,omitempty"`     // The minimum monthly rent in gold coins.
+	MaxRent       int64                  `protobuf:"varint,9,opt,name=max_rent,json=maxRent,proto3" json:"max_rent,omitempty"`     // The maximum monthly rent in gold coins.
+	Auctioned     bool                   `protobuf:"varint,10,opt,name=auctioned,proto3" json:"auctioned,omitempty"`               // Whether to only include auctioned houses.
+	Sort          string                 `protobuf:"bytes,11,opt,name=sort,proto3" json:"sort,omitempty"`                          // The value to sort by (rent, name, size, beds, current_bid or auction_end), rent if empty.
+	Order         string                 `protobuf:"bytes,12,opt,name=order,proto3" json:"order,omitempty"`                        // The order to sort in (asc or desc), asc if empty.
+	Page          int64                  `protobuf:"varint,13,opt,name=page,proto3" json:"page,omitempty"`                         // The page to show, the first if zero.
+	PageSize      int64                  `protobuf:"varint,14,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // The number of houses of a page, 25 if zero.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HousesSearchRequest) Reset() {
+	*x = HousesSearchRequest{}
+	mi := &file_tibiadata_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HousesSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HousesSearchRequest) ProtoMessage() {}
+
+func (x *HousesSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HousesSearchRequest.ProtoReflect.Descriptor instead.
+func (*HousesSearchRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *HousesSearchRequest) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *HousesSearchRequest) GetTown() string {
+	if x != nil {
+		return x.Town
+	}
+	return ""
+}
+
+func (x *HousesSearchRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *HousesSearchRequest) GetMinSize() int64 {
+	if x != nil {
+		return x.MinSize
+	}
+	return 0
+}
+
+func (x *HousesSearchRequest) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *HousesSearchRequest) GetMinBeds() int64 {
+	if x != nil {
+		return x.MinBeds
+	}
+	return 0
+}
+
+func (x *HousesSearchRequest) GetMaxBeds() int64 {
+	if x != nil {
+		return x.MaxBeds
+	}
+	return 0
+}
+
+func (x *HousesSearchRequest) GetMinRent() int64 {
+	if x != nil {
+		return x.MinRent
+	}
+	return 0
+}
+
+func (x *HousesSearchRequest) GetMaxRent() int64 {
+	if x != nil {
+		return x.MaxRent
+	}
+	return 0
+}
+
+func (x *HousesSearchRequest) GetAuctioned() bool {
+	if x != nil {
+		return x.Auctioned
+	}
+	return false
+}
+
+func (x *HousesSearchRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *HousesSearchRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *HousesSearchRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *HousesSearchRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type HousesWorldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         string                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`                     // The world to show.
+	MinSize       int64                  `protobuf:"varint,2,opt,name=min_size,json=minSize,proto3" json:"min_size,omitempty"` // The minimum size in SQM.
+	MaxSize       int64                  `protobuf:"varint,3,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"` // The maximum size in SQM.
+	MinRent       int64                  `protobuf:"varint,4,opt,name=min_rent,json=minRent,proto3" json:"min_rent,omitempty"` // The minimum monthly rent in gold coins.
+	MaxRent       int64                  `protobuf:"varint,5,opt,name=max_rent,json=maxRent,proto3" json:"max_rent,omitempty"` // The maximum monthly rent in gold coins.
+	Auctioned     bool                   `protobuf:"varint,6,opt,name=auctioned,proto3" json:"auctioned,omitempty"`            // Whether to only include auctioned houses.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HousesWorldRequest) Reset() {
+	*x = HousesWorldRequest{}
+	mi := &file_tibiadata_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HousesWorldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HousesWorldRequest) ProtoMessage() {}
+
+func (x *HousesWorldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HousesWorldRequest.ProtoReflect.Descriptor instead.
+func (*HousesWorldRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *HousesWorldRequest) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *HousesWorldRequest) GetMinSize() int64 {
+	if x != nil {
+		return x.MinSize
+	}
+	return 0
+}
+
+func (x *HousesWorldRequest) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *HousesWorldRequest) GetMinRent() int64 {
+	if x != nil {
+		return x.MinRent
+	}
+	return 0
+}
+
+func (x *HousesWorldRequest) GetMaxRent() int64 {
+	if x != nil {
+		return x.MaxRent
+	}
+	return 0
+}
+
+func (x *HousesWorldRequest) GetAuctioned() bool {
+	if x != nil {
+		return x.Auctioned
+	}
+	return false
+}
+
+type KillStatisticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         string                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"` // The world to show.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KillStatisticsRequest) Reset() {
+	*x = KillStatisticsRequest{}
+	mi := &file_tibiadata_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KillStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillStatisticsRequest) ProtoMessage() {}
+
+func (x *KillStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*KillStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *KillStatisticsRequest) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+type NewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewsId        int64                  `protobuf:"varint,1,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"` // The ID of news entry.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewsRequest) Reset() {
+	*x = NewsRequest{}
+	mi := &file_tibiadata_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewsRequest) ProtoMessage() {}
+
+func (x *NewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewsRequest.ProtoReflect.Descriptor instead.
+func (*NewsRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *NewsRequest) GetNewsId() int64 {
+	if x != nil {
+		return x.NewsId
+	}
+	return 0
+}
+
+type NewsListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          NewsListType           `protobuf:"varint,1,opt,name=type,proto3,enum=tibiadata.v4.NewsListType" json:"type,omitempty"` // The type of news list.
+	Days          int64                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`                                // The number of days to show. (default: 90)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewsListRequest) Reset() {
+	*x = NewsListRequest{}
+	mi := &file_tibiadata_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewsListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewsListRequest) ProtoMessage() {}
+
+func (x *NewsListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewsListRequest.ProtoReflect.Descriptor instead.
+func (*NewsListRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *NewsListRequest) GetType() NewsListType {
+	if x != nil {
+		return x.Type
+	}
+	return NewsListType_NEWS_LIST_TYPE_ARCHIVE
+}
+
+func (x *NewsListRequest) GetDays() int64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type SpellRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SpellId       string                 `protobuf:"bytes,1,opt,name=spell_id,json=spellId,proto3" json:"spell_id,omitempty"` // The name of spell.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpellRequest) Reset() {
+	*x = SpellRequest{}
+	mi := &file_tibiadata_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpellRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpellRequest) ProtoMessage() {}
+
+func (x *SpellRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpellRequest.ProtoReflect.Descriptor instead.
+func (*SpellRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SpellRequest) GetSpellId() string {
+	if x != nil {
+		return x.SpellId
+	}
+	return ""
+}
+
+type SpellsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vocation      string                 `protobuf:"bytes,1,opt,name=vocation,proto3" json:"vocation,omitempty"` // The vocation. (default: all)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpellsRequest) Reset() {
+	*x = SpellsRequest{}
+	mi := &file_tibiadata_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpellsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpellsRequest) ProtoMessage() {}
+
+func (x *SpellsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpellsRequest.ProtoReflect.Descriptor instead.
+func (*SpellsRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SpellsRequest) GetVocation() string {
+	if x != nil {
+		return x.Vocation
+	}
+	return ""
+}
+
+type WarKillsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`        // The ID of the war.
+	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"` // The period ending now: day or week. (default: day)
+	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`     // The start of the period, as RFC 3339 time or date.
+	To            string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`         // The end of the period, as RFC 3339 time or date. (default: now)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarKillsRequest) Reset() {
+	*x = WarKillsRequest{}
+	mi := &file_tibiadata_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarKillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarKillsRequest) ProtoMessage() {}
+
+func (x *WarKillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarKillsRequest.ProtoReflect.Descriptor instead.
+func (*WarKillsRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *WarKillsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WarKillsRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *WarKillsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *WarKillsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type WarTrackerIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // The ID of the war.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarTrackerIDRequest) Reset() {
+	*x = WarTrackerIDRequest{}
+	mi := &file_tibiadata_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarTrackerIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarTrackerIDRequest) ProtoMessage() {}
+
+func (x *WarTrackerIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarTrackerIDRequest.ProtoReflect.Descriptor instead.
+func (*WarTrackerIDRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WarTrackerIDRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type WarTrackerListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarTrackerListRequest) Reset() {
+	*x = WarTrackerListRequest{}
+	mi := &file_tibiadata_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarTrackerListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarTrackerListRequest) ProtoMessage() {}
+
+func (x *WarTrackerListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarTrackerListRequest.ProtoReflect.Descriptor instead.
+func (*WarTrackerListRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{28}
+}
+
+type WarTrackerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guild         string                 `protobuf:"bytes,1,opt,name=guild,proto3" json:"guild,omitempty"`       // The name of the first guild.
+	Opponent      string                 `protobuf:"bytes,2,opt,name=opponent,proto3" json:"opponent,omitempty"` // The name of the second guild.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarTrackerRequest) Reset() {
+	*x = WarTrackerRequest{}
+	mi := &file_tibiadata_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarTrackerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarTrackerRequest) ProtoMessage() {}
+
+func (x *WarTrackerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarTrackerRequest.ProtoReflect.Descriptor instead.
+func (*WarTrackerRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *WarTrackerRequest) GetGuild() string {
+	if x != nil {
+		return x.Guild
+	}
+	return ""
+}
+
+func (x *WarTrackerRequest) GetOpponent() string {
+	if x != nil {
+		return x.Opponent
+	}
+	return ""
+}
+
+type WatchlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchlistRequest) Reset() {
+	*x = WatchlistRequest{}
+	mi := &file_tibiadata_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistRequest) ProtoMessage() {}
+
+func (x *WatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistRequest.ProtoReflect.Descriptor instead.
+func (*WatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{30}
+}
+
+type WatchlistTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`     // The character name.
+	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"` // The period ending now: day or week. (default: day)
+	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`     // The start of the period, as RFC 3339 time or date.
+	To            string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`         // The end of the period, as RFC 3339 time or date. (default: now)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchlistTimelineRequest) Reset() {
+	*x = WatchlistTimelineRequest{}
+	mi := &file_tibiadata_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistTimelineRequest) ProtoMessage() {}
+
+func (x *WatchlistTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistTimelineRequest.ProtoReflect.Descriptor instead.
+func (*WatchlistTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *WatchlistTimelineRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WatchlistTimelineRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *WatchlistTimelineRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *WatchlistTimelineRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type WebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`        // The ID of the webhook.
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // The status of the deliveries: pending, delivered or failed. (default: all)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDeliveriesRequest) Reset() {
+	*x = WebhookDeliveriesRequest{}
+	mi := &file_tibiadata_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *WebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WebhookDeliveriesRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDeliveriesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type WebhookIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // The ID of the webhook.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookIDRequest) Reset() {
+	*x = WebhookIDRequest{}
+	mi := &file_tibiadata_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookIDRequest) ProtoMessage() {}
+
+func (x *WebhookIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookIDRequest.ProtoReflect.Descriptor instead.
+func (*WebhookIDRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WebhookIDRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type WebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`               // The url the events are posted to.
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`         // The secret of the signatures. (default: generated)
+	Events        []string               `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`         // The event types to deliver. (default: all)
+	Characters    []string               `protobuf:"bytes,4,rep,name=characters,proto3" json:"characters,omitempty"` // The watched characters to deliver events of. (default: all)
+	Guilds        []string               `protobuf:"bytes,5,rep,name=guilds,proto3" json:"guilds,omitempty"`         // The guilds to deliver member events of.
+	Houses        []*WebhookHouse        `protobuf:"bytes,6,rep,name=houses,proto3" json:"houses,omitempty"`         // The houses to deliver auction and owner events of.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookRequest) Reset() {
+	*x = WebhookRequest{}
+	mi := &file_tibiadata_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookRequest) ProtoMessage() {}
+
+func (x *WebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookRequest.ProtoReflect.Descriptor instead.
+func (*WebhookRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *WebhookRequest) GetCharacters() []string {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+func (x *WebhookRequest) GetGuilds() []string {
+	if x != nil {
+		return x.Guilds
+	}
+	return nil
+}
+
+func (x *WebhookRequest) GetHouses() []*WebhookHouse {
+	if x != nil {
+		return x.Houses
+	}
+	return nil
+}
+
+type WebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhooksRequest) Reset() {
+	*x = WebhooksRequest{}
+	mi := &file_tibiadata_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhooksRequest) ProtoMessage() {}
+
+func (x *WebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhooksRequest.ProtoReflect.Descriptor instead.
+func (*WebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{35}
+}
+
+type WorldOnlineDiffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`     // The name of world.
+	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"` // The period ending now: day or week. (default: day)
+	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`     // The start of the period, as RFC 3339 time or date.
+	To            string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`         // The end of the period, as RFC 3339 time or date. (default: now)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldOnlineDiffRequest) Reset() {
+	*x = WorldOnlineDiffRequest{}
+	mi := &file_tibiadata_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldOnlineDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldOnlineDiffRequest) ProtoMessage() {}
+
+func (x *WorldOnlineDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldOnlineDiffRequest.ProtoReflect.Descriptor instead.
+func (*WorldOnlineDiffRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *WorldOnlineDiffRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorldOnlineDiffRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *WorldOnlineDiffRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *WorldOnlineDiffRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type WorldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // The name of world.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldRequest) Reset() {
+	*x = WorldRequest{}
+	mi := &file_tibiadata_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldRequest) ProtoMessage() {}
+
+func (x *WorldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldRequest.ProtoReflect.Descriptor instead.
+func (*WorldRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *WorldRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type WorldsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldsRequest) Reset() {
+	*x = WorldsRequest{}
+	mi := &file_tibiadata_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldsRequest) ProtoMessage() {}
+
+func (x *WorldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tibiadata_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldsRequest.ProtoReflect.Descriptor instead.
+func (*WorldsRequest) Descriptor() ([]byte, []int) {
+	return file_tibiadata_proto_rawDescGZIP(), []int{38}
+}
+
+var File_tibiadata_proto protoreflect.FileDescriptor
+
+const file_tibiadata_proto_rawDesc = "" +
+	"\n" +
+	"\x0ftibiadata.proto\x12\ftibiadata.v4\x1a\x1fboostable_bosses_overview.proto\x1a\x16characters_batch.proto\x1a\x1acharacters_character.proto\x1a\x16characters_ranks.proto\x1a\x18creatures_creature.proto\x1a\x18creatures_overview.proto\x1a\x10experience.proto\x1a\x0efansites.proto\x1a\x12guilds_guild.proto\x1a\x19guilds_guild_events.proto\x1a\x1bguilds_guild_expanded.proto\x1a\x17guilds_guild_wars.proto\x1a\x14guilds_history.proto\x1a\x18guilds_leaderboard.proto\x1a\x15guilds_overview.proto\x1a\x17guilds_statistics.proto\x1a\x18guilds_war_tracker.proto\x1a\x10highscores.proto\x1a\x1ahighscores_snapshots.proto\x1a\x12houses_house.proto\x1a\x15houses_overview.proto\x1a\x13houses_search.proto\x1a\x12houses_world.proto\x1a\x14killstatistics.proto\x1a\n" +
+	"news.proto\x1a\x0enewslist.proto\x1a\x15spells_overview.proto\x1a\x12spells_spell.proto\x1a\x0fwatchlist.proto\x1a\x0ewebhooks.proto\x1a\x13worlds_online.proto\x1a\x15worlds_overview.proto\x1a\x12worlds_world.proto\"\x18\n" +
+	"\x16BoostableBossesRequest\"&\n" +
+	"\x10CharacterRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\")\n" +
+	"\x11CharactersRequest\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\"%\n" +
+	"\x0fCreatureRequest\x12\x12\n" +
+	"\x04race\x18\x01 \x01(\tR\x04race\"\x12\n" +
+	"\x10CreaturesRequest\"l\n" +
+	"\x11ExperienceRequest\x12\x19\n" +
+	"\x05level\x18\x01 \x01(\x03H\x00R\x05level\x88\x01\x01\x12#\n" +
+	"\n" +
+	"experience\x18\x02 \x01(\x03H\x01R\n" +
+	"experience\x88\x01\x01B\b\n" +
+	"\x06_levelB\r\n" +
+	"\v_experience\"\x11\n" +
+	"\x0fFansitesRequest\"D\n" +
+	"\x14GuildExpandedRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\ainclude\x18\x02 \x03(\tR\ainclude\"e\n" +
+	"\x13GuildHistoryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\"O\n" +
+	"\fGuildRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12+\n" +
+	"\x11guildhall_details\x18\x02 \x01(\bR\x10guildhallDetails\"%\n" +
+	"\rGuildsRequest\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\"D\n" +
+	"\x18GuildsLeaderboardRequest\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\x12\x12\n" +
+	"\x04sort\x18\x02 \x01(\tR\x04sort\"C\n" +
+	"\x17GuildsStatisticsRequest\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\x12\x12\n" +
+	"\x04sort\x18\x02 \x01(\tR\x04sort\"\x19\n" +
+	"\x17GuildTrackerListRequest\"+\n" +
+	"\x13GuildTrackerRequest\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\"\xb2\x01\n" +
+	"\x11HighscoresRequest\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x1a\n" +
+	"\bvocation\x18\x03 \x01(\tR\bvocation\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x03R\x04page\x12\x1f\n" +
+	"\vworld_types\x18\x05 \x03(\tR\n" +
+	"worldTypes\x12\x1a\n" +
+	"\bbattleye\x18\x06 \x01(\tR\bbattleye\"\xa6\x01\n" +
+	"\x1aHighscoresSnapshotsRequest\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x1a\n" +
+	"\bvocation\x18\x03 \x01(\tR\bvocation\x12\x16\n" +
+	"\x06period\x18\x04 \x01(\tR\x06period\x12\x12\n" +
+	"\x04from\x18\x05 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x06 \x01(\tR\x02to\"?\n" +
+	"\fHouseRequest\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\x12\x19\n" +
+	"\bhouse_id\x18\x02 \x01(\x03R\ahouseId\"9\n" +
+	"\rHousesRequest\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\x12\x12\n" +
+	"\x04town\x18\x02 \x01(\tR\x04town\"\xee\x02\n" +
+	"\x13HousesSearchRequest\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\x12\x12\n" +
+	"\x04town\x18\x02 \x01(\tR\x04town\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x19\n" +
+	"\bmin_size\x18\x04 \x01(\x03R\aminSize\x12\x19\n" +
+	"\bmax_size\x18\x05 \x01(\x03R\amaxSize\x12\x19\n" +
+	"\bmin_beds\x18\x06 \x01(\x03R\aminBeds\x12\x19\n" +
+	"\bmax_beds\x18\a \x01(\x03R\amaxBeds\x12\x19\n" +
+	"\bmin_rent\x18\b \x01(\x03R\aminRent\x12\x19\n" +
+	"\bmax_rent\x18\t \x01(\x03R\amaxRent\x12\x1c\n" +
+	"\tauctioned\x18\n" +
+	" \x01(\bR\tauctioned\x12\x12\n" +
+	"\x04sort\x18\v \x01(\tR\x04sort\x12\x14\n" +
+	"\x05order\x18\f \x01(\tR\x05order\x12\x12\n" +
+	"\x04page\x18\r \x01(\x03R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x0e \x01(\x03R\bpageSize\"\xb4\x01\n" +
+	"\x12HousesWorldRequest\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\x12\x19\n" +
+	"\bmin_size\x18\x02 \x01(\x03R\aminSize\x12\x19\n" +
+	"\bmax_size\x18\x03 \x01(\x03R\amaxSize\x12\x19\n" +
+	"\bmin_rent\x18\x04 \x01(\x03R\aminRent\x12\x19\n" +
+	"\bmax_rent\x18\x05 \x01(\x03R\amaxRent\x12\x1c\n" +
+	"\tauctioned\x18\x06 \x01(\bR\tauctioned\"-\n" +
+	"\x15KillStatisticsRequest\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\"&\n" +
+	"\vNewsRequest\x12\x17\n" +
+	"\anews_id\x18\x01 \x01(\x03R\x06newsId\"U\n" +
+	"\x0fNewsListRequest\x12.\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1a.tibiadata.v4.NewsListTypeR\x04type\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x03R\x04days\")\n" +
+	"\fSpellRequest\x12\x19\n" +
+	"\bspell_id\x18\x01 \x01(\tR\aspellId\"+\n" +
+	"\rSpellsRequest\x12\x1a\n" +
+	"\bvocation\x18\x01 \x01(\tR\bvocation\"]\n" +
+	"\x0fWarKillsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\"%\n" +
+	"\x13WarTrackerIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x17\n" +
+	"\x15WarTrackerListRequest\"E\n" +
+	"\x11WarTrackerRequest\x12\x14\n" +
+	"\x05guild\x18\x01 \x01(\tR\x05guild\x12\x1a\n" +
+	"\bopponent\x18\x02 \x01(\tR\bopponent\"\x12\n" +
+	"\x10WatchlistRequest\"j\n" +
+	"\x18WatchlistTimelineRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\"B\n" +
+	"\x18WebhookDeliveriesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\"\n" +
+	"\x10WebhookIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xbe\x01\n" +
+	"\x0eWebhookRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x16\n" +
+	"\x06events\x18\x03 \x03(\tR\x06events\x12\x1e\n" +
+	"\n" +
+	"characters\x18\x04 \x03(\tR\n" +
+	"characters\x12\x16\n" +
+	"\x06guilds\x18\x05 \x03(\tR\x06guilds\x122\n" +
+	"\x06houses\x18\x06 \x03(\v2\x1a.tibiadata.v4.WebhookHouseR\x06houses\"\x11\n" +
+	"\x0fWebhooksRequest\"h\n" +
+	"\x16WorldOnlineDiffRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\"\"\n" +
+	"\fWorldRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x0f\n" +
+	"\rWorldsRequest*d\n" +
+	"\fNewsListType\x12\x1a\n" +
+	"\x16NEWS_LIST_TYPE_ARCHIVE\x10\x00\x12\x19\n" +
+	"\x15NEWS_LIST_TYPE_LATEST\x10\x01\x12\x1d\n" +
+	"\x19NEWS_LIST_TYPE_NEWSTICKER\x10\x022\xca#\n" +
+	"\tTibiaData\x12i\n" +
+	"\x12GetBoostableBosses\x12$.tibiadata.v4.BoostableBossesRequest\x1a-.tibiadata.v4.BoostableBossesOverviewResponse\x12O\n" +
+	"\fGetCharacter\x12\x1e.tibiadata.v4.CharacterRequest\x1a\x1f.tibiadata.v4.CharacterResponse\x12g\n" +
+	"\x18GetCharacterGuildHistory\x12\x1e.tibiadata.v4.CharacterRequest\x1a+.tibiadata.v4.CharacterGuildHistoryResponse\x12Y\n" +
+	"\x11GetCharacterRanks\x12\x1e.tibiadata.v4.CharacterRequest\x1a$.tibiadata.v4.CharacterRanksResponse\x12R\n" +
+	"\rGetCharacters\x12\x1f.tibiadata.v4.CharactersRequest\x1a .tibiadata.v4.CharactersResponse\x12L\n" +
+	"\vGetCreature\x12\x1d.tibiadata.v4.CreatureRequest\x1a\x1e.tibiadata.v4.CreatureResponse\x12W\n" +
+	"\fGetCreatures\x12\x1e.tibiadata.v4.CreaturesRequest\x1a'.tibiadata.v4.CreaturesOverviewResponse\x12R\n" +
+	"\rGetExperience\x12\x1f.tibiadata.v4.ExperienceRequest\x1a .tibiadata.v4.ExperienceResponse\x12L\n" +
+	"\vGetFansites\x12\x1d.tibiadata.v4.FansitesRequest\x1a\x1e.tibiadata.v4.FansitesResponse\x12C\n" +
+	"\bGetGuild\x12\x1a.tibiadata.v4.GuildRequest\x1a\x1b.tibiadata.v4.GuildResponse\x12O\n" +
+	"\x0eGetGuildEvents\x12\x1a.tibiadata.v4.GuildRequest\x1a!.tibiadata.v4.GuildEventsResponse\x12[\n" +
+	"\x10GetGuildExpanded\x12\".tibiadata.v4.GuildExpandedRequest\x1a#.tibiadata.v4.GuildExpandedResponse\x12W\n" +
+	"\x12GetGuildStatistics\x12\x1a.tibiadata.v4.GuildRequest\x1a%.tibiadata.v4.GuildStatisticsResponse\x12K\n" +
+	"\fGetGuildWars\x12\x1a.tibiadata.v4.GuildRequest\x1a\x1f.tibiadata.v4.GuildWarsResponse\x12N\n" +
+	"\tGetGuilds\x12\x1b.tibiadata.v4.GuildsRequest\x1a$.tibiadata.v4.GuildsOverviewResponse\x12g\n" +
+	"\x14GetGuildsLeaderboard\x12&.tibiadata.v4.GuildsLeaderboardRequest\x1a'.tibiadata.v4.GuildsLeaderboardResponse\x12d\n" +
+	"\x13GetGuildsStatistics\x12%.tibiadata.v4.GuildsStatisticsRequest\x1a&.tibiadata.v4.GuildsStatisticsResponse\x12\\\n" +
+	"\x0fGetGuildTracker\x12%.tibiadata.v4.GuildTrackerListRequest\x1a\".tibiadata.v4.GuildTrackerResponse\x12Z\n" +
+	"\x11AddToGuildTracker\x12!.tibiadata.v4.GuildTrackerRequest\x1a\".tibiadata.v4.GuildTrackerResponse\x12X\n" +
+	"\x16RemoveFromGuildTracker\x12\x1a.tibiadata.v4.GuildRequest\x1a\".tibiadata.v4.GuildTrackerResponse\x12X\n" +
+	"\x0fGetGuildHistory\x12!.tibiadata.v4.GuildHistoryRequest\x1a\".tibiadata.v4.GuildHistoryResponse\x12R\n" +
+	"\rGetHighscores\x12\x1f.tibiadata.v4.HighscoresRequest\x1a .tibiadata.v4.HighscoresResponse\x12W\n" +
+	"\x10StreamHighscores\x12\x1f.tibiadata.v4.HighscoresRequest\x1a .tibiadata.v4.HighscoresResponse0\x01\x12X\n" +
+	"\x10GetAllHighscores\x12\x1f.tibiadata.v4.HighscoresRequest\x1a#.tibiadata.v4.HighscoresAllResponse\x12g\n" +
+	"\x13GetHighscoresDeltas\x12(.tibiadata.v4.HighscoresSnapshotsRequest\x1a&.tibiadata.v4.HighscoresDeltasResponse\x12q\n" +
+	"\x18GetHighscoresRankChanges\x12(.tibiadata.v4.HighscoresSnapshotsRequest\x1a+.tibiadata.v4.HighscoresRankChangesResponse\x12C\n" +
+	"\bGetHouse\x12\x1a.tibiadata.v4.HouseRequest\x1a\x1b.tibiadata.v4.HouseResponse\x12U\n" +
+	"\x0eGetHousesWorld\x12 .tibiadata.v4.HousesWorldRequest\x1a!.tibiadata.v4.HousesWorldResponse\x12N\n" +
+	"\tGetHouses\x12\x1b.tibiadata.v4.HousesRequest\x1a$.tibiadata.v4.HousesOverviewResponse\x12X\n" +
+	"\x0fGetHousesSearch\x12!.tibiadata.v4.HousesSearchRequest\x1a\".tibiadata.v4.HousesSearchResponse\x12^\n" +
+	"\x11GetKillStatistics\x12#.tibiadata.v4.KillStatisticsRequest\x1a$.tibiadata.v4.KillStatisticsResponse\x12@\n" +
+	"\aGetNews\x12\x19.tibiadata.v4.NewsRequest\x1a\x1a.tibiadata.v4.NewsResponse\x12L\n" +
+	"\vGetNewsList\x12\x1d.tibiadata.v4.NewsListRequest\x1a\x1e.tibiadata.v4.NewsListResponse\x12N\n" +
+	"\bGetSpell\x12\x1a.tibiadata.v4.SpellRequest\x1a&.tibiadata.v4.SpellInformationResponse\x12N\n" +
+	"\tGetSpells\x12\x1b.tibiadata.v4.SpellsRequest\x1a$.tibiadata.v4.SpellsOverviewResponse\x12V\n" +
+	"\rGetWarTracker\x12#.tibiadata.v4.WarTrackerListRequest\x1a .tibiadata.v4.WarTrackerResponse\x12T\n" +
+	"\x0fAddToWarTracker\x12\x1f.tibiadata.v4.WarTrackerRequest\x1a .tibiadata.v4.WarTrackerResponse\x12[\n" +
+	"\x14RemoveFromWarTracker\x12!.tibiadata.v4.WarTrackerIDRequest\x1a .tibiadata.v4.WarTrackerResponse\x12L\n" +
+	"\vGetWarKills\x12\x1d.tibiadata.v4.WarKillsRequest\x1a\x1e.tibiadata.v4.WarKillsResponse\x12Z\n" +
+	"\x10GetWarScoreboard\x12!.tibiadata.v4.WarTrackerIDRequest\x1a#.tibiadata.v4.WarScoreboardResponse\x12O\n" +
+	"\fGetWatchlist\x12\x1e.tibiadata.v4.WatchlistRequest\x1a\x1f.tibiadata.v4.WatchlistResponse\x12R\n" +
+	"\x0eAddToWatchlist\x12\x1f.tibiadata.v4.CharactersRequest\x1a\x1f.tibiadata.v4.WatchlistResponse\x12V\n" +
+	"\x13RemoveFromWatchlist\x12\x1e.tibiadata.v4.CharacterRequest\x1a\x1f.tibiadata.v4.WatchlistResponse\x12g\n" +
+	"\x14GetWatchlistTimeline\x12&.tibiadata.v4.WatchlistTimelineRequest\x1a'.tibiadata.v4.WatchlistTimelineResponse\x12[\n" +
+	"\x12GetWatchlistDeaths\x12\x1e.tibiadata.v4.CharacterRequest\x1a%.tibiadata.v4.WatchlistDeathsResponse\x12L\n" +
+	"\vGetWebhooks\x12\x1d.tibiadata.v4.WebhooksRequest\x1a\x1e.tibiadata.v4.WebhooksResponse\x12I\n" +
+	"\n" +
+	"AddWebhook\x12\x1c.tibiadata.v4.WebhookRequest\x1a\x1d.tibiadata.v4.WebhookResponse\x12O\n" +
+	"\rRemoveWebhook\x12\x1e.tibiadata.v4.WebhookIDRequest\x1a\x1e.tibiadata.v4.WebhooksResponse\x12g\n" +
+	"\x14GetWebhookDeliveries\x12&.tibiadata.v4.WebhookDeliveriesRequest\x1a'.tibiadata.v4.WebhookDeliveriesResponse\x12C\n" +
+	"\bGetWorld\x12\x1a.tibiadata.v4.WorldRequest\x1a\x1b.tibiadata.v4.WorldResponse\x12a\n" +
+	"\x12GetWorldOnlineDiff\x12$.tibiadata.v4.WorldOnlineDiffRequest\x1a%.tibiadata.v4.WorldOnlineDiffResponse\x12N\n" +
+	"\tGetWorlds\x12\x1b.tibiadata.v4.WorldsRequest\x1a$.tibiadata.v4.WorldsOverviewResponseB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_tibiadata_proto_rawDescOnce sync.Once
+	file_tibiadata_proto_rawDescData []byte
+)
+
+func file_tibiadata_proto_rawDescGZIP() []byte {
+	file_tibiadata_proto_rawDescOnce.Do(func() {
+		file_tibiadata_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tibiadata_proto_rawDesc), len(file_tibiadata_proto_rawDesc)))
+	})
+	return file_tibiadata_proto_rawDescData
+}
+
+var file_tibiadata_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tibiadata_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_tibiadata_proto_goTypes = []any{
+	(NewsListType)(0),                       // 0: tibiadata.v4.NewsListType
+	(*BoostableBossesRequest)(nil),          // 1: tibiadata.v4.BoostableBossesRequest
+	(*CharacterRequest)(nil),                // 2: tibiadata.v4.CharacterRequest
+	(*CharactersRequest)(nil),               // 3: tibiadata.v4.CharactersRequest
+	(*CreatureRequest)(nil),                 // 4: tibiadata.v4.CreatureRequest
+	(*CreaturesRequest)(nil),                // 5: tibiadata.v4.CreaturesRequest
+	(*ExperienceRequest)(nil),               // 6: tibiadata.v4.ExperienceRequest
+	(*FansitesRequest)(nil),                 // 7: tibiadata.v4.FansitesRequest
+	(*GuildExpandedRequest)(nil),            // 8: tibiadata.v4.GuildExpandedRequest
+	(*GuildHistoryRequest)(nil),             // 9: tibiadata.v4.GuildHistoryRequest
+	(*GuildRequest)(nil),                    // 10: tibiadata.v4.GuildRequest
+	(*GuildsRequest)(nil),                   // 11: tibiadata.v4.GuildsRequest
+	(*GuildsLeaderboardRequest)(nil),        // 12: tibiadata.v4.GuildsLeaderboardRequest
+	(*GuildsStatisticsRequest)(nil),         // 13: tibiadata.v4.GuildsStatisticsRequest
+	(*GuildTrackerListRequest)(nil),         // 14: tibiadata.v4.GuildTrackerListRequest
+	(*GuildTrackerRequest)(nil),             // 15: tibiadata.v4.GuildTrackerRequest
+	(*HighscoresRequest)(nil),               // 16: tibiadata.v4.HighscoresRequest
+	(*HighscoresSnapshotsRequest)(nil),      // 17: tibiadata.v4.HighscoresSnapshotsRequest
+	(*HouseRequest)(nil),                    // 18: tibiadata.v4.HouseRequest
+	(*HousesRequest)(nil),                   // 19: tibiadata.v4.HousesRequest
+	(*HousesSearchRequest)(nil),             // 20: tibiadata.v4.HousesSearchRequest
+	(*HousesWorldRequest)(nil),              // 21: tibiadata.v4.HousesWorldRequest
+	(*KillStatisticsRequest)(nil),           // 22: tibiadata.v4.KillStatisticsRequest
+	(*NewsRequest)(nil),                     // 23: tibiadata.v4.NewsRequest
+	(*NewsListRequest)(nil),                 // 24: tibiadata.v4.NewsListRequest
+	(*SpellRequest)(nil),                    // 25: tibiadata.v4.SpellRequest
+	(*SpellsRequest)(nil),                   // 26: tibiadata.v4.SpellsRequest
+	(*WarKillsRequest)(nil),                 // 27: tibiadata.v4.WarKillsRequest
+	(*WarTrackerIDRequest)(nil),             // 28: tibiadata.v4.WarTrackerIDRequest
+	(*WarTrackerListRequest)(nil),           // 29: tibiadata.v4.WarTrackerListRequest
+	(*WarTrackerRequest)(nil),               // 30: tibiadata.v4.WarTrackerRequest
+	(*WatchlistRequest)(nil),                // 31: tibiadata.v4.WatchlistRequest
+	(*WatchlistTimelineRequest)(nil),        // 32: tibiadata.v4.WatchlistTimelineRequest
+	(*WebhookDeliveriesRequest)(nil),        // 33: tibiadata.v4.WebhookDeliveriesRequest
+	(*WebhookIDRequest)(nil),                // 34: tibiadata.v4.WebhookIDRequest
+	(*WebhookRequest)(nil),                  // 35: tibiadata.v4.WebhookRequest
+	(*WebhooksRequest)(nil),                 // 36: tibiadata.v4.WebhooksRequest
+	(*WorldOnlineDiffRequest)(nil),          // 37: tibiadata.v4.WorldOnlineDiffRequest
+	(*WorldRequest)(nil),                    // 38: tibiadata.v4.WorldRequest
+	(*WorldsRequest)(nil),                   // 39: tibiadata.v4.WorldsRequest
+	(*WebhookHouse)(nil),                    // 40: tibiadata.v4.WebhookHouse
+	(*BoostableBossesOverviewResponse)(nil), // 41: tibiadata.v4.BoostableBossesOverviewResponse
+	(*CharacterResponse)(nil),               // 42: tibiadata.v4.CharacterResponse
+	(*CharacterGuildHistoryResponse)(nil),   // 43: tibiadata.v4.CharacterGuildHistoryResponse
+	(*CharacterRanksResponse)(nil),          // 44: tibiadata.v4.CharacterRanksResponse
+	(*CharactersResponse)(nil),              // 45: tibiadata.v4.CharactersResponse
+	(*CreatureResponse)(nil),                // 46: tibiadata.v4.CreatureResponse
+	(*CreaturesOverviewResponse)(nil),       // 47: tibiadata.v4.CreaturesOverviewResponse
+	(*ExperienceResponse)(nil),              // 48: tibiadata.v4.ExperienceResponse
+	(*FansitesResponse)(nil),                // 49: tibiadata.v4.FansitesResponse
+	(*GuildResponse)(nil),                   // 50: tibiadata.v4.GuildResponse
+	(*GuildEventsResponse)(nil),             // 51: tibiadata.v4.GuildEventsResponse
+	(*GuildExpandedResponse)(nil),           // 52: tibiadata.v4.GuildExpandedResponse
+	(*GuildStatisticsResponse)(nil),         // 53: tibiadata.v4.GuildStatisticsResponse
+	(*GuildWarsResponse)(nil),               // 54: tibiadata.v4.GuildWarsResponse
+	(*GuildsOverviewResponse)(nil),          // 55: tibiadata.v4.GuildsOverviewResponse
+	(*GuildsLeaderboardResponse)(nil),       // 56: tibiadata.v4.GuildsLeaderboardResponse
+	(*GuildsStatisticsResponse)(nil),        // 57: tibiadata.v4.GuildsStatisticsResponse
+	(*GuildTrackerResponse)(nil),            // 58: tibiadata.v4.GuildTrackerResponse
+	(*GuildHistoryResponse)(nil),            // 59: tibiadata.v4.GuildHistoryResponse
+	(*HighscoresResponse)(nil),              // 60: tibiadata.v4.HighscoresResponse
+	(*HighscoresAllResponse)(nil),           // 61: tibiadata.v4.HighscoresAllResponse
+	(*HighscoresDeltasResponse)(nil),        // 62: tibiadata.v4.HighscoresDeltasResponse
+	(*HighscoresRankChangesResponse)(nil),   // 63: tibiadata.v4.HighscoresRankChangesResponse
+	(*HouseResponse)(nil),                   // 64: tibiadata.v4.HouseResponse
+	(*HousesWorldResponse)(nil),             // 65: tibiadata.v4.HousesWorldResponse
+	(*HousesOverviewResponse)(nil),          // 66: tibiadata.v4.HousesOverviewResponse
+	(*HousesSearchResponse)(nil),            // 67: tibiadata.v4.HousesSearchResponse
+	(*KillStatisticsResponse)(nil),          // 68: tibiadata.v4.KillStatisticsResponse
+	(*NewsResponse)(nil),                    // 69: tibiadata.v4.NewsResponse
+	(*NewsListResponse)(nil),                // 70: tibiadata.v4.NewsListResponse
+	(*SpellInformationResponse)(nil),        // 71: tibiadata.v4.SpellInformationResponse
+	(*SpellsOverviewResponse)(nil),          // 72: tibiadata.v4.SpellsOverviewResponse
+	(*WarTrackerResponse)(nil),              // 73: tibiadata.v4.WarTrackerResponse
+	(*WarKillsResponse)(nil),                // 74: tibiadata.v4.WarKillsResponse
+	(*WarScoreboardResponse)(nil),           // 75: tibiadata.v4.WarScoreboardResponse
+	(*WatchlistResponse)(nil),               // 76: tibiadata.v4.WatchlistResponse
+	(*WatchlistTimelineResponse)(nil),       // 77: tibiadata.v4.WatchlistTimelineResponse
+	(*WatchlistDeathsResponse)(nil),         // 78: tibiadata.v4.WatchlistDeathsResponse
+	(*WebhooksResponse)(nil),                // 79: tibiadata.v4.WebhooksResponse
+	(*WebhookResponse)(nil),                 // 80: tibiadata.v4.WebhookResponse
+	(*WebhookDeliveriesResponse)(nil),       // 81: tibiadata.v4.WebhookDeliveriesResponse
+	(*WorldResponse)(nil),                   // 82: tibiadata.v4.WorldResponse
+	(*WorldOnlineDiffResponse)(nil),         // 83: tibiadata.v4.WorldOnlineDiffResponse
+	(*WorldsOverviewResponse)(nil),          // 84: tibiadata.v4.WorldsOverviewResponse
+}
+var file_tibiadata_proto_depIdxs = []int32{
+	0,  // 0: tibiadata.v4.NewsListRequest.type:type_name -> tibiadata.v4.NewsListType
+	40, // 1: tibiadata.v4.WebhookRequest.houses:type_name -> tibiadata.v4.WebhookHouse
+	1,  // 2: tibiadata.v4.TibiaData.GetBoostableBosses:input_type -> tibiadata.v4.BoostableBossesRequest
+	2,  // 3: tibiadata.v4.TibiaData.GetCharacter:input_type -> tibiadata.v4.CharacterRequest
+	2,  // 4: tibiadata.v4.TibiaData.GetCharacterGuildHistory:input_type -> tibiadata.v4.CharacterRequest
+	2,  // 5: tibiadata.v4.TibiaData.GetCharacterRanks:input_type -> tibiadata.v4.CharacterRequest
+	3,  // 6: tibiadata.v4.TibiaData.GetCharacters:input_type -> tibiadata.v4.CharactersRequest
+	4,  // 7: tibiadata.v4.TibiaData.GetCreature:input_type -> tibiadata.v4.CreatureRequest
+	5,  // 8: tibiadata.v4.TibiaData.GetCreatures:input_type -> tibiadata.v4.CreaturesRequest
+	6,  // 9: tibiadata.v4.TibiaData.GetExperience:input_type -> tibiadata.v4.ExperienceRequest
+	7,  // 10: tibiadata.v4.TibiaData.GetFansites:input_type -> tibiadata.v4.FansitesRequest
+	10, // 11: tibiadata.v4.TibiaData.GetGuild:input_type -> tibiadata.v4.GuildRequest
+	10, // 12: tibiadata.v4.TibiaData.GetGuildEvents:input_type -> tibiadata.v4.GuildRequest
+	8,  // 13: tibiadata.v4.TibiaData.GetGuildExpanded:input_type -> tibiadata.v4.GuildExpandedRequest
+	10, // 14: tibiadata.v4.TibiaData.GetGuildStatistics:input_type -> tibiadata.v4.GuildRequest
+	10, // 15: tibiadata.v4.TibiaData.GetGuildWars:input_type -> tibiadata.v4.GuildRequest
+	11, // 16: tibiadata.v4.TibiaData.GetGuilds:input_type -> tibiadata.v4.GuildsRequest
+	12, // 17: tibiadata.v4.TibiaData.GetGuildsLeaderboard:input_type -> tibiadata.v4.GuildsLeaderboardRequest
+	13, // 18: tibiadata.v4.TibiaData.GetGuildsStatistics:input_type -> tibiadata.v4.GuildsStatisticsRequest
+	14, // 19: tibiadata.v4.TibiaData.GetGuildTracker:input_type -> tibiadata.v4.GuildTrackerListRequest
+	15, // 20: tibiadata.v4.TibiaData.AddToGuildTracker:input_type -> tibiadata.v4.GuildTrackerRequest
+	10, // 21: tibiadata.v4.TibiaData.RemoveFromGuildTracker:input_type -> tibiadata.v4.GuildRequest
+	9,  // 22: tibiadata.v4.TibiaData.GetGuildHistory:input_type -> tibiadata.v4.GuildHistoryRequest
+	16, // 23: tibiadata.v4.TibiaData.GetHighscores:input_type -> tibiadata.v4.HighscoresRequest
+	16, // 24: tibiadata.v4.TibiaData.StreamHighscores:input_type -> tibiadata.v4.HighscoresRequest
+	16, // 25: tibiadata.v4.TibiaData.GetAllHighscores:input_type -> tibiadata.v4.HighscoresRequest
+	17, // 26: tibiadata.v4.TibiaData.GetHighscoresDeltas:input_type -> tibiadata.v4.HighscoresSnapshotsRequest
+	17, // 27: tibiadata.v4.TibiaData.GetHighscoresRankChanges:input_type -> tibiadata.v4.HighscoresSnapshotsRequest
+	18, // 28: tibiadata.v4.TibiaData.GetHouse:input_type -> tibiadata.v4.HouseRequest
+	21, // 29: tibiadata.v4.TibiaData.GetHousesWorld:input_type -> tibiadata.v4.HousesWorldRequest
+	19, // 30: tibiadata.v4.TibiaData.GetHouses:input_type -> tibiadata.v4.HousesRequest
+	20, // 31: tibiadata.v4.TibiaData.GetHousesSearch:input_type -> tibiadata.v4.HousesSearchRequest
+	22, // 32: tibiadata.v4.TibiaData.GetKillStatistics:input_type -> tibiadata.v4.KillStatisticsRequest
+	23, // 33: tibiadata.v4.TibiaData.GetNews:input_type -> tibiadata.v4.NewsRequest
+	24, // 34: tibiadata.v4.TibiaData.GetNewsList:input_type -> tibiadata.v4.NewsListRequest
+	25, // 35: tibiadata.v4.TibiaData.GetSpell:input_type -> tibiadata.v4.SpellRequest
+	26, // 36: tibiadata.v4.TibiaData.GetSpells:input_type -> tibiadata.v4.SpellsRequest
+	29, // 37: tibiadata.v4.TibiaData.GetWarTracker:input_type -> tibiadata.v4.WarTrackerListRequest
+	30, // 38: tibiadata.v4.TibiaData.AddToWarTracker:input_type -> tibiadata.v4.WarTrackerRequest
+	28, // 39: tibiadata.v4.TibiaData.RemoveFromWarTracker:input_type -> tibiadata.v4.WarTrackerIDRequest
+	27, // 40: tibiadata.v4.TibiaData.GetWarKills:input_type -> tibiadata.v4.WarKillsRequest
+	28, // 41: tibiadata.v4.TibiaData.GetWarScoreboard:input_type -> tibiadata.v4.WarTrackerIDRequest
+	31, // 42: tibiadata.v4.TibiaData.GetWatchlist:input_type -> tibiadata.v4.WatchlistRequest
+	3,  // 43: tibiadata.v4.TibiaData.AddToWatchlist:input_type -> tibiadata.v4.CharactersRequest
+	2,  // 44: tibiadata.v4.TibiaData.RemoveFromWatchlist:input_type -> tibiadata.v4.CharacterRequest
+	32, // 45: tibiadata.v4.TibiaData.GetWatchlistTimeline:input_type -> tibiadata.v4.WatchlistTimelineRequest
+	2,  // 46: tibiadata.v4.TibiaData.GetWatchlistDeaths:input_type -> tibiadata.v4.CharacterRequest
+	36, // 47: tibiadata.v4.TibiaData.GetWebhooks:input_type -> tibiadata.v4.WebhooksRequest
+	35, // 48: tibiadata.v4.TibiaData.AddWebhook:input_type -> tibiadata.v4.WebhookRequest
+	34, // 49: tibiadata.v4.TibiaData.RemoveWebhook:input_type -> tibiadata.v4.WebhookIDRequest
+	33, // 50: tibiadata.v4.TibiaData.GetWebhookDeliveries:input_type -> tibiadata.v4.WebhookDeliveriesRequest
+	38, // 51: tibiadata.v4.TibiaData.GetWorld:input_type -> tibiadata.v4.WorldRequest
+	37, // 52: tibiadata.v4.TibiaData.GetWorldOnlineDiff:input_type -> tibiadata.v4.WorldOnlineDiffRequest
+	39, // 53: tibiadata.v4.TibiaData.GetWorlds:input_type -> tibiadata.v4.WorldsRequest
+	41, // 54: tibiadata.v4.TibiaData.GetBoostableBosses:output_type -> tibiadata.v4.BoostableBossesOverviewResponse
+	42, // 55: tibiadata.v4.TibiaData.GetCharacter:output_type -> tibiadata.v4.CharacterResponse
+	43, // 56: tibiadata.v4.TibiaData.GetCharacterGuildHistory:output_type -> tibiadata.v4.CharacterGuildHistoryResponse
+	44, // 57: tibiadata.v4.TibiaData.GetCharacterRanks:output_type -> tibiadata.v4.CharacterRanksResponse
+	45, // 58: tibiadata.v4.TibiaData.GetCharacters:output_type -> tibiadata.v4.CharactersResponse
+	46, // 59: tibiadata.v4.TibiaData.GetCreature:output_type -> tibiadata.v4.CreatureResponse
+	47, // 60: tibiadata.v4.TibiaData.GetCreatures:output_type -> tibiadata.v4.CreaturesOverviewResponse
+	48, // 61: tibiadata.v4.TibiaData.GetExperience:output_type -> tibiadata.v4.ExperienceResponse
+	49, // 62: tibiadata.v4.TibiaData.GetFansites:output_type -> tibiadata.v4.FansitesResponse
+	50, // 63: tibiadata.v4.TibiaData.GetGuild:output_type -> tibiadata.v4.GuildResponse
+	51, // 64: tibiadata.v4.TibiaData.GetGuildEvents:output_type -> tibiadata.v4.GuildEventsResponse
+	52, // 65: tibiadata.v4.TibiaData.GetGuildExpanded:output_type -> tibiadata.v4.GuildExpandedResponse
+	53, // 66: tibiadata.v4.TibiaData.GetGuildStatistics:output_type -> tibiadata.v4.GuildStatisticsResponse
+	54, // 67: tibiadata.v4.TibiaData.GetGuildWars:output_type -> tibiadata.v4.GuildWarsResponse
+	55, // 68: tibiadata.v4.TibiaData.GetGuilds:output_type -> tibiadata.v4.GuildsOverviewResponse
+	56, // 69: tibiadata.v4.TibiaData.GetGuildsLeaderboard:output_type -> tibiadata.v4.GuildsLeaderboardResponse
+	57, // 70: tibiadata.v4.TibiaData.GetGuildsStatistics:output_type -> tibiadata.v4.GuildsStatisticsResponse
+	58, // 71: tibiadata.v4.TibiaData.GetGuildTracker:output_type -> tibiadata.v4.GuildTrackerResponse
+	58, // 72: tibiadata.v4.TibiaData.AddToGuildTracker:output_type -> tibiadata.v4.GuildTrackerResponse
+	58, // 73: tibiadata.v4.TibiaData.RemoveFromGuildTracker:output_type -> tibiadata.v4.GuildTrackerResponse
+	59, // 74: tibiadata.v4.TibiaData.GetGuildHistory:output_type -> tibiadata.v4.GuildHistoryResponse
+	60, // 75: tibiadata.v4.TibiaData.GetHighscores:output_type -> tibiadata.v4.HighscoresResponse
+	60, // 76: tibiadata.v4.TibiaData.StreamHighscores:output_type -> tibiadata.v4.HighscoresResponse
+	61, // 77: tibiadata.v4.TibiaData.GetAllHighscores:output_type -> tibiadata.v4.HighscoresAllResponse
+	62, // 78: tibiadata.v4.TibiaData.GetHighscoresDeltas:output_type -> tibiadata.v4.HighscoresDeltasResponse
+	63, // 79: tibiadata.v4.TibiaData.GetHighscoresRankChanges:output_type -> tibiadata.v4.HighscoresRankChangesResponse
+	64, // 80: tibiadata.v4.TibiaData.GetHouse:output_type -> tibiadata.v4.HouseResponse
+	65, // 81: tibiadata.v4.TibiaData.GetHousesWorld:output_type -> tibiadata.v4.HousesWorldResponse
+	66, // 82: tibiadata.v4.TibiaData.GetHouses:output_type -> tibiadata.v4.HousesOverviewResponse
+	67, // 83: tibiadata.v4.TibiaData.GetHousesSearch:output_type -> tibiadata.v4.HousesSearchResponse
+	68, // 84: tibiadata.v4.TibiaData.GetKillStatistics:output_type -> tibiadata.v4.KillStatisticsResponse
+	69, // 85: tibiadata.v4.TibiaData.GetNews:output_type -> tibiadata.v4.NewsResponse
+	70, // 86: tibiadata.v4.TibiaData.GetNewsList:output_type -> tibiadata.v4.NewsListResponse
+	71, // 87: tibiadata.v4.TibiaData.GetSpell:output_type -> tibiadata.v4.SpellInformationResponse
+	72, // 88: tibiadata.v4.TibiaData.GetSpells:output_type -> tibiadata.v4.SpellsOverviewResponse
+	73, // 89: tibiadata.v4.TibiaData.GetWarTracker:output_type -> tibiadata.v4.WarTrackerResponse
+	73, // 90: tibiadata.v4.TibiaData.AddToWarTracker:output_type -> tibiadata.v4.WarTrackerResponse
+	73, // 91: tibiadata.v4.TibiaData.RemoveFromWarTracker:output_type -> tibiadata.v4.WarTrackerResponse
+	74, // 92: tibiadata.v4.TibiaData.GetWarKills:output_type -> tibiadata.v4.WarKillsResponse
+	75, // 93: tibiadata.v4.TibiaData.GetWarScoreboard:output_type -> tibiadata.v4.WarScoreboardResponse
+	76, // 94: tibiadata.v4.TibiaData.GetWatchlist:output_type -> tibiadata.v4.WatchlistResponse
+	76, // 95: tibiadata.v4.TibiaData.AddToWatchlist:output_type -> tibiadata.v4.WatchlistResponse
+	76, // 96: tibiadata.v4.TibiaData.RemoveFromWatchlist:output_type -> tibiadata.v4.WatchlistResponse
+	77, // 97: tibiadata.v4.TibiaData.GetWatchlistTimeline:output_type -> tibiadata.v4.WatchlistTimelineResponse
+	78, // 98: tibiadata.v4.TibiaData.GetWatchlistDeaths:output_type -> tibiadata.v4.WatchlistDeathsResponse
+	79, // 99: tibiadata.v4.TibiaData.GetWebhooks:output_type -> tibiadata.v4.WebhooksResponse
+	80, // 100: tibiadata.v4.TibiaData.AddWebhook:output_type -> tibiadata.v4.WebhookResponse
+	79, // 101: tibiadata.v4.TibiaData.RemoveWebhook:output_type -> tibiadata.v4.WebhooksResponse
+	81, // 102: tibiadata.v4.TibiaData.GetWebhookDeliveries:output_type -> tibiadata.v4.WebhookDeliveriesResponse
+	82, // 103: tibiadata.v4.TibiaData.GetWorld:output_type -> tibiadata.v4.WorldResponse
+	83, // 104: tibiadata.v4.TibiaData.GetWorldOnlineDiff:output_type -> tibiadata.v4.WorldOnlineDiffResponse
+	84, // 105: tibiadata.v4.TibiaData.GetWorlds:output_type -> tibiadata.v4.WorldsOverviewResponse
+	54, // [54:106] is the sub-list for method output_type
+	2,  // [2:54] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_tibiadata_proto_init() }
+func file_tibiadata_proto_init() {
+	if File_tibiadata_proto != nil {
+		return
+	}
+	file_boostable_bosses_overview_proto_init()
+	file_characters_batch_proto_init()
+	file_characters_character_proto_init()
+	file_characters_ranks_proto_init()
+	file_creatures_creature_proto_init()
+	file_creatures_overview_proto_init()
+	file_experience_proto_init()
+	file_fansites_proto_init()
+	file_guilds_guild_proto_init()
+	file_guilds_guild_events_proto_init()
+	file_guilds_guild_expanded_proto_init()
+	file_guilds_guild_wars_proto_init()
+	file_guilds_history_proto_init()
+	file_guilds_leaderboard_proto_init()
+	file_guilds_overview_proto_init()
+	file_guilds_statistics_proto_init()
+	file_guilds_war_tracker_proto_init()
+	file_highscores_proto_init()
+	file_highscores_snapshots_proto_init()
+	file_houses_house_proto_init()
+	file_houses_overview_proto_init()
+	file_houses_search_proto_init()
+	file_houses_world_proto_init()
+	file_killstatistics_proto_init()
+	file_news_proto_init()
+	file_newslist_proto_init()
+	file_spells_overview_proto_init()
+	file_spells_spell_proto_init()
+	file_watchlist_proto_init()
+	file_webhooks_proto_init()
+	file_worlds_online_proto_init()
+	file_worlds_overview_proto_init()
+	file_worlds_world_proto_init()
+	file_tibiadata_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tibiadata_proto_rawDesc), len(file_tibiadata_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   39,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tibiadata_proto_goTypes,
+		DependencyIndexes: file_tibiadata_proto_depIdxs,
+		EnumInfos:         file_tibiadata_proto_enumTypes,
+		MessageInfos:      file_tibiadata_proto_msgTypes,
+	}.Build()
+	File_tibiadata_proto = out.File
+	file_tibiadata_proto_goTypes = nil
+	file_tibiadata_proto_depIdxs = nil
+}
@@ -0,0 +1,2177 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: tibiadata.proto
+
+package tibiadatapb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TibiaData_GetBoostableBosses_FullMethodName       = "/tibiadata.v4.TibiaData/GetBoostableBosses"
+	TibiaData_GetCharacter_FullMethodName             = "/tibiadata.v4.TibiaData/GetCharacter"
+	TibiaData_GetCharacterGuildHistory_FullMethodName = "/tibiadata.v4.TibiaData/GetCharacterGuildHistory"
+	TibiaData_GetCharacterRanks_FullMethodName        = "/tibiadata.v4.TibiaData/GetCharacterRanks"
+	TibiaData_GetCharacters_FullMethodName            = "/tibiadata.v4.TibiaData/GetCharacters"
+	TibiaData_GetCreature_FullMethodName              = "/tibiadata.v4.TibiaData/GetCreature"
+	TibiaData_GetCreatures_FullMethodName             = "/tibiadata.v4.TibiaData/GetCreatures"
+	TibiaData_GetExperience_FullMethodName            = "/tibiadata.v4.TibiaData/GetExperience"
+	TibiaData_GetFansites_FullMethodName              = "/tibiadata.v4.TibiaData/GetFansites"
+	TibiaData_GetGuild_FullMethodName                 = "/tibiadata.v4.TibiaData/GetGuild"
+	TibiaData_GetGuildEvents_FullMethodName           = "/tibiadata.v4.TibiaData/GetGuildEvents"
+	TibiaData_GetGuildExpanded_FullMethodName         = "/tibiadata.v4.TibiaData/GetGuildExpanded"
+	TibiaData_GetGuildStatistics_FullMethodName       = "/tibiadata.v4.TibiaData/GetGuildStatistics"
+	TibiaData_GetGuildWars_FullMethodName             = "/tibiadata.v4.TibiaData/GetGuildWars"
+	TibiaData_GetGuilds_FullMethodName                = "/tibiadata.v4.TibiaData/GetGuilds"
+	TibiaData_GetGuildsLeaderboard_FullMethodName     = "/tibiadata.v4.TibiaData/GetGuildsLeaderboard"
+	TibiaData_GetGuildsStatistics_FullMethodName      = "/tibiadata.v4.TibiaData/GetGuildsStatistics"
+	TibiaData_GetGuildTracker_FullMethodName          = "/tibiadata.v4.TibiaData/GetGuildTracker"
+	TibiaData_AddToGuildTracker_FullMethodName        = "/tibiadata.v4.TibiaData/AddToGuildTracker"
+	TibiaData_RemoveFromGuildTracker_FullMethodName   = "/tibiadata.v4.TibiaData/RemoveFromGuildTracker"
+	TibiaData_GetGuildHistory_FullMethodName          = "/tibiadata.v4.TibiaData/GetGuildHistory"
+	TibiaData_GetHighscores_FullMethodName            = "/tibiadata.v4.TibiaData/GetHighscores"
+	TibiaData_StreamHighscores_FullMethodName         = "/tibiadata.v4.TibiaData/StreamHighscores"
+	TibiaData_GetAllHighscores_FullMethodName         = "/tibiadata.v4.TibiaData/GetAllHighscores"
+	TibiaData_GetHighscoresDeltas_FullMethodName      = "/tibiadata.v4.TibiaData/GetHighscoresDeltas"
+	TibiaData_GetHighscoresRankChanges_FullMethodName = "/tibiadata.v4.TibiaData/GetHighscoresRankChanges"
+	TibiaData_GetHouse_FullMethodName                 = "/tibiadata.v4.TibiaData/GetHouse"
+	TibiaData_GetHousesWorld_FullMethodName           = "/tibiadata.v4.TibiaData/GetHousesWorld"
+	TibiaData_GetHouses_FullMethodName                = "/tibiadata.v4.TibiaData/GetHouses"
+	TibiaData_GetHousesSearch_FullMethodName          = "/tibiadata.v4.TibiaData/GetHousesSearch"
+	TibiaData_GetKillStatistics_FullMethodName        = "/tibiadata.v4.TibiaData/GetKillStatistics"
+	TibiaData_GetNews_FullMethodName                  = "/tibiadata.v4.TibiaData/GetNews"
+	TibiaData_GetNewsList_FullMethodName              = "/tibiadata.v4.TibiaData/GetNewsList"
+	TibiaData_GetSpell_FullMethodName                 = "/tibiadata.v4.TibiaData/GetSpell"
+	TibiaData_GetSpells_FullMethodName                = "/tibiadata.v4.TibiaData/GetSpells"
+	TibiaData_GetWarTracker_FullMethodName            = "/tibiadata.v4.TibiaData/GetWarTracker"
+	TibiaData_AddToWarTracker_FullMethodName          = "/tibiadata.v4.TibiaData/AddToWarTracker"
+	TibiaData_RemoveFromWarTracker_FullMethodName     = "/tibiadata.v4.TibiaData/RemoveFromWarTracker"
+	TibiaData_GetWarKills_FullMethodName              = "/tibiadata.v4.TibiaData/GetWarKills"
+	TibiaData_GetWarScoreboard_FullMethodName         = "/tibiadata.v4.TibiaData/GetWarScoreboard"
+	TibiaData_GetWatchlist_FullMethodName             = "/tibiadata.v4.TibiaData/GetWatchlist"
+	TibiaData_AddToWatchlist_FullMethodName           = "/tibiadata.v4.TibiaData/AddToWatchlist"
+	TibiaData_RemoveFromWatchlist_FullMethodName      = "/tibiadata.v4.TibiaData/RemoveFromWatchlist"
+	TibiaData_GetWatchlistTimeline_FullMethodName     = "/tibiadata.v4.TibiaData/GetWatchlistTimeline"
+	TibiaData_GetWatchlistDeaths_FullMethodName       = "/tibiadata.v4.TibiaData/GetWatchlistDeaths"
+	TibiaData_GetWebhooks_FullMethodName              = "/tibiadata.v4.TibiaData/GetWebhooks"
+	TibiaData_AddWebhook_FullMethodName               = "/tibiadata.v4.TibiaData/AddWebhook"
+	TibiaData_RemoveWebhook_FullMethodName            = "/tibiadata.v4.TibiaData/RemoveWebhook"
+	TibiaData_GetWebhookDeliveries_FullMethodName     = "/tibiadata.v4.TibiaData/GetWebhookDeliveries"
+	TibiaData_GetWorld_FullMethodName                 = "/tibiadata.v4.TibiaData/GetWorld"
+	TibiaData_GetWorldOnlineDiff_FullMethodName       = "/tibiadata.v4.TibiaData/GetWorldOnlineDiff"
+	TibiaData_GetWorlds_FullMethodName                = "/tibiadata.v4.TibiaData/GetWorlds"
+)
+
+// TibiaDataClient is the client API for TibiaData service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TibiaData mirrors the /v4 endpoints of the REST API
+//
+// Errors are returned with a status code matching the validation error and carry
+// an ErrorInfo (reason ERROR_<code>) and the Information of the error as details.
+type TibiaDataClient interface {
+	// GET /v4/boostablebosses
+	GetBoostableBosses(ctx context.Context, in *BoostableBossesRequest, opts ...grpc.CallOption) (*BoostableBossesOverviewResponse, error)
+	// GET /v4/character/:name
+	GetCharacter(ctx context.Context, in *CharacterRequest, opts ...grpc.CallOption) (*CharacterResponse, error)
+	// GET /v4/character/:name/guildhistory
+	GetCharacterGuildHistory(ctx context.Context, in *CharacterRequest, opts ...grpc.CallOption) (*CharacterGuildHistoryResponse, error)
+	// GET /v4/character/:name/ranks
+	GetCharacterRanks(ctx context.Context, in *CharacterRequest, opts ...grpc.CallOption) (*CharacterRanksResponse, error)
+	// POST /v4/characters
+	GetCharacters(ctx context.Context, in *CharactersRequest, opts ...grpc.CallOption) (*CharactersResponse, error)
+	// GET /v4/creature/:race
+	GetCreature(ctx context.Context, in *CreatureRequest, opts ...grpc.CallOption) (*CreatureResponse, error)
+	// GET /v4/creatures
+	GetCreatures(ctx context.Context, in *CreaturesRequest, opts ...grpc.CallOption) (*CreaturesOverviewResponse, error)
+	// GET /v4/experience
+	GetExperience(ctx context.Context, in *ExperienceRequest, opts ...grpc.CallOption) (*ExperienceResponse, error)
+	// GET /v4/fansites
+	GetFansites(ctx context.Context, in *FansitesRequest, opts ...grpc.CallOption) (*FansitesResponse, error)
+	// GET /v4/guild/:name
+	GetGuild(ctx context.Context, in *GuildRequest, opts ...grpc.CallOption) (*GuildResponse, error)
+	// GET /v4/guild/:name/events
+	GetGuildEvents(ctx context.Context, in *GuildRequest, opts ...grpc.CallOption) (*GuildEventsResponse, error)
+	// GET /v4/guild/:name/expanded
+	GetGuildExpanded(ctx context.Context, in *GuildExpandedRequest, opts ...grpc.CallOption) (*GuildExpandedResponse, error)
+	// GET /v4/guild/:name/statistics
+	GetGuildStatistics(ctx context.Context, in *GuildRequest, opts ...grpc.CallOption) (*GuildStatisticsResponse, error)
+	// GET /v4/guild/:name/wars
+	GetGuildWars(ctx context.Context, in *GuildRequest, opts ...grpc.CallOption) (*GuildWarsResponse, error)
+	// GET /v4/guilds/:world
+	GetGuilds(ctx context.Context, in *GuildsRequest, opts ...grpc.CallOption) (*GuildsOverviewResponse, error)
+	// GET /v4/guilds/:world/leaderboard
+	GetGuildsLeaderboard(ctx context.Context, in *GuildsLeaderboardRequest, opts ...grpc.CallOption) (*GuildsLeaderboardResponse, error)
+	// GET /v4/guilds/:world/statistics
+	GetGuildsStatistics(ctx context.Context, in *GuildsStatisticsRequest, opts ...grpc.CallOption) (*GuildsStatisticsResponse, error)
+	// GET /v4/guildtracker
+	GetGuildTracker(ctx context.Context, in *GuildTrackerListRequest, opts ...grpc.CallOption) (*GuildTrackerResponse, error)
+	// POST /v4/guildtracker
+	AddToGuildTracker(ctx context.Context, in *GuildTrackerRequest, opts ...grpc.CallOption) (*GuildTrackerResponse, error)
+	// DELETE /v4/guildtracker/:name
+	RemoveFromGuildTracker(ctx context.Context, in *GuildRequest, opts ...grpc.CallOption) (*GuildTrackerResponse, error)
+	// GET /v4/guildtracker/:name/history
+	GetGuildHistory(ctx context.Context, in *GuildHistoryRequest, opts ...grpc.CallOption) (*GuildHistoryResponse, error)
+	// GET /v4/highscores/:world/:category/:vocation/:page
+	GetHighscores(ctx context.Context, in *HighscoresRequest, opts ...grpc.CallOption) (*HighscoresResponse, error)
+	// Streams the highscore pages from the requested page until the last page
+	StreamHighscores(ctx context.Context, in *HighscoresRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[HighscoresResponse], error)
+	// GET /v4/highscores/:world/:category/:vocation/all (the page is ignored)
+	GetAllHighscores(ctx context.Context, in *HighscoresRequest, opts ...grpc.CallOption) (*HighscoresAllResponse, error)
+	// GET /v4/highscores/:world/:category/:vocation/deltas
+	GetHighscoresDeltas(ctx context.Context, in *HighscoresSnapshotsRequest, opts ...grpc.CallOption) (*HighscoresDeltasResponse, error)
+	// GET /v4/highscores/:world/:category/:vocation/rankchanges
+	GetHighscoresRankChanges(ctx context.Context, in *HighscoresSnapshotsRequest, opts ...grpc.CallOption) (*HighscoresRankChangesResponse, error)
+	// GET /v4/house/:world/:house_id
+	GetHouse(ctx context.Context, in *HouseRequest, opts ...grpc.CallOption) (*HouseResponse, error)
+	// GET /v4/houses/:world
+	GetHousesWorld(ctx context.Context, in *HousesWorldRequest, opts ...grpc.CallOption) (*HousesWorldResponse, error)
+	// GET /v4/houses/:world/:town
+	GetHouses(ctx context.Context, in *HousesRequest, opts ...grpc.CallOption) (*HousesOverviewResponse, error)
+	// GET /v4/houses/:world/search
+	GetHousesSearch(ctx context.Context, in *HousesSearchRequest, opts ...grpc.CallOption) (*HousesSearchResponse, error)
+	// GET /v4/killstatistics/:world
+	GetKillStatistics(ctx context.Context, in *KillStatisticsRequest, opts ...grpc.CallOption) (*KillStatisticsResponse, error)
+	// GET /v4/news/id/:news_id
+	GetNews(ctx context.Context, in *NewsRequest, opts ...grpc.CallOption) (*NewsResponse, error)
+	// GET /v4/news/archive, /v4/news/archive/:days, /v4/news/latest and /v4/news/newsticker
+	GetNewsList(ctx context.Context, in *NewsListRequest, opts ...grpc.CallOption) (*NewsListResponse, error)
+	// GET /v4/spell/:spell_id
+	GetSpell(ctx context.Context, in *SpellRequest, opts ...grpc.CallOption) (*SpellInformationResponse, error)
+	// GET /v4/spells
+	GetSpells(ctx context.Context, in *SpellsRequest, opts ...grpc.CallOption) (*SpellsOverviewResponse, error)
+	// GET /v4/wartracker
+	GetWarTracker(ctx context.Context, in *WarTrackerListRequest, opts ...grpc.CallOption) (*WarTrackerResponse, error)
+	// POST /v4/wartracker
+	AddToWarTracker(ctx context.Context, in *WarTrackerRequest, opts ...grpc.CallOption) (*WarTrackerResponse, error)
+	// DELETE /v4/wartracker/:id
+	RemoveFromWarTracker(ctx context.Context, in *WarTrackerIDRequest, opts ...grpc.CallOption) (*WarTrackerResponse, error)
+	// GET /v4/wartracker/:id/kills
+	GetWarKills(ctx context.Context, in *WarKillsRequest, opts ...grpc.CallOption) (*WarKillsResponse, error)
+	// GET /v4/wartracker/:id/scoreboard
+	GetWarScoreboard(ctx context.Context, in *WarTrackerIDRequest, opts ...grpc.CallOption) (*WarScoreboardResponse, error)
+	// GET /v4/watchlist
+	GetWatchlist(ctx context.Context, in *WatchlistRequest, opts ...grpc.CallOption) (*WatchlistResponse, error)
+	// POST /v4/watchlist
+	AddToWatchlist(ctx context.Context, in *CharactersRequest, opts ...grpc.CallOption) (*WatchlistResponse, error)
+	// DELETE /v4/watchlist/:name
+	RemoveFromWatchlist(ctx context.Context, in *CharacterRequest, opts ...grpc.CallOption) (*WatchlistResponse, error)
+	// GET /v4/watchlist/:name/timeline
+	GetWatchlistTimeline(ctx context.Context, in *WatchlistTimelineRequest, opts ...grpc.CallOption) (*WatchlistTimelineResponse, error)
+	// GET /v4/watchlist/:name/deaths
+	GetWatchlistDeaths(ctx context.Context, in *CharacterRequest, opts ...grpc.CallOption) (*WatchlistDeathsResponse, error)
+	// GET /v4/webhooks
+	GetWebhooks(ctx context.Context, in *WebhooksRequest, opts ...grpc.CallOption) (*WebhooksResponse, error)
+	// POST /v4/webhooks
+	AddWebhook(ctx context.Context, in *WebhookRequest, opts ...grpc.CallOption) (*WebhookResponse, error)
+	// DELETE /v4/webhooks/:id
+	RemoveWebhook(ctx context.Context, in *WebhookIDRequest, opts ...grpc.CallOption) (*WebhooksResponse, error)
+	// GET /v4/webhooks/:id/deliveries
+	GetWebhookDeliveries(ctx context.Context, in *WebhookDeliveriesRequest, opts ...grpc.CallOption) (*WebhookDeliveriesResponse, error)
+	// GET /v4/world/:name
+	GetWorld(ctx context.Context, in *WorldRequest, opts ...grpc.CallOption) (*WorldResponse, error)
+	// GET /v4/world/:name/online/diff
+	GetWorldOnlineDiff(ctx context.Context, in *WorldOnlineDiffRequest, opts ...grpc.CallOption) (*WorldOnlineDiffResponse, error)
+	// GET /v4/worlds
+	GetWorlds(ctx context.Context, in *WorldsRequest, opts ...grpc.CallOption) (*WorldsOverviewResponse, error)
+}
+
+type tibiaDataClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTibiaDataClient(cc grpc.ClientConnInterface) TibiaDataClient {
+	return &tibiaDataClient{cc}
+}
+
+func (c *tibiaDataClient) GetBoostableBosses(ctx context.Context, in *BoostableBossesRequest, opts ...grpc.CallOption) (*BoostableBossesOverviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoostableBossesOverviewResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetBoostableBosses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetCharacter(ctx context.Context, in *CharacterRequest, opts ...grpc.CallOption) (*CharacterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CharacterResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetCharacter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetCharacterGuildHistory(ctx context.Context, in *CharacterRequest, opts ...grpc.CallOption) (*CharacterGuildHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CharacterGuildHistoryResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetCharacterGuildHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetCharacterRanks(ctx context.Context, in *CharacterRequest, opts ...grpc.CallOption) (*CharacterRanksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CharacterRanksResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetCharacterRanks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetCharacters(ctx context.Context, in *CharactersRequest, opts ...grpc.CallOption) (*CharactersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CharactersResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetCharacters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetCreature(ctx context.Context, in *CreatureRequest, opts ...grpc.CallOption) (*CreatureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatureResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetCreature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetCreatures(ctx context.Context, in *CreaturesRequest, opts ...grpc.CallOption) (*CreaturesOverviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreaturesOverviewResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetCreatures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetExperience(ctx context.Context, in *ExperienceRequest, opts ...grpc.CallOption) (*ExperienceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExperienceResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetExperience_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetFansites(ctx context.Context, in *FansitesRequest, opts ...grpc.CallOption) (*FansitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FansitesResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetFansites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetGuild(ctx context.Context, in *GuildRequest, opts ...grpc.CallOption) (*GuildResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuildResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetGuild_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetGuildEvents(ctx context.Context, in *GuildRequest, opts ...grpc.CallOption) (*GuildEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuildEventsResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetGuildEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetGuildExpanded(ctx context.Context, in *GuildExpandedRequest, opts ...grpc.CallOption) (*GuildExpandedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuildExpandedResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetGuildExpanded_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetGuildStatistics(ctx context.Context, in *GuildRequest, opts ...grpc.CallOption) (*GuildStatisticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuildStatisticsResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetGuildStatistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetGuildWars(ctx context.Context, in *GuildRequest, opts ...grpc.CallOption) (*GuildWarsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuildWarsResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetGuildWars_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetGuilds(ctx context.Context, in *GuildsRequest, opts ...grpc.CallOption) (*GuildsOverviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuildsOverviewResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetGuilds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetGuildsLeaderboard(ctx context.Context, in *GuildsLeaderboardRequest, opts ...grpc.CallOption) (*GuildsLeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuildsLeaderboardResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetGuildsLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetGuildsStatistics(ctx context.Context, in *GuildsStatisticsRequest, opts ...grpc.CallOption) (*GuildsStatisticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuildsStatisticsResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetGuildsStatistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetGuildTracker(ctx context.Context, in *GuildTrackerListRequest, opts ...grpc.CallOption) (*GuildTrackerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuildTrackerResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetGuildTracker_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) AddToGuildTracker(ctx context.Context, in *GuildTrackerRequest, opts ...grpc.CallOption) (*GuildTrackerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuildTrackerResponse)
+	err := c.cc.Invoke(ctx, TibiaData_AddToGuildTracker_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) RemoveFromGuildTracker(ctx context.Context, in *GuildRequest, opts ...grpc.CallOption) (*GuildTrackerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuildTrackerResponse)
+	err := c.cc.Invoke(ctx, TibiaData_RemoveFromGuildTracker_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetGuildHistory(ctx context.Context, in *GuildHistoryRequest, opts ...grpc.CallOption) (*GuildHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuildHistoryResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetGuildHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetHighscores(ctx context.Context, in *HighscoresRequest, opts ...grpc.CallOption) (*HighscoresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HighscoresResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetHighscores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) StreamHighscores(ctx context.Context, in *HighscoresRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[HighscoresResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TibiaData_ServiceDesc.Streams[0], TibiaData_StreamHighscores_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[HighscoresRequest, HighscoresResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TibiaData_StreamHighscoresClient = grpc.ServerStreamingClient[HighscoresResponse]
+
+func (c *tibiaDataClient) GetAllHighscores(ctx context.Context, in *HighscoresRequest, opts ...grpc.CallOption) (*HighscoresAllResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HighscoresAllResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetAllHighscores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetHighscoresDeltas(ctx context.Context, in *HighscoresSnapshotsRequest, opts ...grpc.CallOption) (*HighscoresDeltasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HighscoresDeltasResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetHighscoresDeltas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetHighscoresRankChanges(ctx context.Context, in *HighscoresSnapshotsRequest, opts ...grpc.CallOption) (*HighscoresRankChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HighscoresRankChangesResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetHighscoresRankChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetHouse(ctx context.Context, in *HouseRequest, opts ...grpc.CallOption) (*HouseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HouseResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetHouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetHousesWorld(ctx context.Context, in *HousesWorldRequest, opts ...grpc.CallOption) (*HousesWorldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HousesWorldResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetHousesWorld_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetHouses(ctx context.Context, in *HousesRequest, opts ...grpc.CallOption) (*HousesOverviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HousesOverviewResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetHouses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetHousesSearch(ctx context.Context, in *HousesSearchRequest, opts ...grpc.CallOption) (*HousesSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HousesSearchResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetHousesSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetKillStatistics(ctx context.Context, in *KillStatisticsRequest, opts ...grpc.CallOption) (*KillStatisticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KillStatisticsResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetKillStatistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetNews(ctx context.Context, in *NewsRequest, opts ...grpc.CallOption) (*NewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NewsResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetNews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetNewsList(ctx context.Context, in *NewsListRequest, opts ...grpc.CallOption) (*NewsListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NewsListResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetNewsList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetSpell(ctx context.Context, in *SpellRequest, opts ...grpc.CallOption) (*SpellInformationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpellInformationResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetSpell_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetSpells(ctx context.Context, in *SpellsRequest, opts ...grpc.CallOption) (*SpellsOverviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpellsOverviewResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetSpells_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetWarTracker(ctx context.Context, in *WarTrackerListRequest, opts ...grpc.CallOption) (*WarTrackerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarTrackerResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetWarTracker_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) AddToWarTracker(ctx context.Context, in *WarTrackerRequest, opts ...grpc.CallOption) (*WarTrackerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarTrackerResponse)
+	err := c.cc.Invoke(ctx, TibiaData_AddToWarTracker_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) RemoveFromWarTracker(ctx context.Context, in *WarTrackerIDRequest, opts ...grpc.CallOption) (*WarTrackerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarTrackerResponse)
+	err := c.cc.Invoke(ctx, TibiaData_RemoveFromWarTracker_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetWarKills(ctx context.Context, in *WarKillsRequest, opts ...grpc.CallOption) (*WarKillsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarKillsResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetWarKills_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetWarScoreboard(ctx context.Context, in *WarTrackerIDRequest, opts ...grpc.CallOption) (*WarScoreboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarScoreboardResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetWarScoreboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetWatchlist(ctx context.Context, in *WatchlistRequest, opts ...grpc.CallOption) (*WatchlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatchlistResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetWatchlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) AddToWatchlist(ctx context.Context, in *CharactersRequest, opts ...grpc.CallOption) (*WatchlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatchlistResponse)
+	err := c.cc.Invoke(ctx, TibiaData_AddToWatchlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) RemoveFromWatchlist(ctx context.Context, in *CharacterRequest, opts ...grpc.CallOption) (*WatchlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatchlistResponse)
+	err := c.cc.Invoke(ctx, TibiaData_RemoveFromWatchlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetWatchlistTimeline(ctx context.Context, in *WatchlistTimelineRequest, opts ...grpc.CallOption) (*WatchlistTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatchlistTimelineResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetWatchlistTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetWatchlistDeaths(ctx context.Context, in *CharacterRequest, opts ...grpc.CallOption) (*WatchlistDeathsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatchlistDeathsResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetWatchlistDeaths_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetWebhooks(ctx context.Context, in *WebhooksRequest, opts ...grpc.CallOption) (*WebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhooksResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) AddWebhook(ctx context.Context, in *WebhookRequest, opts ...grpc.CallOption) (*WebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookResponse)
+	err := c.cc.Invoke(ctx, TibiaData_AddWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) RemoveWebhook(ctx context.Context, in *WebhookIDRequest, opts ...grpc.CallOption) (*WebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhooksResponse)
+	err := c.cc.Invoke(ctx, TibiaData_RemoveWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetWebhookDeliveries(ctx context.Context, in *WebhookDeliveriesRequest, opts ...grpc.CallOption) (*WebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetWorld(ctx context.Context, in *WorldRequest, opts ...grpc.CallOption) (*WorldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorldResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetWorld_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetWorldOnlineDiff(ctx context.Context, in *WorldOnlineDiffRequest, opts ...grpc.CallOption) (*WorldOnlineDiffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorldOnlineDiffResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetWorldOnlineDiff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tibiaDataClient) GetWorlds(ctx context.Context, in *WorldsRequest, opts ...grpc.CallOption) (*WorldsOverviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorldsOverviewResponse)
+	err := c.cc.Invoke(ctx, TibiaData_GetWorlds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TibiaDataServer is the server API for TibiaData service.
+// All implementations must embed UnimplementedTibiaDataServer
+// for forward compatibility.
+//
+// TibiaData mirrors the /v4 endpoints of the REST API
+//
+// Errors are returned with a status code matching the validation error and carry
+// an ErrorInfo (reason ERROR_<code>) and the Information of the error as details.
+type TibiaDataServer interface {
+	// GET /v4/boostablebosses
+	GetBoostableBosses(context.Context, *BoostableBossesRequest) (*BoostableBossesOverviewResponse, error)
+	// GET /v4/character/:name
+	GetCharacter(context.Context, *CharacterRequest) (*CharacterResponse, error)
+	// GET /v4/character/:name/guildhistory
+	GetCharacterGuildHistory(context.Context, *CharacterRequest) (*CharacterGuildHistoryResponse, error)
+	// GET /v4/character/:name/ranks
+	GetCharacterRanks(context.Context, *CharacterRequest) (*CharacterRanksResponse, error)
+	// POST /v4/characters
+	GetCharacters(context.Context, *CharactersRequest) (*CharactersResponse, error)
+	// GET /v4/creature/:race
+	GetCreature(context.Context, *CreatureRequest) (*CreatureResponse, error)
+	// GET /v4/creatures
+	GetCreatures(context.Context, *CreaturesRequest) (*CreaturesOverviewResponse, error)
+	// GET /v4/experience
+	GetExperience(context.Context, *ExperienceRequest) (*ExperienceResponse, error)
+	// GET /v4/fansites
+	GetFansites(context.Context, *FansitesRequest) (*FansitesResponse, error)
+	// GET /v4/guild/:name
+	GetGuild(context.Context, *GuildRequest) (*GuildResponse, error)
+	// GET /v4/guild/:name/events
+	GetGuildEvents(context.Context, *GuildRequest) (*GuildEventsResponse, error)
+	// GET /v4/guild/:name/expanded
+	GetGuildExpanded(context.Context, *GuildExpandedRequest) (*GuildExpandedResponse, error)
+	// GET /v4/guild/:name/statistics
+	GetGuildStatistics(context.Context, *GuildRequest) (*GuildStatisticsResponse, error)
+	// GET /v4/guild/:name/wars
+	GetGuildWars(context.Context, *GuildRequest) (*GuildWarsResponse, error)
+	// GET /v4/guilds/:world
+	GetGuilds(context.Context, *GuildsRequest) (*GuildsOverviewResponse, error)
+	// GET /v4/guilds/:world/leaderboard
+	GetGuildsLeaderboard(context.Context, *GuildsLeaderboardRequest) (*GuildsLeaderboardResponse, error)
+	// GET /v4/guilds/:world/statistics
+	GetGuildsStatistics(context.Context, *GuildsStatisticsRequest) (*GuildsStatisticsResponse, error)
+	// GET /v4/guildtracker
+	GetGuildTracker(context.Context, *GuildTrackerListRequest) (*GuildTrackerResponse, error)
+	// POST /v4/guildtracker
+	AddToGuildTracker(context.Context, *GuildTrackerRequest) (*GuildTrackerResponse, error)
+	// DELETE /v4/guildtracker/:name
+	RemoveFromGuildTracker(context.Context, *GuildRequest) (*GuildTrackerResponse, error)
+	// GET /v4/guildtracker/:name/history
+	GetGuildHistory(context.Context, *GuildHistoryRequest) (*GuildHistoryResponse, error)
+	// GET /v4/highscores/:world/:category/:vocation/:page
+	GetHighscores(context.Context, *HighscoresRequest) (*HighscoresResponse, error)
+	// Streams the highscore pages from the requested page until the last page
+	StreamHighscores(*HighscoresRequest, grpc.ServerStreamingServer[HighscoresResponse]) error
+	// GET /v4/highscores/:world/:category/:vocation/all (the page is ignored)
+	GetAllHighscores(context.Context, *HighscoresRequest) (*HighscoresAllResponse, error)
+	// GET /v4/highscores/:world/:category/:vocation/deltas
+	GetHighscoresDeltas(context.Context, *HighscoresSnapshotsRequest) (*HighscoresDeltasResponse, error)
+	// GET /v4/highscores/:world/:category/:vocation/rankchanges
+	GetHighscoresRankChanges(context.Context, *HighscoresSnapshotsRequest) (*HighscoresRankChangesResponse, error)
+	// GET /v4/house/:world/:house_id
+	GetHouse(context.Context, *HouseRequest) (*HouseResponse, error)
+	// GET /v4/houses/:world
+	GetHousesWorld(context.Context, *HousesWorldRequest) (*HousesWorldResponse, error)
+	// GET /v4/houses/:world/:town
+	GetHouses(context.Context, *HousesRequest) (*HousesOverviewResponse, error)
+	// GET /v4/houses/:world/search
+	GetHousesSearch(context.Context, *HousesSearchRequest) (*HousesSearchResponse, error)
+	// GET /v4/killstatistics/:world
+	GetKillStatistics(context.Context, *KillStatisticsRequest) (*KillStatisticsResponse, error)
+	// GET /v4/news/id/:news_id
+	GetNews(context.Context, *NewsRequest) (*NewsResponse, error)
+	// GET /v4/news/archive, /v4/news/archive/:days, /v4/news/latest and /v4/news/newsticker
+	GetNewsList(context.Context, *NewsListRequest) (*NewsListResponse, error)
+	// GET /v4/spell/:spell_id
+	GetSpell(context.Context, *SpellRequest) (*SpellInformationResponse, error)
+	// GET /v4/spells
+	GetSpells(context.Context, *SpellsRequest) (*SpellsOverviewResponse, error)
+	// GET /v4/wartracker
+	GetWarTracker(context.Context, *WarTrackerListRequest) (*WarTrackerResponse, error)
+	// POST /v4/wartracker
+	AddToWarTracker(context.Context, *WarTrackerRequest) (*WarTrackerResponse, error)
+	// DELETE /v4/wartracker/:id
+	RemoveFromWarTracker(context.Context, *WarTrackerIDRequest) (*WarTrackerResponse, error)
+	// GET /v4/wartracker/:id/kills
+	GetWarKills(context.Context, *WarKillsRequest) (*WarKillsResponse, error)
+	// GET /v4/wartracker/:id/scoreboard
+	GetWarScoreboard(context.Context, *WarTrackerIDRequest) (*WarScoreboardResponse, error)
+	// GET /v4/watchlist
+	GetWatchlist(context.Context, *WatchlistRequest) (*WatchlistResponse, error)
+	// POST /v4/watchlist
+	AddToWatchlist(context.Context, *CharactersRequest) (*WatchlistResponse, error)
+	// DELETE /v4/watchlist/:name
+	RemoveFromWatchlist(context.Context, *CharacterRequest) (*WatchlistResponse, error)
+	// GET /v4/watchlist/:name/timeline
+	GetWatchlistTimeline(context.Context, *WatchlistTimelineRequest) (*WatchlistTimelineResponse, error)
+	// GET /v4/watchlist/:name/deaths
+	GetWatchlistDeaths(context.Context, *CharacterRequest) (*WatchlistDeathsResponse, error)
+	// GET /v4/webhooks
+	GetWebhooks(context.Context, *WebhooksRequest) (*WebhooksResponse, error)
+	// POST /v4/webhooks
+	AddWebhook(context.Context, *WebhookRequest) (*WebhookResponse, error)
+	// DELETE /v4/webhooks/:id
+	RemoveWebhook(context.Context, *WebhookIDRequest) (*WebhooksResponse, error)
+	// GET /v4/webhooks/:id/deliveries
+	GetWebhookDeliveries(context.Context, *WebhookDeliveriesRequest) (*WebhookDeliveriesResponse, error)
+	// GET /v4/world/:name
+	GetWorld(context.Context, *WorldRequest) (*WorldResponse, error)
+	// GET /v4/world/:name/online/diff
+	GetWorldOnlineDiff(context.Context, *WorldOnlineDiffRequest) (*WorldOnlineDiffResponse, error)
+	// GET /v4/worlds
+	GetWorlds(context.Context, *WorldsRequest) (*WorldsOverviewResponse, error)
+	mustEmbedUnimplementedTibiaDataServer()
+}
+
+// UnimplementedTibiaDataServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTibiaDataServer struct{}
+
+func (UnimplementedTibiaDataServer) GetBoostableBosses(context.Context, *BoostableBossesRequest) (*BoostableBossesOverviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoostableBosses not implemented")
+}
+func (UnimplementedTibiaDataServer) GetCharacter(context.Context, *CharacterRequest) (*CharacterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCharacter not implemented")
+}
+func (UnimplementedTibiaDataServer) GetCharacterGuildHistory(context.Context, *CharacterRequest) (*CharacterGuildHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCharacterGuildHistory not implemented")
+}
+func (UnimplementedTibiaDataServer) GetCharacterRanks(context.Context, *CharacterRequest) (*CharacterRanksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCharacterRanks not implemented")
+}
+func (UnimplementedTibiaDataServer) GetCharacters(context.Context, *CharactersRequest) (*CharactersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCharacters not implemented")
+}
+func (UnimplementedTibiaDataServer) GetCreature(context.Context, *CreatureRequest) (*CreatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreature not implemented")
+}
+func (UnimplementedTibiaDataServer) GetCreatures(context.Context, *CreaturesRequest) (*CreaturesOverviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreatures not implemented")
+}
+func (UnimplementedTibiaDataServer) GetExperience(context.Context, *ExperienceRequest) (*ExperienceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExperience not implemented")
+}
+func (UnimplementedTibiaDataServer) GetFansites(context.Context, *FansitesRequest) (*FansitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFansites not implemented")
+}
+func (UnimplementedTibiaDataServer) GetGuild(context.Context, *GuildRequest) (*GuildResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuild not implemented")
+}
+func (UnimplementedTibiaDataServer) GetGuildEvents(context.Context, *GuildRequest) (*GuildEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuildEvents not implemented")
+}
+func (UnimplementedTibiaDataServer) GetGuildExpanded(context.Context, *GuildExpandedRequest) (*GuildExpandedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuildExpanded not implemented")
+}
+func (UnimplementedTibiaDataServer) GetGuildStatistics(context.Context, *GuildRequest) (*GuildStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuildStatistics not implemented")
+}
+func (UnimplementedTibiaDataServer) GetGuildWars(context.Context, *GuildRequest) (*GuildWarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuildWars not implemented")
+}
+func (UnimplementedTibiaDataServer) GetGuilds(context.Context, *GuildsRequest) (*GuildsOverviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuilds not implemented")
+}
+func (UnimplementedTibiaDataServer) GetGuildsLeaderboard(context.Context, *GuildsLeaderboardRequest) (*GuildsLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuildsLeaderboard not implemented")
+}
+func (UnimplementedTibiaDataServer) GetGuildsStatistics(context.Context, *GuildsStatisticsRequest) (*GuildsStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuildsStatistics not implemented")
+}
+func (UnimplementedTibiaDataServer) GetGuildTracker(context.Context, *GuildTrackerListRequest) (*GuildTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuildTracker not implemented")
+}
+func (UnimplementedTibiaDataServer) AddToGuildTracker(context.Context, *GuildTrackerRequest) (*GuildTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToGuildTracker not implemented")
+}
+func (UnimplementedTibiaDataServer) RemoveFromGuildTracker(context.Context, *GuildRequest) (*GuildTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromGuildTracker not implemented")
+}
+func (UnimplementedTibiaDataServer) GetGuildHistory(context.Context, *GuildHistoryRequest) (*GuildHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuildHistory not implemented")
+}
+func (UnimplementedTibiaDataServer) GetHighscores(context.Context, *HighscoresRequest) (*HighscoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHighscores not implemented")
+}
+func (UnimplementedTibiaDataServer) StreamHighscores(*HighscoresRequest, grpc.ServerStreamingServer[HighscoresResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamHighscores not implemented")
+}
+func (UnimplementedTibiaDataServer) GetAllHighscores(context.Context, *HighscoresRequest) (*HighscoresAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllHighscores not implemented")
+}
+func (UnimplementedTibiaDataServer) GetHighscoresDeltas(context.Context, *HighscoresSnapshotsRequest) (*HighscoresDeltasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHighscoresDeltas not implemented")
+}
+func (UnimplementedTibiaDataServer) GetHighscoresRankChanges(context.Context, *HighscoresSnapshotsRequest) (*HighscoresRankChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHighscoresRankChanges not implemented")
+}
+func (UnimplementedTibiaDataServer) GetHouse(context.Context, *HouseRequest) (*HouseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHouse not implemented")
+}
+func (UnimplementedTibiaDataServer) GetHousesWorld(context.Context, *HousesWorldRequest) (*HousesWorldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHousesWorld not implemented")
+}
+func (UnimplementedTibiaDataServer) GetHouses(context.Context, *HousesRequest) (*HousesOverviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHouses not implemented")
+}
+func (UnimplementedTibiaDataServer) GetHousesSearch(context.Context, *HousesSearchRequest) (*HousesSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHousesSearch not implemented")
+}
+func (UnimplementedTibiaDataServer) GetKillStatistics(context.Context, *KillStatisticsRequest) (*KillStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKillStatistics not implemented")
+}
+func (UnimplementedTibiaDataServer) GetNews(context.Context, *NewsRequest) (*NewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNews not implemented")
+}
+func (UnimplementedTibiaDataServer) GetNewsList(context.Context, *NewsListRequest) (*NewsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNewsList not implemented")
+}
+func (UnimplementedTibiaDataServer) GetSpell(context.Context, *SpellRequest) (*SpellInformationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpell not implemented")
+}
+func (UnimplementedTibiaDataServer) GetSpells(context.Context, *SpellsRequest) (*SpellsOverviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpells not implemented")
+}
+func (UnimplementedTibiaDataServer) GetWarTracker(context.Context, *WarTrackerListRequest) (*WarTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWarTracker not implemented")
+}
+func (UnimplementedTibiaDataServer) AddToWarTracker(context.Context, *WarTrackerRequest) (*WarTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToWarTracker not implemented")
+}
+func (UnimplementedTibiaDataServer) RemoveFromWarTracker(context.Context, *WarTrackerIDRequest) (*WarTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWarTracker not implemented")
+}
+func (UnimplementedTibiaDataServer) GetWarKills(context.Context, *WarKillsRequest) (*WarKillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWarKills not implemented")
+}
+func (UnimplementedTibiaDataServer) GetWarScoreboard(context.Context, *WarTrackerIDRequest) (*WarScoreboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWarScoreboard not implemented")
+}
+func (UnimplementedTibiaDataServer) GetWatchlist(context.Context, *WatchlistRequest) (*WatchlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWatchlist not implemented")
+}
+func (UnimplementedTibiaDataServer) AddToWatchlist(context.Context, *CharactersRequest) (*WatchlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToWatchlist not implemented")
+}
+func (UnimplementedTibiaDataServer) RemoveFromWatchlist(context.Context, *CharacterRequest) (*WatchlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWatchlist not implemented")
+}
+func (UnimplementedTibiaDataServer) GetWatchlistTimeline(context.Context, *WatchlistTimelineRequest) (*WatchlistTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWatchlistTimeline not implemented")
+}
+func (UnimplementedTibiaDataServer) GetWatchlistDeaths(context.Context, *CharacterRequest) (*WatchlistDeathsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWatchlistDeaths not implemented")
+}
+func (UnimplementedTibiaDataServer) GetWebhooks(context.Context, *WebhooksRequest) (*WebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhooks not implemented")
+}
+func (UnimplementedTibiaDataServer) AddWebhook(context.Context, *WebhookRequest) (*WebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWebhook not implemented")
+}
+func (UnimplementedTibiaDataServer) RemoveWebhook(context.Context, *WebhookIDRequest) (*WebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWebhook not implemented")
+}
+func (UnimplementedTibiaDataServer) GetWebhookDeliveries(context.Context, *WebhookDeliveriesRequest) (*WebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDeliveries not implemented")
+}
+func (UnimplementedTibiaDataServer) GetWorld(context.Context, *WorldRequest) (*WorldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorld not implemented")
+}
+func (UnimplementedTibiaDataServer) GetWorldOnlineDiff(context.Context, *WorldOnlineDiffRequest) (*WorldOnlineDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorldOnlineDiff not implemented")
+}
+func (UnimplementedTibiaDataServer) GetWorlds(context.Context, *WorldsRequest) (*WorldsOverviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorlds not implemented")
+}
+func (UnimplementedTibiaDataServer) mustEmbedUnimplementedTibiaDataServer() {}
+func (UnimplementedTibiaDataServer) testEmbeddedByValue()                   {}
+
+// UnsafeTibiaDataServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TibiaDataServer will
+// result in compilation errors.
+type UnsafeTibiaDataServer interface {
+	mustEmbedUnimplementedTibiaDataServer()
+}
+
+func RegisterTibiaDataServer(s grpc.ServiceRegistrar, srv TibiaDataServer) {
+	// If the following call pancis, it indicates UnimplementedTibiaDataServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TibiaData_ServiceDesc, srv)
+}
+
+func _TibiaData_GetBoostableBosses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoostableBossesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetBoostableBosses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetBoostableBosses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetBoostableBosses(ctx, req.(*BoostableBossesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetCharacter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetCharacter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetCharacter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetCharacter(ctx, req.(*CharacterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetCharacterGuildHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetCharacterGuildHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetCharacterGuildHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetCharacterGuildHistory(ctx, req.(*CharacterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetCharacterRanks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetCharacterRanks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetCharacterRanks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetCharacterRanks(ctx, req.(*CharacterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetCharacters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharactersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetCharacters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetCharacters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetCharacters(ctx, req.(*CharactersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetCreature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetCreature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetCreature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetCreature(ctx, req.(*CreatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetCreatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetCreatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetCreatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetCreatures(ctx, req.(*CreaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetExperience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExperienceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetExperience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetExperience_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetExperience(ctx, req.(*ExperienceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetFansites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FansitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetFansites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetFansites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetFansites(ctx, req.(*FansitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetGuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetGuild_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetGuild(ctx, req.(*GuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetGuildEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetGuildEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetGuildEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetGuildEvents(ctx, req.(*GuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetGuildExpanded_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuildExpandedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetGuildExpanded(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetGuildExpanded_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetGuildExpanded(ctx, req.(*GuildExpandedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetGuildStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetGuildStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetGuildStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetGuildStatistics(ctx, req.(*GuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetGuildWars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetGuildWars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetGuildWars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetGuildWars(ctx, req.(*GuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetGuilds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuildsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetGuilds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetGuilds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetGuilds(ctx, req.(*GuildsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetGuildsLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuildsLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetGuildsLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetGuildsLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetGuildsLeaderboard(ctx, req.(*GuildsLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetGuildsStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuildsStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetGuildsStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetGuildsStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetGuildsStatistics(ctx, req.(*GuildsStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetGuildTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuildTrackerListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetGuildTracker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetGuildTracker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetGuildTracker(ctx, req.(*GuildTrackerListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_AddToGuildTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuildTrackerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).AddToGuildTracker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_AddToGuildTracker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).AddToGuildTracker(ctx, req.(*GuildTrackerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_RemoveFromGuildTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).RemoveFromGuildTracker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_RemoveFromGuildTracker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).RemoveFromGuildTracker(ctx, req.(*GuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetGuildHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuildHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetGuildHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetGuildHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetGuildHistory(ctx, req.(*GuildHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetHighscores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HighscoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetHighscores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetHighscores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetHighscores(ctx, req.(*HighscoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_StreamHighscores_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(HighscoresRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TibiaDataServer).StreamHighscores(m, &grpc.GenericServerStream[HighscoresRequest, HighscoresResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TibiaData_StreamHighscoresServer = grpc.ServerStreamingServer[HighscoresResponse]
+
+func _TibiaData_GetAllHighscores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HighscoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetAllHighscores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetAllHighscores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetAllHighscores(ctx, req.(*HighscoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetHighscoresDeltas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HighscoresSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetHighscoresDeltas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetHighscoresDeltas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetHighscoresDeltas(ctx, req.(*HighscoresSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetHighscoresRankChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HighscoresSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetHighscoresRankChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetHighscoresRankChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetHighscoresRankChanges(ctx, req.(*HighscoresSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetHouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetHouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetHouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetHouse(ctx, req.(*HouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetHousesWorld_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HousesWorldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetHousesWorld(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetHousesWorld_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetHousesWorld(ctx, req.(*HousesWorldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetHouses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HousesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetHouses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetHouses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetHouses(ctx, req.(*HousesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetHousesSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HousesSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetHousesSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetHousesSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetHousesSearch(ctx, req.(*HousesSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetKillStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetKillStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetKillStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetKillStatistics(ctx, req.(*KillStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetNews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetNews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetNews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetNews(ctx, req.(*NewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetNewsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewsListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetNewsList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetNewsList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetNewsList(ctx, req.(*NewsListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetSpell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetSpell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetSpell_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetSpell(ctx, req.(*SpellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetSpells_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpellsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetSpells(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetSpells_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetSpells(ctx, req.(*SpellsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetWarTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarTrackerListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetWarTracker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetWarTracker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetWarTracker(ctx, req.(*WarTrackerListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_AddToWarTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarTrackerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).AddToWarTracker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_AddToWarTracker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).AddToWarTracker(ctx, req.(*WarTrackerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_RemoveFromWarTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarTrackerIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).RemoveFromWarTracker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_RemoveFromWarTracker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).RemoveFromWarTracker(ctx, req.(*WarTrackerIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetWarKills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarKillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetWarKills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetWarKills_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetWarKills(ctx, req.(*WarKillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetWarScoreboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarTrackerIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetWarScoreboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetWarScoreboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetWarScoreboard(ctx, req.(*WarTrackerIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetWatchlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetWatchlist(ctx, req.(*WatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_AddToWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharactersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).AddToWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_AddToWatchlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).AddToWatchlist(ctx, req.(*CharactersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_RemoveFromWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).RemoveFromWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_RemoveFromWatchlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).RemoveFromWatchlist(ctx, req.(*CharacterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetWatchlistTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchlistTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetWatchlistTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetWatchlistTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetWatchlistTimeline(ctx, req.(*WatchlistTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetWatchlistDeaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetWatchlistDeaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetWatchlistDeaths_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetWatchlistDeaths(ctx, req.(*CharacterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetWebhooks(ctx, req.(*WebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_AddWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).AddWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_AddWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).AddWebhook(ctx, req.(*WebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_RemoveWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).RemoveWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_RemoveWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).RemoveWebhook(ctx, req.(*WebhookIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetWebhookDeliveries(ctx, req.(*WebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetWorld_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetWorld(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetWorld_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetWorld(ctx, req.(*WorldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetWorldOnlineDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorldOnlineDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetWorldOnlineDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetWorldOnlineDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetWorldOnlineDiff(ctx, req.(*WorldOnlineDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TibiaData_GetWorlds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TibiaDataServer).GetWorlds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TibiaData_GetWorlds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TibiaDataServer).GetWorlds(ctx, req.(*WorldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TibiaData_ServiceDesc is the grpc.ServiceDesc for TibiaData service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TibiaData_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tibiadata.v4.TibiaData",
+	HandlerType: (*TibiaDataServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBoostableBosses",
+			Handler:    _TibiaData_GetBoostableBosses_Handler,
+		},
+		{
+			MethodName: "GetCharacter",
+			Handler:    _TibiaData_GetCharacter_Handler,
+		},
+		{
+			MethodName: "GetCharacterGuildHistory",
+			Handler:    _TibiaData_GetCharacterGuildHistory_Handler,
+		},
+		{
+			MethodName: "GetCharacterRanks",
+			Handler:    _TibiaData_GetCharacterRanks_Handler,
+		},
+		{
+			MethodName: "GetCharacters",
+			Handler:    _TibiaData_GetCharacters_Handler,
+		},
+		{
+			MethodName: "GetCreature",
+			Handler:    _TibiaData_GetCreature_Handler,
+		},
+		{
+			MethodName: "GetCreatures",
+			Handler:    _TibiaData_GetCreatures_Handler,
+		},
+		{
+			MethodName: "GetExperience",
+			Handler:    _TibiaData_GetExperience_Handler,
+		},
+		{
+			MethodName: "GetFansites",
+			Handler:    _TibiaData_GetFansites_Handler,
+		},
+		{
+			MethodName: "GetGuild",
+			Handler:    _TibiaData_GetGuild_Handler,
+		},
+		{
+			MethodName: "GetGuildEvents",
+			Handler:    _TibiaData_GetGuildEvents_Handler,
+		},
+		{
+			MethodName: "GetGuildExpanded",
+			Handler:    _TibiaData_GetGuildExpanded_Handler,
+		},
+		{
+			MethodName: "GetGuildStatistics",
+			Handler:    _TibiaData_GetGuildStatistics_Handler,
+		},
+		{
+			MethodName: "GetGuildWars",
+			Handler:    _TibiaData_GetGuildWars_Handler,
+		},
+		{
+			MethodName: "GetGuilds",
+			Handler:    _TibiaData_GetGuilds_Handler,
+		},
+		{
+			MethodName: "GetGuildsLeaderboard",
+			Handler:    _TibiaData_GetGuildsLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetGuildsStatistics",
+			Handler:    _TibiaData_GetGuildsStatistics_Handler,
+		},
+		{
+			MethodName: "GetGuildTracker",
+			Handler:    _TibiaData_GetGuildTracker_Handler,
+		},
+		{
+			MethodName: "AddToGuildTracker",
+			Handler:    _TibiaData_AddToGuildTracker_Handler,
+		},
+		{
+			MethodName: "RemoveFromGuildTracker",
+			Handler:    _TibiaData_RemoveFromGuildTracker_Handler,
+		},
+		{
+			MethodName: "GetGuildHistory",
+			Handler:    _TibiaData_GetGuildHistory_Handler,
+		},
+		{
+			MethodName: "GetHighscores",
+			Handler:    _TibiaData_GetHighscores_Handler,
+		},
+		{
+			MethodName: "GetAllHighscores",
+			Handler:    _TibiaData_GetAllHighscores_Handler,
+		},
+		{
+			MethodName: "GetHighscoresDeltas",
+			Handler:    _TibiaData_GetHighscoresDeltas_Handler,
+		},
+		{
+			MethodName: "GetHighscoresRankChanges",
+			Handler:    _TibiaData_GetHighscoresRankChanges_Handler,
+		},
+		{
+			MethodName: "GetHouse",
+			Handler:    _TibiaData_GetHouse_Handler,
+		},
+		{
+			MethodName: "GetHousesWorld",
+			Handler:    _TibiaData_GetHousesWorld_Handler,
+		},
+		{
+			MethodName: "GetHouses",
+			Handler:    _TibiaData_GetHouses_Handler,
+		},
+		{
+			MethodName: "GetHousesSearch",
+			Handler:    _TibiaData_GetHousesSearch_Handler,
+		},
+		{
+			MethodName: "GetKillStatistics",
+			Handler:    _TibiaData_GetKillStatistics_Handler,
+		},
+		{
+			MethodName: "GetNews",
+			Handler:    _TibiaData_GetNews_Handler,
+		},
+		{
+			MethodName: "GetNewsList",
+			Handler:    _TibiaData_GetNewsList_Handler,
+		},
+		{
+			MethodName: "GetSpell",
+			Handler:    _TibiaData_GetSpell_Handler,
+		},
+		{
+			MethodName: "GetSpells",
+			Handler:    _TibiaData_GetSpells_Handler,
+		},
+		{
+			MethodName: "GetWarTracker",
+			Handler:    _TibiaData_GetWarTracker_Handler,
+		},
+		{
+			MethodName: "AddToWarTracker",
+			Handler:    _TibiaData_AddToWarTracker_Handler,
+		},
+		{
+			MethodName: "RemoveFromWarTracker",
+			Handler:    _TibiaData_RemoveFromWarTracker_Handler,
+		},
+		{
+			MethodName: "GetWarKills",
+			Handler:    _TibiaData_GetWarKills_Handler,
+		},
+		{
+			MethodName: "GetWarScoreboard",
+			Handler:    _TibiaData_GetWarScoreboard_Handler,
+		},
+		{
+			MethodName: "GetWatchlist",
+			Handler:    _TibiaData_GetWatchlist_Handler,
+		},
+		{
+			MethodName: "AddToWatchlist",
+			Handler:    _TibiaData_AddToWatchlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromWatchlist",
+			Handler:    _TibiaData_RemoveFromWatchlist_Handler,
+		},
+		{
+			MethodName: "GetWatchlistTimeline",
+			Handler:    _TibiaData_GetWatchlistTimeline_Handler,
+		},
+		{
+			MethodName: "GetWatchlistDeaths",
+			Handler:    _TibiaData_GetWatchlistDeaths_Handler,
+		},
+		{
+			MethodName: "GetWebhooks",
+			Handler:    _TibiaData_GetWebhooks_Handler,
+		},
+		{
+			MethodName: "AddWebhook",
+			Handler:    _TibiaData_AddWebhook_Handler,
+		},
+		{
+			MethodName: "RemoveWebhook",
+			Handler:    _TibiaData_RemoveWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhookDeliveries",
+			Handler:    _TibiaData_GetWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "GetWorld",
+			Handler:    _TibiaData_GetWorld_Handler,
+		},
+		{
+			MethodName: "GetWorldOnlineDiff",
+			Handler:    _TibiaData_GetWorldOnlineDiff_Handler,
+		},
+		{
+			MethodName: "GetWorlds",
+			Handler:    _TibiaData_GetWorlds_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamHighscores",
+			Handler:       _TibiaData_StreamHighscores_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tibiadata.proto",
+}
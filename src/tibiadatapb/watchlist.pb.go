@@ -0,0 +1,667 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: watchlist.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: Watchlist and Information
+type WatchlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Watchlist     []*WatchlistCharacter  `protobuf:"bytes,1,rep,name=watchlist,proto3" json:"watchlist,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchlistResponse) Reset() {
+	*x = WatchlistResponse{}
+	mi := &file_watchlist_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistResponse) ProtoMessage() {}
+
+func (x *WatchlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_watchlist_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistResponse.ProtoReflect.Descriptor instead.
+func (*WatchlistResponse) Descriptor() ([]byte, []int) {
+	return file_watchlist_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchlistResponse) GetWatchlist() []*WatchlistCharacter {
+	if x != nil {
+		return x.Watchlist
+	}
+	return nil
+}
+
+func (x *WatchlistResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of WatchlistResponse
+type WatchlistCharacter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                               // The name the character is watched with.
+	Added         string                 `protobuf:"bytes,2,opt,name=added,proto3" json:"added,omitempty"`                             // The time the character was added to the watchlist.
+	LastPolled    string                 `protobuf:"bytes,3,opt,name=last_polled,json=lastPolled,proto3" json:"last_polled,omitempty"` // The time the character was last polled successfully.
+	Error         *Status                `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`                             // The error of the last poll (if it failed).
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchlistCharacter) Reset() {
+	*x = WatchlistCharacter{}
+	mi := &file_watchlist_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistCharacter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistCharacter) ProtoMessage() {}
+
+func (x *WatchlistCharacter) ProtoReflect() protoreflect.Message {
+	mi := &file_watchlist_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistCharacter.ProtoReflect.Descriptor instead.
+func (*WatchlistCharacter) Descriptor() ([]byte, []int) {
+	return file_watchlist_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WatchlistCharacter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WatchlistCharacter) GetAdded() string {
+	if x != nil {
+		return x.Added
+	}
+	return ""
+}
+
+func (x *WatchlistCharacter) GetLastPolled() string {
+	if x != nil {
+		return x.LastPolled
+	}
+	return ""
+}
+
+func (x *WatchlistCharacter) GetError() *Status {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// The base includes two levels: WatchlistTimeline and Information
+type WatchlistTimelineResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	WatchlistTimeline *WatchlistTimeline     `protobuf:"bytes,1,opt,name=watchlist_timeline,json=watchlistTimeline,proto3" json:"watchlist_timeline,omitempty"`
+	Information       *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WatchlistTimelineResponse) Reset() {
+	*x = WatchlistTimelineResponse{}
+	mi := &file_watchlist_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistTimelineResponse) ProtoMessage() {}
+
+func (x *WatchlistTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_watchlist_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistTimelineResponse.ProtoReflect.Descriptor instead.
+func (*WatchlistTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_watchlist_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WatchlistTimelineResponse) GetWatchlistTimeline() *WatchlistTimeline {
+	if x != nil {
+		return x.WatchlistTimeline
+	}
+	return nil
+}
+
+func (x *WatchlistTimelineResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type WatchlistTimeline struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                     // The name the character is watched with.
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`                                     // The start of the period.
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`                                         // The end of the period.
+	LastPolled    string                 `protobuf:"bytes,4,opt,name=last_polled,json=lastPolled,proto3" json:"last_polled,omitempty"`       // The time the character was last polled successfully.
+	Snapshots     []*WatchlistSnapshot   `protobuf:"bytes,5,rep,name=snapshots,proto3" json:"snapshots,omitempty"`                           // The snapshots of the period, starting with the state at its start.
+	LevelHistory  []*WatchlistLevel      `protobuf:"bytes,6,rep,name=level_history,json=levelHistory,proto3" json:"level_history,omitempty"` // The levels of the period.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchlistTimeline) Reset() {
+	*x = WatchlistTimeline{}
+	mi := &file_watchlist_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistTimeline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistTimeline) ProtoMessage() {}
+
+func (x *WatchlistTimeline) ProtoReflect() protoreflect.Message {
+	mi := &file_watchlist_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistTimeline.ProtoReflect.Descriptor instead.
+func (*WatchlistTimeline) Descriptor() ([]byte, []int) {
+	return file_watchlist_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WatchlistTimeline) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WatchlistTimeline) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *WatchlistTimeline) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *WatchlistTimeline) GetLastPolled() string {
+	if x != nil {
+		return x.LastPolled
+	}
+	return ""
+}
+
+func (x *WatchlistTimeline) GetSnapshots() []*WatchlistSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+func (x *WatchlistTimeline) GetLevelHistory() []*WatchlistLevel {
+	if x != nil {
+		return x.LevelHistory
+	}
+	return nil
+}
+
+// Child of WatchlistTimeline
+type WatchlistSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`                                        // The time of the snapshot.
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                        // The name of the character.
+	Level         int64                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`                                     // The character's level.
+	Vocation      string                 `protobuf:"bytes,4,opt,name=vocation,proto3" json:"vocation,omitempty"`                                // The character's vocation.
+	World         string                 `protobuf:"bytes,5,opt,name=world,proto3" json:"world,omitempty"`                                      // The character's world.
+	Guild         *CharacterGuild        `protobuf:"bytes,6,opt,name=guild,proto3" json:"guild,omitempty"`                                      // The guild that the character is member of.
+	Residence     string                 `protobuf:"bytes,7,opt,name=residence,proto3" json:"residence,omitempty"`                              // The character's residence.
+	FormerNames   []string               `protobuf:"bytes,8,rep,name=former_names,json=formerNames,proto3" json:"former_names,omitempty"`       // List of former names of the character.
+	AccountStatus string                 `protobuf:"bytes,9,opt,name=account_status,json=accountStatus,proto3" json:"account_status,omitempty"` // Whether account is Free or Premium.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchlistSnapshot) Reset() {
+	*x = WatchlistSnapshot{}
+	mi := &file_watchlist_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistSnapshot) ProtoMessage() {}
+
+func (x *WatchlistSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_watchlist_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistSnapshot.ProtoReflect.Descriptor instead.
+func (*WatchlistSnapshot) Descriptor() ([]byte, []int) {
+	return file_watchlist_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WatchlistSnapshot) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *WatchlistSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WatchlistSnapshot) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *WatchlistSnapshot) GetVocation() string {
+	if x != nil {
+		return x.Vocation
+	}
+	return ""
+}
+
+func (x *WatchlistSnapshot) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *WatchlistSnapshot) GetGuild() *CharacterGuild {
+	if x != nil {
+		return x.Guild
+	}
+	return nil
+}
+
+func (x *WatchlistSnapshot) GetResidence() string {
+	if x != nil {
+		return x.Residence
+	}
+	return ""
+}
+
+func (x *WatchlistSnapshot) GetFormerNames() []string {
+	if x != nil {
+		return x.FormerNames
+	}
+	return nil
+}
+
+func (x *WatchlistSnapshot) GetAccountStatus() string {
+	if x != nil {
+		return x.AccountStatus
+	}
+	return ""
+}
+
+// Child of WatchlistTimeline
+type WatchlistLevel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`    // The time the level was first seen.
+	Level         int64                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"` // The character's level.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchlistLevel) Reset() {
+	*x = WatchlistLevel{}
+	mi := &file_watchlist_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistLevel) ProtoMessage() {}
+
+func (x *WatchlistLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_watchlist_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistLevel.ProtoReflect.Descriptor instead.
+func (*WatchlistLevel) Descriptor() ([]byte, []int) {
+	return file_watchlist_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WatchlistLevel) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *WatchlistLevel) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+// The base includes two levels: WatchlistDeaths and Information
+type WatchlistDeathsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	WatchlistDeaths *WatchlistDeaths       `protobuf:"bytes,1,opt,name=watchlist_deaths,json=watchlistDeaths,proto3" json:"watchlist_deaths,omitempty"`
+	Information     *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchlistDeathsResponse) Reset() {
+	*x = WatchlistDeathsResponse{}
+	mi := &file_watchlist_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistDeathsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistDeathsResponse) ProtoMessage() {}
+
+func (x *WatchlistDeathsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_watchlist_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistDeathsResponse.ProtoReflect.Descriptor instead.
+func (*WatchlistDeathsResponse) Descriptor() ([]byte, []int) {
+	return file_watchlist_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WatchlistDeathsResponse) GetWatchlistDeaths() *WatchlistDeaths {
+	if x != nil {
+		return x.WatchlistDeaths
+	}
+	return nil
+}
+
+func (x *WatchlistDeathsResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type WatchlistDeaths struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`     // The name the character is watched with.
+	Deaths        []*Deaths              `protobuf:"bytes,2,rep,name=deaths,proto3" json:"deaths,omitempty"` // All deaths seen since the character was added, the newest first.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchlistDeaths) Reset() {
+	*x = WatchlistDeaths{}
+	mi := &file_watchlist_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistDeaths) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistDeaths) ProtoMessage() {}
+
+func (x *WatchlistDeaths) ProtoReflect() protoreflect.Message {
+	mi := &file_watchlist_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistDeaths.ProtoReflect.Descriptor instead.
+func (*WatchlistDeaths) Descriptor() ([]byte, []int) {
+	return file_watchlist_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WatchlistDeaths) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WatchlistDeaths) GetDeaths() []*Deaths {
+	if x != nil {
+		return x.Deaths
+	}
+	return nil
+}
+
+var File_watchlist_proto protoreflect.FileDescriptor
+
+const file_watchlist_proto_rawDesc = "" +
+	"\n" +
+	"\x0fwatchlist.proto\x12\ftibiadata.v4\x1a\x1acharacters_character.proto\x1a\x11information.proto\"\x90\x01\n" +
+	"\x11WatchlistResponse\x12>\n" +
+	"\twatchlist\x18\x01 \x03(\v2 .tibiadata.v4.WatchlistCharacterR\twatchlist\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\x8b\x01\n" +
+	"\x12WatchlistCharacter\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05added\x18\x02 \x01(\tR\x05added\x12\x1f\n" +
+	"\vlast_polled\x18\x03 \x01(\tR\n" +
+	"lastPolled\x12*\n" +
+	"\x05error\x18\x04 \x01(\v2\x14.tibiadata.v4.StatusR\x05error\"\xa8\x01\n" +
+	"\x19WatchlistTimelineResponse\x12N\n" +
+	"\x12watchlist_timeline\x18\x01 \x01(\v2\x1f.tibiadata.v4.WatchlistTimelineR\x11watchlistTimeline\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xee\x01\n" +
+	"\x11WatchlistTimeline\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12\x1f\n" +
+	"\vlast_polled\x18\x04 \x01(\tR\n" +
+	"lastPolled\x12=\n" +
+	"\tsnapshots\x18\x05 \x03(\v2\x1f.tibiadata.v4.WatchlistSnapshotR\tsnapshots\x12A\n" +
+	"\rlevel_history\x18\x06 \x03(\v2\x1c.tibiadata.v4.WatchlistLevelR\flevelHistory\"\x9f\x02\n" +
+	"\x11WatchlistSnapshot\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\tR\x04time\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\x03R\x05level\x12\x1a\n" +
+	"\bvocation\x18\x04 \x01(\tR\bvocation\x12\x14\n" +
+	"\x05world\x18\x05 \x01(\tR\x05world\x122\n" +
+	"\x05guild\x18\x06 \x01(\v2\x1c.tibiadata.v4.CharacterGuildR\x05guild\x12\x1c\n" +
+	"\tresidence\x18\a \x01(\tR\tresidence\x12!\n" +
+	"\fformer_names\x18\b \x03(\tR\vformerNames\x12%\n" +
+	"\x0eaccount_status\x18\t \x01(\tR\raccountStatus\":\n" +
+	"\x0eWatchlistLevel\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\tR\x04time\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x03R\x05level\"\xa0\x01\n" +
+	"\x17WatchlistDeathsResponse\x12H\n" +
+	"\x10watchlist_deaths\x18\x01 \x01(\v2\x1d.tibiadata.v4.WatchlistDeathsR\x0fwatchlistDeaths\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"S\n" +
+	"\x0fWatchlistDeaths\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12,\n" +
+	"\x06deaths\x18\x02 \x03(\v2\x14.tibiadata.v4.DeathsR\x06deathsB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_watchlist_proto_rawDescOnce sync.Once
+	file_watchlist_proto_rawDescData []byte
+)
+
+func file_watchlist_proto_rawDescGZIP() []byte {
+	file_watchlist_proto_rawDescOnce.Do(func() {
+		file_watchlist_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_watchlist_proto_rawDesc), len(file_watchlist_proto_rawDesc)))
+	})
+	return file_watchlist_proto_rawDescData
+}
+
+var file_watchlist_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_watchlist_proto_goTypes = []any{
+	(*WatchlistResponse)(nil),         // 0: tibiadata.v4.WatchlistResponse
+	(*WatchlistCharacter)(nil),        // 1: tibiadata.v4.WatchlistCharacter
+	(*WatchlistTimelineResponse)(nil), // 2: tibiadata.v4.WatchlistTimelineResponse
+	(*WatchlistTimeline)(nil),         // 3: tibiadata.v4.WatchlistTimeline
+	(*WatchlistSnapshot)(nil),         // 4: tibiadata.v4.WatchlistSnapshot
+	(*WatchlistLevel)(nil),            // 5: tibiadata.v4.WatchlistLevel
+	(*WatchlistDeathsResponse)(nil),   // 6: tibiadata.v4.WatchlistDeathsResponse
+	(*WatchlistDeaths)(nil),           // 7: tibiadata.v4.WatchlistDeaths
+	(*Information)(nil),               // 8: tibiadata.v4.Information
+	(*Status)(nil),                    // 9: tibiadata.v4.Status
+	(*CharacterGuild)(nil),            // 10: tibiadata.v4.CharacterGuild
+	(*Deaths)(nil),                    // 11: tibiadata.v4.Deaths
+}
+var file_watchlist_proto_depIdxs = []int32{
+	1,  // 0: tibiadata.v4.WatchlistResponse.watchlist:type_name -> tibiadata.v4.WatchlistCharacter
+	8,  // 1: tibiadata.v4.WatchlistResponse.information:type_name -> tibiadata.v4.Information
+	9,  // 2: tibiadata.v4.WatchlistCharacter.error:type_name -> tibiadata.v4.Status
+	3,  // 3: tibiadata.v4.WatchlistTimelineResponse.watchlist_timeline:type_name -> tibiadata.v4.WatchlistTimeline
+	8,  // 4: tibiadata.v4.WatchlistTimelineResponse.information:type_name -> tibiadata.v4.Information
+	4,  // 5: tibiadata.v4.WatchlistTimeline.snapshots:type_name -> tibiadata.v4.WatchlistSnapshot
+	5,  // 6: tibiadata.v4.WatchlistTimeline.level_history:type_name -> tibiadata.v4.WatchlistLevel
+	10, // 7: tibiadata.v4.WatchlistSnapshot.guild:type_name -> tibiadata.v4.CharacterGuild
+	7,  // 8: tibiadata.v4.WatchlistDeathsResponse.watchlist_deaths:type_name -> tibiadata.v4.WatchlistDeaths
+	8,  // 9: tibiadata.v4.WatchlistDeathsResponse.information:type_name -> tibiadata.v4.Information
+	11, // 10: tibiadata.v4.WatchlistDeaths.deaths:type_name -> tibiadata.v4.Deaths
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_watchlist_proto_init() }
+func file_watchlist_proto_init() {
+	if File_watchlist_proto != nil {
+		return
+	}
+	file_characters_character_proto_init()
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_watchlist_proto_rawDesc), len(file_watchlist_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_watchlist_proto_goTypes,
+		DependencyIndexes: file_watchlist_proto_depIdxs,
+		MessageInfos:      file_watchlist_proto_msgTypes,
+	}.Build()
+	File_watchlist_proto = out.File
+	file_watchlist_proto_goTypes = nil
+	file_watchlist_proto_depIdxs = nil
+}
@@ -0,0 +1,635 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: webhooks.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: Webhooks and Information
+type WebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhooksResponse) Reset() {
+	*x = WebhooksResponse{}
+	mi := &file_webhooks_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhooksResponse) ProtoMessage() {}
+
+func (x *WebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_webhooks_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhooksResponse.ProtoReflect.Descriptor instead.
+func (*WebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_webhooks_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *WebhooksResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// The base includes two levels: Webhook and Information
+type WebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookResponse) Reset() {
+	*x = WebhookResponse{}
+	mi := &file_webhooks_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookResponse) ProtoMessage() {}
+
+func (x *WebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_webhooks_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookResponse.ProtoReflect.Descriptor instead.
+func (*WebhookResponse) Descriptor() ([]byte, []int) {
+	return file_webhooks_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *WebhookResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of WebhooksResponse
+type Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                // The ID of the webhook.
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`               // The url the events are posted to.
+	Secret        string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`         // The secret of the signatures (only returned when the webhook is added).
+	Events        []string               `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`         // The event types to deliver (all if empty).
+	Characters    []string               `protobuf:"bytes,5,rep,name=characters,proto3" json:"characters,omitempty"` // The watched characters to deliver events of (all if empty).
+	Guilds        []string               `protobuf:"bytes,6,rep,name=guilds,proto3" json:"guilds,omitempty"`         // The guilds to deliver member events of.
+	Houses        []*WebhookHouse        `protobuf:"bytes,7,rep,name=houses,proto3" json:"houses,omitempty"`         // The houses to deliver auction and owner events of.
+	Created       string                 `protobuf:"bytes,8,opt,name=created,proto3" json:"created,omitempty"`       // The time the webhook was added.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_webhooks_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_webhooks_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_webhooks_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Webhook) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Webhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Webhook) GetCharacters() []string {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+func (x *Webhook) GetGuilds() []string {
+	if x != nil {
+		return x.Guilds
+	}
+	return nil
+}
+
+func (x *Webhook) GetHouses() []*WebhookHouse {
+	if x != nil {
+		return x.Houses
+	}
+	return nil
+}
+
+func (x *Webhook) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+// Child of Webhook
+type WebhookHouse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         string                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`                     // The world of the house.
+	HouseId       int64                  `protobuf:"varint,2,opt,name=house_id,json=houseId,proto3" json:"house_id,omitempty"` // The internal ID of the house.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookHouse) Reset() {
+	*x = WebhookHouse{}
+	mi := &file_webhooks_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookHouse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookHouse) ProtoMessage() {}
+
+func (x *WebhookHouse) ProtoReflect() protoreflect.Message {
+	mi := &file_webhooks_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookHouse.ProtoReflect.Descriptor instead.
+func (*WebhookHouse) Descriptor() ([]byte, []int) {
+	return file_webhooks_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WebhookHouse) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *WebhookHouse) GetHouseId() int64 {
+	if x != nil {
+		return x.HouseId
+	}
+	return 0
+}
+
+// The base includes two levels: WebhookDeliveries and Information
+type WebhookDeliveriesResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	WebhookDeliveries *WebhookDeliveries     `protobuf:"bytes,1,opt,name=webhook_deliveries,json=webhookDeliveries,proto3" json:"webhook_deliveries,omitempty"`
+	Information       *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WebhookDeliveriesResponse) Reset() {
+	*x = WebhookDeliveriesResponse{}
+	mi := &file_webhooks_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *WebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_webhooks_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_webhooks_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WebhookDeliveriesResponse) GetWebhookDeliveries() *WebhookDeliveries {
+	if x != nil {
+		return x.WebhookDeliveries
+	}
+	return nil
+}
+
+func (x *WebhookDeliveriesResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type WebhookDeliveries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     int64                  `protobuf:"varint,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"` // The ID of the webhook.
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                         // The status the deliveries are filtered on.
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,3,rep,name=deliveries,proto3" json:"deliveries,omitempty"`                 // The deliveries, the newest first.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDeliveries) Reset() {
+	*x = WebhookDeliveries{}
+	mi := &file_webhooks_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeliveries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveries) ProtoMessage() {}
+
+func (x *WebhookDeliveries) ProtoReflect() protoreflect.Message {
+	mi := &file_webhooks_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveries.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveries) Descriptor() ([]byte, []int) {
+	return file_webhooks_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WebhookDeliveries) GetWebhookId() int64 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *WebhookDeliveries) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDeliveries) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// Child of WebhookDeliveries
+type WebhookDelivery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                     // The ID of the delivery.
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`             // The ID of the event.
+	EventType     string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`       // The type of the event.
+	Created       string                 `protobuf:"bytes,4,opt,name=created,proto3" json:"created,omitempty"`                            // The time the event was seen.
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                              // The status of the delivery (pending, delivered or failed).
+	Attempts      int64                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`                         // The number of attempts so far.
+	LastAttempt   string                 `protobuf:"bytes,7,opt,name=last_attempt,json=lastAttempt,proto3" json:"last_attempt,omitempty"` // The time of the last attempt.
+	NextAttempt   string                 `protobuf:"bytes,8,opt,name=next_attempt,json=nextAttempt,proto3" json:"next_attempt,omitempty"` // The time of the next attempt (if pending).
+	HttpCode      int64                  `protobuf:"varint,9,opt,name=http_code,json=httpCode,proto3" json:"http_code,omitempty"`         // The response code of the last attempt.
+	Error         string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`                               // The error of the last attempt (if it failed).
+	Payload       string                 `protobuf:"bytes,11,opt,name=payload,proto3" json:"payload,omitempty"`                           // The body that is posted.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_webhooks_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_webhooks_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_webhooks_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WebhookDelivery) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastAttempt() string {
+	if x != nil {
+		return x.LastAttempt
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetNextAttempt() string {
+	if x != nil {
+		return x.NextAttempt
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetHttpCode() int64 {
+	if x != nil {
+		return x.HttpCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+var File_webhooks_proto protoreflect.FileDescriptor
+
+const file_webhooks_proto_rawDesc = "" +
+	"\n" +
+	"\x0ewebhooks.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\x82\x01\n" +
+	"\x10WebhooksResponse\x121\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x15.tibiadata.v4.WebhookR\bwebhooks\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\x7f\n" +
+	"\x0fWebhookResponse\x12/\n" +
+	"\awebhook\x18\x01 \x01(\v2\x15.tibiadata.v4.WebhookR\awebhook\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xe1\x01\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\x12\x16\n" +
+	"\x06events\x18\x04 \x03(\tR\x06events\x12\x1e\n" +
+	"\n" +
+	"characters\x18\x05 \x03(\tR\n" +
+	"characters\x12\x16\n" +
+	"\x06guilds\x18\x06 \x03(\tR\x06guilds\x122\n" +
+	"\x06houses\x18\a \x03(\v2\x1a.tibiadata.v4.WebhookHouseR\x06houses\x12\x18\n" +
+	"\acreated\x18\b \x01(\tR\acreated\"?\n" +
+	"\fWebhookHouse\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\x12\x19\n" +
+	"\bhouse_id\x18\x02 \x01(\x03R\ahouseId\"\xa8\x01\n" +
+	"\x19WebhookDeliveriesResponse\x12N\n" +
+	"\x12webhook_deliveries\x18\x01 \x01(\v2\x1f.tibiadata.v4.WebhookDeliveriesR\x11webhookDeliveries\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\x89\x01\n" +
+	"\x11WebhookDeliveries\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\x03R\twebhookId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12=\n" +
+	"\n" +
+	"deliveries\x18\x03 \x03(\v2\x1d.tibiadata.v4.WebhookDeliveryR\n" +
+	"deliveries\"\xbc\x02\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x12\x18\n" +
+	"\acreated\x18\x04 \x01(\tR\acreated\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x03R\battempts\x12!\n" +
+	"\flast_attempt\x18\a \x01(\tR\vlastAttempt\x12!\n" +
+	"\fnext_attempt\x18\b \x01(\tR\vnextAttempt\x12\x1b\n" +
+	"\thttp_code\x18\t \x01(\x03R\bhttpCode\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12\x18\n" +
+	"\apayload\x18\v \x01(\tR\apayloadB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_webhooks_proto_rawDescOnce sync.Once
+	file_webhooks_proto_rawDescData []byte
+)
+
+func file_webhooks_proto_rawDescGZIP() []byte {
+	file_webhooks_proto_rawDescOnce.Do(func() {
+		file_webhooks_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_webhooks_proto_rawDesc), len(file_webhooks_proto_rawDesc)))
+	})
+	return file_webhooks_proto_rawDescData
+}
+
+var file_webhooks_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_webhooks_proto_goTypes = []any{
+	(*WebhooksResponse)(nil),          // 0: tibiadata.v4.WebhooksResponse
+	(*WebhookResponse)(nil),           // 1: tibiadata.v4.WebhookResponse
+	(*Webhook)(nil),                   // 2: tibiadata.v4.Webhook
+	(*WebhookHouse)(nil),              // 3: tibiadata.v4.WebhookHouse
+	(*WebhookDeliveriesResponse)(nil), // 4: tibiadata.v4.WebhookDeliveriesResponse
+	(*WebhookDeliveries)(nil),         // 5: tibiadata.v4.WebhookDeliveries
+	(*WebhookDelivery)(nil),           // 6: tibiadata.v4.WebhookDelivery
+	(*Information)(nil),               // 7: tibiadata.v4.Information
+}
+var file_webhooks_proto_depIdxs = []int32{
+	2, // 0: tibiadata.v4.WebhooksResponse.webhooks:type_name -> tibiadata.v4.Webhook
+	7, // 1: tibiadata.v4.WebhooksResponse.information:type_name -> tibiadata.v4.Information
+	2, // 2: tibiadata.v4.WebhookResponse.webhook:type_name -> tibiadata.v4.Webhook
+	7, // 3: tibiadata.v4.WebhookResponse.information:type_name -> tibiadata.v4.Information
+	3, // 4: tibiadata.v4.Webhook.houses:type_name -> tibiadata.v4.WebhookHouse
+	5, // 5: tibiadata.v4.WebhookDeliveriesResponse.webhook_deliveries:type_name -> tibiadata.v4.WebhookDeliveries
+	7, // 6: tibiadata.v4.WebhookDeliveriesResponse.information:type_name -> tibiadata.v4.Information
+	6, // 7: tibiadata.v4.WebhookDeliveries.deliveries:type_name -> tibiadata.v4.WebhookDelivery
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_webhooks_proto_init() }
+func file_webhooks_proto_init() {
+	if File_webhooks_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_webhooks_proto_rawDesc), len(file_webhooks_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_webhooks_proto_goTypes,
+		DependencyIndexes: file_webhooks_proto_depIdxs,
+		MessageInfos:      file_webhooks_proto_msgTypes,
+	}.Build()
+	File_webhooks_proto = out.File
+	file_webhooks_proto_goTypes = nil
+	file_webhooks_proto_depIdxs = nil
+}
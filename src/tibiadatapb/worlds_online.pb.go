@@ -0,0 +1,486 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: worlds_online.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: WorldOnlineDiff and Information
+type WorldOnlineDiffResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	WorldOnlineDiff *WorldOnlineDiff       `protobuf:"bytes,1,opt,name=world_online_diff,json=worldOnlineDiff,proto3" json:"world_online_diff,omitempty"`
+	Information     *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WorldOnlineDiffResponse) Reset() {
+	*x = WorldOnlineDiffResponse{}
+	mi := &file_worlds_online_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldOnlineDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldOnlineDiffResponse) ProtoMessage() {}
+
+func (x *WorldOnlineDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_worlds_online_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldOnlineDiffResponse.ProtoReflect.Descriptor instead.
+func (*WorldOnlineDiffResponse) Descriptor() ([]byte, []int) {
+	return file_worlds_online_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WorldOnlineDiffResponse) GetWorldOnlineDiff() *WorldOnlineDiff {
+	if x != nil {
+		return x.WorldOnlineDiff
+	}
+	return nil
+}
+
+func (x *WorldOnlineDiffResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of JSONData
+type WorldOnlineDiff struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	World         string                    `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`                                   // The name of the world.
+	From          string                    `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`                                     // The time of the snapshot the period starts with.
+	To            string                    `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`                                         // The time of the snapshot the period ends with.
+	Snapshots     int64                     `protobuf:"varint,4,opt,name=snapshots,proto3" json:"snapshots,omitempty"`                          // The number of snapshots of the period.
+	LoggedIn      []*WorldOnlineChange      `protobuf:"bytes,5,rep,name=logged_in,json=loggedIn,proto3" json:"logged_in,omitempty"`             // The logins seen in the period, in the order they were seen.
+	LoggedOut     []*WorldOnlineChange      `protobuf:"bytes,6,rep,name=logged_out,json=loggedOut,proto3" json:"logged_out,omitempty"`          // The logouts seen in the period, in the order they were seen.
+	LevelChanges  []*WorldOnlineLevelChange `protobuf:"bytes,7,rep,name=level_changes,json=levelChanges,proto3" json:"level_changes,omitempty"` // The characters whose level changed while they were seen online, sorted by name.
+	Sessions      []*WorldOnlineSession     `protobuf:"bytes,8,rep,name=sessions,proto3" json:"sessions,omitempty"`                             // The sessions whose login and logout were both seen, in the order of their logouts.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldOnlineDiff) Reset() {
+	*x = WorldOnlineDiff{}
+	mi := &file_worlds_online_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldOnlineDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldOnlineDiff) ProtoMessage() {}
+
+func (x *WorldOnlineDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_worlds_online_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldOnlineDiff.ProtoReflect.Descriptor instead.
+func (*WorldOnlineDiff) Descriptor() ([]byte, []int) {
+	return file_worlds_online_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WorldOnlineDiff) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *WorldOnlineDiff) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *WorldOnlineDiff) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *WorldOnlineDiff) GetSnapshots() int64 {
+	if x != nil {
+		return x.Snapshots
+	}
+	return 0
+}
+
+func (x *WorldOnlineDiff) GetLoggedIn() []*WorldOnlineChange {
+	if x != nil {
+		return x.LoggedIn
+	}
+	return nil
+}
+
+func (x *WorldOnlineDiff) GetLoggedOut() []*WorldOnlineChange {
+	if x != nil {
+		return x.LoggedOut
+	}
+	return nil
+}
+
+func (x *WorldOnlineDiff) GetLevelChanges() []*WorldOnlineLevelChange {
+	if x != nil {
+		return x.LevelChanges
+	}
+	return nil
+}
+
+func (x *WorldOnlineDiff) GetSessions() []*WorldOnlineSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// Child of WorldOnlineDiff
+type WorldOnlineChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`         // The name of the character.
+	Level         int64                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`      // The character's level.
+	Vocation      string                 `protobuf:"bytes,3,opt,name=vocation,proto3" json:"vocation,omitempty"` // The character's vocation.
+	Time          string                 `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`         // The time of the snapshot the login or logout was seen in.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldOnlineChange) Reset() {
+	*x = WorldOnlineChange{}
+	mi := &file_worlds_online_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldOnlineChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldOnlineChange) ProtoMessage() {}
+
+func (x *WorldOnlineChange) ProtoReflect() protoreflect.Message {
+	mi := &file_worlds_online_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldOnlineChange.ProtoReflect.Descriptor instead.
+func (*WorldOnlineChange) Descriptor() ([]byte, []int) {
+	return file_worlds_online_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WorldOnlineChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorldOnlineChange) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *WorldOnlineChange) GetVocation() string {
+	if x != nil {
+		return x.Vocation
+	}
+	return ""
+}
+
+func (x *WorldOnlineChange) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+// Child of WorldOnlineDiff
+type WorldOnlineLevelChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                         // The name of the character.
+	Vocation      string                 `protobuf:"bytes,2,opt,name=vocation,proto3" json:"vocation,omitempty"`                                 // The character's vocation.
+	Level         int64                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`                                      // The character's level when it was last seen online in the period.
+	PreviousLevel int64                  `protobuf:"varint,4,opt,name=previous_level,json=previousLevel,proto3" json:"previous_level,omitempty"` // The character's level when it was first seen online in the period.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldOnlineLevelChange) Reset() {
+	*x = WorldOnlineLevelChange{}
+	mi := &file_worlds_online_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldOnlineLevelChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldOnlineLevelChange) ProtoMessage() {}
+
+func (x *WorldOnlineLevelChange) ProtoReflect() protoreflect.Message {
+	mi := &file_worlds_online_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldOnlineLevelChange.ProtoReflect.Descriptor instead.
+func (*WorldOnlineLevelChange) Descriptor() ([]byte, []int) {
+	return file_worlds_online_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WorldOnlineLevelChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorldOnlineLevelChange) GetVocation() string {
+	if x != nil {
+		return x.Vocation
+	}
+	return ""
+}
+
+func (x *WorldOnlineLevelChange) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *WorldOnlineLevelChange) GetPreviousLevel() int64 {
+	if x != nil {
+		return x.PreviousLevel
+	}
+	return 0
+}
+
+// Child of WorldOnlineDiff
+type WorldOnlineSession struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`          // The name of the character.
+	Vocation      string                 `protobuf:"bytes,2,opt,name=vocation,proto3" json:"vocation,omitempty"`  // The character's vocation.
+	Login         string                 `protobuf:"bytes,3,opt,name=login,proto3" json:"login,omitempty"`        // The time of the snapshot the login was seen in.
+	Logout        string                 `protobuf:"bytes,4,opt,name=logout,proto3" json:"logout,omitempty"`      // The time of the snapshot the logout was seen in.
+	Duration      int64                  `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"` // The duration of the session in seconds.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldOnlineSession) Reset() {
+	*x = WorldOnlineSession{}
+	mi := &file_worlds_online_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldOnlineSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldOnlineSession) ProtoMessage() {}
+
+func (x *WorldOnlineSession) ProtoReflect() protoreflect.Message {
+	mi := &file_worlds_online_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldOnlineSession.ProtoReflect.Descriptor instead.
+func (*WorldOnlineSession) Descriptor() ([]byte, []int) {
+	return file_worlds_online_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WorldOnlineSession) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorldOnlineSession) GetVocation() string {
+	if x != nil {
+		return x.Vocation
+	}
+	return ""
+}
+
+func (x *WorldOnlineSession) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *WorldOnlineSession) GetLogout() string {
+	if x != nil {
+		return x.Logout
+	}
+	return ""
+}
+
+func (x *WorldOnlineSession) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+var File_worlds_online_proto protoreflect.FileDescriptor
+
+const file_worlds_online_proto_rawDesc = "" +
+	"\n" +
+	"\x13worlds_online.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\xa1\x01\n" +
+	"\x17WorldOnlineDiffResponse\x12I\n" +
+	"\x11world_online_diff\x18\x01 \x01(\v2\x1d.tibiadata.v4.WorldOnlineDiffR\x0fworldOnlineDiff\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\xf0\x02\n" +
+	"\x0fWorldOnlineDiff\x12\x14\n" +
+	"\x05world\x18\x01 \x01(\tR\x05world\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12\x1c\n" +
+	"\tsnapshots\x18\x04 \x01(\x03R\tsnapshots\x12<\n" +
+	"\tlogged_in\x18\x05 \x03(\v2\x1f.tibiadata.v4.WorldOnlineChangeR\bloggedIn\x12>\n" +
+	"\n" +
+	"logged_out\x18\x06 \x03(\v2\x1f.tibiadata.v4.WorldOnlineChangeR\tloggedOut\x12I\n" +
+	"\rlevel_changes\x18\a \x03(\v2$.tibiadata.v4.WorldOnlineLevelChangeR\flevelChanges\x12<\n" +
+	"\bsessions\x18\b \x03(\v2 .tibiadata.v4.WorldOnlineSessionR\bsessions\"m\n" +
+	"\x11WorldOnlineChange\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x03R\x05level\x12\x1a\n" +
+	"\bvocation\x18\x03 \x01(\tR\bvocation\x12\x12\n" +
+	"\x04time\x18\x04 \x01(\tR\x04time\"\x85\x01\n" +
+	"\x16WorldOnlineLevelChange\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bvocation\x18\x02 \x01(\tR\bvocation\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\x03R\x05level\x12%\n" +
+	"\x0eprevious_level\x18\x04 \x01(\x03R\rpreviousLevel\"\x8e\x01\n" +
+	"\x12WorldOnlineSession\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bvocation\x18\x02 \x01(\tR\bvocation\x12\x14\n" +
+	"\x05login\x18\x03 \x01(\tR\x05login\x12\x16\n" +
+	"\x06logout\x18\x04 \x01(\tR\x06logout\x12\x1a\n" +
+	"\bduration\x18\x05 \x01(\x03R\bdurationB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_worlds_online_proto_rawDescOnce sync.Once
+	file_worlds_online_proto_rawDescData []byte
+)
+
+func file_worlds_online_proto_rawDescGZIP() []byte {
+	file_worlds_online_proto_rawDescOnce.Do(func() {
+		file_worlds_online_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_worlds_online_proto_rawDesc), len(file_worlds_online_proto_rawDesc)))
+	})
+	return file_worlds_online_proto_rawDescData
+}
+
+var file_worlds_online_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_worlds_online_proto_goTypes = []any{
+	(*WorldOnlineDiffResponse)(nil), // 0: tibiadata.v4.WorldOnlineDiffResponse
+	(*WorldOnlineDiff)(nil),         // 1: tibiadata.v4.WorldOnlineDiff
+	(*WorldOnlineChange)(nil),       // 2: tibiadata.v4.WorldOnlineChange
+	(*WorldOnlineLevelChange)(nil),  // 3: tibiadata.v4.WorldOnlineLevelChange
+	(*WorldOnlineSession)(nil),      // 4: tibiadata.v4.WorldOnlineSession
+	(*Information)(nil),             // 5: tibiadata.v4.Information
+}
+var file_worlds_online_proto_depIdxs = []int32{
+	1, // 0: tibiadata.v4.WorldOnlineDiffResponse.world_online_diff:type_name -> tibiadata.v4.WorldOnlineDiff
+	5, // 1: tibiadata.v4.WorldOnlineDiffResponse.information:type_name -> tibiadata.v4.Information
+	2, // 2: tibiadata.v4.WorldOnlineDiff.logged_in:type_name -> tibiadata.v4.WorldOnlineChange
+	2, // 3: tibiadata.v4.WorldOnlineDiff.logged_out:type_name -> tibiadata.v4.WorldOnlineChange
+	3, // 4: tibiadata.v4.WorldOnlineDiff.level_changes:type_name -> tibiadata.v4.WorldOnlineLevelChange
+	4, // 5: tibiadata.v4.WorldOnlineDiff.sessions:type_name -> tibiadata.v4.WorldOnlineSession
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_worlds_online_proto_init() }
+func file_worlds_online_proto_init() {
+	if File_worlds_online_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_worlds_online_proto_rawDesc), len(file_worlds_online_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_worlds_online_proto_goTypes,
+		DependencyIndexes: file_worlds_online_proto_depIdxs,
+		MessageInfos:      file_worlds_online_proto_msgTypes,
+	}.Build()
+	File_worlds_online_proto = out.File
+	file_worlds_online_proto_goTypes = nil
+	file_worlds_online_proto_depIdxs = nil
+}
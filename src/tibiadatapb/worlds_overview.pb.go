@@ -0,0 +1,362 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: worlds_overview.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: Worlds and Information
+type WorldsOverviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Worlds        *OverviewWorlds        `protobuf:"bytes,1,opt,name=worlds,proto3" json:"worlds,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldsOverviewResponse) Reset() {
+	*x = WorldsOverviewResponse{}
+	mi := &file_worlds_overview_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldsOverviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldsOverviewResponse) ProtoMessage() {}
+
+func (x *WorldsOverviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_worlds_overview_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldsOverviewResponse.ProtoReflect.Descriptor instead.
+func (*WorldsOverviewResponse) Descriptor() ([]byte, []int) {
+	return file_worlds_overview_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WorldsOverviewResponse) GetWorlds() *OverviewWorlds {
+	if x != nil {
+		return x.Worlds
+	}
+	return nil
+}
+
+func (x *WorldsOverviewResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of Worlds
+type OverviewWorld struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                             // The name of the world.
+	Status              string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                                                         // The current status of the world.
+	PlayersOnline       int64                  `protobuf:"varint,3,opt,name=players_online,json=playersOnline,proto3" json:"players_online,omitempty"`                     // The number of currently online players.
+	Location            string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`                                                     // The physical location of the servers.
+	PvpType             string                 `protobuf:"bytes,5,opt,name=pvp_type,json=pvpType,proto3" json:"pvp_type,omitempty"`                                        // The type of PvP.
+	PremiumOnly         bool                   `protobuf:"varint,6,opt,name=premium_only,json=premiumOnly,proto3" json:"premium_only,omitempty"`                           // Whether only premium account players are allowed to play on it.
+	TransferType        string                 `protobuf:"bytes,7,opt,name=transfer_type,json=transferType,proto3" json:"transfer_type,omitempty"`                         // The type of transfer restrictions it has. regular / locked / blocked
+	BattleyeProtected   bool                   `protobuf:"varint,8,opt,name=battleye_protected,json=battleyeProtected,proto3" json:"battleye_protected,omitempty"`         // The type of BattlEye protection. true if protected / false if "Not protected by BattlEye."
+	BattleyeDate        string                 `protobuf:"bytes,9,opt,name=battleye_date,json=battleyeDate,proto3" json:"battleye_date,omitempty"`                         // The date when BattlEye was added. "" if since release / else show date?
+	GameWorldType       string                 `protobuf:"bytes,10,opt,name=game_world_type,json=gameWorldType,proto3" json:"game_world_type,omitempty"`                   // The type of world. regular / experimental / tournament (if Tournament World Type exists)
+	TournamentWorldType string                 `protobuf:"bytes,11,opt,name=tournament_world_type,json=tournamentWorldType,proto3" json:"tournament_world_type,omitempty"` // The type of tournament world. "" (default?) / regular / restricted
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *OverviewWorld) Reset() {
+	*x = OverviewWorld{}
+	mi := &file_worlds_overview_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverviewWorld) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverviewWorld) ProtoMessage() {}
+
+func (x *OverviewWorld) ProtoReflect() protoreflect.Message {
+	mi := &file_worlds_overview_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverviewWorld.ProtoReflect.Descriptor instead.
+func (*OverviewWorld) Descriptor() ([]byte, []int) {
+	return file_worlds_overview_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OverviewWorld) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OverviewWorld) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OverviewWorld) GetPlayersOnline() int64 {
+	if x != nil {
+		return x.PlayersOnline
+	}
+	return 0
+}
+
+func (x *OverviewWorld) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *OverviewWorld) GetPvpType() string {
+	if x != nil {
+		return x.PvpType
+	}
+	return ""
+}
+
+func (x *OverviewWorld) GetPremiumOnly() bool {
+	if x != nil {
+		return x.PremiumOnly
+	}
+	return false
+}
+
+func (x *OverviewWorld) GetTransferType() string {
+	if x != nil {
+		return x.TransferType
+	}
+	return ""
+}
+
+func (x *OverviewWorld) GetBattleyeProtected() bool {
+	if x != nil {
+		return x.BattleyeProtected
+	}
+	return false
+}
+
+func (x *OverviewWorld) GetBattleyeDate() string {
+	if x != nil {
+		return x.BattleyeDate
+	}
+	return ""
+}
+
+func (x *OverviewWorld) GetGameWorldType() string {
+	if x != nil {
+		return x.GameWorldType
+	}
+	return ""
+}
+
+func (x *OverviewWorld) GetTournamentWorldType() string {
+	if x != nil {
+		return x.TournamentWorldType
+	}
+	return ""
+}
+
+// Child of JSONData
+type OverviewWorlds struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PlayersOnline    int64                  `protobuf:"varint,1,opt,name=players_online,json=playersOnline,proto3" json:"players_online,omitempty"`         // Total players online across all worlds.
+	RecordPlayers    int64                  `protobuf:"varint,2,opt,name=record_players,json=recordPlayers,proto3" json:"record_players,omitempty"`         // The world's online players record.
+	RecordDate       string                 `protobuf:"bytes,3,opt,name=record_date,json=recordDate,proto3" json:"record_date,omitempty"`                   // The date when the record was achieved.
+	RegularWorlds    []*OverviewWorld       `protobuf:"bytes,4,rep,name=regular_worlds,json=regularWorlds,proto3" json:"regular_worlds,omitempty"`          // List of regular worlds.
+	TournamentWorlds []*OverviewWorld       `protobuf:"bytes,5,rep,name=tournament_worlds,json=tournamentWorlds,proto3" json:"tournament_worlds,omitempty"` // List of tournament worlds.
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OverviewWorlds) Reset() {
+	*x = OverviewWorlds{}
+	mi := &file_worlds_overview_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverviewWorlds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverviewWorlds) ProtoMessage() {}
+
+func (x *OverviewWorlds) ProtoReflect() protoreflect.Message {
+	mi := &file_worlds_overview_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverviewWorlds.ProtoReflect.Descriptor instead.
+func (*OverviewWorlds) Descriptor() ([]byte, []int) {
+	return file_worlds_overview_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OverviewWorlds) GetPlayersOnline() int64 {
+	if x != nil {
+		return x.PlayersOnline
+	}
+	return 0
+}
+
+func (x *OverviewWorlds) GetRecordPlayers() int64 {
+	if x != nil {
+		return x.RecordPlayers
+	}
+	return 0
+}
+
+func (x *OverviewWorlds) GetRecordDate() string {
+	if x != nil {
+		return x.RecordDate
+	}
+	return ""
+}
+
+func (x *OverviewWorlds) GetRegularWorlds() []*OverviewWorld {
+	if x != nil {
+		return x.RegularWorlds
+	}
+	return nil
+}
+
+func (x *OverviewWorlds) GetTournamentWorlds() []*OverviewWorld {
+	if x != nil {
+		return x.TournamentWorlds
+	}
+	return nil
+}
+
+var File_worlds_overview_proto protoreflect.FileDescriptor
+
+const file_worlds_overview_proto_rawDesc = "" +
+	"\n" +
+	"\x15worlds_overview.proto\x12\ftibiadata.v4\x1a\x11information.proto\"\x8b\x01\n" +
+	"\x16WorldsOverviewResponse\x124\n" +
+	"\x06worlds\x18\x01 \x01(\v2\x1c.tibiadata.v4.OverviewWorldsR\x06worlds\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"\x91\x03\n" +
+	"\rOverviewWorld\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12%\n" +
+	"\x0eplayers_online\x18\x03 \x01(\x03R\rplayersOnline\x12\x1a\n" +
+	"\blocation\x18\x04 \x01(\tR\blocation\x12\x19\n" +
+	"\bpvp_type\x18\x05 \x01(\tR\apvpType\x12!\n" +
+	"\fpremium_only\x18\x06 \x01(\bR\vpremiumOnly\x12#\n" +
+	"\rtransfer_type\x18\a \x01(\tR\ftransferType\x12-\n" +
+	"\x12battleye_protected\x18\b \x01(\bR\x11battleyeProtected\x12#\n" +
+	"\rbattleye_date\x18\t \x01(\tR\fbattleyeDate\x12&\n" +
+	"\x0fgame_world_type\x18\n" +
+	" \x01(\tR\rgameWorldType\x122\n" +
+	"\x15tournament_world_type\x18\v \x01(\tR\x13tournamentWorldType\"\x8d\x02\n" +
+	"\x0eOverviewWorlds\x12%\n" +
+	"\x0eplayers_online\x18\x01 \x01(\x03R\rplayersOnline\x12%\n" +
+	"\x0erecord_players\x18\x02 \x01(\x03R\rrecordPlayers\x12\x1f\n" +
+	"\vrecord_date\x18\x03 \x01(\tR\n" +
+	"recordDate\x12B\n" +
+	"\x0eregular_worlds\x18\x04 \x03(\v2\x1b.tibiadata.v4.OverviewWorldR\rregularWorlds\x12H\n" +
+	"\x11tournament_worlds\x18\x05 \x03(\v2\x1b.tibiadata.v4.OverviewWorldR\x10tournamentWorldsB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_worlds_overview_proto_rawDescOnce sync.Once
+	file_worlds_overview_proto_rawDescData []byte
+)
+
+func file_worlds_overview_proto_rawDescGZIP() []byte {
+	file_worlds_overview_proto_rawDescOnce.Do(func() {
+		file_worlds_overview_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_worlds_overview_proto_rawDesc), len(file_worlds_overview_proto_rawDesc)))
+	})
+	return file_worlds_overview_proto_rawDescData
+}
+
+var file_worlds_overview_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_worlds_overview_proto_goTypes = []any{
+	(*WorldsOverviewResponse)(nil), // 0: tibiadata.v4.WorldsOverviewResponse
+	(*OverviewWorld)(nil),          // 1: tibiadata.v4.OverviewWorld
+	(*OverviewWorlds)(nil),         // 2: tibiadata.v4.OverviewWorlds
+	(*Information)(nil),            // 3: tibiadata.v4.Information
+}
+var file_worlds_overview_proto_depIdxs = []int32{
+	2, // 0: tibiadata.v4.WorldsOverviewResponse.worlds:type_name -> tibiadata.v4.OverviewWorlds
+	3, // 1: tibiadata.v4.WorldsOverviewResponse.information:type_name -> tibiadata.v4.Information
+	1, // 2: tibiadata.v4.OverviewWorlds.regular_worlds:type_name -> tibiadata.v4.OverviewWorld
+	1, // 3: tibiadata.v4.OverviewWorlds.tournament_worlds:type_name -> tibiadata.v4.OverviewWorld
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_worlds_overview_proto_init() }
+func file_worlds_overview_proto_init() {
+	if File_worlds_overview_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_worlds_overview_proto_rawDesc), len(file_worlds_overview_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_worlds_overview_proto_goTypes,
+		DependencyIndexes: file_worlds_overview_proto_depIdxs,
+		MessageInfos:      file_worlds_overview_proto_msgTypes,
+	}.Build()
+	File_worlds_overview_proto = out.File
+	file_worlds_overview_proto_goTypes = nil
+	file_worlds_overview_proto_depIdxs = nil
+}
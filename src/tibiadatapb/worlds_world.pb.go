@@ -0,0 +1,388 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: worlds_world.proto
+
+package tibiadatapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The base includes two levels: World and Information
+type WorldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         *World                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`
+	Information   *Information           `protobuf:"bytes,2,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldResponse) Reset() {
+	*x = WorldResponse{}
+	mi := &file_worlds_world_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldResponse) ProtoMessage() {}
+
+func (x *WorldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_worlds_world_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldResponse.ProtoReflect.Descriptor instead.
+func (*WorldResponse) Descriptor() ([]byte, []int) {
+	return file_worlds_world_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WorldResponse) GetWorld() *World {
+	if x != nil {
+		return x.World
+	}
+	return nil
+}
+
+func (x *WorldResponse) GetInformation() *Information {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+// Child of World
+type OnlinePlayers struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`         // The name of the character.
+	Level         int64                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`      // The character's level.
+	Vocation      string                 `protobuf:"bytes,3,opt,name=vocation,proto3" json:"vocation,omitempty"` // The character's vocation.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnlinePlayers) Reset() {
+	*x = OnlinePlayers{}
+	mi := &file_worlds_world_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnlinePlayers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnlinePlayers) ProtoMessage() {}
+
+func (x *OnlinePlayers) ProtoReflect() protoreflect.Message {
+	mi := &file_worlds_world_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnlinePlayers.ProtoReflect.Descriptor instead.
+func (*OnlinePlayers) Descriptor() ([]byte, []int) {
+	return file_worlds_world_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OnlinePlayers) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OnlinePlayers) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *OnlinePlayers) GetVocation() string {
+	if x != nil {
+		return x.Vocation
+	}
+	return ""
+}
+
+// Child of JSONData
+type World struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                             // The name of the world.
+	Status              string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                                                         // The current status of the world.
+	PlayersOnline       int64                  `protobuf:"varint,3,opt,name=players_online,json=playersOnline,proto3" json:"players_online,omitempty"`                     // The number of currently online players.
+	RecordPlayers       int64                  `protobuf:"varint,4,opt,name=record_players,json=recordPlayers,proto3" json:"record_players,omitempty"`                     // The world's online players record.
+	RecordDate          string                 `protobuf:"bytes,5,opt,name=record_date,json=recordDate,proto3" json:"record_date,omitempty"`                               // The date when the record was achieved.
+	CreationDate        string                 `protobuf:"bytes,6,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`                         // The year and month it was created.
+	Location            string                 `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`                                                     // The physical location of the servers.
+	PvpType             string                 `protobuf:"bytes,8,opt,name=pvp_type,json=pvpType,proto3" json:"pvp_type,omitempty"`                                        // The type of PvP.
+	PremiumOnly         bool                   `protobuf:"varint,9,opt,name=premium_only,json=premiumOnly,proto3" json:"premium_only,omitempty"`                           // Whether only premium account players are allowed to play on it.
+	TransferType        string                 `protobuf:"bytes,10,opt,name=transfer_type,json=transferType,proto3" json:"transfer_type,omitempty"`                        // The type of transfer restrictions it has. regular / locked / blocked
+	WorldQuestTitles    []string               `protobuf:"bytes,11,rep,name=world_quest_titles,json=worldQuestTitles,proto3" json:"world_quest_titles,omitempty"`          // List of world quest titles the server has achieved.
+	BattleyeProtected   bool                   `protobuf:"varint,12,opt,name=battleye_protected,json=battleyeProtected,proto3" json:"battleye_protected,omitempty"`        // The type of BattlEye protection. true if protected / false if "Not protected by BattlEye."
+	BattleyeDate        string                 `protobuf:"bytes,13,opt,name=battleye_date,json=battleyeDate,proto3" json:"battleye_date,omitempty"`                        // The date when BattlEye was added. "" if since release / else show date?
+	GameWorldType       string                 `protobuf:"bytes,14,opt,name=game_world_type,json=gameWorldType,proto3" json:"game_world_type,omitempty"`                   // The type of world. regular / experimental / tournament (if Tournament World Type exists)
+	TournamentWorldType string                 `protobuf:"bytes,15,opt,name=tournament_world_type,json=tournamentWorldType,proto3" json:"tournament_world_type,omitempty"` // The type of tournament world. "" (default?) / regular / restricted
+	OnlinePlayers       []*OnlinePlayers       `protobuf:"bytes,16,rep,name=online_players,json=onlinePlayers,proto3" json:"online_players,omitempty"`                     // List of players being currently online.
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *World) Reset() {
+	*x = World{}
+	mi := &file_worlds_world_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *World) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*World) ProtoMessage() {}
+
+func (x *World) ProtoReflect() protoreflect.Message {
+	mi := &file_worlds_world_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use World.ProtoReflect.Descriptor instead.
+func (*World) Descriptor() ([]byte, []int) {
+	return file_worlds_world_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *World) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *World) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *World) GetPlayersOnline() int64 {
+	if x != nil {
+		return x.PlayersOnline
+	}
+	return 0
+}
+
+func (x *World) GetRecordPlayers() int64 {
+	if x != nil {
+		return x.RecordPlayers
+	}
+	return 0
+}
+
+func (x *World) GetRecordDate() string {
+	if x != nil {
+		return x.RecordDate
+	}
+	return ""
+}
+
+func (x *World) GetCreationDate() string {
+	if x != nil {
+		return x.CreationDate
+	}
+	return ""
+}
+
+func (x *World) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *World) GetPvpType() string {
+	if x != nil {
+		return x.PvpType
+	}
+	return ""
+}
+
+func (x *World) GetPremiumOnly() bool {
+	if x != nil {
+		return x.PremiumOnly
+	}
+	return false
+}
+
+func (x *World) GetTransferType() string {
+	if x != nil {
+		return x.TransferType
+	}
+	return ""
+}
+
+func (x *World) GetWorldQuestTitles() []string {
+	if x != nil {
+		return x.WorldQuestTitles
+	}
+	return nil
+}
+
+func (x *World) GetBattleyeProtected() bool {
+	if x != nil {
+		return x.BattleyeProtected
+	}
+	return false
+}
+
+func (x *World) GetBattleyeDate() string {
+	if x != nil {
+		return x.BattleyeDate
+	}
+	return ""
+}
+
+func (x *World) GetGameWorldType() string {
+	if x != nil {
+		return x.GameWorldType
+	}
+	return ""
+}
+
+func (x *World) GetTournamentWorldType() string {
+	if x != nil {
+		return x.TournamentWorldType
+	}
+	return ""
+}
+
+func (x *World) GetOnlinePlayers() []*OnlinePlayers {
+	if x != nil {
+		return x.OnlinePlayers
+	}
+	return nil
+}
+
+var File_worlds_world_proto protoreflect.FileDescriptor
+
+const file_worlds_world_proto_rawDesc = "" +
+	"\n" +
+	"\x12worlds_world.proto\x12\ftibiadata.v4\x1a\x11information.proto\"w\n" +
+	"\rWorldResponse\x12)\n" +
+	"\x05world\x18\x01 \x01(\v2\x13.tibiadata.v4.WorldR\x05world\x12;\n" +
+	"\vinformation\x18\x02 \x01(\v2\x19.tibiadata.v4.InformationR\vinformation\"U\n" +
+	"\rOnlinePlayers\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x03R\x05level\x12\x1a\n" +
+	"\bvocation\x18\x03 \x01(\tR\bvocation\"\xe8\x04\n" +
+	"\x05World\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12%\n" +
+	"\x0eplayers_online\x18\x03 \x01(\x03R\rplayersOnline\x12%\n" +
+	"\x0erecord_players\x18\x04 \x01(\x03R\rrecordPlayers\x12\x1f\n" +
+	"\vrecord_date\x18\x05 \x01(\tR\n" +
+	"recordDate\x12#\n" +
+	"\rcreation_date\x18\x06 \x01(\tR\fcreationDate\x12\x1a\n" +
+	"\blocation\x18\a \x01(\tR\blocation\x12\x19\n" +
+	"\bpvp_type\x18\b \x01(\tR\apvpType\x12!\n" +
+	"\fpremium_only\x18\t \x01(\bR\vpremiumOnly\x12#\n" +
+	"\rtransfer_type\x18\n" +
+	" \x01(\tR\ftransferType\x12,\n" +
+	"\x12world_quest_titles\x18\v \x03(\tR\x10worldQuestTitles\x12-\n" +
+	"\x12battleye_protected\x18\f \x01(\bR\x11battleyeProtected\x12#\n" +
+	"\rbattleye_date\x18\r \x01(\tR\fbattleyeDate\x12&\n" +
+	"\x0fgame_world_type\x18\x0e \x01(\tR\rgameWorldType\x122\n" +
+	"\x15tournament_world_type\x18\x0f \x01(\tR\x13tournamentWorldType\x12B\n" +
+	"\x0eonline_players\x18\x10 \x03(\v2\x1b.tibiadata.v4.OnlinePlayersR\ronlinePlayersB7Z5github.com/tibiadata/tibiadata-api-go/src/tibiadatapbb\x06proto3"
+
+var (
+	file_worlds_world_proto_rawDescOnce sync.Once
+	file_worlds_world_proto_rawDescData []byte
+)
+
+func file_worlds_world_proto_rawDescGZIP() []byte {
+	file_worlds_world_proto_rawDescOnce.Do(func() {
+		file_worlds_world_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_worlds_world_proto_rawDesc), len(file_worlds_world_proto_rawDesc)))
+	})
+	return file_worlds_world_proto_rawDescData
+}
+
+var file_worlds_world_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_worlds_world_proto_goTypes = []any{
+	(*WorldResponse)(nil), // 0: tibiadata.v4.WorldResponse
+	(*OnlinePlayers)(nil), // 1: tibiadata.v4.OnlinePlayers
+	(*World)(nil),         // 2: tibiadata.v4.World
+	(*Information)(nil),   // 3: tibiadata.v4.Information
+}
+var file_worlds_world_proto_depIdxs = []int32{
+	2, // 0: tibiadata.v4.WorldResponse.world:type_name -> tibiadata.v4.World
+	3, // 1: tibiadata.v4.WorldResponse.information:type_name -> tibiadata.v4.Information
+	1, // 2: tibiadata.v4.World.online_players:type_name -> tibiadata.v4.OnlinePlayers
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_worlds_world_proto_init() }
+func file_worlds_world_proto_init() {
+	if File_worlds_world_proto != nil {
+		return
+	}
+	file_information_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_worlds_world_proto_rawDesc), len(file_worlds_world_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_worlds_world_proto_goTypes,
+		DependencyIndexes: file_worlds_world_proto_depIdxs,
+		MessageInfos:      file_worlds_world_proto_msgTypes,
+	}.Build()
+	File_worlds_world_proto = out.File
+	file_worlds_world_proto_goTypes = nil
+	file_worlds_world_proto_depIdxs = nil
+}
@@ -34,6 +34,98 @@ var (
 	// Code: 9002
 	ErrorRestrictionMode = Error{errors.New("the provided page is not available due to restriction mode")}
 
+	// ErrorFieldsPathInvalid will be sent if the fields query parameter contains an empty or unknown path
+	// Code: 9003
+	ErrorFieldsPathInvalid = Error{errors.New("the provided fields parameter contains an invalid or unknown path")}
+
+	// ErrorFormatNotSupported will be sent if the requested output format is unknown or not available for the endpoint
+	// Code: 9004
+	ErrorFormatNotSupported = Error{errors.New("the provided format is not supported by this endpoint")}
+
+	// ErrorGraphQLQueryInvalid will be sent if the graphql request has no query or can not be decoded
+	// Code: 9005
+	ErrorGraphQLQueryInvalid = Error{errors.New("the provided graphql request is invalid")}
+
+	// ErrorGraphQLQueryTooExpensive will be sent if the graphql query would exceed the allowed number of requests to tibia.com
+	// Code: 9006
+	ErrorGraphQLQueryTooExpensive = Error{errors.New("the provided graphql query exceeds the maximum query cost")}
+
+	// ErrorRequestBodyInvalid will be sent if the request body can not be decoded
+	// Code: 9007
+	ErrorRequestBodyInvalid = Error{errors.New("the provided request body is invalid")}
+
+	// ErrorBatchEmpty will be sent if a batch request contains no entries
+	// Code: 9008
+	ErrorBatchEmpty = Error{errors.New("the provided batch is empty")}
+
+	// ErrorBatchTooBig will be sent if a batch request contains more entries than allowed
+	// Code: 9009
+	ErrorBatchTooBig = Error{errors.New("the provided batch exceeds the maximum batch size")}
+
+	// ErrorStoreNotEnabled will be sent if an endpoint needs the persistence store but TIBIADATA_STORE_PATH is not set
+	// Code: 9010
+	ErrorStoreNotEnabled = Error{errors.New("the persistence store is not enabled")}
+
+	// ErrorPeriodInvalid will be sent if the requested period (from, to or period) can not be parsed or ends before it starts
+	// Code: 9011
+	ErrorPeriodInvalid = Error{errors.New("the provided period is invalid")}
+
+	// ErrorLevelInvalid will be sent if the requested level is not a number between 1 and MaxLevel
+	// Code: 9012
+	ErrorLevelInvalid = Error{errors.New("the provided level is invalid")}
+
+	// ErrorExperienceInvalid will be sent if the requested experience is not a number between 0 and MaxExperience
+	// Code: 9013
+	ErrorExperienceInvalid = Error{errors.New("the provided experience is invalid")}
+
+	// ErrorLevelOrExperienceRequired will be sent if not exactly one of level and experience is requested
+	// Code: 9014
+	ErrorLevelOrExperienceRequired = Error{errors.New("exactly one of level and experience has to be provided")}
+
+	// ErrorWebhookURLInvalid will be sent if the url of a webhook is not an absolute http or https url
+	// Code: 9015
+	ErrorWebhookURLInvalid = Error{errors.New("the provided webhook url is invalid")}
+
+	// ErrorWebhookEventInvalid will be sent if a webhook subscribes to an event type that does not exist
+	// Code: 9016
+	ErrorWebhookEventInvalid = Error{errors.New("the provided webhook event does not exist")}
+
+	// ErrorWebhookHouseInvalid will be sent if a house of a webhook has no world or house id
+	// Code: 9017
+	ErrorWebhookHouseInvalid = Error{errors.New("the provided webhook house is invalid")}
+
+	// ErrorWebhookNotFound will be sent if the requested webhook does not exist
+	// Code: 9018
+	ErrorWebhookNotFound = Error{errors.New("the provided webhook does not exist")}
+
+	// ErrorWebhooksTooMany will be sent if adding a webhook would exceed TIBIADATA_WEBHOOKS_MAX_SIZE webhooks
+	// Code: 9019
+	ErrorWebhooksTooMany = Error{errors.New("there are too many webhooks")}
+
+	// ErrorWebhookDeliveryStatusInvalid will be sent if the deliveries are filtered on a status that does not exist
+	// Code: 9020
+	ErrorWebhookDeliveryStatusInvalid = Error{errors.New("the provided delivery status does not exist")}
+
+	// ErrorWebhookURLNotAllowed will be sent if the host of a webhook url resolves to a loopback, private, link-local or unspecified address
+	// Code: 9021
+	ErrorWebhookURLNotAllowed = Error{errors.New("the provided webhook url is not allowed")}
+
+	// ErrorManagementNotEnabled will be sent if an endpoint managing the store needs a token but TIBIADATA_MANAGEMENT_TOKEN is not set
+	// Code: 9022
+	ErrorManagementNotEnabled = Error{errors.New("the management endpoints are not enabled")}
+
+	// ErrorManagementTokenInvalid will be sent if the bearer token of an endpoint managing the store is missing or wrong
+	// Code: 9023
+	ErrorManagementTokenInvalid = Error{errors.New("the provided management token is invalid")}
+
+	// ErrorWatchlistFull will be sent if adding characters would exceed TIBIADATA_WATCHLIST_MAX_SIZE watched characters
+	// Code: 9024
+	ErrorWatchlistFull = Error{errors.New("the watchlist is full")}
+
+	// ErrorCharacterNotWatched will be sent if the requested character is not on the watchlist
+	// Code: 9025
+	ErrorCharacterNotWatched = Error{errors.New("the provided character is not on the watchlist")}
+
 	// ErrorCharacterNameEmpty will be sent if the request contains an empty character name
 	// Code: 10001
 	ErrorCharacterNameEmpty = Error{errors.New("the provided character name is an empty string")}
@@ -94,6 +186,42 @@ var (
 	// Code: 11008
 	ErrorHighscorePageTooBig = Error{errors.New("the provided page is larger than max amount of pages")}
 
+	// ErrorHighscoreWorldTypeDoesNotExist will be sent if the world type filter of the highscores does not exist
+	// Code: 11009
+	ErrorHighscoreWorldTypeDoesNotExist = Error{errors.New("the provided world type does not exist")}
+
+	// ErrorHighscoreBattlEyeDoesNotExist will be sent if the battleye filter of the highscores does not exist
+	// Code: 11010
+	ErrorHighscoreBattlEyeDoesNotExist = Error{errors.New("the provided battleye protection does not exist")}
+
+	// ErrorHighscoreFilterNeedsAllWorlds will be sent if the highscores of one world are filtered on world type or battleye
+	// Code: 11011
+	ErrorHighscoreFilterNeedsAllWorlds = Error{errors.New("the world type and battleye filters can only be used on the highscores of all worlds")}
+
+	// ErrorHighscoreSnapshotsNotEnough will be sent if there are less than two highscore snapshots in the requested period
+	// Code: 11012
+	ErrorHighscoreSnapshotsNotEnough = Error{errors.New("there are not enough highscore snapshots in the provided period")}
+
+	// ErrorHouseFilterInvalid will be sent if a house filter (size, beds, rent, type or auctioned) can not be parsed, is negative or its minimum is above its maximum
+	// Code: 11013
+	ErrorHouseFilterInvalid = Error{errors.New("the provided house filter is invalid")}
+
+	// ErrorHouseSortInvalid will be sent if the house search is sorted by a value or in an order that does not exist
+	// Code: 11014
+	ErrorHouseSortInvalid = Error{errors.New("the provided house sort does not exist")}
+
+	// ErrorHousePageInvalid will be sent if the page or page size of the house search is not a number, below 1 or the page size is above the maximum page size
+	// Code: 11015
+	ErrorHousePageInvalid = Error{errors.New("the provided house search page is invalid")}
+
+	// ErrorWorldNotTracked will be sent if the requested world is not in TIBIADATA_WORLDS_TRACKED
+	// Code: 11016
+	ErrorWorldNotTracked = Error{errors.New("the provided world is not tracked")}
+
+	// ErrorWorldSnapshotsNotEnough will be sent if there are less than two online snapshots of the world in the requested period
+	// Code: 11017
+	ErrorWorldSnapshotsNotEnough = Error{errors.New("there are not enough online snapshots of the world in the period")}
+
 	// ErrorCreatureNameEmpty will be sent if the request contains an empty creature name
 	// Code: 12001
 	ErrorCreatureNameEmpty = Error{errors.New("the provided creature name is an empty string")}
@@ -178,6 +306,46 @@ var (
 	// Code: 14007
 	ErrorGuildWordTooSmall = Error{errors.New("the provided guild name has a word too smal")}
 
+	// ErrorGuildMemberFieldInvalid will be sent if the expanded guild should include a character field that does not exist
+	// Code: 14008
+	ErrorGuildMemberFieldInvalid = Error{errors.New("the provided guild member field does not exist")}
+
+	// ErrorGuildTrackerFull will be sent if tracking guilds would exceed TIBIADATA_GUILDS_TRACKER_MAX_SIZE tracked guilds
+	// Code: 14009
+	ErrorGuildTrackerFull = Error{errors.New("the guild tracker is full")}
+
+	// ErrorGuildNotTracked will be sent if the requested guild is not tracked
+	// Code: 14010
+	ErrorGuildNotTracked = Error{errors.New("the provided guild is not tracked")}
+
+	// ErrorGuildStatisticsSortInvalid will be sent if the guild statistics are sorted by a statistic that does not exist
+	// Code: 14011
+	ErrorGuildStatisticsSortInvalid = Error{errors.New("the provided guild statistics sort does not exist")}
+
+	// ErrorGuildLeaderboardSortInvalid will be sent if the guild leaderboard is sorted by a value that does not exist
+	// Code: 14012
+	ErrorGuildLeaderboardSortInvalid = Error{errors.New("the provided guild leaderboard sort does not exist")}
+
+	// ErrorWarGuildsInvalid will be sent if a war is not tracked between two different guilds
+	// Code: 14013
+	ErrorWarGuildsInvalid = Error{errors.New("the provided war guilds must be two different guilds")}
+
+	// ErrorWarGuildsDifferentWorlds will be sent if the guilds of a war are not on the same world
+	// Code: 14014
+	ErrorWarGuildsDifferentWorlds = Error{errors.New("the provided war guilds are not on the same world")}
+
+	// ErrorWarTrackerFull will be sent if tracking a war would exceed TIBIADATA_WAR_TRACKER_MAX_SIZE tracked wars
+	// Code: 14015
+	ErrorWarTrackerFull = Error{errors.New("the war tracker is full")}
+
+	// ErrorWarNotTracked will be sent if the requested war is not tracked
+	// Code: 14016
+	ErrorWarNotTracked = Error{errors.New("the provided war is not tracked")}
+
+	// ErrorGuildTooManyMembers will be sent if the expanded guild has more members than TIBIADATA_GUILD_EXPANDED_MAX_MEMBERS
+	// Code: 14017
+	ErrorGuildTooManyMembers = Error{errors.New("the provided guild has too many members to be expanded")}
+
 	///////////////////
 	// Tibia Errors //
 	/////////////////
@@ -229,6 +397,52 @@ func (e Error) Code() int {
 		return 9001
 	case ErrorRestrictionMode:
 		return 9002
+	case ErrorFieldsPathInvalid:
+		return 9003
+	case ErrorFormatNotSupported:
+		return 9004
+	case ErrorGraphQLQueryInvalid:
+		return 9005
+	case ErrorGraphQLQueryTooExpensive:
+		return 9006
+	case ErrorRequestBodyInvalid:
+		return 9007
+	case ErrorBatchEmpty:
+		return 9008
+	case ErrorBatchTooBig:
+		return 9009
+	case ErrorStoreNotEnabled:
+		return 9010
+	case ErrorPeriodInvalid:
+		return 9011
+	case ErrorLevelInvalid:
+		return 9012
+	case ErrorExperienceInvalid:
+		return 9013
+	case ErrorLevelOrExperienceRequired:
+		return 9014
+	case ErrorWebhookURLInvalid:
+		return 9015
+	case ErrorWebhookEventInvalid:
+		return 9016
+	case ErrorWebhookHouseInvalid:
+		return 9017
+	case ErrorWebhookNotFound:
+		return 9018
+	case ErrorWebhooksTooMany:
+		return 9019
+	case ErrorWebhookDeliveryStatusInvalid:
+		return 9020
+	case ErrorWebhookURLNotAllowed:
+		return 9021
+	case ErrorManagementNotEnabled:
+		return 9022
+	case ErrorManagementTokenInvalid:
+		return 9023
+	case ErrorWatchlistFull:
+		return 9024
+	case ErrorCharacterNotWatched:
+		return 9025
 	case ErrorCharacterNameEmpty:
 		return 10001
 	case ErrorCharacterNameTooSmall:
@@ -259,6 +473,24 @@ func (e Error) Code() int {
 		return 11007
 	case ErrorHighscorePageTooBig:
 		return 11008
+	case ErrorHighscoreWorldTypeDoesNotExist:
+		return 11009
+	case ErrorHighscoreBattlEyeDoesNotExist:
+		return 11010
+	case ErrorHighscoreFilterNeedsAllWorlds:
+		return 11011
+	case ErrorHighscoreSnapshotsNotEnough:
+		return 11012
+	case ErrorHouseFilterInvalid:
+		return 11013
+	case ErrorHouseSortInvalid:
+		return 11014
+	case ErrorHousePageInvalid:
+		return 11015
+	case ErrorWorldNotTracked:
+		return 11016
+	case ErrorWorldSnapshotsNotEnough:
+		return 11017
 	case ErrorCreatureNameEmpty:
 		return 12001
 	case ErrorCreatureNameTooSmall:
@@ -301,6 +533,26 @@ func (e Error) Code() int {
 		return 14006
 	case ErrorGuildWordTooSmall:
 		return 14007
+	case ErrorGuildMemberFieldInvalid:
+		return 14008
+	case ErrorGuildTrackerFull:
+		return 14009
+	case ErrorGuildNotTracked:
+		return 14010
+	case ErrorGuildStatisticsSortInvalid:
+		return 14011
+	case ErrorGuildLeaderboardSortInvalid:
+		return 14012
+	case ErrorWarGuildsInvalid:
+		return 14013
+	case ErrorWarGuildsDifferentWorlds:
+		return 14014
+	case ErrorWarTrackerFull:
+		return 14015
+	case ErrorWarNotTracked:
+		return 14016
+	case ErrorGuildTooManyMembers:
+		return 14017
 	case ErrorCharacterNotFound:
 		return 20001
 	case ErrorCreatureNotFound:
@@ -321,3 +573,99 @@ func (e Error) Code() int {
 		return 0
 	}
 }
+
+// Errors returns all errors ordered by their code
+func Errors() []Error {
+	return []Error{
+		ErrorAlreadyRunning,
+		ErrorValidatorNotInitiated,
+		ErrorStringCanNotBeConvertedToInt,
+		ErrorRestrictionMode,
+		ErrorFieldsPathInvalid,
+		ErrorFormatNotSupported,
+		ErrorGraphQLQueryInvalid,
+		ErrorGraphQLQueryTooExpensive,
+		ErrorRequestBodyInvalid,
+		ErrorBatchEmpty,
+		ErrorBatchTooBig,
+		ErrorStoreNotEnabled,
+		ErrorPeriodInvalid,
+		ErrorLevelInvalid,
+		ErrorExperienceInvalid,
+		ErrorLevelOrExperienceRequired,
+		ErrorWebhookURLInvalid,
+		ErrorWebhookEventInvalid,
+		ErrorWebhookHouseInvalid,
+		ErrorWebhookNotFound,
+		ErrorWebhooksTooMany,
+		ErrorWebhookDeliveryStatusInvalid,
+		ErrorWebhookURLNotAllowed,
+		ErrorManagementNotEnabled,
+		ErrorManagementTokenInvalid,
+		ErrorWatchlistFull,
+		ErrorCharacterNotWatched,
+		ErrorCharacterNameEmpty,
+		ErrorCharacterNameTooSmall,
+		ErrorCharacterNameInvalid,
+		ErrorCharacterNameIsOnlyWhiteSpace,
+		ErrorCharacterNameTooBig,
+		ErrorCharacterWordTooBig,
+		ErrorCharacterWordTooSmall,
+		ErrorInvalidNewsID,
+		ErrorWorldDoesNotExist,
+		ErrorVocationDoesNotExist,
+		ErrorHighscoreCategoryDoesNotExist,
+		ErrorHouseDoesNotExist,
+		ErrorTownDoesNotExist,
+		ErrorHighscorePageInvalid,
+		ErrorHighscorePageTooBig,
+		ErrorHighscoreWorldTypeDoesNotExist,
+		ErrorHighscoreBattlEyeDoesNotExist,
+		ErrorHighscoreFilterNeedsAllWorlds,
+		ErrorHighscoreSnapshotsNotEnough,
+		ErrorHouseFilterInvalid,
+		ErrorHouseSortInvalid,
+		ErrorHousePageInvalid,
+		ErrorWorldNotTracked,
+		ErrorWorldSnapshotsNotEnough,
+		ErrorCreatureNameEmpty,
+		ErrorCreatureNameTooSmall,
+		ErrorCreatureNameInvalid,
+		ErrorCreatureNameIsOnlyWhiteSpace,
+		ErrorCreatureNameTooBig,
+		ErrorCreatureWordTooBig,
+		ErrorCreatureWordTooSmall,
+		ErrorSpellNameEmpty,
+		ErrorSpellNameTooSmall,
+		ErrorSpellNameInvalid,
+		ErrorSpellNameIsOnlyWhiteSpace,
+		ErrorSpellNameTooBig,
+		ErrorSpellWordTooBig,
+		ErrorSpellWordTooSmall,
+		ErrorGuildNameEmpty,
+		ErrorGuildNameTooSmall,
+		ErrorGuildNameInvalid,
+		ErrorGuildNameIsOnlyWhiteSpace,
+		ErrorGuildNameTooBig,
+		ErrorGuildWordTooBig,
+		ErrorGuildWordTooSmall,
+		ErrorGuildMemberFieldInvalid,
+		ErrorGuildTrackerFull,
+		ErrorGuildNotTracked,
+		ErrorGuildStatisticsSortInvalid,
+		ErrorGuildLeaderboardSortInvalid,
+		ErrorWarGuildsInvalid,
+		ErrorWarGuildsDifferentWorlds,
+		ErrorWarTrackerFull,
+		ErrorWarNotTracked,
+		ErrorGuildTooManyMembers,
+		ErrorCharacterNotFound,
+		ErrorCreatureNotFound,
+		ErrorSpellNotFound,
+		ErrorGuildNotFound,
+		ErrorMaintenanceMode,
+		ErrStatusForbidden,
+		ErrStatusFound,
+		ErrStatusUnknown,
+	}
+}
@@ -86,3 +86,104 @@ func HighscoreCategoryFromString(input string) HighscoreCategory {
 		return HighScoreExperience
 	}
 }
+
+// HighscoreWorldType is a world type the highscores of all worlds can be filtered on
+type HighscoreWorldType int
+
+const (
+	HighscoreWorldTypeOpenPvP HighscoreWorldType = iota
+	HighscoreWorldTypeOptionalPvP
+	HighscoreWorldTypeHardcorePvP
+	HighscoreWorldTypeRetroOpenPvP
+	HighscoreWorldTypeRetroHardcorePvP
+)
+
+// validHighscoreWorldTypes maps all valid world type inputs to their world type
+var validHighscoreWorldTypes = map[string]HighscoreWorldType{
+	"open":             HighscoreWorldTypeOpenPvP,
+	"openpvp":          HighscoreWorldTypeOpenPvP,
+	"optional":         HighscoreWorldTypeOptionalPvP,
+	"optionalpvp":      HighscoreWorldTypeOptionalPvP,
+	"hardcore":         HighscoreWorldTypeHardcorePvP,
+	"hardcorepvp":      HighscoreWorldTypeHardcorePvP,
+	"retroopen":        HighscoreWorldTypeRetroOpenPvP,
+	"retroopenpvp":     HighscoreWorldTypeRetroOpenPvP,
+	"retrohardcore":    HighscoreWorldTypeRetroHardcorePvP,
+	"retrohardcorepvp": HighscoreWorldTypeRetroHardcorePvP,
+}
+
+// highscoreFilterInput removes case and separators of a filter input (e.g. Optional_PvP to optionalpvp)
+func highscoreFilterInput(input string) string {
+	return strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToLower(input))
+}
+
+// IsHighscoreWorldTypeValid reports wheter the provided string represents a valid world type
+// Check if error == nil to see whether the world type is valid or not
+func IsHighscoreWorldTypeValid(wt string) error {
+	if _, exists := validHighscoreWorldTypes[highscoreFilterInput(wt)]; !exists {
+		return ErrorHighscoreWorldTypeDoesNotExist
+	}
+
+	return nil
+}
+
+// HighscoreWorldTypeFromString returns the world type of a valid input (Open PvP otherwise)
+func HighscoreWorldTypeFromString(input string) HighscoreWorldType {
+	return validHighscoreWorldTypes[highscoreFilterInput(input)]
+}
+
+// String returns the world type as written on tibia.com (e.g. Optional PvP)
+func (wt HighscoreWorldType) String() (string, error) {
+	worldTypes := [...]string{"Open PvP", "Optional PvP", "Hardcore PvP", "Retro Open PvP", "Retro Hardcore PvP"}
+	if wt < HighscoreWorldTypeOpenPvP || wt > HighscoreWorldTypeRetroHardcorePvP {
+		return "", errors.New("invalid HighscoreWorldType value")
+	}
+	return worldTypes[wt], nil
+}
+
+// HighscoreBattlEye is a battleye protection the highscores of all worlds can be filtered on
+type HighscoreBattlEye int
+
+const (
+	HighscoreBattlEyeAny HighscoreBattlEye = iota - 1
+	HighscoreBattlEyeUnprotected
+	HighscoreBattlEyeProtected
+	HighscoreBattlEyeInitiallyProtected
+)
+
+// validHighscoreBattlEyes maps all valid battleye inputs to their battleye protection
+var validHighscoreBattlEyes = map[string]HighscoreBattlEye{
+	"":                   HighscoreBattlEyeAny,
+	"any":                HighscoreBattlEyeAny,
+	"unprotected":        HighscoreBattlEyeUnprotected,
+	"protected":          HighscoreBattlEyeProtected,
+	"initially":          HighscoreBattlEyeInitiallyProtected,
+	"initiallyprotected": HighscoreBattlEyeInitiallyProtected,
+}
+
+// IsHighscoreBattlEyeValid reports wheter the provided string represents a valid battleye protection
+// Check if error == nil to see whether the battleye protection is valid or not
+func IsHighscoreBattlEyeValid(be string) error {
+	if _, exists := validHighscoreBattlEyes[highscoreFilterInput(be)]; !exists {
+		return ErrorHighscoreBattlEyeDoesNotExist
+	}
+
+	return nil
+}
+
+// HighscoreBattlEyeFromString returns the battleye protection of a valid input (any otherwise)
+func HighscoreBattlEyeFromString(input string) HighscoreBattlEye {
+	if be, exists := validHighscoreBattlEyes[highscoreFilterInput(input)]; exists {
+		return be
+	}
+	return HighscoreBattlEyeAny
+}
+
+// String returns the battleye protection as written on tibia.com (e.g. Initially Protected)
+func (be HighscoreBattlEye) String() (string, error) {
+	battlEyes := [...]string{"Any World", "Unprotected", "Protected", "Initially Protected"}
+	if be < HighscoreBattlEyeAny || be > HighscoreBattlEyeInitiallyProtected {
+		return "", errors.New("invalid HighscoreBattlEye value")
+	}
+	return battlEyes[be+1], nil
+}
@@ -138,3 +138,59 @@ func TestHighscoreCategoryFromString(t *testing.T) {
 		})
 	}
 }
+
+func TestHighscoreWorldType(t *testing.T) {
+	assert := assert.New(t)
+
+	for input, expected := range map[string]HighscoreWorldType{
+		"open":               HighscoreWorldTypeOpenPvP,
+		"Optional PvP":       HighscoreWorldTypeOptionalPvP,
+		"optional_pvp":       HighscoreWorldTypeOptionalPvP,
+		"hardcore":           HighscoreWorldTypeHardcorePvP,
+		"retro-open-pvp":     HighscoreWorldTypeRetroOpenPvP,
+		"RetroHardcore":      HighscoreWorldTypeRetroHardcorePvP,
+		"retro_hardcore_pvp": HighscoreWorldTypeRetroHardcorePvP,
+	} {
+		assert.Nil(IsHighscoreWorldTypeValid(input), input)
+		assert.Equal(expected, HighscoreWorldTypeFromString(input), input)
+	}
+
+	assert.Equal(ErrorHighscoreWorldTypeDoesNotExist, IsHighscoreWorldTypeValid("nopvp"))
+	assert.Equal(ErrorHighscoreWorldTypeDoesNotExist, IsHighscoreWorldTypeValid(""))
+
+	stringValue, err := HighscoreWorldTypeOptionalPvP.String()
+	assert.Nil(err)
+	assert.Equal("Optional PvP", stringValue)
+
+	_, err = HighscoreWorldType(5).String()
+	assert.NotNil(err)
+}
+
+func TestHighscoreBattlEye(t *testing.T) {
+	assert := assert.New(t)
+
+	for input, expected := range map[string]HighscoreBattlEye{
+		"":                    HighscoreBattlEyeAny,
+		"any":                 HighscoreBattlEyeAny,
+		"unprotected":         HighscoreBattlEyeUnprotected,
+		"Protected":           HighscoreBattlEyeProtected,
+		"initially_protected": HighscoreBattlEyeInitiallyProtected,
+	} {
+		assert.Nil(IsHighscoreBattlEyeValid(input), input)
+		assert.Equal(expected, HighscoreBattlEyeFromString(input), input)
+	}
+
+	assert.Equal(ErrorHighscoreBattlEyeDoesNotExist, IsHighscoreBattlEyeValid("yellow"))
+	assert.Equal(HighscoreBattlEyeAny, HighscoreBattlEyeFromString("yellow"))
+
+	stringValue, err := HighscoreBattlEyeInitiallyProtected.String()
+	assert.Nil(err)
+	assert.Equal("Initially Protected", stringValue)
+
+	stringValue, err = HighscoreBattlEyeAny.String()
+	assert.Nil(err)
+	assert.Equal("Any World", stringValue)
+
+	_, err = HighscoreBattlEye(3).String()
+	assert.NotNil(err)
+}
@@ -11,6 +11,9 @@ const (
 	MaxRunesAllowedInAGuildNameWord = 14 // Larggest guild name word length possible
 	MinRunesAllowedInAGuildNameWord = 2  // Smallest character name word length possible
 
+	MaxLevel      = 100000            // Largest level accepted by the experience calculator
+	MaxExperience = 16665666694999800 // Experience of MaxLevel
+
 	// AmountOfBoostableBosses is the amount of boostable bosses.
 	// Last updated: Feb 03 2025
 	AmountOfBoostableBosses = 99
@@ -48,6 +48,26 @@ func IsNewsIDValid(ID int) error {
 	return nil
 }
 
+// IsLevelValid reports wheter the provided int represents a valid level
+// Check if error == nil to see whether the level is valid or not
+func IsLevelValid(level int) error {
+	if level < 1 || level > MaxLevel {
+		return ErrorLevelInvalid
+	}
+
+	return nil
+}
+
+// IsExperienceValid reports wheter the provided int represents a valid amount of experience
+// Check if error == nil to see whether the experience is valid or not
+func IsExperienceValid(experience int) error {
+	if experience < 0 || experience > MaxExperience {
+		return ErrorExperienceInvalid
+	}
+
+	return nil
+}
+
 // IsVocationValid reports wheter the provided string represents a valid vocation
 // Check if error == nil to see whether the vocation is valid or not
 func IsVocationValid(vocation string) error {
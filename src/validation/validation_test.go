@@ -2,6 +2,11 @@ package validation
 
 import (
 	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"strconv"
 	"sync"
 	"testing"
 
@@ -488,6 +493,75 @@ func TestErrors(t *testing.T) {
 		ErrorRestrictionMode: {
 			Code: 9002,
 		},
+		ErrorFieldsPathInvalid: {
+			Code: 9003,
+		},
+		ErrorFormatNotSupported: {
+			Code: 9004,
+		},
+		ErrorGraphQLQueryInvalid: {
+			Code: 9005,
+		},
+		ErrorGraphQLQueryTooExpensive: {
+			Code: 9006,
+		},
+		ErrorRequestBodyInvalid: {
+			Code: 9007,
+		},
+		ErrorBatchEmpty: {
+			Code: 9008,
+		},
+		ErrorBatchTooBig: {
+			Code: 9009,
+		},
+		ErrorStoreNotEnabled: {
+			Code: 9010,
+		},
+		ErrorPeriodInvalid: {
+			Code: 9011,
+		},
+		ErrorLevelInvalid: {
+			Code: 9012,
+		},
+		ErrorExperienceInvalid: {
+			Code: 9013,
+		},
+		ErrorLevelOrExperienceRequired: {
+			Code: 9014,
+		},
+		ErrorWebhookURLInvalid: {
+			Code: 9015,
+		},
+		ErrorWebhookEventInvalid: {
+			Code: 9016,
+		},
+		ErrorWebhookHouseInvalid: {
+			Code: 9017,
+		},
+		ErrorWebhookNotFound: {
+			Code: 9018,
+		},
+		ErrorWebhooksTooMany: {
+			Code: 9019,
+		},
+		ErrorWebhookDeliveryStatusInvalid: {
+			Code: 9020,
+		},
+		ErrorWebhookURLNotAllowed: {
+			Code: 9021,
+		},
+		ErrorManagementNotEnabled: {
+			Code: 9022,
+		},
+		ErrorManagementTokenInvalid: {
+			Code: 9023,
+		},
+		ErrorWatchlistFull: {
+			Code: 9024,
+		},
+		ErrorCharacterNotWatched: {
+			Code: 9025,
+		},
 		ErrorCharacterNameEmpty: {
 			Code: 10001,
 		},
@@ -533,6 +607,33 @@ func TestErrors(t *testing.T) {
 		ErrorHighscorePageTooBig: {
 			Code: 11008,
 		},
+		ErrorHighscoreWorldTypeDoesNotExist: {
+			Code: 11009,
+		},
+		ErrorHighscoreBattlEyeDoesNotExist: {
+			Code: 11010,
+		},
+		ErrorHighscoreFilterNeedsAllWorlds: {
+			Code: 11011,
+		},
+		ErrorHighscoreSnapshotsNotEnough: {
+			Code: 11012,
+		},
+		ErrorHouseFilterInvalid: {
+			Code: 11013,
+		},
+		ErrorHouseSortInvalid: {
+			Code: 11014,
+		},
+		ErrorHousePageInvalid: {
+			Code: 11015,
+		},
+		ErrorWorldNotTracked: {
+			Code: 11016,
+		},
+		ErrorWorldSnapshotsNotEnough: {
+			Code: 11017,
+		},
 		ErrorCreatureNameEmpty: {
 			Code: 12001,
 		},
@@ -596,6 +697,36 @@ func TestErrors(t *testing.T) {
 		ErrorGuildWordTooSmall: {
 			Code: 14007,
 		},
+		ErrorGuildMemberFieldInvalid: {
+			Code: 14008,
+		},
+		ErrorGuildTrackerFull: {
+			Code: 14009,
+		},
+		ErrorGuildNotTracked: {
+			Code: 14010,
+		},
+		ErrorGuildStatisticsSortInvalid: {
+			Code: 14011,
+		},
+		ErrorGuildLeaderboardSortInvalid: {
+			Code: 14012,
+		},
+		ErrorWarGuildsInvalid: {
+			Code: 14013,
+		},
+		ErrorWarGuildsDifferentWorlds: {
+			Code: 14014,
+		},
+		ErrorWarTrackerFull: {
+			Code: 14015,
+		},
+		ErrorWarNotTracked: {
+			Code: 14016,
+		},
+		ErrorGuildTooManyMembers: {
+			Code: 14017,
+		},
 		ErrorCharacterNotFound: {
 			Code: 20001,
 		},
@@ -620,6 +751,42 @@ func TestErrors(t *testing.T) {
 	}
 }
 
+func TestErrorsComplete(t *testing.T) {
+	// the codes documented on the errors in errors.go
+	file, err := parser.ParseFile(token.NewFileSet(), "errors.go", nil, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	codeRegex := regexp.MustCompile(`Code: ([0-9]+)`)
+	var documented []int
+	ast.Inspect(file, func(n ast.Node) bool {
+		spec, ok := n.(*ast.ValueSpec)
+		if !ok || len(spec.Values) != 1 {
+			return true
+		}
+		if lit, ok := spec.Values[0].(*ast.CompositeLit); ok {
+			if ident, ok := lit.Type.(*ast.Ident); ok && ident.Name == "Error" {
+				match := codeRegex.FindStringSubmatch(spec.Doc.Text())
+				if match == nil {
+					t.Errorf("%s has no documented code", spec.Names[0].Name)
+					return true
+				}
+				code, _ := strconv.Atoi(match[1])
+				documented = append(documented, code)
+			}
+		}
+		return true
+	})
+
+	var codes []int
+	for _, err := range Errors() {
+		codes = append(codes, err.Code())
+	}
+
+	assert.Equal(t, documented, codes)
+}
+
 func TestUtils(t *testing.T) {
 	if !initiated {
 		err := Initiate(TIBIADATA_API_TESTING)
@@ -889,6 +1056,30 @@ func TestNewsIDValidator(t *testing.T) {
 	}
 }
 
+func TestLevelAndExperienceValidator(t *testing.T) {
+	for _, level := range []int{1, 2000, MaxLevel} {
+		if err := IsLevelValid(level); err != nil {
+			t.Fatalf("Level %d is being reported as invalid but should be valid, err: %s", level, err)
+		}
+	}
+	for _, level := range []int{-1, 0, MaxLevel + 1} {
+		if err := IsLevelValid(level); err != ErrorLevelInvalid {
+			t.Fatalf("Level %d is being reported as valid but should be invalid", level)
+		}
+	}
+
+	for _, experience := range []int{0, 100, MaxExperience} {
+		if err := IsExperienceValid(experience); err != nil {
+			t.Fatalf("Experience %d is being reported as invalid but should be valid, err: %s", experience, err)
+		}
+	}
+	for _, experience := range []int{-1, MaxExperience + 1} {
+		if err := IsExperienceValid(experience); err != ErrorExperienceInvalid {
+			t.Fatalf("Experience %d is being reported as valid but should be invalid", experience)
+		}
+	}
+}
+
 func TestVocationValidator(t *testing.T) {
 	err := IsVocationValid("tibia")
 	if err == nil {
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -22,6 +23,7 @@ import (
 	"github.com/gin-contrib/gzip"
 	"github.com/gin-gonic/gin"
 	"github.com/go-resty/resty/v2"
+	"google.golang.org/grpc"
 )
 
 var (
@@ -30,6 +32,9 @@ var (
 
 	// ErrorNotFound will be returned if the requests ends up in a 404
 	ErrorNotFound = errors.New("page not found")
+
+	// TibiaDataUpstreamLimiter limits the concurrent requests to tibia.com (nil if unlimited)
+	TibiaDataUpstreamLimiter chan struct{}
 )
 
 // DebugOutInformation wraps OutInformation with some debug info
@@ -73,6 +78,14 @@ type TibiaDataRequestStruct struct {
 	RawBody  bool              `json:"raw_body"`  // If set to true the whole content from tibia.com will be passed down
 }
 
+// tibiaDataEndpoint is the validated request of an endpoint together with the parser of its content
+// It is independent of gin, so that it can be used by the other servers as well
+type tibiaDataEndpoint struct {
+	Name    string                                           // The name of the handler (used for logging)
+	Request TibiaDataRequestStruct                           // The request to tibia.com
+	Parse   func(BoxContentHTML string) (interface{}, error) // The parser of the content returned by tibia.com
+}
+
 // RunWebServer starts the gin server
 // It blocks the code and will only finish execution on shutdown
 func runWebServer() {
@@ -95,15 +108,6 @@ func runWebServer() {
 	// Gin middleware to enable GZIP support
 	router.Use(gzip.Gzip(gzip.DefaultCompression))
 
-	// Set 404 not found page
-	router.NoRoute(func(c *gin.Context) {
-		TibiaDataErrorHandler(
-			c,
-			ErrorNotFound,
-			http.StatusNotFound,
-		)
-	})
-
 	// Set proxy feature of gin
 	if isEnvExist("GIN_TRUSTED_PROXIES") {
 		trustedProxies := getEnv("GIN_TRUSTED_PROXIES", "")
@@ -117,6 +121,198 @@ func runWebServer() {
 	TibiaDataRestrictionMode = getEnvAsBool("TIBIADATA_RESTRICTION_MODE", false)
 	log.Printf("[info] TibiaData API restriction-mode: %t", TibiaDataRestrictionMode)
 
+	// Set the limit of concurrent requests to tibia.com (0 disables the limit)
+	if upstreamConcurrency := getEnvAsInt("TIBIADATA_UPSTREAM_CONCURRENCY", 10); upstreamConcurrency > 0 {
+		TibiaDataUpstreamLimiter = make(chan struct{}, upstreamConcurrency)
+	}
+	log.Printf("[info] TibiaData API upstream-concurrency: %d", cap(TibiaDataUpstreamLimiter))
+
+	// Set the limits of requests fetching many pages from tibia.com
+	TibiaDataFanOutConcurrency = getEnvAsInt("TIBIADATA_FANOUT_CONCURRENCY", TibiaDataFanOutConcurrency)
+	TibiaDataCharactersBatchMaxSize = getEnvAsInt("TIBIADATA_CHARACTERS_BATCH_MAX_SIZE", TibiaDataCharactersBatchMaxSize)
+	log.Printf("[info] TibiaData API fanout-concurrency: %d, characters-batch-max-size: %d", TibiaDataFanOutConcurrency, TibiaDataCharactersBatchMaxSize)
+
+	// Set the limits of graphql queries
+	TibiaDataGraphQLMaxCost = getEnvAsInt("TIBIADATA_GRAPHQL_MAX_COST", TibiaDataGraphQLMaxCost)
+	TibiaDataGraphQLConcurrency = getEnvAsInt("TIBIADATA_GRAPHQL_CONCURRENCY", TibiaDataGraphQLConcurrency)
+	log.Printf("[info] TibiaData API graphql-max-cost: %d, graphql-concurrency: %d", TibiaDataGraphQLMaxCost, TibiaDataGraphQLConcurrency)
+
+	// Set how long highscore pages are cached for the character ranks (0 disables the cache)
+	highscoresCacheTTL := time.Duration(getEnvAsInt("TIBIADATA_HIGHSCORES_CACHE_TTL", 300)) * time.Second
+	tibiaHighscoresPageCache.SetTTL(highscoresCacheTTL)
+	log.Printf("[info] TibiaData API highscores-cache-ttl: %s", highscoresCacheTTL)
+
+	// Set how many highscore pages are searched per category for the character ranks
+	TibiaDataCharacterRanksMaxPages = getEnvAsInt("TIBIADATA_CHARACTER_RANKS_MAX_PAGES", TibiaDataCharacterRanksMaxPages)
+	log.Printf("[info] TibiaData API character-ranks-max-pages: %d", TibiaDataCharacterRanksMaxPages)
+
+	// Set how long the guilds of a world are cached for the guild leaderboard and statistics (0 disables the cache)
+	guildsLeaderboardCacheTTL := time.Duration(getEnvAsInt("TIBIADATA_GUILDS_LEADERBOARD_CACHE_TTL", 10800)) * time.Second
+	tibiaGuildsWorldGuildsCache.SetTTL(guildsLeaderboardCacheTTL)
+	log.Printf("[info] TibiaData API guilds-leaderboard-cache-ttl: %s", guildsLeaderboardCacheTTL)
+
+	// Set the maximum number of members of an expanded guild
+	TibiaDataGuildExpandedMaxMembers = getEnvAsInt("TIBIADATA_GUILD_EXPANDED_MAX_MEMBERS", TibiaDataGuildExpandedMaxMembers)
+	log.Printf("[info] TibiaData API guild-expanded-max-members: %d", TibiaDataGuildExpandedMaxMembers)
+
+	// Set how long the house pages of the house search are cached (0 disables the cache) and how many are fetched per search
+	housesSearchCacheTTL := time.Duration(getEnvAsInt("TIBIADATA_HOUSES_SEARCH_CACHE_TTL", 86400)) * time.Second
+	tibiaHousesSearchCache.SetTTL(housesSearchCacheTTL)
+	TibiaDataHousesSearchMaxFetches = getEnvAsInt("TIBIADATA_HOUSES_SEARCH_MAX_FETCHES", TibiaDataHousesSearchMaxFetches)
+	log.Printf("[info] TibiaData API houses-search-cache-ttl: %s, houses-search-max-fetches: %d", housesSearchCacheTTL, TibiaDataHousesSearchMaxFetches)
+
+	// Set how long the auctions of the house pages of the house search are cached (0 disables the cache)
+	housesSearchAuctionCacheTTL := time.Duration(getEnvAsInt("TIBIADATA_HOUSES_SEARCH_AUCTION_CACHE_TTL", 300)) * time.Second
+	tibiaHousesSearchAuctionCache.SetTTL(housesSearchAuctionCacheTTL)
+	log.Printf("[info] TibiaData API houses-search-auction-cache-ttl: %s", housesSearchAuctionCacheTTL)
+
+	// Open the store of the features keeping data over time if TIBIADATA_STORE_PATH is set
+	if isEnvExist("TIBIADATA_STORE_PATH") {
+		store, err := TibiaDataStoreOpen(getEnv("TIBIADATA_STORE_PATH", ""))
+		if err != nil {
+			log.Fatal("[error] TibiaData API store could not be opened:", err)
+		}
+		TibiaDataStore = store
+		log.Printf("[info] TibiaData API store: %s", store.Path())
+	}
+
+	// Set the highscore lists that are snapshotted (needs the store)
+	snapshotLists, err := ParseHighscoresSnapshotLists(getEnv("TIBIADATA_HIGHSCORES_SNAPSHOTS", ""))
+	if err != nil {
+		log.Fatal("[error] TibiaData API highscores snapshots:", err)
+	}
+	TibiaDataHighscoresSnapshotLists = snapshotLists
+	TibiaDataHighscoresSnapshotInterval = time.Duration(getEnvAsInt("TIBIADATA_HIGHSCORES_SNAPSHOT_INTERVAL_MINUTES", int(TibiaDataHighscoresSnapshotInterval/time.Minute))) * time.Minute
+	TibiaDataHighscoresSnapshotRetention = time.Duration(getEnvAsInt("TIBIADATA_HIGHSCORES_SNAPSHOT_RETENTION_DAYS", int(TibiaDataHighscoresSnapshotRetention/(24*time.Hour)))) * 24 * time.Hour
+	TibiaDataHighscoresSnapshotMaxCount = getEnvAsInt("TIBIADATA_HIGHSCORES_SNAPSHOT_MAX_COUNT", TibiaDataHighscoresSnapshotMaxCount)
+	log.Printf("[info] TibiaData API highscores-snapshots: %v, interval: %s, retention: %s, max-count: %d", TibiaDataHighscoresSnapshotLists, TibiaDataHighscoresSnapshotInterval, TibiaDataHighscoresSnapshotRetention, TibiaDataHighscoresSnapshotMaxCount)
+
+	// Set the token of the endpoints managing the store, they are disabled without it
+	TibiaDataManagementToken = getEnv("TIBIADATA_MANAGEMENT_TOKEN", "")
+	log.Printf("[info] TibiaData API management-token: %t", TibiaDataManagementToken != "")
+
+	// Set the polling of the watchlist (needs the store)
+	TibiaDataWatchlistInterval = time.Duration(getEnvAsInt("TIBIADATA_WATCHLIST_INTERVAL_MINUTES", int(TibiaDataWatchlistInterval/time.Minute))) * time.Minute
+	TibiaDataWatchlistRetention = time.Duration(getEnvAsInt("TIBIADATA_WATCHLIST_RETENTION_DAYS", int(TibiaDataWatchlistRetention/(24*time.Hour)))) * 24 * time.Hour
+	TibiaDataWatchlistMaxSize = getEnvAsInt("TIBIADATA_WATCHLIST_MAX_SIZE", TibiaDataWatchlistMaxSize)
+	log.Printf("[info] TibiaData API watchlist interval: %s, retention: %s, max-size: %d", TibiaDataWatchlistInterval, TibiaDataWatchlistRetention, TibiaDataWatchlistMaxSize)
+
+	// Set the polling and deliveries of the webhooks (needs the store)
+	TibiaDataWebhooksInterval = time.Duration(getEnvAsInt("TIBIADATA_WEBHOOKS_INTERVAL_MINUTES", int(TibiaDataWebhooksInterval/time.Minute))) * time.Minute
+	TibiaDataWebhooksMaxAttempts = getEnvAsInt("TIBIADATA_WEBHOOKS_MAX_ATTEMPTS", TibiaDataWebhooksMaxAttempts)
+	TibiaDataWebhooksRetryDelay = time.Duration(getEnvAsInt("TIBIADATA_WEBHOOKS_RETRY_DELAY_SECONDS", int(TibiaDataWebhooksRetryDelay/time.Second))) * time.Second
+	TibiaDataWebhooksRetention = time.Duration(getEnvAsInt("TIBIADATA_WEBHOOKS_RETENTION_DAYS", int(TibiaDataWebhooksRetention/(24*time.Hour)))) * 24 * time.Hour
+	TibiaDataWebhooksMaxSize = getEnvAsInt("TIBIADATA_WEBHOOKS_MAX_SIZE", TibiaDataWebhooksMaxSize)
+	TibiaDataWebhooksAllowLoopback = getEnvAsBool("TIBIADATA_WEBHOOKS_ALLOW_LOOPBACK", TibiaDataWebhooksAllowLoopback)
+	log.Printf("[info] TibiaData API webhooks interval: %s, max-attempts: %d, retry-delay: %s, retention: %s, max-size: %d, allow-loopback: %t", TibiaDataWebhooksInterval, TibiaDataWebhooksMaxAttempts, TibiaDataWebhooksRetryDelay, TibiaDataWebhooksRetention, TibiaDataWebhooksMaxSize, TibiaDataWebhooksAllowLoopback)
+
+	// Set the polling of the guild tracker (needs the store)
+	TibiaDataGuildsTrackerInterval = time.Duration(getEnvAsInt("TIBIADATA_GUILDS_TRACKER_INTERVAL_MINUTES", int(TibiaDataGuildsTrackerInterval/time.Minute))) * time.Minute
+	TibiaDataGuildsTrackerMaxSize = getEnvAsInt("TIBIADATA_GUILDS_TRACKER_MAX_SIZE", TibiaDataGuildsTrackerMaxSize)
+	log.Printf("[info] TibiaData API guild-tracker interval: %s, max-size: %d", TibiaDataGuildsTrackerInterval, TibiaDataGuildsTrackerMaxSize)
+
+	// Set the polling of the war tracker (needs the store)
+	TibiaDataWarTrackerInterval = time.Duration(getEnvAsInt("TIBIADATA_WAR_TRACKER_INTERVAL_MINUTES", int(TibiaDataWarTrackerInterval/time.Minute))) * time.Minute
+	TibiaDataWarTrackerMaxSize = getEnvAsInt("TIBIADATA_WAR_TRACKER_MAX_SIZE", TibiaDataWarTrackerMaxSize)
+	log.Printf("[info] TibiaData API war-tracker interval: %s, max-size: %d", TibiaDataWarTrackerInterval, TibiaDataWarTrackerMaxSize)
+
+	// Set the polling of the world streams
+	TibiaDataWorldStreamInterval = time.Duration(getEnvAsInt("TIBIADATA_WORLD_STREAM_INTERVAL_SECONDS", int(TibiaDataWorldStreamInterval/time.Second))) * time.Second
+	TibiaDataWorldStreamReplaySize = getEnvAsInt("TIBIADATA_WORLD_STREAM_REPLAY_SIZE", TibiaDataWorldStreamReplaySize)
+	log.Printf("[info] TibiaData API world-stream interval: %s, replay-size: %d", TibiaDataWorldStreamInterval, TibiaDataWorldStreamReplaySize)
+
+	// Set the worlds whose online players are snapshotted (needs the store)
+	TibiaDataWorldsTracked = ParseWorldsTracked(getEnv("TIBIADATA_WORLDS_TRACKED", ""))
+	TibiaDataWorldsSnapshotInterval = time.Duration(getEnvAsInt("TIBIADATA_WORLDS_SNAPSHOT_INTERVAL_MINUTES", int(TibiaDataWorldsSnapshotInterval/time.Minute))) * time.Minute
+	TibiaDataWorldsSnapshotRetention = time.Duration(getEnvAsInt("TIBIADATA_WORLDS_SNAPSHOT_RETENTION_DAYS", int(TibiaDataWorldsSnapshotRetention/(24*time.Hour)))) * 24 * time.Hour
+	log.Printf("[info] TibiaData API worlds-tracked: %v, interval: %s, retention: %s", TibiaDataWorldsTracked, TibiaDataWorldsSnapshotInterval, TibiaDataWorldsSnapshotRetention)
+
+	// Set the endpoints
+	tibiaDataRoutes(router)
+
+	// Build the http server
+	server := &http.Server{
+		Addr:    ":8080", // listen and serve on 0.0.0.0:8080 (for windows "localhost:8080")
+		Handler: router,
+	}
+
+	// Start the gRPC server on its own port if TIBIADATA_GRPC_PORT is set
+	var grpcServer *grpc.Server
+	if isEnvExist("TIBIADATA_GRPC_PORT") {
+		grpcServer = newGRPCServer(TibiaDataHTMLDataCollector)
+		go runGRPCServer(grpcServer, ":"+getEnv("TIBIADATA_GRPC_PORT", "50051"))
+	}
+
+	// Start the highscore snapshots, the watchlist polling, the webhooks, the guild and war trackers and the online snapshots in the background
+	stopBackground := make(chan struct{})
+	if TibiaDataStore != nil && len(TibiaDataHighscoresSnapshotLists) > 0 && TibiaDataHighscoresSnapshotInterval > 0 {
+		go runHighscoresSnapshots(TibiaDataStore, TibiaDataHighscoresSnapshotLists, TibiaDataHighscoresSnapshotInterval, TibiaDataHTMLDataCollector, stopBackground)
+	}
+	if TibiaDataStore != nil && TibiaDataWatchlistInterval > 0 {
+		go runWatchlist(TibiaDataStore, TibiaDataWatchlistInterval, TibiaDataHTMLDataCollector, stopBackground)
+	}
+	if TibiaDataStore != nil && TibiaDataWebhooksInterval > 0 {
+		go runWebhooks(TibiaDataStore, TibiaDataWebhooksInterval, TibiaDataHTMLDataCollector, stopBackground)
+	}
+	if TibiaDataStore != nil && TibiaDataGuildsTrackerInterval > 0 {
+		go runGuildsTracker(TibiaDataStore, TibiaDataGuildsTrackerInterval, TibiaDataHTMLDataCollector, stopBackground)
+	}
+	if TibiaDataStore != nil && TibiaDataWarTrackerInterval > 0 {
+		go runGuildsWarTracker(TibiaDataStore, TibiaDataWarTrackerInterval, TibiaDataHTMLDataCollector, stopBackground)
+	}
+	if TibiaDataStore != nil && len(TibiaDataWorldsTracked) > 0 && TibiaDataWorldsSnapshotInterval > 0 {
+		go runWorldsSnapshots(TibiaDataStore, TibiaDataWorldsTracked, TibiaDataWorldsSnapshotInterval, TibiaDataHTMLDataCollector, stopBackground)
+	}
+
+	// Prepare for a graceful shutdown
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt)
+
+	// Run a go routine that will receive the shutdown input
+	go func() {
+		<-quit
+		log.Println("[info] TibiaData API received shutdown input")
+		if grpcServer != nil {
+			grpcServer.Stop()
+		}
+		close(stopBackground)
+		if TibiaDataStore != nil {
+			if err := TibiaDataStore.Close(); err != nil {
+				log.Println("[error] TibiaData API store close error:", err)
+			}
+		}
+		if err := server.Close(); err != nil {
+			log.Fatal("[error] TibiaData API server close error:", err)
+		}
+	}()
+
+	// setting readyz endpoint to true
+	isReady.Store(true)
+
+	log.Println("[info] TibiaData API starting webserver")
+
+	// Run the server
+	if err := server.ListenAndServe(); err != nil {
+		if err == http.ErrServerClosed {
+			log.Println("[info] TibiaData API server gracefully shut down")
+		} else {
+			log.Fatal("[error] TibiaData API server closed unexpectedly")
+		}
+	}
+}
+
+// tibiaDataRoutes registers all endpoints of the API on the router
+// Every route has to be documented in the OpenAPI document (see openapi.go).
+func tibiaDataRoutes(router *gin.Engine) {
+	// Set 404 not found page
+	router.NoRoute(func(c *gin.Context) {
+		TibiaDataErrorHandler(
+			c,
+			ErrorNotFound,
+			http.StatusNotFound,
+		)
+	})
+
 	// Set the ping endpoint
 	router.GET("/ping", func(c *gin.Context) {
 		data := Information{
@@ -163,33 +359,48 @@ func runWebServer() {
 
 		// Tibia characters
 		v4.GET("/character/:name", tibiaCharactersCharacter)
+		v4.GET("/character/:name/guildhistory", tibiaCharactersGuildHistory)
+		v4.GET("/character/:name/ranks", tibiaCharactersRanks)
+		v4.POST("/characters", tibiaCharactersBatch)
 
 		// Tibia creatures
 		v4.GET("/creature/:race", tibiaCreaturesCreature)
 		v4.GET("/creatures", tibiaCreaturesOverview)
 
+		// Tibia experience
+		v4.GET("/experience", tibiaExperience)
+
 		// Tibia fansites
 		v4.GET("/fansites", tibiaFansites)
 
 		// Tibia guilds
 		v4.GET("/guild/:name", tibiaGuildsGuild)
-		// v4.GET("/guild/:name/events",TibiaGuildsGuildEvents)
-		// v4.GET("/guild/:name/wars",TibiaGuildsGuildWars)
+		v4.GET("/guild/:name/events", tibiaGuildsGuildEvents)
+		v4.GET("/guild/:name/expanded", tibiaGuildsGuildExpanded)
+		v4.GET("/guild/:name/statistics", tibiaGuildsGuildStatistics)
+		v4.GET("/guild/:name/wars", tibiaGuildsGuildWars)
 		v4.GET("/guilds/:world", tibiaGuildsOverview)
+		v4.GET("/guilds/:world/leaderboard", tibiaGuildsLeaderboard)
+		v4.GET("/guilds/:world/statistics", tibiaGuildsOverviewStatistics)
 
 		// Tibia highscores
 		v4.GET("/highscores/:world", func(c *gin.Context) {
-			c.Redirect(http.StatusMovedPermanently, v4.BasePath()+"/highscores/"+c.Param("world")+"/experience/"+TibiaDataDefaultVoc+"/1")
+			c.Redirect(http.StatusMovedPermanently, v4.BasePath()+"/highscores/"+c.Param("world")+"/experience/"+TibiaDataDefaultVoc+"/1"+tibiaDataRawQuery(c))
 		})
 		v4.GET("/highscores/:world/:category", func(c *gin.Context) {
-			c.Redirect(http.StatusMovedPermanently, v4.BasePath()+"/highscores/"+c.Param("world")+"/"+c.Param("category")+"/"+TibiaDataDefaultVoc+"/1")
+			c.Redirect(http.StatusMovedPermanently, v4.BasePath()+"/highscores/"+c.Param("world")+"/"+c.Param("category")+"/"+TibiaDataDefaultVoc+"/1"+tibiaDataRawQuery(c))
 		})
 		v4.GET("/highscores/:world/:category/:vocation", tibiaHighscores)
 		v4.GET("/highscores/:world/:category/:vocation/:page", tibiaHighscores)
+		v4.GET("/highscores/:world/:category/:vocation/all", tibiaHighscoresAll)
+		v4.GET("/highscores/:world/:category/:vocation/deltas", tibiaHighscoresDeltas)
+		v4.GET("/highscores/:world/:category/:vocation/rankchanges", tibiaHighscoresRankChanges)
 
 		// Tibia houses
 		v4.GET("/house/:world/:house_id", tibiaHousesHouse)
+		v4.GET("/houses/:world", tibiaHousesWorld)
 		v4.GET("/houses/:world/:town", tibiaHousesOverview)
+		v4.GET("/houses/:world/search", tibiaHousesSearch)
 
 		// Tibia killstatistics
 		v4.GET("/killstatistics/:world", tibiaKillstatistics)
@@ -205,11 +416,48 @@ func runWebServer() {
 		v4.GET("/spell/:spell_id", tibiaSpellsSpell)
 		v4.GET("/spells", tibiaSpellsOverview)
 
+		// Watchlist of characters
+		v4.GET("/watchlist", tibiaWatchlist)
+		v4.POST("/watchlist", tibiaDataManagementAuth, tibiaWatchlistAdd)
+		v4.DELETE("/watchlist/:name", tibiaDataManagementAuth, tibiaWatchlistRemove)
+		v4.GET("/watchlist/:name/timeline", tibiaWatchlistTimeline)
+		v4.GET("/watchlist/:name/deaths", tibiaWatchlistDeaths)
+
+		// Tracker of guild members
+		v4.GET("/guildtracker", tibiaGuildsTracker)
+		v4.POST("/guildtracker", tibiaDataManagementAuth, tibiaGuildsTrackerAdd)
+		v4.DELETE("/guildtracker/:name", tibiaDataManagementAuth, tibiaGuildsTrackerRemove)
+		v4.GET("/guildtracker/:name/history", tibiaGuildsHistory)
+
+		// War tracker of two guilds
+		v4.GET("/wartracker", tibiaGuildsWarTracker)
+		v4.POST("/wartracker", tibiaDataManagementAuth, tibiaGuildsWarTrackerAdd)
+		v4.DELETE("/wartracker/:id", tibiaDataManagementAuth, tibiaGuildsWarTrackerRemove)
+		v4.GET("/wartracker/:id/kills", tibiaGuildsWarKills)
+		v4.GET("/wartracker/:id/scoreboard", tibiaGuildsWarScoreboard)
+
+		// Webhooks of character, guild and house events
+		v4.GET("/webhooks", tibiaDataManagementAuth, tibiaWebhooks)
+		v4.POST("/webhooks", tibiaDataManagementAuth, tibiaWebhooksAdd)
+		v4.DELETE("/webhooks/:id", tibiaDataManagementAuth, tibiaWebhooksRemove)
+		v4.GET("/webhooks/:id/deliveries", tibiaDataManagementAuth, tibiaWebhooksDeliveries)
+
 		// Tibia worlds
 		v4.GET("/world/:name", tibiaWorldsWorld)
+		v4.GET("/world/:name/online/diff", tibiaWorldsOnlineDiff)
+		v4.GET("/world/:name/stream", tibiaWorldsWorldStream)
+		v4.GET("/world/:name/ws", tibiaWorldsWorldWebSocket)
 		v4.GET("/worlds", tibiaWorldsOverview)
 	}
 
+	// OpenAPI document and interactive documentation
+	router.GET("/openapi.json", tibiaOpenAPI)
+	router.GET("/docs", tibiaDocs)
+
+	// TibiaData GraphQL endpoint
+	router.GET("/graphql", tibiaGraphQL)
+	router.POST("/graphql", tibiaGraphQL)
+
 	// Container version details endpoint
 	router.GET("/versions", func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{
@@ -219,39 +467,6 @@ func runWebServer() {
 			"edition": TibiaDataBuildEdition,
 		})
 	})
-
-	// Build the http server
-	server := &http.Server{
-		Addr:    ":8080", // listen and serve on 0.0.0.0:8080 (for windows "localhost:8080")
-		Handler: router,
-	}
-
-	// Prepare for a graceful shutdown
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, os.Interrupt)
-
-	// Run a go routine that will receive the shutdown input
-	go func() {
-		<-quit
-		log.Println("[info] TibiaData API received shutdown input")
-		if err := server.Close(); err != nil {
-			log.Fatal("[error] TibiaData API server close error:", err)
-		}
-	}()
-
-	// setting readyz endpoint to true
-	isReady.Store(true)
-
-	log.Println("[info] TibiaData API starting webserver")
-
-	// Run the server
-	if err := server.ListenAndServe(); err != nil {
-		if err == http.ErrServerClosed {
-			log.Println("[info] TibiaData API server gracefully shut down")
-		} else {
-			log.Fatal("[error] TibiaData API server closed unexpectedly")
-		}
-	}
 }
 
 // BoostableBosses godoc
@@ -266,19 +481,24 @@ func runWebServer() {
 // @Failure      503  {object}  Information
 // @Router       /v4/boostablebosses [get]
 func tibiaBoostableBosses(c *gin.Context) {
+	tibiaDataEndpointHandler(c, tibiaBoostableBossesEndpoint(), nil)
+}
+
+// tibiaBoostableBossesEndpoint returns the endpoint of the boostable bosses
+func tibiaBoostableBossesEndpoint() tibiaDataEndpoint {
 	tibiadataRequest := TibiaDataRequestStruct{
 		Method:  resty.MethodGet,
 		URL:     "https://www.tibia.com/library/?subtopic=boostablebosses",
 		RawBody: true,
 	}
 
-	tibiaDataRequestHandler(
-		c,
-		tibiadataRequest,
-		func(BoxContentHTML string) (interface{}, error) {
+	return tibiaDataEndpoint{
+		Name:    "TibiaBoostableBosses",
+		Request: tibiadataRequest,
+		Parse: func(BoxContentHTML string) (interface{}, error) {
 			return TibiaBoostableBossesOverviewImpl(BoxContentHTML, tibiadataRequest.URL)
 		},
-		"TibiaBoostableBosses")
+	}
 }
 
 // Character godoc
@@ -297,11 +517,16 @@ func tibiaCharactersCharacter(c *gin.Context) {
 	// Getting params from URL
 	name := c.Param("name")
 
+	endpoint, err := tibiaCharactersCharacterEndpoint(name)
+	tibiaDataEndpointHandler(c, endpoint, err)
+}
+
+// tibiaCharactersCharacterEndpoint validates the name and returns the endpoint of the character
+func tibiaCharactersCharacterEndpoint(name string) (tibiaDataEndpoint, error) {
 	// Validate the name
 	err := validation.IsCharacterNameValid(name)
 	if err != nil {
-		TibiaDataErrorHandler(c, err, http.StatusBadRequest)
-		return
+		return tibiaDataEndpoint{}, err
 	}
 
 	// Build the request structure
@@ -310,14 +535,68 @@ func tibiaCharactersCharacter(c *gin.Context) {
 		URL:    "https://www.tibia.com/community/?subtopic=characters&name=" + TibiaDataQueryEscapeString(name),
 	}
 
-	// Handle the request
-	tibiaDataRequestHandler(
-		c,
-		tibiadataRequest,
-		func(BoxContentHTML string) (interface{}, error) {
+	return tibiaDataEndpoint{
+		Name:    "TibiaCharactersCharacter",
+		Request: tibiadataRequest,
+		Parse: func(BoxContentHTML string) (interface{}, error) {
 			return TibiaCharactersCharacterImpl(BoxContentHTML, tibiadataRequest.URL)
 		},
-		"TibiaCharactersCharacter")
+	}, nil
+}
+
+// CharacterRanks godoc
+// @Summary      Highscore ranks of one character
+// @Description  Show the rank of one character in every highscore category of its world and vocation
+// @Description  Categories the character is not on have ranked false, categories the searched pages do not decide have ranked null. In restriction mode, the highscores of all vocations are searched.
+// @Tags         characters
+// @Accept       json
+// @Produce      json
+// @Param        name path string true "The character name" extensions(x-example=Trollefar)
+// @Success      200  {object}  CharacterRanksResponse
+// @Failure      400  {object}  Information
+// @Failure      404  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/character/{name}/ranks [get]
+func tibiaCharactersRanks(c *gin.Context) {
+	// Getting params from URL
+	name := c.Param("name")
+
+	jsonData, err := TibiaCharactersRanksImpl(name, TibiaDataHTMLDataCollector)
+	if err != nil {
+		TibiaDataErrorHandler(c, err, 0)
+		return
+	}
+
+	TibiaDataAPIHandleResponse(c, "TibiaCharactersRanks", jsonData)
+}
+
+// Characters godoc
+// @Summary      Show many characters
+// @Description  Show all information about many characters at once
+// @Description  Every name is validated and fetched on its own, so the response has a result or an error per name.
+// @Tags         characters
+// @Accept       json
+// @Produce      json
+// @Param        request body CharactersRequest true "The character names"
+// @Success      200  {object}  CharactersResponse
+// @Failure      400  {object}  Information
+// @Failure      404  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/characters [post]
+func tibiaCharactersBatch(c *gin.Context) {
+	var request CharactersRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		TibiaDataErrorHandler(c, validation.ErrorRequestBodyInvalid, http.StatusBadRequest)
+		return
+	}
+
+	jsonData, err := TibiaCharactersBatchImpl(request.Names, TibiaDataHTMLDataCollector)
+	if err != nil {
+		TibiaDataErrorHandler(c, err, 0)
+		return
+	}
+
+	TibiaDataAPIHandleResponse(c, "TibiaCharactersBatch", jsonData)
 }
 
 // Creatures godoc
@@ -332,18 +611,23 @@ func tibiaCharactersCharacter(c *gin.Context) {
 // @Failure      503  {object}  Information
 // @Router       /v4/creatures [get]
 func tibiaCreaturesOverview(c *gin.Context) {
+	tibiaDataEndpointHandler(c, tibiaCreaturesOverviewEndpoint(), nil)
+}
+
+// tibiaCreaturesOverviewEndpoint returns the endpoint of the creatures overview
+func tibiaCreaturesOverviewEndpoint() tibiaDataEndpoint {
 	tibiadataRequest := TibiaDataRequestStruct{
 		Method: resty.MethodGet,
 		URL:    "https://www.tibia.com/library/?subtopic=creatures",
 	}
 
-	tibiaDataRequestHandler(
-		c,
-		tibiadataRequest,
-		func(BoxContentHTML string) (interface{}, error) {
+	return tibiaDataEndpoint{
+		Name:    "TibiaCreaturesOverview",
+		Request: tibiadataRequest,
+		Parse: func(BoxContentHTML string) (interface{}, error) {
 			return TibiaCreaturesOverviewImpl(BoxContentHTML, tibiadataRequest.URL)
 		},
-		"TibiaCreaturesOverview")
+	}
 }
 
 // Creature godoc
@@ -362,11 +646,16 @@ func tibiaCreaturesCreature(c *gin.Context) {
 	// getting params from URL
 	race := c.Param("race")
 
+	endpoint, err := tibiaCreaturesCreatureEndpoint(race)
+	tibiaDataEndpointHandler(c, endpoint, err)
+}
+
+// tibiaCreaturesCreatureEndpoint validates the race and returns the endpoint of the creature
+func tibiaCreaturesCreatureEndpoint(race string) (tibiaDataEndpoint, error) {
 	// Validate the race
 	endpoint, err := validation.IsCreatureNameValid(race)
 	if err != nil {
-		TibiaDataErrorHandler(c, err, 0)
-		return
+		return tibiaDataEndpoint{}, err
 	}
 
 	tibiadataRequest := TibiaDataRequestStruct{
@@ -374,13 +663,34 @@ func tibiaCreaturesCreature(c *gin.Context) {
 		URL:    "https://www.tibia.com/library/?subtopic=creatures&race=" + endpoint,
 	}
 
-	tibiaDataRequestHandler(
-		c,
-		tibiadataRequest,
-		func(BoxContentHTML string) (interface{}, error) {
+	return tibiaDataEndpoint{
+		Name:    "TibiaCreaturesCreature",
+		Request: tibiadataRequest,
+		Parse: func(BoxContentHTML string) (interface{}, error) {
 			return TibiaCreaturesCreatureImpl(endpoint, BoxContentHTML, tibiadataRequest.URL)
 		},
-		"TibiaCreaturesCreature")
+	}, nil
+}
+
+// Experience godoc
+// @Summary      Experience calculator
+// @Description  Show the experience of a level or the level of an amount of experience, and the progress towards the next level
+// @Tags         experience
+// @Accept       json
+// @Produce      json
+// @Param        level      query int false "The level" extensions(x-example=100)
+// @Param        experience query int false "The experience" extensions(x-example=15694800)
+// @Success      200  {object}  ExperienceResponse
+// @Failure      400  {object}  Information
+// @Router       /v4/experience [get]
+func tibiaExperience(c *gin.Context) {
+	jsonData, err := TibiaExperienceImpl(c.Query("level"), c.Query("experience"))
+	if err != nil {
+		TibiaDataErrorHandler(c, err, 0)
+		return
+	}
+
+	TibiaDataAPIHandleResponse(c, "TibiaExperience", jsonData)
 }
 
 // Fansites godoc
@@ -395,27 +705,34 @@ func tibiaCreaturesCreature(c *gin.Context) {
 // @Failure      503  {object}  Information
 // @Router       /v4/fansites [get]
 func tibiaFansites(c *gin.Context) {
+	tibiaDataEndpointHandler(c, tibiaFansitesEndpoint(), nil)
+}
+
+// tibiaFansitesEndpoint returns the endpoint of the fansites
+func tibiaFansitesEndpoint() tibiaDataEndpoint {
 	tibiadataRequest := TibiaDataRequestStruct{
 		Method: resty.MethodGet,
 		URL:    "https://www.tibia.com/community/?subtopic=fansites",
 	}
 
-	tibiaDataRequestHandler(
-		c,
-		tibiadataRequest,
-		func(BoxContentHTML string) (interface{}, error) {
+	return tibiaDataEndpoint{
+		Name:    "TibiaFansites",
+		Request: tibiadataRequest,
+		Parse: func(BoxContentHTML string) (interface{}, error) {
 			return TibiaFansitesImpl(BoxContentHTML, tibiadataRequest.URL)
 		},
-		"TibiaFansites")
+	}
 }
 
 // Guild godoc
 // @Summary      Show one guild
 // @Description  Show all information about one guild
+// @Description  With guildhall_details the guildhalls are searched in the guildhall lists of the world for their town and id and their house pages are fetched as well.
 // @Tags         guilds
 // @Accept       json
 // @Produce      json
-// @Param        name path string true "The name of guild" extensions(x-example=Elysium)
+// @Param        name              path  string true  "The name of guild" extensions(x-example=Elysium)
+// @Param        guildhall_details query bool   false "Whether to fetch the house pages of the guildhalls for their owner, rent and status"
 // @Success      200  {object}  GuildResponse
 // @Failure      400  {object}  Information
 // @Failure      404  {object}  Information
@@ -425,11 +742,27 @@ func tibiaGuildsGuild(c *gin.Context) {
 	// getting params from URL
 	guild := c.Param("name")
 
+	if details, _ := strconv.ParseBool(c.Query("guildhall_details")); details {
+		jsonData, err := TibiaGuildsGuildWithGuildhallsImpl(guild, TibiaDataHTMLDataCollector)
+		if err != nil {
+			TibiaDataErrorHandler(c, err, 0)
+			return
+		}
+
+		TibiaDataAPIHandleResponse(c, "TibiaGuildsGuild", jsonData)
+		return
+	}
+
+	endpoint, err := tibiaGuildsGuildEndpoint(guild)
+	tibiaDataEndpointHandler(c, endpoint, err)
+}
+
+// tibiaGuildsGuildEndpoint validates the name and returns the endpoint of the guild
+func tibiaGuildsGuildEndpoint(guild string) (tibiaDataEndpoint, error) {
 	// Validate the name
 	err := validation.IsGuildNameValid(guild)
 	if err != nil {
-		TibiaDataErrorHandler(c, err, http.StatusBadRequest)
-		return
+		return tibiaDataEndpoint{}, err
 	}
 
 	tibiadataRequest := TibiaDataRequestStruct{
@@ -437,580 +770,1579 @@ func tibiaGuildsGuild(c *gin.Context) {
 		URL:    "https://www.tibia.com/community/?subtopic=guilds&page=view&GuildName=" + TibiaDataQueryEscapeString(guild),
 	}
 
-	tibiaDataRequestHandler(
-		c,
-		tibiadataRequest,
-		func(BoxContentHTML string) (interface{}, error) {
+	return tibiaDataEndpoint{
+		Name:    "TibiaGuildsGuild",
+		Request: tibiadataRequest,
+		Parse: func(BoxContentHTML string) (interface{}, error) {
 			return TibiaGuildsGuildImpl(guild, BoxContentHTML, tibiadataRequest.URL)
 		},
-		"TibiaGuildsGuild")
+	}, nil
 }
 
-// Guilds godoc
-// @Summary      List all guilds from a world
-// @Description  Show all guilds on a certain world
+// GuildEvents godoc
+// @Summary      Show the events of one guild
+// @Description  Show the event history of one guild: members joining, leaving and being kicked, invitations and rank and title changes
 // @Tags         guilds
 // @Accept       json
 // @Produce      json
-// @Param        world path string true "The world" extensions(x-example=Antica)
-// @Success      200  {object}  GuildsOverviewResponse
+// @Param        name path string true "The name of guild" extensions(x-example=Elysium)
+// @Success      200  {object}  GuildEventsResponse
 // @Failure      400  {object}  Information
 // @Failure      404  {object}  Information
 // @Failure      503  {object}  Information
-// @Router       /v4/guilds/{world} [get]
-func tibiaGuildsOverview(c *gin.Context) {
+// @Router       /v4/guild/{name}/events [get]
+func tibiaGuildsGuildEvents(c *gin.Context) {
 	// getting params from URL
-	world := c.Param("world")
+	guild := c.Param("name")
 
-	// Check if world exists
-	exists, err := validation.WorldExists(world)
-	if err != nil {
-		TibiaDataErrorHandler(c, err, 0)
-		return
-	}
+	endpoint, err := tibiaGuildsGuildEventsEndpoint(guild)
+	tibiaDataEndpointHandler(c, endpoint, err)
+}
 
-	if !exists {
-		TibiaDataErrorHandler(c, validation.ErrorWorldDoesNotExist, http.StatusBadRequest)
-		return
+// tibiaGuildsGuildEventsEndpoint validates the name and returns the endpoint of the guild's events
+func tibiaGuildsGuildEventsEndpoint(guild string) (tibiaDataEndpoint, error) {
+	// Validate the name
+	err := validation.IsGuildNameValid(guild)
+	if err != nil {
+		return tibiaDataEndpoint{}, err
 	}
 
-	// Adding fix for First letter to be upper and rest lower
-	world = TibiaDataStringWorldFormatToTitle(world)
-
 	tibiadataRequest := TibiaDataRequestStruct{
 		Method: resty.MethodGet,
-		URL:    "https://www.tibia.com/community/?subtopic=guilds&world=" + TibiaDataQueryEscapeString(world),
+		URL:    "https://www.tibia.com/community/?subtopic=guilds&page=guildevents&GuildName=" + TibiaDataQueryEscapeString(guild),
 	}
 
-	tibiaDataRequestHandler(
-		c,
-		tibiadataRequest,
-		func(BoxContentHTML string) (interface{}, error) {
-			return TibiaGuildsOverviewImpl(world, BoxContentHTML, tibiadataRequest.URL)
+	return tibiaDataEndpoint{
+		Name:    "TibiaGuildsGuildEvents",
+		Request: tibiadataRequest,
+		Parse: func(BoxContentHTML string) (interface{}, error) {
+			return TibiaGuildsGuildEventsImpl(BoxContentHTML, tibiadataRequest.URL)
 		},
-		"TibiaGuildsOverview")
+	}, nil
 }
 
-// Highscores godoc
-// @Summary      Highscores of tibia
-// @Description  Show all highscores of tibia
-// @Description  In restriction mode, the valid vocation option is all.
-// @Tags         highscores
+// GuildExpanded godoc
+// @Summary      Show one guild with the characters of its members
+// @Description  Show one guild with selected fields of the character of every member
+// @Description  The characters are fetched concurrently, members whose characters could not be fetched have an error and are listed in failed_members.
+// @Description  Guilds with more members than TIBIADATA_GUILD_EXPANDED_MAX_MEMBERS are not expanded.
+// @Tags         guilds
 // @Accept       json
 // @Produce      json
-// @Param        world    path string true "The world" default(all) extensions(x-example=Antica)
-// @Param        category path string true "The category" default(experience) Enums(achievements, axefighting, charmpoints, clubfighting, distancefighting, experience, fishing, fistfighting, goshnarstaint, loyaltypoints, magiclevel, shielding, swordfighting, dromescore, bosspoints) extensions(x-example=fishing)
-// @Param        vocation path string true "The vocation" default(all) Enums(all, knights, paladins, sorcerers, druids, monks) extensions(x-example=all)
-// @Param        page     path int    true "The current page" default(1) minimum(1) extensions(x-example=1)
-// @Success      200  {object}  HighscoresResponse
+// @Param        name    path  string    true   "The name of guild" extensions(x-example=Elysium)
+// @Param        include query []string  false  "The character fields to include (can be repeated or comma separated)" collectionFormat(multi) Enums(account_status, achievement_points, deaths, former_names, houses, last_login, residence)
+// @Success      200  {object}  GuildExpandedResponse
 // @Failure      400  {object}  Information
 // @Failure      404  {object}  Information
 // @Failure      503  {object}  Information
-// @Router       /v4/highscores/{world}/{category}/{vocation}/{page} [get]
-func tibiaHighscores(c *gin.Context) {
+// @Router       /v4/guild/{name}/expanded [get]
+func tibiaGuildsGuildExpanded(c *gin.Context) {
 	// getting params from URL
-	world := c.Param("world")
-	category := c.Param("category")
-	vocation := c.Param("vocation")
-	page := c.Param("page")
+	guild := c.Param("name")
 
-	// Check if vocation is valid
-	err := validation.IsVocationValid(vocation)
+	jsonData, err := TibiaGuildsGuildExpandedImpl(guild, c.QueryArray("include"), TibiaDataHTMLDataCollector)
 	if err != nil {
-		TibiaDataErrorHandler(c, err, http.StatusBadRequest)
+		TibiaDataErrorHandler(c, err, 0)
 		return
 	}
 
-	// Adding fix for First letter to be upper and rest lower
-	if strings.EqualFold(world, "all") {
-		world = ""
-	} else {
-		world = TibiaDataStringWorldFormatToTitle(world)
-	}
+	TibiaDataAPIHandleResponse(c, "TibiaGuildsGuildExpanded", jsonData)
+}
 
-	if world != "" {
-		// Check if world exists
-		exists, err := validation.WorldExists(world)
-		if err != nil {
-			TibiaDataErrorHandler(c, err, 0)
-			return
-		}
+// GuildStatistics godoc
+// @Summary      Show the statistics of one guild
+// @Description  Show the members of one guild per vocation and rank, the distribution of their levels, the online ratio, the invitations per day and the longest-serving members
+// @Tags         guilds
+// @Accept       json
+// @Produce      json
+// @Param        name path string true "The name of guild" extensions(x-example=Elysium)
+// @Success      200  {object}  GuildStatisticsResponse
+// @Failure      400  {object}  Information
+// @Failure      404  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/guild/{name}/statistics [get]
+func tibiaGuildsGuildStatistics(c *gin.Context) {
+	// getting params from URL
+	guild := c.Param("name")
 
-		if !exists {
-			TibiaDataErrorHandler(c, validation.ErrorWorldDoesNotExist, http.StatusBadRequest)
-			return
-		}
-	}
+	endpoint, err := tibiaGuildsGuildStatisticsEndpoint(guild)
+	tibiaDataEndpointHandler(c, endpoint, err)
+}
+
+// tibiaGuildsGuildStatisticsEndpoint validates the name and returns the endpoint of the guild's statistics
+func tibiaGuildsGuildStatisticsEndpoint(guild string) (tibiaDataEndpoint, error) {
+	// Validate the name
+	err := validation.IsGuildNameValid(guild)
+	if err != nil {
+		return tibiaDataEndpoint{}, err
+	}
+
+	tibiadataRequest := TibiaDataRequestStruct{
+		Method: resty.MethodGet,
+		URL:    "https://www.tibia.com/community/?subtopic=guilds&page=view&GuildName=" + TibiaDataQueryEscapeString(guild),
+	}
+
+	return tibiaDataEndpoint{
+		Name:    "TibiaGuildsGuildStatistics",
+		Request: tibiadataRequest,
+		Parse: func(BoxContentHTML string) (interface{}, error) {
+			return TibiaGuildsGuildStatisticsImpl(guild, BoxContentHTML, tibiadataRequest.URL)
+		},
+	}, nil
+}
+
+// GuildWars godoc
+// @Summary      Show the wars of one guild
+// @Description  Show the active and past wars of one guild with the opponents, kills, war conditions and end dates
+// @Tags         guilds
+// @Accept       json
+// @Produce      json
+// @Param        name path string true "The name of guild" extensions(x-example=Elysium)
+// @Success      200  {object}  GuildWarsResponse
+// @Failure      400  {object}  Information
+// @Failure      404  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/guild/{name}/wars [get]
+func tibiaGuildsGuildWars(c *gin.Context) {
+	// getting params from URL
+	guild := c.Param("name")
+
+	endpoint, err := tibiaGuildsGuildWarsEndpoint(guild)
+	tibiaDataEndpointHandler(c, endpoint, err)
+}
+
+// tibiaGuildsGuildWarsEndpoint validates the name and returns the endpoint of the guild's wars
+func tibiaGuildsGuildWarsEndpoint(guild string) (tibiaDataEndpoint, error) {
+	// Validate the name
+	err := validation.IsGuildNameValid(guild)
+	if err != nil {
+		return tibiaDataEndpoint{}, err
+	}
+
+	tibiadataRequest := TibiaDataRequestStruct{
+		Method: resty.MethodGet,
+		URL:    "https://www.tibia.com/community/?subtopic=guilds&page=guildwars&action=view&GuildName=" + TibiaDataQueryEscapeString(guild),
+	}
+
+	return tibiaDataEndpoint{
+		Name:    "TibiaGuildsGuildWars",
+		Request: tibiadataRequest,
+		Parse: func(BoxContentHTML string) (interface{}, error) {
+			return TibiaGuildsGuildWarsImpl(BoxContentHTML, tibiadataRequest.URL)
+		},
+	}, nil
+}
+
+// Guilds godoc
+// @Summary      List all guilds from a world
+// @Description  Show all guilds on a certain world
+// @Tags         guilds
+// @Accept       json
+// @Produce      json
+// @Param        world path string true "The world" extensions(x-example=Antica)
+// @Success      200  {object}  GuildsOverviewResponse
+// @Failure      400  {object}  Information
+// @Failure      404  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/guilds/{world} [get]
+func tibiaGuildsOverview(c *gin.Context) {
+	// getting params from URL
+	world := c.Param("world")
+
+	endpoint, err := tibiaGuildsOverviewEndpoint(world)
+	tibiaDataEndpointHandler(c, endpoint, err)
+}
+
+// tibiaGuildsOverviewEndpoint validates the world and returns the endpoint of its guilds
+func tibiaGuildsOverviewEndpoint(world string) (tibiaDataEndpoint, error) {
+	// Check if world exists
+	exists, err := validation.WorldExists(world)
+	if err != nil {
+		return tibiaDataEndpoint{}, err
+	}
+
+	if !exists {
+		return tibiaDataEndpoint{}, validation.ErrorWorldDoesNotExist
+	}
+
+	// Adding fix for First letter to be upper and rest lower
+	world = TibiaDataStringWorldFormatToTitle(world)
+
+	tibiadataRequest := TibiaDataRequestStruct{
+		Method: resty.MethodGet,
+		URL:    "https://www.tibia.com/community/?subtopic=guilds&world=" + TibiaDataQueryEscapeString(world),
+	}
+
+	return tibiaDataEndpoint{
+		Name:    "TibiaGuildsOverview",
+		Request: tibiadataRequest,
+		Parse: func(BoxContentHTML string) (interface{}, error) {
+			return TibiaGuildsOverviewImpl(world, BoxContentHTML, tibiadataRequest.URL)
+		},
+	}, nil
+}
+
+// GuildsLeaderboard godoc
+// @Summary      Leaderboard of the guilds of a world
+// @Description  Rank all active guilds on a certain world by the total and average level of their members, their number of members or their online members
+// @Description  The guilds of a world are fetched concurrently and cached for hours, fetched shows when. Guilds that could not be fetched are listed in failed_guilds.
+// @Tags         guilds
+// @Accept       json
+// @Produce      json
+// @Param        world path  string true  "The world" extensions(x-example=Antica)
+// @Param        sort  query string false "The value to rank the guilds by" Enums(level_total, members_total, level_average, members_online) default(level_total)
+// @Success      200  {object}  GuildsLeaderboardResponse
+// @Failure      400  {object}  Information
+// @Failure      404  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/guilds/{world}/leaderboard [get]
+func tibiaGuildsLeaderboard(c *gin.Context) {
+	// getting params from URL
+	world := c.Param("world")
+
+	jsonData, err := TibiaGuildsLeaderboardImpl(world, c.Query("sort"), TibiaDataHTMLDataCollector)
+	if err != nil {
+		TibiaDataErrorHandler(c, err, 0)
+		return
+	}
+
+	TibiaDataAPIHandleResponse(c, "TibiaGuildsLeaderboard", jsonData)
+}
+
+// GuildsStatistics godoc
+// @Summary      Rank the guilds of a world by their statistics
+// @Description  Show the statistics of all active guilds on a certain world, ranked by one of them
+// @Description  The guilds of a world are fetched concurrently and cached for hours together with the leaderboard, fetched shows when. Guilds that could not be fetched are listed in failed_guilds.
+// @Tags         guilds
+// @Accept       json
+// @Produce      json
+// @Param        world path  string true  "The world" extensions(x-example=Antica)
+// @Param        sort  query string false "The statistic to rank the guilds by" Enums(members_total, members_online, members_invited, online_ratio, level_total, level_average, level_median) default(members_total)
+// @Success      200  {object}  GuildsStatisticsResponse
+// @Failure      400  {object}  Information
+// @Failure      404  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/guilds/{world}/statistics [get]
+func tibiaGuildsOverviewStatistics(c *gin.Context) {
+	// getting params from URL
+	world := c.Param("world")
+
+	jsonData, err := TibiaGuildsOverviewStatisticsImpl(world, c.Query("sort"), TibiaDataHTMLDataCollector)
+	if err != nil {
+		TibiaDataErrorHandler(c, err, 0)
+		return
+	}
+
+	TibiaDataAPIHandleResponse(c, "TibiaGuildsOverviewStatistics", jsonData)
+}
+
+// Highscores godoc
+// @Summary      Highscores of tibia
+// @Description  Show all highscores of tibia
+// @Description  In restriction mode, the valid vocation option is all.
+// @Tags         highscores
+// @Accept       json
+// @Produce      json
+// @Param        world    path string true "The world" default(all) extensions(x-example=Antica)
+// @Param        category path string true "The category" default(experience) Enums(achievements, axefighting, charmpoints, clubfighting, distancefighting, experience, fishing, fistfighting, goshnarstaint, loyaltypoints, magiclevel, shielding, swordfighting, dromescore, bosspoints) extensions(x-example=fishing)
+// @Param        vocation path string true "The vocation" default(all) Enums(all, knights, paladins, sorcerers, druids, monks) extensions(x-example=all)
+// @Param        page     path int    true "The current page" default(1) minimum(1) extensions(x-example=1)
+// @Param        world_type query string false "The world types (only on all worlds)" collectionFormat(csv) Enums(open, optional, hardcore, retro_open, retro_hardcore)
+// @Param        battleye   query string false "The battleye protection (only on all worlds)" Enums(any, unprotected, protected, initially_protected)
+// @Success      200  {object}  HighscoresResponse
+// @Failure      400  {object}  Information
+// @Failure      404  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/highscores/{world}/{category}/{vocation}/{page} [get]
+func tibiaHighscores(c *gin.Context) {
+	// getting params from URL
+	world := c.Param("world")
+	category := c.Param("category")
+	vocation := c.Param("vocation")
+	page := c.Param("page")
+
+	endpoint, err := tibiaHighscoresEndpoint(world, category, vocation, page, tibiaHighscoresFilterQuery(c))
+	tibiaDataEndpointHandler(c, endpoint, err)
+}
+
+// tibiaDataRawQuery returns the query of the request including the question mark, so that redirects keep it
+func tibiaDataRawQuery(c *gin.Context) string {
+	if c.Request.URL.RawQuery == "" {
+		return ""
+	}
+	return "?" + c.Request.URL.RawQuery
+}
+
+// tibiaHighscoresFilterQuery returns the highscores filter of the query parameters world_type and battleye
+// world_type can be repeated or comma separated (e.g. ?world_type=optional,hardcore)
+func tibiaHighscoresFilterQuery(c *gin.Context) HighscoresFilter {
+	var filter HighscoresFilter
+	for _, worldTypes := range c.QueryArray("world_type") {
+		for _, worldType := range strings.Split(worldTypes, ",") {
+			if worldType = strings.TrimSpace(worldType); worldType != "" {
+				filter.WorldTypes = append(filter.WorldTypes, worldType)
+			}
+		}
+	}
+	filter.BattlEye = c.Query("battleye")
+
+	return filter
+}
+
+// tibiaHighscoresEndpoint validates the parameters and returns the endpoint of the highscore page
+func tibiaHighscoresEndpoint(world, category, vocation, page string, filter HighscoresFilter) (tibiaDataEndpoint, error) {
+	// Check if vocation is valid
+	err := validation.IsVocationValid(vocation)
+	if err != nil {
+		return tibiaDataEndpoint{}, err
+	}
+
+	// Adding fix for First letter to be upper and rest lower
+	if strings.EqualFold(world, "all") {
+		world = ""
+	} else {
+		world = TibiaDataStringWorldFormatToTitle(world)
+	}
+
+	if world != "" {
+		// Check if world exists
+		exists, err := validation.WorldExists(world)
+		if err != nil {
+			return tibiaDataEndpoint{}, err
+		}
+
+		if !exists {
+			return tibiaDataEndpoint{}, validation.ErrorWorldDoesNotExist
+		}
+	}
 
 	if category != "" {
 		err = validation.IsHighscoreCategoryValid(category)
 		if err != nil {
-			TibiaDataErrorHandler(c, validation.ErrorHighscoreCategoryDoesNotExist, http.StatusBadRequest)
+			return tibiaDataEndpoint{}, validation.ErrorHighscoreCategoryDoesNotExist
+		}
+	}
+
+	highscoreCategory := validation.HighscoreCategoryFromString(category)
+
+	// Sanitize of vocation input
+	vocationName, _ := TibiaDataVocationValidator(vocation)
+
+	// Check if restriction mode is enabled
+	if TibiaDataRestrictionMode && vocationName != "all" {
+		return tibiaDataEndpoint{}, validation.ErrorRestrictionMode
+	}
+
+	// checking the page provided
+	if page == "" {
+		page = "1"
+	}
+	if TibiaDataStringToInteger(page) < 1 {
+		return tibiaDataEndpoint{}, validation.ErrorHighscorePageInvalid
+	}
+
+	// checking the filters provided (tibia.com only has them on all worlds)
+	var worldTypes []validation.HighscoreWorldType
+	for _, worldType := range filter.WorldTypes {
+		err = validation.IsHighscoreWorldTypeValid(worldType)
+		if err != nil {
+			return tibiaDataEndpoint{}, err
+		}
+
+		highscoreWorldType := validation.HighscoreWorldTypeFromString(worldType)
+		if !slices.Contains(worldTypes, highscoreWorldType) {
+			worldTypes = append(worldTypes, highscoreWorldType)
+		}
+	}
+	slices.Sort(worldTypes)
+
+	err = validation.IsHighscoreBattlEyeValid(filter.BattlEye)
+	if err != nil {
+		return tibiaDataEndpoint{}, err
+	}
+	battlEye := validation.HighscoreBattlEyeFromString(filter.BattlEye)
+
+	if world != "" && (len(worldTypes) > 0 || battlEye != validation.HighscoreBattlEyeAny) {
+		return tibiaDataEndpoint{}, validation.ErrorHighscoreFilterNeedsAllWorlds
+	}
+
+	return tibiaHighscoresPageEndpoint(world, highscoreCategory, vocationName, TibiaDataStringToInteger(page), worldTypes, battlEye), nil
+}
+
+// tibiaHighscoresPageEndpoint returns the endpoint of a highscore page of already validated parameters
+// world is empty for all worlds and vocationName is one of the names of TibiaDataVocationValidator.
+func tibiaHighscoresPageEndpoint(world string, highscoreCategory validation.HighscoreCategory, vocationName string, page int, worldTypes []validation.HighscoreWorldType, battlEye validation.HighscoreBattlEye) tibiaDataEndpoint {
+	_, vocationid := TibiaDataVocationValidator(vocationName)
+
+	var filterParams string
+	if battlEye != validation.HighscoreBattlEyeAny {
+		filterParams += "&beprotection=" + strconv.Itoa(int(battlEye))
+	}
+	for _, worldType := range worldTypes {
+		filterParams += "&worldtypes%5B%5D=" + strconv.Itoa(int(worldType))
+	}
+
+	tibiadataRequest := TibiaDataRequestStruct{
+		Method: resty.MethodGet,
+		URL:    "https://www.tibia.com/community/?subtopic=highscores&world=" + TibiaDataQueryEscapeString(world) + "&category=" + strconv.Itoa(int(highscoreCategory)) + "&profession=" + TibiaDataQueryEscapeString(vocationid) + filterParams + "&currentpage=" + strconv.Itoa(page),
+	}
+
+	return tibiaDataEndpoint{
+		Name:    "TibiaHighscores",
+		Request: tibiadataRequest,
+		Parse: func(BoxContentHTML string) (interface{}, error) {
+			response, err := TibiaHighscoresImpl(world, highscoreCategory, vocationName, page, BoxContentHTML, tibiadataRequest.URL)
+
+			// reflecting the filters in the response
+			for _, worldType := range worldTypes {
+				worldTypeName, _ := worldType.String()
+				response.Highscores.WorldTypes = append(response.Highscores.WorldTypes, worldTypeName)
+			}
+			if battlEye != validation.HighscoreBattlEyeAny {
+				response.Highscores.BattlEye, _ = battlEye.String()
+			}
+
+			return response, err
+		},
+	}
+}
+
+// HighscoresAll godoc
+// @Summary      Highscores of tibia (all pages)
+// @Description  Show the highscores of all pages merged in rank order
+// @Description  Pages that could not be fetched are listed in failed_pages. With format ndjson the entries are streamed page by page.
+// @Description  In restriction mode, the valid vocation option is all.
+// @Tags         highscores
+// @Accept       json
+// @Produce      json
+// @Param        world    path string true "The world" default(all) extensions(x-example=Antica)
+// @Param        category path string true "The category" default(experience) Enums(achievements, axefighting, charmpoints, clubfighting, distancefighting, experience, fishing, fistfighting, goshnarstaint, loyaltypoints, magiclevel, shielding, swordfighting, dromescore, bosspoints) extensions(x-example=fishing)
+// @Param        vocation path string true "The vocation" default(all) Enums(all, knights, paladins, sorcerers, druids, monks) extensions(x-example=all)
+// @Param        world_type query string false "The world types (only on all worlds)" collectionFormat(csv) Enums(open, optional, hardcore, retro_open, retro_hardcore)
+// @Param        battleye   query string false "The battleye protection (only on all worlds)" Enums(any, unprotected, protected, initially_protected)
+// @Success      200  {object}  HighscoresAllResponse
+// @Failure      400  {object}  Information
+// @Failure      404  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/highscores/{world}/{category}/{vocation}/all [get]
+func tibiaHighscoresAll(c *gin.Context) {
+	// getting params from URL
+	world := c.Param("world")
+	category := c.Param("category")
+	vocation := c.Param("vocation")
+	filter := tibiaHighscoresFilterQuery(c)
+
+	format, err := TibiaDataResponseFormat(c)
+	if err != nil {
+		TibiaDataErrorHandler(c, err, http.StatusNotAcceptable)
+		return
+	}
+
+	if format != TibiaDataFormatNDJSON {
+		jsonData, err := TibiaHighscoresAllImpl(world, category, vocation, filter, TibiaDataHTMLDataCollector, nil)
+		if err != nil {
+			TibiaDataErrorHandler(c, err, 0)
 			return
 		}
+
+		TibiaDataAPIHandleResponse(c, "TibiaHighscoresAll", jsonData)
+		return
+	}
+
+	// stream the entries (or the failure) of every page as soon as it is done
+	encoder := json.NewEncoder(c.Writer)
+	_, err = TibiaHighscoresAllImpl(world, category, vocation, filter, TibiaDataHTMLDataCollector, func(page int, highscores []Highscore, failure *HighscoresFailedPage) {
+		if !c.Writer.Written() {
+			c.Header("Content-Type", tibiaDataFormatContentTypes[TibiaDataFormatNDJSON]+"; charset=utf-8")
+			c.Status(http.StatusOK)
+		}
+
+		if failure != nil {
+			_ = encoder.Encode(failure)
+		}
+		for _, highscore := range highscores {
+			_ = encoder.Encode(highscore)
+		}
+		c.Writer.Flush()
+	})
+	if err != nil {
+		TibiaDataErrorHandler(c, err, 0)
+	}
+}
+
+// HighscoresDeltas godoc
+// @Summary      Highscore gains
+// @Description  Show the value gained by every character between two stored snapshots of a highscore list
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH) and the list in TIBIADATA_HIGHSCORES_SNAPSHOTS. Without parameters the last day is used.
+// @Tags         highscores
+// @Accept       json
+// @Produce      json
+// @Param        world    path  string true  "The world" default(all) extensions(x-example=Antica)
+// @Param        category path  string true  "The category" default(experience) extensions(x-example=experience)
+// @Param        vocation path  string true  "The vocation" default(all) extensions(x-example=all)
+// @Param        period   query string false "The period ending now" Enums(day, week)
+// @Param        from     query string false "The start of the period (RFC 3339 or date)"
+// @Param        to       query string false "The end of the period (RFC 3339 or date)"
+// @Success      200  {object}  HighscoresDeltasResponse
+// @Failure      400  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/highscores/{world}/{category}/{vocation}/deltas [get]
+func tibiaHighscoresDeltas(c *gin.Context) {
+	jsonData, err := TibiaHighscoresDeltasImpl(c.Param("world"), c.Param("category"), c.Param("vocation"), c.Query("from"), c.Query("to"), c.Query("period"))
+	if err != nil {
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
+		return
+	}
+
+	TibiaDataAPIHandleResponse(c, "TibiaHighscoresDeltas", jsonData)
+}
+
+// HighscoresRankChanges godoc
+// @Summary      Highscore rank changes
+// @Description  Show the rank changes of all characters between two stored snapshots of a highscore list
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH) and the list in TIBIADATA_HIGHSCORES_SNAPSHOTS. Without parameters the last day is used.
+// @Tags         highscores
+// @Accept       json
+// @Produce      json
+// @Param        world    path  string true  "The world" default(all) extensions(x-example=Antica)
+// @Param        category path  string true  "The category" default(experience) extensions(x-example=experience)
+// @Param        vocation path  string true  "The vocation" default(all) extensions(x-example=all)
+// @Param        period   query string false "The period ending now" Enums(day, week)
+// @Param        from     query string false "The start of the period (RFC 3339 or date)"
+// @Param        to       query string false "The end of the period (RFC 3339 or date)"
+// @Success      200  {object}  HighscoresRankChangesResponse
+// @Failure      400  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/highscores/{world}/{category}/{vocation}/rankchanges [get]
+func tibiaHighscoresRankChanges(c *gin.Context) {
+	jsonData, err := TibiaHighscoresRankChangesImpl(c.Param("world"), c.Param("category"), c.Param("vocation"), c.Query("from"), c.Query("to"), c.Query("period"))
+	if err != nil {
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
+		return
+	}
+
+	TibiaDataAPIHandleResponse(c, "TibiaHighscoresRankChanges", jsonData)
+}
+
+// House godoc
+// @Summary      House view
+// @Description  Show all information about one house
+// @Tags         houses
+// @Accept       json
+// @Produce      json
+// @Param        world     path string true "The world to show" extensions(x-example=Antica)
+// @Param        house_id  path int    true "The ID of the house" extensions(x-example=35019)
+// @Success      200  {object}  HouseResponse
+// @Failure      400  {object}  Information
+// @Failure      404  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/house/{world}/{house_id} [get]
+func tibiaHousesHouse(c *gin.Context) {
+	// getting params from URL
+	world := c.Param("world")
+	houseidStr := c.Param("house_id")
+
+	endpoint, err := tibiaHousesHouseEndpoint(world, houseidStr)
+	tibiaDataEndpointHandler(c, endpoint, err)
+}
+
+// tibiaHousesHouseEndpoint validates the world and house and returns the endpoint of the house
+func tibiaHousesHouseEndpoint(world, houseidStr string) (tibiaDataEndpoint, error) {
+	houseid, err := strconv.Atoi(houseidStr)
+	if err != nil {
+		return tibiaDataEndpoint{}, validation.ErrorStringCanNotBeConvertedToInt
+	}
+
+	// Adding fix for First letter to be upper and rest lower
+	world = TibiaDataStringWorldFormatToTitle(world)
+
+	// Check if world exists
+	exists, err := validation.WorldExists(world)
+	if err != nil {
+		return tibiaDataEndpoint{}, err
+	}
+
+	if !exists {
+		return tibiaDataEndpoint{}, validation.ErrorWorldDoesNotExist
+	}
+
+	// check if house exists
+	exists, err = validation.HouseExistsRaw(houseid)
+	if err != nil {
+		return tibiaDataEndpoint{}, err
+	}
+
+	if !exists {
+		return tibiaDataEndpoint{}, validation.ErrorHouseDoesNotExist
+	}
+
+	tibiadataRequest := tibiaHousesHouseRequest(world, houseid)
+
+	return tibiaDataEndpoint{
+		Name:    "TibiaHousesHouse",
+		Request: tibiadataRequest,
+		Parse: func(BoxContentHTML string) (interface{}, error) {
+			return TibiaHousesHouseImpl(houseid, BoxContentHTML, tibiadataRequest.URL)
+		},
+	}, nil
+}
+
+// tibiaHousesHouseRequest returns the request of the house page of a house of a world
+func tibiaHousesHouseRequest(world string, houseid int) TibiaDataRequestStruct {
+	return TibiaDataRequestStruct{
+		Method: resty.MethodGet,
+		URL:    "https://www.tibia.com/community/?subtopic=houses&page=view&world=" + TibiaDataQueryEscapeString(world) + "&houseid=" + strconv.Itoa(houseid),
+	}
+}
+
+// Houses godoc
+// @Summary      List of houses
+// @Description  Show all houses filtered on world and town
+// @Tags         houses
+// @Accept       json
+// @Produce      json
+// @Param        world path string true "The world to show" extensions(x-example=Antica)
+// @Param        town  path string true "The town to show" extensions(x-example=Venore)
+// @Success      200  {object}  HousesOverviewResponse
+// @Failure      400  {object}  Information
+// @Failure      404  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/houses/{world}/{town} [get]
+// TODO: This API needs to be refactored somehow to use tibiaDataRequestHandler
+func tibiaHousesOverview(c *gin.Context) {
+	// getting params from URL
+	world := c.Param("world")
+	town := c.Param("town")
+
+	world, town, err := tibiaHousesOverviewParams(world, town)
+	if err != nil {
+		TibiaDataErrorHandler(c, err, 0)
+		return
+	}
+
+	jsonData, err := TibiaHousesOverviewImpl(c, world, town, TibiaDataHTMLDataCollector)
+	if err != nil {
+		TibiaDataErrorHandler(c, err, 0)
+		return
+	}
+
+	// return jsonData
+	TibiaDataAPIHandleResponse(c, "TibiaHousesOverview", jsonData)
+}
+
+// HousesWorld godoc
+// @Summary      List of houses of a world
+// @Description  Show the houses and guildhalls of all towns of a world grouped by town, with the counts of rented, auctioned and free houses
+// @Tags         houses
+// @Accept       json
+// @Produce      json
+// @Param        world     path  string true  "The world to show" extensions(x-example=Antica)
+// @Param        min_size  query int    false "The minimum size in SQM"
+// @Param        max_size  query int    false "The maximum size in SQM"
+// @Param        min_rent  query int    false "The minimum monthly rent in gold coins"
+// @Param        max_rent  query int    false "The maximum monthly rent in gold coins"
+// @Param        auctioned query bool   false "Whether to only show auctioned houses"
+// @Success      200  {object}  HousesWorldResponse
+// @Failure      400  {object}  Information
+// @Failure      404  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/houses/{world} [get]
+func tibiaHousesWorld(c *gin.Context) {
+	filter, err := tibiaHousesFilterParams(c.Query("min_size"), c.Query("max_size"), c.Query("min_rent"), c.Query("max_rent"), c.Query("auctioned"))
+	if err != nil {
+		TibiaDataErrorHandler(c, err, 0)
+		return
+	}
+
+	jsonData, err := TibiaHousesWorldImpl(c.Param("world"), filter, TibiaDataHTMLDataCollector)
+	if err != nil {
+		TibiaDataErrorHandler(c, err, 0)
+		return
+	}
+
+	TibiaDataAPIHandleResponse(c, "TibiaHousesWorld", jsonData)
+}
+
+// HousesSearch godoc
+// @Summary      Search of houses of a world
+// @Description  Search the houses and guildhalls of a world by their size, beds, rent and auction, sorted and paginated
+// @Tags         houses
+// @Accept       json
+// @Produce      json
+// @Param        world     path  string true  "The world to search" extensions(x-example=Antica)
+// @Param        town      query string false "The town to search, all towns if not given"
+// @Param        type      query string false "The type of home to search" Enums(house, guildhall)
+// @Param        min_size  query int    false "The minimum size in SQM"
+// @Param        max_size  query int    false "The maximum size in SQM"
+// @Param        min_beds  query int    false "The minimum number of beds"
+// @Param        max_beds  query int    false "The maximum number of beds"
+// @Param        min_rent  query int    false "The minimum monthly rent in gold coins"
+// @Param        max_rent  query int    false "The maximum monthly rent in gold coins"
+// @Param        auctioned query bool   false "Whether to only show auctioned houses"
+// @Param        sort      query string false "The value to sort by" Enums(rent, name, size, beds, current_bid, auction_end)
+// @Param        order     query string false "The order to sort in" Enums(asc, desc)
+// @Param        page      query int    false "The page to show"
+// @Param        page_size query int    false "The number of houses of a page"
+// @Success      200  {object}  HousesSearchResponse
+// @Failure      400  {object}  Information
+// @Failure      404  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/houses/{world}/search [get]
+func tibiaHousesSearch(c *gin.Context) {
+	query, err := tibiaHousesSearchParams(c.Query)
+	if err != nil {
+		TibiaDataErrorHandler(c, err, 0)
+		return
+	}
+
+	jsonData, err := TibiaHousesSearchImpl(c.Param("world"), query, TibiaDataHTMLDataCollector)
+	if err != nil {
+		TibiaDataErrorHandler(c, err, 0)
+		return
+	}
+
+	TibiaDataAPIHandleResponse(c, "TibiaHousesSearch", jsonData)
+}
+
+// tibiaHousesOverviewParams validates world and town and returns them in the format of tibia.com
+func tibiaHousesOverviewParams(world, town string) (string, string, error) {
+	// Adding fix for First letter to be upper and rest lower
+	world = TibiaDataStringWorldFormatToTitle(world)
+	town = strings.ReplaceAll(TibiaDataStringWorldFormatToTitle(town), "+", " ")
+
+	// Check if world exists
+	exists, err := validation.WorldExists(world)
+	if err != nil {
+		return "", "", err
+	}
+
+	if !exists {
+		return "", "", validation.ErrorWorldDoesNotExist
+	}
+
+	// Check if town exists
+	exists, err = validation.TownExists(town)
+	if err != nil {
+		return "", "", err
+	}
+
+	if !exists {
+		return "", "", validation.ErrorTownDoesNotExist
+	}
+
+	// Ab'Dendriel gets formatted as Ab'dendriel by TibiaDataStringWorldFormatToTitle
+	// which makes tibia.com not recognize it and return an empty response.
+	if strings.EqualFold(town, "ab'dendriel") {
+		town = "Ab'Dendriel"
+	}
+
+	return world, town, nil
+}
+
+// Killstatistics godoc
+// @Summary      The killstatistics
+// @Description  Show all killstatistics filtered on world
+// @Tags         killstatistics
+// @Accept       json
+// @Produce      json
+// @Param        world path string true "The world to show" extensions(x-example=Antica)
+// @Success      200  {object}  KillStatisticsResponse
+// @Failure      400  {object}  Information
+// @Failure      404  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/killstatistics/{world} [get]
+func tibiaKillstatistics(c *gin.Context) {
+	// getting params from URL
+	world := c.Param("world")
+
+	endpoint, err := tibiaKillstatisticsEndpoint(world)
+	tibiaDataEndpointHandler(c, endpoint, err)
+}
+
+// tibiaKillstatisticsEndpoint validates the world and returns the endpoint of its killstatistics
+func tibiaKillstatisticsEndpoint(world string) (tibiaDataEndpoint, error) {
+	// Adding fix for First letter to be upper and rest lower
+	world = TibiaDataStringWorldFormatToTitle(world)
+
+	// Check if world exists
+	exists, err := validation.WorldExists(world)
+	if err != nil {
+		return tibiaDataEndpoint{}, err
+	}
+
+	if !exists {
+		return tibiaDataEndpoint{}, validation.ErrorWorldDoesNotExist
+	}
+
+	tibiadataRequest := TibiaDataRequestStruct{
+		Method: resty.MethodGet,
+		URL:    "https://www.tibia.com/community/?subtopic=killstatistics&world=" + TibiaDataQueryEscapeString(world),
+	}
+
+	return tibiaDataEndpoint{
+		Name:    "TibiaKillstatistics",
+		Request: tibiadataRequest,
+		Parse: func(BoxContentHTML string) (interface{}, error) {
+			return TibiaKillstatisticsImpl(world, BoxContentHTML, tibiadataRequest.URL)
+		},
+	}, nil
+}
+
+// News archive godoc
+// @Summary      Show news archive (90 days)
+// @Description  Show news archive with a filtering on 90 days
+// @Tags         news
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  NewsListResponse
+// @Failure      400  {object}  Information
+// @Failure      404  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/news/archive [get]
+func tibiaNewslistArchive() bool {
+	// Not used function.. but required for documentation purpose
+	return false
+}
+
+// News archive (with day filter) godoc
+// @Summary      Show news archive (with days filter)
+// @Description  Show news archive with a filtering option on days
+// @Tags         news
+// @Accept       json
+// @Produce      json
+// @Param        days path int true "The number of days to show" default(90) minimum(1) extensions(x-example=30)
+// @Success      200  {object}  NewsListResponse
+// @Failure      400  {object}  Information
+// @Failure      404  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/news/archive/{days} [get]
+func tibiaNewslistArchiveDays() bool {
+	// Not used function.. but required for documentation purpose
+	return false
+}
+
+// Latest news godoc
+// @Summary      Show newslist (90 days)
+// @Description  Show newslist with filtering on articles and news of last 90 days
+// @Tags         news
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  NewsListResponse
+// @Failure      400  {object}  Information
+// @Failure      404  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/news/latest [get]
+func tibiaNewslistLatest() bool {
+	// Not used function.. but required for documentation purpose
+	return false
+}
+
+// News ticker godoc
+// @Summary      Show news tickers (90 days)
+// @Description  Show news of type news tickers of last 90 days
+// @Tags         news
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  NewsListResponse
+// @Failure      400  {object}  Information
+// @Failure      404  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/news/newsticker [get]
+func tibiaNewslist(c *gin.Context) {
+	// getting params from URL
+	daysStr := c.Param("days")
+
+	// getting type of news list
+	var newsType string
+	if c.Request != nil {
+		newsType = strings.Split(c.Request.URL.Path, "/")[3]
+	}
+
+	endpoint, err := tibiaNewslistEndpoint(newsType, daysStr)
+	tibiaDataEndpointHandler(c, endpoint, err)
+}
+
+// tibiaNewslistEndpoint validates the days and returns the endpoint of the news list
+// The newsType is one of archive, latest or newsticker
+func tibiaNewslistEndpoint(newsType, daysStr string) (tibiaDataEndpoint, error) {
+	var (
+		days int
+		err  error
+	)
+
+	if daysStr != "" {
+		// convert param to int
+		days, err = strconv.Atoi(daysStr)
+		if err != nil {
+			return tibiaDataEndpoint{}, validation.ErrorStringCanNotBeConvertedToInt
+		}
+	}
+
+	if days == 0 {
+		days = 90 // default for recent posts
+	}
+
+	// generating dates to pass to FormData
+	DateBegin := time.Now().AddDate(0, 0, -days)
+	DateEnd := time.Now()
+
+	tibiadataRequest := TibiaDataRequestStruct{
+		Method: http.MethodPost,
+		URL:    "https://www.tibia.com/news/?subtopic=newsarchive",
+		FormData: map[string]string{
+			"filter_begin_day":   strconv.Itoa(DateBegin.UTC().Day()),        // period
+			"filter_begin_month": strconv.Itoa(int(DateBegin.UTC().Month())), // period
+			"filter_begin_year":  strconv.Itoa(DateBegin.UTC().Year()),       // period
+			"filter_end_day":     strconv.Itoa(DateEnd.UTC().Day()),          // period
+			"filter_end_month":   strconv.Itoa(int(DateEnd.UTC().Month())),   // period
+			"filter_end_year":    strconv.Itoa(DateEnd.UTC().Year()),         // period
+			"filter_cipsoft":     "cipsoft",                                  // category
+			"filter_community":   "community",                                // category
+			"filter_development": "development",                              // category
+			"filter_support":     "support",                                  // category
+			"filter_technical":   "technical",                                // category
+		},
 	}
 
-	highscoreCategory := validation.HighscoreCategoryFromString(category)
+	switch newsType {
+	case "newsticker":
+		tibiadataRequest.FormData["filter_ticker"] = "ticker"
+	case "latest":
+		tibiadataRequest.FormData["filter_article"] = "article"
+		tibiadataRequest.FormData["filter_news"] = "news"
+	case "archive":
+		tibiadataRequest.FormData["filter_ticker"] = "ticker"
+		tibiadataRequest.FormData["filter_article"] = "article"
+		tibiadataRequest.FormData["filter_news"] = "news"
+	}
 
-	// Sanitize of vocation input
-	vocationName, vocationid := TibiaDataVocationValidator(vocation)
+	return tibiaDataEndpoint{
+		Name:    "TibiaNewslist",
+		Request: tibiadataRequest,
+		Parse: func(BoxContentHTML string) (interface{}, error) {
+			return TibiaNewslistImpl(days, BoxContentHTML, tibiadataRequest.URL)
+		},
+	}, nil
+}
 
-	// Check if restriction mode is enabled
-	if TibiaDataRestrictionMode && vocationName != "all" {
-		TibiaDataErrorHandler(c, validation.ErrorRestrictionMode, http.StatusBadRequest)
-		return
-	}
+// News entry godoc
+// @Summary      Show one news entry
+// @Description  Show one news entry
+// @Tags         news
+// @Accept       json
+// @Produce      json
+// @Param        news_id path int true "The ID of news entry" extensions(x-example=6512)
+// @Success      200  {object}  NewsResponse
+// @Failure      400  {object}  Information
+// @Failure      404  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/news/id/{news_id} [get]
+func tibiaNews(c *gin.Context) {
+	// getting params from URL
+	newsIDStr := c.Param("news_id")
 
-	// checking the page provided
-	if page == "" {
-		page = "1"
+	endpoint, err := tibiaNewsEndpoint(newsIDStr)
+	tibiaDataEndpointHandler(c, endpoint, err)
+}
+
+// tibiaNewsEndpoint validates the news id and returns the endpoint of the news entry
+func tibiaNewsEndpoint(newsIDStr string) (tibiaDataEndpoint, error) {
+	// convert param to int
+	newsID, err := strconv.Atoi(newsIDStr)
+	if err != nil {
+		return tibiaDataEndpoint{}, validation.ErrorStringCanNotBeConvertedToInt
 	}
-	if TibiaDataStringToInteger(page) < 1 {
-		TibiaDataErrorHandler(c, validation.ErrorHighscorePageInvalid, http.StatusBadRequest)
-		return
+
+	// checking the NewsID provided
+	err = validation.IsNewsIDValid(newsID)
+	if err != nil {
+		return tibiaDataEndpoint{}, err
 	}
 
 	tibiadataRequest := TibiaDataRequestStruct{
 		Method: resty.MethodGet,
-		URL:    "https://www.tibia.com/community/?subtopic=highscores&world=" + TibiaDataQueryEscapeString(world) + "&category=" + strconv.Itoa(int(highscoreCategory)) + "&profession=" + TibiaDataQueryEscapeString(vocationid) + "&currentpage=" + TibiaDataQueryEscapeString(page),
+		URL:    "https://www.tibia.com/news/?subtopic=newsarchive&id=" + newsIDStr,
 	}
 
-	tibiaDataRequestHandler(
-		c,
-		tibiadataRequest,
-		func(BoxContentHTML string) (interface{}, error) {
-			return TibiaHighscoresImpl(world, highscoreCategory, vocationName, TibiaDataStringToInteger(page), BoxContentHTML, tibiadataRequest.URL)
+	return tibiaDataEndpoint{
+		Name:    "TibiaNews",
+		Request: tibiadataRequest,
+		Parse: func(BoxContentHTML string) (interface{}, error) {
+			return TibiaNewsImpl(newsID, tibiadataRequest.URL, BoxContentHTML)
 		},
-		"TibiaHighscores")
+	}, nil
 }
 
-// House godoc
-// @Summary      House view
-// @Description  Show all information about one house
-// @Tags         houses
+// Spells godoc
+// @Summary      List all spells
+// @Description  Show all spells
+// @Tags         spells
 // @Accept       json
 // @Produce      json
-// @Param        world     path string true "The world to show" extensions(x-example=Antica)
-// @Param        house_id  path int    true "The ID of the house" extensions(x-example=35019)
-// @Success      200  {object}  HouseResponse
+// @Success      200  {object}  SpellsOverviewResponse
 // @Failure      400  {object}  Information
 // @Failure      404  {object}  Information
 // @Failure      503  {object}  Information
-// @Router       /v4/house/{world}/{house_id} [get]
-func tibiaHousesHouse(c *gin.Context) {
+// @Router       /v4/spells [get]
+func tibiaSpellsOverview(c *gin.Context) {
 	// getting params from URL
-	world := c.Param("world")
-	houseidStr := c.Param("house_id")
-
-	houseid, err := strconv.Atoi(houseidStr)
-	if err != nil {
-		TibiaDataErrorHandler(c, validation.ErrorStringCanNotBeConvertedToInt, http.StatusBadRequest)
-		return
-	}
-
-	// Adding fix for First letter to be upper and rest lower
-	world = TibiaDataStringWorldFormatToTitle(world)
+	vocation := c.Param("vocation")
 
-	// Check if world exists
-	exists, err := validation.WorldExists(world)
-	if err != nil {
-		TibiaDataErrorHandler(c, err, 0)
-		return
-	}
+	endpoint, err := tibiaSpellsOverviewEndpoint(vocation)
+	tibiaDataEndpointHandler(c, endpoint, err)
+}
 
-	if !exists {
-		TibiaDataErrorHandler(c, validation.ErrorWorldDoesNotExist, http.StatusBadRequest)
-		return
+// tibiaSpellsOverviewEndpoint validates the vocation and returns the endpoint of its spells
+func tibiaSpellsOverviewEndpoint(vocation string) (tibiaDataEndpoint, error) {
+	if vocation == "" {
+		vocation = TibiaDataDefaultVoc
 	}
 
-	// check if house exists
-	exists, err = validation.HouseExistsRaw(houseid)
+	err := validation.IsVocationValid(vocation)
 	if err != nil {
-		TibiaDataErrorHandler(c, err, 0)
-		return
+		return tibiaDataEndpoint{}, err
 	}
 
-	if !exists {
-		TibiaDataErrorHandler(c, validation.ErrorHouseDoesNotExist, http.StatusBadRequest)
-		return
+	// Sanitize of vocation input
+	vocationName, _ := TibiaDataVocationValidator(vocation)
+	if vocationName == "all" || vocationName == "none" {
+		vocationName = ""
+	} else {
+		// removes the last letter (s) from the string (required for spells page)
+		vocationName = strings.TrimSuffix(vocationName, "s")
+		vocationName = cases.Title(language.English).String(vocationName)
 	}
 
 	tibiadataRequest := TibiaDataRequestStruct{
 		Method: resty.MethodGet,
-		URL:    "https://www.tibia.com/community/?subtopic=houses&page=view&world=" + TibiaDataQueryEscapeString(world) + "&houseid=" + TibiaDataQueryEscapeString(houseidStr),
+		URL:    "https://www.tibia.com/library/?subtopic=spells&vocation=" + TibiaDataQueryEscapeString(vocationName),
 	}
 
-	tibiaDataRequestHandler(
-		c,
-		tibiadataRequest,
-		func(BoxContentHTML string) (interface{}, error) {
-			return TibiaHousesHouseImpl(houseid, BoxContentHTML, tibiadataRequest.URL)
+	return tibiaDataEndpoint{
+		Name:    "TibiaSpellsOverview",
+		Request: tibiadataRequest,
+		Parse: func(BoxContentHTML string) (interface{}, error) {
+			return TibiaSpellsOverviewImpl(vocationName, BoxContentHTML, tibiadataRequest.URL)
 		},
-		"TibiaHousesHouse")
+	}, nil
 }
 
-// Houses godoc
-// @Summary      List of houses
-// @Description  Show all houses filtered on world and town
-// @Tags         houses
+// Spell godoc
+// @Summary      Show one spell
+// @Description  Show all information about one spell
+// @Tags         spells
 // @Accept       json
 // @Produce      json
-// @Param        world path string true "The world to show" extensions(x-example=Antica)
-// @Param        town  path string true "The town to show" extensions(x-example=Venore)
-// @Success      200  {object}  HousesOverviewResponse
+// @Param        spell_id path string true "The name of spell" extensions(x-example=stronghaste)
+// @Success      200  {object}  SpellInformationResponse
 // @Failure      400  {object}  Information
 // @Failure      404  {object}  Information
 // @Failure      503  {object}  Information
-// @Router       /v4/houses/{world}/{town} [get]
-// TODO: This API needs to be refactored somehow to use tibiaDataRequestHandler
-func tibiaHousesOverview(c *gin.Context) {
+// @Router       /v4/spell/{spell_id} [get]
+func tibiaSpellsSpell(c *gin.Context) {
 	// getting params from URL
-	world := c.Param("world")
-	town := c.Param("town")
+	spellRaw := c.Param("spell_id")
 
-	// Adding fix for First letter to be upper and rest lower
-	world = TibiaDataStringWorldFormatToTitle(world)
-	town = strings.ReplaceAll(TibiaDataStringWorldFormatToTitle(town), "+", " ")
+	endpoint, err := tibiaSpellsSpellEndpoint(spellRaw)
+	tibiaDataEndpointHandler(c, endpoint, err)
+}
 
-	// Check if world exists
-	exists, err := validation.WorldExists(world)
+// tibiaSpellsSpellEndpoint validates the spell and returns the endpoint of the spell
+func tibiaSpellsSpellEndpoint(spellRaw string) (tibiaDataEndpoint, error) {
+	spell, err := validation.IsSpellNameOrFormulaValid(spellRaw)
 	if err != nil {
-		TibiaDataErrorHandler(c, err, 0)
+		return tibiaDataEndpoint{}, err
+	}
+
+	tibiadataRequest := TibiaDataRequestStruct{
+		Method: resty.MethodGet,
+		URL:    "https://www.tibia.com/library/?subtopic=spells&spell=" + spell,
+	}
+
+	return tibiaDataEndpoint{
+		Name:    "TibiaSpellsSpell",
+		Request: tibiadataRequest,
+		Parse: func(BoxContentHTML string) (interface{}, error) {
+			return TibiaSpellsSpellImpl(spell, BoxContentHTML, tibiadataRequest.URL)
+		},
+	}, nil
+}
+
+// Watchlist godoc
+// @Summary      Watched characters
+// @Description  Show all characters on the watchlist with the time of their last poll
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH).
+// @Tags         watchlist
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  WatchlistResponse
+// @Failure      503  {object}  Information
+// @Router       /v4/watchlist [get]
+func tibiaWatchlist(c *gin.Context) {
+	jsonData, err := TibiaWatchlistImpl()
+	if err != nil {
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
 		return
 	}
 
-	if !exists {
-		TibiaDataErrorHandler(c, validation.ErrorWorldDoesNotExist, http.StatusBadRequest)
+	TibiaDataAPIHandleResponse(c, "TibiaWatchlist", jsonData)
+}
+
+// WatchlistAdd godoc
+// @Summary      Watch characters
+// @Description  Add characters to the watchlist, they are polled every TIBIADATA_WATCHLIST_INTERVAL_MINUTES
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH).
+// @Description  Needs the management token (TIBIADATA_MANAGEMENT_TOKEN) in the Authorization header (Bearer <token>).
+// @Tags         watchlist
+// @Accept       json
+// @Produce      json
+// @Param        request body CharactersRequest true "The character names"
+// @Success      200  {object}  WatchlistResponse
+// @Failure      400  {object}  Information
+// @Failure      401  {object}  Information
+// @Failure      403  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/watchlist [post]
+func tibiaWatchlistAdd(c *gin.Context) {
+	var request CharactersRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		TibiaDataErrorHandler(c, validation.ErrorRequestBodyInvalid, http.StatusBadRequest)
 		return
 	}
 
-	// Check if town exists
-	exists, err = validation.TownExists(town)
+	jsonData, err := TibiaWatchlistAddImpl(request.Names, time.Now())
 	if err != nil {
-		TibiaDataErrorHandler(c, err, 0)
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
 		return
 	}
 
-	if !exists {
-		TibiaDataErrorHandler(c, validation.ErrorTownDoesNotExist, http.StatusBadRequest)
+	TibiaDataAPIHandleResponse(c, "TibiaWatchlistAdd", jsonData)
+}
+
+// WatchlistRemove godoc
+// @Summary      Stop watching a character
+// @Description  Remove a character and its history from the watchlist
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH).
+// @Description  Needs the management token (TIBIADATA_MANAGEMENT_TOKEN) in the Authorization header (Bearer <token>).
+// @Tags         watchlist
+// @Accept       json
+// @Produce      json
+// @Param        name path string true "The character name" extensions(x-example=Trollefar)
+// @Success      200  {object}  WatchlistResponse
+// @Failure      400  {object}  Information
+// @Failure      401  {object}  Information
+// @Failure      403  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/watchlist/{name} [delete]
+func tibiaWatchlistRemove(c *gin.Context) {
+	jsonData, err := TibiaWatchlistRemoveImpl(c.Param("name"))
+	if err != nil {
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
 		return
 	}
 
-	// Ab'Dendriel gets formatted as Ab'dendriel by TibiaDataStringWorldFormatToTitle
-	// which makes tibia.com not recognize it and return an empty response.
-	if strings.EqualFold(town, "ab'dendriel") {
-		town = "Ab'Dendriel"
-	}
+	TibiaDataAPIHandleResponse(c, "TibiaWatchlistRemove", jsonData)
+}
 
-	jsonData, err := TibiaHousesOverviewImpl(c, world, town, TibiaDataHTMLDataCollector)
+// WatchlistTimeline godoc
+// @Summary      Timeline of a watched character
+// @Description  Show the snapshots (level, world, guild, residence, former names and account status) and the level history of a watched character
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH). Without parameters the last day is used.
+// @Tags         watchlist
+// @Accept       json
+// @Produce      json
+// @Param        name   path  string true  "The character name" extensions(x-example=Trollefar)
+// @Param        period query string false "The period ending now" Enums(day, week)
+// @Param        from   query string false "The start of the period (RFC 3339 or date)"
+// @Param        to     query string false "The end of the period (RFC 3339 or date)"
+// @Success      200  {object}  WatchlistTimelineResponse
+// @Failure      400  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/watchlist/{name}/timeline [get]
+func tibiaWatchlistTimeline(c *gin.Context) {
+	jsonData, err := TibiaWatchlistTimelineImpl(c.Param("name"), c.Query("from"), c.Query("to"), c.Query("period"))
 	if err != nil {
-		TibiaDataErrorHandler(c, err, 0)
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
 		return
 	}
 
-	// return jsonData
-	TibiaDataAPIHandleResponse(c, "TibiaHousesOverview", jsonData)
+	TibiaDataAPIHandleResponse(c, "TibiaWatchlistTimeline", jsonData)
 }
 
-// Killstatistics godoc
-// @Summary      The killstatistics
-// @Description  Show all killstatistics filtered on world
-// @Tags         killstatistics
+// WatchlistDeaths godoc
+// @Summary      Deaths of a watched character
+// @Description  Show all deaths of a watched character seen since it was added, including the ones tibia.com no longer lists
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH).
+// @Tags         watchlist
 // @Accept       json
 // @Produce      json
-// @Param        world path string true "The world to show" extensions(x-example=Antica)
-// @Success      200  {object}  KillStatisticsResponse
+// @Param        name path string true "The character name" extensions(x-example=Trollefar)
+// @Success      200  {object}  WatchlistDeathsResponse
 // @Failure      400  {object}  Information
-// @Failure      404  {object}  Information
 // @Failure      503  {object}  Information
-// @Router       /v4/killstatistics/{world} [get]
-func tibiaKillstatistics(c *gin.Context) {
-	// getting params from URL
-	world := c.Param("world")
+// @Router       /v4/watchlist/{name}/deaths [get]
+func tibiaWatchlistDeaths(c *gin.Context) {
+	jsonData, err := TibiaWatchlistDeathsImpl(c.Param("name"))
+	if err != nil {
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
+		return
+	}
 
-	// Adding fix for First letter to be upper and rest lower
-	world = TibiaDataStringWorldFormatToTitle(world)
+	TibiaDataAPIHandleResponse(c, "TibiaWatchlistDeaths", jsonData)
+}
 
-	// Check if world exists
-	exists, err := validation.WorldExists(world)
+// GuildTracker godoc
+// @Summary      Tracked guilds
+// @Description  Show all guilds of the guild tracker with the time of their last poll
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH).
+// @Tags         guildtracker
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  GuildTrackerResponse
+// @Failure      503  {object}  Information
+// @Router       /v4/guildtracker [get]
+func tibiaGuildsTracker(c *gin.Context) {
+	jsonData, err := TibiaGuildsTrackerImpl()
 	if err != nil {
-		TibiaDataErrorHandler(c, err, 0)
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
 		return
 	}
 
-	if !exists {
-		TibiaDataErrorHandler(c, validation.ErrorWorldDoesNotExist, http.StatusBadRequest)
+	TibiaDataAPIHandleResponse(c, "TibiaGuildsTracker", jsonData)
+}
+
+// GuildTrackerAdd godoc
+// @Summary      Track guilds
+// @Description  Add guilds to the guild tracker, their members are polled every TIBIADATA_GUILDS_TRACKER_INTERVAL_MINUTES
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH).
+// @Description  Needs the management token (TIBIADATA_MANAGEMENT_TOKEN) in the Authorization header (Bearer <token>).
+// @Tags         guildtracker
+// @Accept       json
+// @Produce      json
+// @Param        request body GuildTrackerRequest true "The guild names"
+// @Success      200  {object}  GuildTrackerResponse
+// @Failure      400  {object}  Information
+// @Failure      401  {object}  Information
+// @Failure      403  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/guildtracker [post]
+func tibiaGuildsTrackerAdd(c *gin.Context) {
+	var request GuildTrackerRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		TibiaDataErrorHandler(c, validation.ErrorRequestBodyInvalid, http.StatusBadRequest)
 		return
 	}
 
-	tibiadataRequest := TibiaDataRequestStruct{
-		Method: resty.MethodGet,
-		URL:    "https://www.tibia.com/community/?subtopic=killstatistics&world=" + TibiaDataQueryEscapeString(world),
+	jsonData, err := TibiaGuildsTrackerAddImpl(request.Names, time.Now())
+	if err != nil {
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
+		return
 	}
 
-	tibiaDataRequestHandler(
-		c,
-		tibiadataRequest,
-		func(BoxContentHTML string) (interface{}, error) {
-			return TibiaKillstatisticsImpl(world, BoxContentHTML, tibiadataRequest.URL)
-		},
-		"TibiaKillstatistics")
+	TibiaDataAPIHandleResponse(c, "TibiaGuildsTrackerAdd", jsonData)
 }
 
-// News archive godoc
-// @Summary      Show news archive (90 days)
-// @Description  Show news archive with a filtering on 90 days
-// @Tags         news
+// GuildTrackerRemove godoc
+// @Summary      Stop tracking a guild
+// @Description  Remove a guild and its history from the guild tracker
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH).
+// @Description  Needs the management token (TIBIADATA_MANAGEMENT_TOKEN) in the Authorization header (Bearer <token>).
+// @Tags         guildtracker
 // @Accept       json
 // @Produce      json
-// @Success      200  {object}  NewsListResponse
+// @Param        name path string true "The name of guild" extensions(x-example=Elysium)
+// @Success      200  {object}  GuildTrackerResponse
 // @Failure      400  {object}  Information
-// @Failure      404  {object}  Information
+// @Failure      401  {object}  Information
+// @Failure      403  {object}  Information
 // @Failure      503  {object}  Information
-// @Router       /v4/news/archive [get]
-func tibiaNewslistArchive() bool {
-	// Not used function.. but required for documentation purpose
-	return false
+// @Router       /v4/guildtracker/{name} [delete]
+func tibiaGuildsTrackerRemove(c *gin.Context) {
+	jsonData, err := TibiaGuildsTrackerRemoveImpl(c.Param("name"))
+	if err != nil {
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
+		return
+	}
+
+	TibiaDataAPIHandleResponse(c, "TibiaGuildsTrackerRemove", jsonData)
 }
 
-// News archive (with day filter) godoc
-// @Summary      Show news archive (with days filter)
-// @Description  Show news archive with a filtering option on days
-// @Tags         news
+// GuildHistory godoc
+// @Summary      Membership history of a tracked guild
+// @Description  Show the members that joined or left a tracked guild and the changes of their ranks and titles
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH). Without parameters the last day is used.
+// @Tags         guildtracker
 // @Accept       json
 // @Produce      json
-// @Param        days path int true "The number of days to show" default(90) minimum(1) extensions(x-example=30)
-// @Success      200  {object}  NewsListResponse
+// @Param        name   path  string true  "The name of guild" extensions(x-example=Elysium)
+// @Param        period query string false "The period ending now" Enums(day, week)
+// @Param        from   query string false "The start of the period (RFC 3339 or date)"
+// @Param        to     query string false "The end of the period (RFC 3339 or date)"
+// @Success      200  {object}  GuildHistoryResponse
 // @Failure      400  {object}  Information
-// @Failure      404  {object}  Information
 // @Failure      503  {object}  Information
-// @Router       /v4/news/archive/{days} [get]
-func tibiaNewslistArchiveDays() bool {
-	// Not used function.. but required for documentation purpose
-	return false
+// @Router       /v4/guildtracker/{name}/history [get]
+func tibiaGuildsHistory(c *gin.Context) {
+	jsonData, err := TibiaGuildsHistoryImpl(c.Param("name"), c.Query("from"), c.Query("to"), c.Query("period"))
+	if err != nil {
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
+		return
+	}
+
+	TibiaDataAPIHandleResponse(c, "TibiaGuildsHistory", jsonData)
 }
 
-// Latest news godoc
-// @Summary      Show newslist (90 days)
-// @Description  Show newslist with filtering on articles and news of last 90 days
-// @Tags         news
+// CharacterGuildHistory godoc
+// @Summary      Guild history of a character
+// @Description  Show all joins, leaves and rank and title changes of a character seen in the tracked guilds
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH).
+// @Tags         guildtracker
+// @Accept       json
+// @Produce      json
+// @Param        name path string true "The character name" extensions(x-example=Trollefar)
+// @Success      200  {object}  CharacterGuildHistoryResponse
+// @Failure      400  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/character/{name}/guildhistory [get]
+func tibiaCharactersGuildHistory(c *gin.Context) {
+	jsonData, err := TibiaCharactersGuildHistoryImpl(c.Param("name"))
+	if err != nil {
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
+		return
+	}
+
+	TibiaDataAPIHandleResponse(c, "TibiaCharactersGuildHistory", jsonData)
+}
+
+// WarTracker godoc
+// @Summary      Tracked wars
+// @Description  Show all wars of the war tracker with the time of their last poll
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH).
+// @Tags         wartracker
 // @Accept       json
 // @Produce      json
-// @Success      200  {object}  NewsListResponse
-// @Failure      400  {object}  Information
-// @Failure      404  {object}  Information
+// @Success      200  {object}  WarTrackerResponse
 // @Failure      503  {object}  Information
-// @Router       /v4/news/latest [get]
-func tibiaNewslistLatest() bool {
-	// Not used function.. but required for documentation purpose
-	return false
+// @Router       /v4/wartracker [get]
+func tibiaGuildsWarTracker(c *gin.Context) {
+	jsonData, err := TibiaGuildsWarTrackerImpl()
+	if err != nil {
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
+		return
+	}
+
+	TibiaDataAPIHandleResponse(c, "TibiaGuildsWarTracker", jsonData)
 }
 
-// News ticker godoc
-// @Summary      Show news tickers (90 days)
-// @Description  Show news of type news tickers of last 90 days
-// @Tags         news
+// WarTrackerAdd godoc
+// @Summary      Track a war
+// @Description  Add a war between two guilds of the same world to the war tracker, the deaths of their members are polled every TIBIADATA_WAR_TRACKER_INTERVAL_MINUTES
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH).
+// @Description  Needs the management token (TIBIADATA_MANAGEMENT_TOKEN) in the Authorization header (Bearer <token>).
+// @Tags         wartracker
 // @Accept       json
 // @Produce      json
-// @Success      200  {object}  NewsListResponse
+// @Param        request body WarTrackerRequest true "The guild names"
+// @Success      200  {object}  WarTrackerResponse
 // @Failure      400  {object}  Information
 // @Failure      404  {object}  Information
+// @Failure      401  {object}  Information
+// @Failure      403  {object}  Information
 // @Failure      503  {object}  Information
-// @Router       /v4/news/newsticker [get]
-func tibiaNewslist(c *gin.Context) {
-	// getting params from URL
-	daysStr := c.Param("days")
-
-	var (
-		days int
-		err  error
-	)
-
-	if daysStr != "" {
-		// convert param to int
-		days, err = strconv.Atoi(daysStr)
-		if err != nil {
-			TibiaDataErrorHandler(c, validation.ErrorStringCanNotBeConvertedToInt, http.StatusBadRequest)
-			return
-		}
+// @Router       /v4/wartracker [post]
+func tibiaGuildsWarTrackerAdd(c *gin.Context) {
+	var request WarTrackerRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		TibiaDataErrorHandler(c, validation.ErrorRequestBodyInvalid, http.StatusBadRequest)
+		return
 	}
 
-	if days == 0 {
-		days = 90 // default for recent posts
+	jsonData, err := TibiaGuildsWarTrackerAddImpl(request, time.Now(), TibiaDataHTMLDataCollector)
+	if err != nil {
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
+		return
 	}
 
-	// generating dates to pass to FormData
-	DateBegin := time.Now().AddDate(0, 0, -days)
-	DateEnd := time.Now()
-
-	tibiadataRequest := TibiaDataRequestStruct{
-		Method: http.MethodPost,
-		URL:    "https://www.tibia.com/news/?subtopic=newsarchive",
-		FormData: map[string]string{
-			"filter_begin_day":   strconv.Itoa(DateBegin.UTC().Day()),        // period
-			"filter_begin_month": strconv.Itoa(int(DateBegin.UTC().Month())), // period
-			"filter_begin_year":  strconv.Itoa(DateBegin.UTC().Year()),       // period
-			"filter_end_day":     strconv.Itoa(DateEnd.UTC().Day()),          // period
-			"filter_end_month":   strconv.Itoa(int(DateEnd.UTC().Month())),   // period
-			"filter_end_year":    strconv.Itoa(DateEnd.UTC().Year()),         // period
-			"filter_cipsoft":     "cipsoft",                                  // category
-			"filter_community":   "community",                                // category
-			"filter_development": "development",                              // category
-			"filter_support":     "support",                                  // category
-			"filter_technical":   "technical",                                // category
-		},
-	}
+	TibiaDataAPIHandleResponse(c, "TibiaGuildsWarTrackerAdd", jsonData)
+}
 
-	if c.Request != nil {
-		// getting type of news list
-		switch tmp := strings.Split(c.Request.URL.Path, "/"); tmp[3] {
-		case "newsticker":
-			tibiadataRequest.FormData["filter_ticker"] = "ticker"
-		case "latest":
-			tibiadataRequest.FormData["filter_article"] = "article"
-			tibiadataRequest.FormData["filter_news"] = "news"
-		case "archive":
-			tibiadataRequest.FormData["filter_ticker"] = "ticker"
-			tibiadataRequest.FormData["filter_article"] = "article"
-			tibiadataRequest.FormData["filter_news"] = "news"
-		}
+// WarTrackerRemove godoc
+// @Summary      Stop tracking a war
+// @Description  Remove a war and its kills from the war tracker
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH).
+// @Description  Needs the management token (TIBIADATA_MANAGEMENT_TOKEN) in the Authorization header (Bearer <token>).
+// @Tags         wartracker
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "The id of the war" extensions(x-example=1)
+// @Success      200  {object}  WarTrackerResponse
+// @Failure      400  {object}  Information
+// @Failure      401  {object}  Information
+// @Failure      403  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/wartracker/{id} [delete]
+func tibiaGuildsWarTrackerRemove(c *gin.Context) {
+	jsonData, err := TibiaGuildsWarTrackerRemoveImpl(c.Param("id"))
+	if err != nil {
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
+		return
 	}
 
-	tibiaDataRequestHandler(
-		c,
-		tibiadataRequest,
-		func(BoxContentHTML string) (interface{}, error) {
-			return TibiaNewslistImpl(days, BoxContentHTML, tibiadataRequest.URL)
-		},
-		"TibiaNewslist")
+	TibiaDataAPIHandleResponse(c, "TibiaGuildsWarTrackerRemove", jsonData)
 }
 
-// News entry godoc
-// @Summary      Show one news entry
-// @Description  Show one news entry
-// @Tags         news
+// WarKills godoc
+// @Summary      Kill feed of a tracked war
+// @Description  Show the members of both guilds killed by the other guild with their killers and assists
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH). Without parameters the last day is used.
+// @Tags         wartracker
 // @Accept       json
 // @Produce      json
-// @Param        news_id path int true "The ID of news entry" extensions(x-example=6512)
-// @Success      200  {object}  NewsResponse
+// @Param        id     path  int    true  "The id of the war" extensions(x-example=1)
+// @Param        period query string false "The period ending now" Enums(day, week)
+// @Param        from   query string false "The start of the period (RFC 3339 or date)"
+// @Param        to     query string false "The end of the period (RFC 3339 or date)"
+// @Success      200  {object}  WarKillsResponse
 // @Failure      400  {object}  Information
-// @Failure      404  {object}  Information
 // @Failure      503  {object}  Information
-// @Router       /v4/news/id/{news_id} [get]
-func tibiaNews(c *gin.Context) {
-	// getting params from URL
-	newsIDStr := c.Param("news_id")
-
-	// convert param to int
-	newsID, err := strconv.Atoi(newsIDStr)
+// @Router       /v4/wartracker/{id}/kills [get]
+func tibiaGuildsWarKills(c *gin.Context) {
+	jsonData, err := TibiaGuildsWarKillsImpl(c.Param("id"), c.Query("from"), c.Query("to"), c.Query("period"))
 	if err != nil {
-		TibiaDataErrorHandler(c, validation.ErrorStringCanNotBeConvertedToInt, http.StatusBadRequest)
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
 		return
 	}
 
-	// checking the NewsID provided
-	err = validation.IsNewsIDValid(newsID)
+	TibiaDataAPIHandleResponse(c, "TibiaGuildsWarKills", jsonData)
+}
+
+// WarScoreboard godoc
+// @Summary      Scoreboard of a tracked war
+// @Description  Show the kills and deaths of both guilds and of their characters since the war was added
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH).
+// @Tags         wartracker
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "The id of the war" extensions(x-example=1)
+// @Success      200  {object}  WarScoreboardResponse
+// @Failure      400  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/wartracker/{id}/scoreboard [get]
+func tibiaGuildsWarScoreboard(c *gin.Context) {
+	jsonData, err := TibiaGuildsWarScoreboardImpl(c.Param("id"))
 	if err != nil {
-		TibiaDataErrorHandler(c, err, http.StatusBadRequest)
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
 		return
 	}
 
-	tibiadataRequest := TibiaDataRequestStruct{
-		Method: resty.MethodGet,
-		URL:    "https://www.tibia.com/news/?subtopic=newsarchive&id=" + newsIDStr,
+	TibiaDataAPIHandleResponse(c, "TibiaGuildsWarScoreboard", jsonData)
+}
+
+// Webhooks godoc
+// @Summary      Webhooks
+// @Description  Show all webhooks (without their secrets)
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH).
+// @Description  Needs the management token (TIBIADATA_MANAGEMENT_TOKEN) in the Authorization header (Bearer <token>).
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  WebhooksResponse
+// @Failure      401  {object}  Information
+// @Failure      403  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/webhooks [get]
+func tibiaWebhooks(c *gin.Context) {
+	jsonData, err := TibiaWebhooksImpl()
+	if err != nil {
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
+		return
 	}
 
-	tibiaDataRequestHandler(
-		c,
-		tibiadataRequest,
-		func(BoxContentHTML string) (interface{}, error) {
-			return TibiaNewsImpl(newsID, tibiadataRequest.URL, BoxContentHTML)
-		},
-		"TibiaNews")
+	TibiaDataAPIHandleResponse(c, "TibiaWebhooks", jsonData)
 }
 
-// Spells godoc
-// @Summary      List all spells
-// @Description  Show all spells
-// @Tags         spells
+// WebhooksAdd godoc
+// @Summary      Add a webhook
+// @Description  Add a webhook that events of watched characters, guilds and houses are posted to
+// @Description  The events are signed with the secret (X-TibiaData-Signature: sha256=<hex HMAC-SHA256 of the body>), which is only returned here.
+// @Description  Urls whose host resolves to a loopback (unless TIBIADATA_WEBHOOKS_ALLOW_LOOPBACK is set), private, link-local, unspecified, 0.0.0.0/8 or carrier-grade NAT (100.64.0.0/10) address are refused.
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH).
+// @Description  Needs the management token (TIBIADATA_MANAGEMENT_TOKEN) in the Authorization header (Bearer <token>).
+// @Tags         webhooks
 // @Accept       json
 // @Produce      json
-// @Success      200  {object}  SpellsOverviewResponse
+// @Param        request body WebhookRequest true "The url and the events of the webhook"
+// @Success      200  {object}  WebhookResponse
 // @Failure      400  {object}  Information
-// @Failure      404  {object}  Information
+// @Failure      401  {object}  Information
+// @Failure      403  {object}  Information
 // @Failure      503  {object}  Information
-// @Router       /v4/spells [get]
-func tibiaSpellsOverview(c *gin.Context) {
-	// getting params from URL
-	vocation := c.Param("vocation")
-	if vocation == "" {
-		vocation = TibiaDataDefaultVoc
+// @Router       /v4/webhooks [post]
+func tibiaWebhooksAdd(c *gin.Context) {
+	var request WebhookRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		TibiaDataErrorHandler(c, validation.ErrorRequestBodyInvalid, http.StatusBadRequest)
+		return
 	}
 
-	err := validation.IsVocationValid(vocation)
+	jsonData, err := TibiaWebhooksAddImpl(request, time.Now())
 	if err != nil {
-		TibiaDataErrorHandler(c, err, 0)
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
 		return
 	}
 
-	// Sanitize of vocation input
-	vocationName, _ := TibiaDataVocationValidator(vocation)
-	if vocationName == "all" || vocationName == "none" {
-		vocationName = ""
-	} else {
-		// removes the last letter (s) from the string (required for spells page)
-		vocationName = strings.TrimSuffix(vocationName, "s")
-		vocationName = cases.Title(language.English).String(vocationName)
-	}
+	TibiaDataAPIHandleResponse(c, "TibiaWebhooksAdd", jsonData)
+}
 
-	tibiadataRequest := TibiaDataRequestStruct{
-		Method: resty.MethodGet,
-		URL:    "https://www.tibia.com/library/?subtopic=spells&vocation=" + TibiaDataQueryEscapeString(vocationName),
+// WebhooksRemove godoc
+// @Summary      Remove a webhook
+// @Description  Remove a webhook and its delivery log
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH).
+// @Description  Needs the management token (TIBIADATA_MANAGEMENT_TOKEN) in the Authorization header (Bearer <token>).
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "The ID of the webhook" extensions(x-example=1)
+// @Success      200  {object}  WebhooksResponse
+// @Failure      400  {object}  Information
+// @Failure      401  {object}  Information
+// @Failure      403  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/webhooks/{id} [delete]
+func tibiaWebhooksRemove(c *gin.Context) {
+	jsonData, err := TibiaWebhooksRemoveImpl(c.Param("id"))
+	if err != nil {
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
+		return
 	}
 
-	tibiaDataRequestHandler(
-		c,
-		tibiadataRequest,
-		func(BoxContentHTML string) (interface{}, error) {
-			return TibiaSpellsOverviewImpl(vocationName, BoxContentHTML, tibiadataRequest.URL)
-		},
-		"TibiaSpellsOverview")
+	TibiaDataAPIHandleResponse(c, "TibiaWebhooksRemove", jsonData)
 }
 
-// Spell godoc
-// @Summary      Show one spell
-// @Description  Show all information about one spell
-// @Tags         spells
+// WebhooksDeliveries godoc
+// @Summary      Delivery log of a webhook
+// @Description  Show the deliveries of a webhook with their attempts and errors, the newest first
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH).
+// @Description  Needs the management token (TIBIADATA_MANAGEMENT_TOKEN) in the Authorization header (Bearer <token>).
+// @Tags         webhooks
 // @Accept       json
 // @Produce      json
-// @Param        spell_id path string true "The name of spell" extensions(x-example=stronghaste)
-// @Success      200  {object}  SpellInformationResponse
+// @Param        id     path  int    true  "The ID of the webhook" extensions(x-example=1)
+// @Param        status query string false "The status of the deliveries" Enums(pending, delivered, failed)
+// @Success      200  {object}  WebhookDeliveriesResponse
 // @Failure      400  {object}  Information
-// @Failure      404  {object}  Information
+// @Failure      401  {object}  Information
+// @Failure      403  {object}  Information
 // @Failure      503  {object}  Information
-// @Router       /v4/spell/{spell_id} [get]
-func tibiaSpellsSpell(c *gin.Context) {
-	// getting params from URL
-	spellRaw := c.Param("spell_id")
-
-	spell, err := validation.IsSpellNameOrFormulaValid(spellRaw)
+// @Router       /v4/webhooks/{id}/deliveries [get]
+func tibiaWebhooksDeliveries(c *gin.Context) {
+	jsonData, err := TibiaWebhooksDeliveriesImpl(c.Param("id"), c.Query("status"))
 	if err != nil {
-		TibiaDataErrorHandler(c, err, 0)
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
 		return
 	}
 
-	tibiadataRequest := TibiaDataRequestStruct{
-		Method: resty.MethodGet,
-		URL:    "https://www.tibia.com/library/?subtopic=spells&spell=" + spell,
-	}
-
-	tibiaDataRequestHandler(
-		c,
-		tibiadataRequest,
-		func(BoxContentHTML string) (interface{}, error) {
-			return TibiaSpellsSpellImpl(spell, BoxContentHTML, tibiadataRequest.URL)
-		},
-		"TibiaSpellsSpell")
+	TibiaDataAPIHandleResponse(c, "TibiaWebhooksDeliveries", jsonData)
 }
 
 // Worlds godoc
@@ -1025,18 +2357,23 @@ func tibiaSpellsSpell(c *gin.Context) {
 // @Failure      503  {object}  Information
 // @Router       /v4/worlds [get]
 func tibiaWorldsOverview(c *gin.Context) {
+	tibiaDataEndpointHandler(c, tibiaWorldsOverviewEndpoint(), nil)
+}
+
+// tibiaWorldsOverviewEndpoint returns the endpoint of the worlds overview
+func tibiaWorldsOverviewEndpoint() tibiaDataEndpoint {
 	tibiadataRequest := TibiaDataRequestStruct{
 		Method: resty.MethodGet,
 		URL:    "https://www.tibia.com/community/?subtopic=worlds",
 	}
 
-	tibiaDataRequestHandler(
-		c,
-		tibiadataRequest,
-		func(BoxContentHTML string) (interface{}, error) {
+	return tibiaDataEndpoint{
+		Name:    "TibiaWorldsOverview",
+		Request: tibiadataRequest,
+		Parse: func(BoxContentHTML string) (interface{}, error) {
 			return TibiaWorldsOverviewImpl(BoxContentHTML, tibiadataRequest.URL)
 		},
-		"TibiaWorldsOverview")
+	}
 }
 
 // World godoc
@@ -1055,19 +2392,111 @@ func tibiaWorldsWorld(c *gin.Context) {
 	// getting params from URL
 	world := c.Param("name")
 
+	endpoint, err := tibiaWorldsWorldEndpoint(world)
+	tibiaDataEndpointHandler(c, endpoint, err)
+}
+
+// WorldStream godoc
+// @Summary      Stream of the online players of a world
+// @Description  Stream the logins, logouts and level changes of the online players of one world as server-sent events
+// @Description  The world is polled once for all clients. Reconnecting clients resume with the Last-Event-ID header (or the last_event_id parameter), others start with a snapshot of all online players.
+// @Tags         worlds
+// @Produce      text/event-stream
+// @Param        name          path  string true  "The name of world" extensions(x-example=Antica)
+// @Param        last_event_id query string false "The ID of the last received event"
+// @Success      200  {object}  WorldStreamEvent
+// @Failure      400  {object}  Information
+// @Router       /v4/world/{name}/stream [get]
+func tibiaWorldsWorldStream(c *gin.Context) {
+	world := c.Param("name")
+
+	endpoint, err := tibiaWorldsWorldEndpoint(world)
+	if err != nil {
+		TibiaDataErrorHandler(c, err, 0)
+		return
+	}
+
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("last_event_id")
+	}
+
+	events, unsubscribe := tibiaWorldStreamSubscribe(TibiaDataStringWorldFormatToTitle(world), tibiaWorldStreamFetch(endpoint, TibiaDataHTMLDataCollector), lastEventID, time.Now())
+	defer unsubscribe()
+
+	tibiaWorldStreamSSE(c.Request.Context(), c.Writer, events)
+}
+
+// WorldWebSocket godoc
+// @Summary      WebSocket of the online players of a world
+// @Description  Stream the logins, logouts and level changes of the online players of one world as json messages over a WebSocket
+// @Description  The world is polled once for all clients. Reconnecting clients resume with the last_event_id parameter, others start with a snapshot of all online players.
+// @Tags         worlds
+// @Produce      json
+// @Param        name          path  string true  "The name of world" extensions(x-example=Antica)
+// @Param        last_event_id query string false "The ID of the last received event"
+// @Success      101  {object}  WorldStreamEvent
+// @Failure      400  {object}  Information
+// @Router       /v4/world/{name}/ws [get]
+func tibiaWorldsWorldWebSocket(c *gin.Context) {
+	world := c.Param("name")
+
+	endpoint, err := tibiaWorldsWorldEndpoint(world)
+	if err != nil {
+		TibiaDataErrorHandler(c, err, 0)
+		return
+	}
+
+	conn, err := tibiaWorldStreamUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// the upgrader responded with the error already
+		return
+	}
+
+	events, unsubscribe := tibiaWorldStreamSubscribe(TibiaDataStringWorldFormatToTitle(world), tibiaWorldStreamFetch(endpoint, TibiaDataHTMLDataCollector), c.Query("last_event_id"), time.Now())
+	defer unsubscribe()
+
+	tibiaWorldStreamWebSocket(conn, events)
+}
+
+// WorldOnlineDiff godoc
+// @Summary      Online changes of a tracked world
+// @Description  Show the players who logged in, logged out and levelled between the online snapshots of a tracked world, and the sessions whose login and logout were both seen
+// @Description  Needs the persistence store (TIBIADATA_STORE_PATH) and the world in TIBIADATA_WORLDS_TRACKED. Without parameters the last day is used.
+// @Tags         worlds
+// @Accept       json
+// @Produce      json
+// @Param        name   path  string true  "The name of world" extensions(x-example=Antica)
+// @Param        period query string false "The period ending now" Enums(day, week)
+// @Param        from   query string false "The start of the period (RFC 3339 or date)"
+// @Param        to     query string false "The end of the period (RFC 3339 or date)"
+// @Success      200  {object}  WorldOnlineDiffResponse
+// @Failure      400  {object}  Information
+// @Failure      503  {object}  Information
+// @Router       /v4/world/{name}/online/diff [get]
+func tibiaWorldsOnlineDiff(c *gin.Context) {
+	jsonData, err := TibiaWorldOnlineDiffImpl(c.Param("name"), c.Query("from"), c.Query("to"), c.Query("period"))
+	if err != nil {
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
+		return
+	}
+
+	TibiaDataAPIHandleResponse(c, "TibiaWorldOnlineDiff", jsonData)
+}
+
+// tibiaWorldsWorldEndpoint validates the world and returns the endpoint of the world
+func tibiaWorldsWorldEndpoint(world string) (tibiaDataEndpoint, error) {
 	// Adding fix for First letter to be upper and rest lower
 	world = TibiaDataStringWorldFormatToTitle(world)
 
 	// Check if world exists
 	exists, err := validation.WorldExists(world)
 	if err != nil {
-		TibiaDataErrorHandler(c, err, 0)
-		return
+		return tibiaDataEndpoint{}, err
 	}
 
 	if !exists {
-		TibiaDataErrorHandler(c, validation.ErrorWorldDoesNotExist, http.StatusBadRequest)
-		return
+		return tibiaDataEndpoint{}, validation.ErrorWorldDoesNotExist
 	}
 
 	tibiadataRequest := TibiaDataRequestStruct{
@@ -1075,13 +2504,21 @@ func tibiaWorldsWorld(c *gin.Context) {
 		URL:    "https://www.tibia.com/community/?subtopic=worlds&world=" + TibiaDataQueryEscapeString(world),
 	}
 
-	tibiaDataRequestHandler(
-		c,
-		tibiadataRequest,
-		func(BoxContentHTML string) (interface{}, error) {
+	return tibiaDataEndpoint{
+		Name:    "TibiaWorldsWorld",
+		Request: tibiadataRequest,
+		Parse: func(BoxContentHTML string) (interface{}, error) {
 			return TibiaWorldsWorldImpl(world, BoxContentHTML, tibiadataRequest.URL)
 		},
-		"TibiaWorldsWorld")
+	}, nil
+}
+
+// tibiaDataManagementAuth aborts requests to endpoints managing the store without the management token
+func tibiaDataManagementAuth(c *gin.Context) {
+	if err := tibiaDataManagementAuthorize(c.GetHeader("Authorization")); err != nil {
+		TibiaDataErrorHandler(c, err, tibiaDataStoreHTTPCode(err))
+		c.Abort()
+	}
 }
 
 func TibiaDataErrorHandler(c *gin.Context, err error, httpCode int) {
@@ -1089,6 +2526,26 @@ func TibiaDataErrorHandler(c *gin.Context, err error, httpCode int) {
 		panic(errors.New("TibiaDataErrorHandler called with nil err"))
 	}
 
+	info := TibiaDataErrorInformation(err, httpCode)
+	httpCode = info.Status.HTTPCode
+
+	var output OutInformation
+	output.Information = info
+
+	// errors are written in the requested binary format, all others get json
+	switch format, _ := TibiaDataResponseFormat(c); format {
+	case TibiaDataFormatMsgpack, TibiaDataFormatProtobuf:
+		if TibiaDataRender(c, httpCode, format, output, output) == nil {
+			return
+		}
+	}
+
+	c.JSON(httpCode, output)
+}
+
+// TibiaDataErrorInformation func - returns the Information of an error
+// An httpCode of 0 lets the code of a validation.Error decide on the status
+func TibiaDataErrorInformation(err error, httpCode int) Information {
 	info := Information{
 		APIDetails: TibiaDataAPIDetails,
 		Timestamp:  TibiaDataDatetime(""),
@@ -1127,10 +2584,7 @@ func TibiaDataErrorHandler(c *gin.Context, err error, httpCode int) {
 		log.Printf("[TibiaDataErrorHandler] HTTPCode: %d], Message: %s", info.Status.HTTPCode, info.Status.Message)
 	}
 
-	var output OutInformation
-	output.Information = info
-
-	c.JSON(httpCode, output)
+	return info
 }
 
 func tibiaDataRequestHandler(c *gin.Context, tibiaDataRequest TibiaDataRequestStruct, requestHandler func(string) (interface{}, error), handlerName string) {
@@ -1151,6 +2605,16 @@ func tibiaDataRequestHandler(c *gin.Context, tibiaDataRequest TibiaDataRequestSt
 	TibiaDataAPIHandleResponse(c, handlerName, jsonData)
 }
 
+// tibiaDataEndpointHandler handles the request of an endpoint or the error of its validation
+func tibiaDataEndpointHandler(c *gin.Context, endpoint tibiaDataEndpoint, err error) {
+	if err != nil {
+		TibiaDataErrorHandler(c, err, 0)
+		return
+	}
+
+	tibiaDataRequestHandler(c, endpoint.Request, endpoint.Parse, endpoint.Name)
+}
+
 // TibiaDataAPIHandleResponse func - handling of responses..
 // This should NOT be invoked if an error occured
 func TibiaDataAPIHandleResponse(c *gin.Context, s string, j interface{}) {
@@ -1164,12 +2628,37 @@ func TibiaDataAPIHandleResponse(c *gin.Context, s string, j interface{}) {
 		}
 	}
 
-	if TibiaDataDebug {
-		log.Println("[info] " + s + " - (" + c.Request.RequestURI + ") executed successfully.")
+	// check which output format was requested
+	format, err := TibiaDataResponseFormat(c)
+	if err != nil {
+		TibiaDataErrorHandler(c, err, http.StatusNotAcceptable)
+		return
+	}
+
+	// reduce the output to the requested fields
+	data := j
+	if fields := c.Query("fields"); fields != "" {
+		data, err = TibiaDataFilterFields(j, fields)
+		if err != nil {
+			TibiaDataErrorHandler(c, err, http.StatusBadRequest)
+			return
+		}
 	}
 
 	// return successful response
-	c.JSON(http.StatusOK, j)
+	err = TibiaDataRender(c, http.StatusOK, format, j, data)
+	if err != nil {
+		if errors.Is(err, validation.ErrorFormatNotSupported) {
+			TibiaDataErrorHandler(c, err, http.StatusNotAcceptable)
+		} else {
+			TibiaDataErrorHandler(c, err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	if TibiaDataDebug {
+		log.Println("[info] " + s + " - (" + c.Request.RequestURI + ") executed successfully as " + format + ".")
+	}
 }
 
 // TibiadataUserAgentGenerator func - creates User-Agent for requests
@@ -1197,6 +2686,12 @@ func TibiaDataUserAgentGenerator(version int) string {
 
 // TibiaDataHTMLDataCollector func
 func TibiaDataHTMLDataCollector(TibiaDataRequest TibiaDataRequestStruct) (string, error) {
+	// Wait for a free slot if the concurrent requests to tibia.com are limited
+	if TibiaDataUpstreamLimiter != nil {
+		TibiaDataUpstreamLimiter <- struct{}{}
+		defer func() { <-TibiaDataUpstreamLimiter }()
+	}
+
 	// Setting up resty client
 	client := resty.New()
 